@@ -103,6 +103,10 @@ func (b *BaseRunner) HandleProviderExecutionError(
 	})
 }
 
+// processWaitDelay bounds how long cmd.Wait blocks on I/O after the process
+// has been killed by context cancellation.
+const processWaitDelay = 5 * time.Second
+
 // ProcessTerminator is an interface for executors that support process termination.
 type ProcessTerminator interface {
 	TerminateProcess() error
@@ -58,6 +58,9 @@ func (e *DefaultExecutor) Execute(_ context.Context, cmd *exec.Cmd) ([]byte, []b
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	// Run in its own process group so cancellation reaches helper processes
+	configureProcessGroup(cmd)
+
 	// Start the command
 	if err := cmd.Start(); err != nil {
 		return nil, nil, err
@@ -94,7 +97,7 @@ func (e *DefaultExecutor) TerminateProcess() error {
 	}
 
 	// First try SIGTERM for graceful shutdown
-	if err := proc.Signal(syscall.SIGTERM); err != nil {
+	if err := signalProcessTree(proc, syscall.SIGTERM); err != nil {
 		// Process may have already exited
 		if errors.Is(err, os.ErrProcessDone) {
 			return nil
@@ -133,7 +136,7 @@ func (e *DefaultExecutor) TerminateProcess() error {
 
 	// Only kill if same process is still running
 	if currentProc != nil && currentProc.Pid == proc.Pid {
-		_ = proc.Kill() // Best effort, ignore errors
+		_ = killProcessTree(proc) // Best effort, ignore errors
 	}
 
 	return nil
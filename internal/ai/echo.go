@@ -0,0 +1,85 @@
+// Package ai provides AI runner implementations for different providers.
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// EchoFileName is the file the mock agent edits in the working directory.
+const EchoFileName = "ATLAS_MOCK.md"
+
+// EchoRunner is the runner behind the built-in mock agent. Instead of calling
+// a provider it appends the prompt's first line to EchoFileName in the request's
+// working directory and returns fixed turn and cost metadata, so a task can
+// run end-to-end offline. The same prompt always produces the same edit.
+type EchoRunner struct{}
+
+// NewEchoRunner creates a runner for the mock agent.
+func NewEchoRunner() *EchoRunner {
+	return &EchoRunner{}
+}
+
+// Run records the prompt in EchoFileName and reports the file as changed.
+func (r *EchoRunner) Run(ctx context.Context, req *domain.AIRequest) (*domain.AIResult, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w: request", atlaserrors.ErrEmptyValue)
+	}
+
+	summary := echoSummary(req.Prompt)
+	sum := sha256.Sum256([]byte(req.Prompt))
+	sessionID := "mock-" + hex.EncodeToString(sum[:4])
+
+	result := &domain.AIResult{
+		Success:   true,
+		Output:    fmt.Sprintf("Mock agent recorded the request in %s: %s", EchoFileName, summary),
+		SessionID: sessionID,
+		NumTurns:  1,
+	}
+
+	if req.WorkingDir == "" {
+		return result, nil
+	}
+
+	path := filepath.Join(req.WorkingDir, EchoFileName)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, constants.WorkspaceFilePerm) //#nosec G304 -- path is the task's own worktree
+	if err != nil {
+		return nil, fmt.Errorf("mock agent failed to open %s: %w", EchoFileName, err)
+	}
+	if _, err := fmt.Fprintf(f, "- %s (%s)\n", summary, sessionID); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("mock agent failed to write %s: %w", EchoFileName, err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("mock agent failed to write %s: %w", EchoFileName, err)
+	}
+
+	result.FilesChanged = []string{EchoFileName}
+	return result, nil
+}
+
+// echoSummary returns the prompt's first non-empty line, or a placeholder.
+func echoSummary(prompt string) string {
+	for _, line := range strings.Split(prompt, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return "(empty prompt)"
+}
+
+// Compile-time check that EchoRunner implements Runner.
+var _ Runner = (*EchoRunner)(nil)
@@ -0,0 +1,63 @@
+package ai
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+func TestEchoRunner_Run(t *testing.T) {
+	t.Run("appends the prompt summary to the mock file", func(t *testing.T) {
+		dir := t.TempDir()
+		runner := NewEchoRunner()
+		req := &domain.AIRequest{
+			Agent:      domain.AgentMock,
+			Prompt:     "\n  Fix the login bug\nmore detail",
+			Model:      domain.MockModel,
+			WorkingDir: dir,
+		}
+
+		result, err := runner.Run(context.Background(), req)
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, 1, result.NumTurns)
+		assert.Zero(t, result.TotalCostUSD)
+		assert.Equal(t, []string{EchoFileName}, result.FilesChanged)
+		assert.Contains(t, result.Output, "Fix the login bug")
+
+		data, err := os.ReadFile(filepath.Join(dir, EchoFileName))
+		require.NoError(t, err)
+		assert.Equal(t, "- Fix the login bug ("+result.SessionID+")\n", string(data))
+	})
+
+	t.Run("is deterministic for the same prompt", func(t *testing.T) {
+		runner := NewEchoRunner()
+		req := &domain.AIRequest{Prompt: "same prompt"}
+
+		first, err := runner.Run(context.Background(), req)
+		require.NoError(t, err)
+		second, err := runner.Run(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, first.SessionID, second.SessionID)
+		assert.Equal(t, first.Output, second.Output)
+		assert.Empty(t, first.FilesChanged, "no working dir means no edit")
+	})
+
+	t.Run("returns error for nil request", func(t *testing.T) {
+		_, err := NewEchoRunner().Run(context.Background(), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("returns context error when canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewEchoRunner().Run(ctx, &domain.AIRequest{Prompt: "x"})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
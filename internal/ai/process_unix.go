@@ -0,0 +1,52 @@
+//go:build unix
+
+package ai
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// configureProcessGroup starts the command in its own process group and, for
+// commands created with exec.CommandContext, replaces the default context
+// cancellation (which only kills the direct child) with a kill of the whole
+// group. AI CLIs spawn helper processes that would otherwise outlive the run
+// and keep the output pipes open.
+func configureProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+
+	if cmd.Cancel != nil {
+		cmd.Cancel = func() error {
+			return killProcessTree(cmd.Process)
+		}
+	}
+	if cmd.WaitDelay == 0 {
+		cmd.WaitDelay = processWaitDelay
+	}
+}
+
+// signalProcessTree sends sig to the process group led by proc.
+// Falls back to signaling proc directly if it does not lead a group.
+func signalProcessTree(proc *os.Process, sig syscall.Signal) error {
+	if proc == nil {
+		return nil
+	}
+	if err := syscall.Kill(-proc.Pid, sig); err == nil {
+		return nil
+	}
+	return proc.Signal(sig)
+}
+
+// killProcessTree forcibly kills the process group led by proc.
+func killProcessTree(proc *os.Process) error {
+	err := signalProcessTree(proc, syscall.SIGKILL)
+	if errors.Is(err, os.ErrProcessDone) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+//go:build unix
+
+package ai
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// startBackgroundChildScript returns a shell script that spawns a long-running
+// grandchild, records its PID in pidFile, and waits on it.
+func startBackgroundChildScript(pidFile string) string {
+	return "sleep 30 & echo $! > " + pidFile + "; wait"
+}
+
+// waitForPIDFile polls until the script has written the grandchild PID.
+func waitForPIDFile(t *testing.T, pidFile string) int {
+	t.Helper()
+
+	var pid int
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(pidFile) //#nosec G304 -- test temp file
+		if err != nil {
+			return false
+		}
+		parsed, err := strconv.Atoi(strings.TrimSpace(string(data)))
+		if err != nil {
+			return false
+		}
+		pid = parsed
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return pid
+}
+
+// processGone reports whether pid has exited. Zombies awaiting reaping count as gone.
+func processGone(pid int) bool {
+	if err := syscall.Kill(pid, 0); err != nil {
+		return true
+	}
+	data, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat")) //#nosec G304 -- procfs path
+	if err != nil {
+		return false
+	}
+	// Format: pid (comm) state ...
+	fields := strings.Fields(string(data[strings.LastIndexByte(string(data), ')')+1:]))
+	return len(fields) > 0 && fields[0] == "Z"
+}
+
+func TestExecutors_CancelKillsProcessGroup(t *testing.T) {
+	t.Parallel()
+
+	executors := map[string]func() CommandExecutor{
+		"default":   func() CommandExecutor { return &DefaultExecutor{} },
+		"streaming": func() CommandExecutor { return NewStreamingExecutor(ActivityOptions{}) },
+	}
+
+	for name, newExecutor := range executors {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pidFile := filepath.Join(t.TempDir(), "child.pid")
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cmd := exec.CommandContext(ctx, "sh", "-c", startBackgroundChildScript(pidFile))
+
+			done := make(chan error, 1)
+			go func() {
+				_, _, err := newExecutor().Execute(ctx, cmd)
+				done <- err
+			}()
+
+			childPID := waitForPIDFile(t, pidFile)
+			cancel()
+
+			select {
+			case err := <-done:
+				require.Error(t, err)
+			case <-time.After(processWaitDelay + 2*time.Second):
+				t.Fatal("Execute did not return promptly after cancellation")
+			}
+
+			assert.Eventually(t, func() bool {
+				return processGone(childPID)
+			}, 2*time.Second, 20*time.Millisecond, "grandchild process %d still running", childPID)
+		})
+	}
+}
+
+func TestClaudeCodeRunner_Run_CancelKillsProcessGroup(t *testing.T) {
+	EnsureNoRealAPIKeys(t)
+
+	binDir := t.TempDir()
+	pidFile := filepath.Join(binDir, "child.pid")
+	script := "#!/bin/sh\n" + startBackgroundChildScript(pidFile) + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "claude"), []byte(script), 0o700)) //#nosec G306 -- test script must be executable
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	runner := NewClaudeCodeRunner(&config.AIConfig{Model: "sonnet", Timeout: time.Minute}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := &domain.AIRequest{
+		Prompt: "test prompt",
+		Model:  "sonnet",
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := runner.Run(ctx, req)
+		done <- err
+	}()
+
+	childPID := waitForPIDFile(t, pidFile)
+	cancel()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(processWaitDelay + 2*time.Second):
+		t.Fatal("Run did not return promptly after cancellation")
+	}
+
+	assert.Eventually(t, func() bool {
+		return processGone(childPID)
+	}, 2*time.Second, 20*time.Millisecond, "grandchild process %d still running", childPID)
+}
@@ -0,0 +1,50 @@
+//go:build windows
+
+package ai
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// configureProcessGroup replaces the default context cancellation (which only
+// kills the direct child) with taskkill /T, terminating the whole process tree.
+// AI CLIs spawn helper processes that would otherwise outlive the run and keep
+// the output pipes open.
+func configureProcessGroup(cmd *exec.Cmd) {
+	if cmd.Cancel != nil {
+		cmd.Cancel = func() error {
+			return killProcessTree(cmd.Process)
+		}
+	}
+	if cmd.WaitDelay == 0 {
+		cmd.WaitDelay = processWaitDelay
+	}
+}
+
+// signalProcessTree signals proc directly; Windows has no process group signals.
+func signalProcessTree(proc *os.Process, sig syscall.Signal) error {
+	if proc == nil {
+		return nil
+	}
+	return proc.Signal(sig)
+}
+
+// killProcessTree forcibly terminates proc and all of its descendants.
+func killProcessTree(proc *os.Process) error {
+	if proc == nil {
+		return nil
+	}
+	//#nosec G204 -- PID is an integer from a process we started
+	kill := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(proc.Pid))
+	if err := kill.Run(); err != nil {
+		// Fall back to killing the direct child
+		if killErr := proc.Kill(); killErr != nil && !errors.Is(killErr, os.ErrProcessDone) {
+			return killErr
+		}
+	}
+	return nil
+}
@@ -95,6 +95,9 @@ func (e *StreamingExecutor) Execute(ctx context.Context, cmd *exec.Cmd) ([]byte,
 		return nil, nil, err
 	}
 
+	// Run in its own process group so cancellation reaches helper processes
+	configureProcessGroup(cmd)
+
 	// Start the command
 	if startErr := cmd.Start(); startErr != nil {
 		return nil, nil, startErr
@@ -181,7 +184,7 @@ func (e *StreamingExecutor) TerminateProcess() error {
 	}
 
 	// First try SIGTERM for graceful shutdown
-	if err := proc.Signal(syscall.SIGTERM); err != nil {
+	if err := signalProcessTree(proc, syscall.SIGTERM); err != nil {
 		// Process may have already exited
 		if errors.Is(err, os.ErrProcessDone) {
 			return nil
@@ -220,7 +223,7 @@ func (e *StreamingExecutor) TerminateProcess() error {
 
 	// Only kill if same process is still running
 	if currentProc != nil && currentProc.Pid == proc.Pid {
-		_ = proc.Kill() // Best effort, ignore errors
+		_ = killProcessTree(proc) // Best effort, ignore errors
 	}
 
 	return nil
@@ -8,7 +8,6 @@ import (
 	"io"
 	"os"
 
-	"github.com/charmbracelet/huh"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 
@@ -27,13 +26,19 @@ func AddAbandonCommand(root *cobra.Command) {
 
 // newAbandonCmd creates the abandon command.
 func newAbandonCmd() *cobra.Command {
-	var force bool
+	var (
+		force bool
+		yes   bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "abandon <workspace>",
 		Short: "Abandon a failed task while preserving the branch and worktree",
 		Long: `Abandon a task that is in an error state (validation_failed, gh_failed, ci_failed, ci_timeout).
 
+You will be asked to type the workspace name to confirm. Use --yes to skip
+confirmation; it is required in non-interactive mode.
+
 Use --force to:
   - Skip the confirmation prompt
   - Force-abandon running tasks (terminates tracked processes and marks task as abandoned)
@@ -43,10 +48,12 @@ for manual work. You can still access the code at the worktree path.
 
 Examples:
   atlas abandon auth-fix           # Abandon task with confirmation
+  atlas abandon auth-fix --yes     # Abandon task without confirmation
   atlas abandon auth-fix --force   # Force-abandon without confirmation or force-abandon running task`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := runAbandon(cmd.Context(), cmd, os.Stdout, args[0], force, "")
+			err := runAbandon(cmd.Context(), cmd, os.Stdout, args[0], force, yes, "")
 			// If JSON error was already output, silence cobra's error printing
 			// but still return error for non-zero exit code
 			if stderrors.Is(err, errors.ErrJSONErrorOutput) {
@@ -57,12 +64,13 @@ Examples:
 	}
 
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation prompt")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Confirm abandonment without prompting (required in non-interactive mode)")
 
 	return cmd
 }
 
 // runAbandon executes the abandon command.
-func runAbandon(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceName string, force bool, storeBaseDir string) error {
+func runAbandon(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceName string, force, yes bool, storeBaseDir string) error {
 	// Check for cancellation at entry
 	select {
 	case <-ctx.Done():
@@ -76,11 +84,12 @@ func runAbandon(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceN
 	// Get output format from global flags
 	outputFormat := cmd.Flag("output").Value.String()
 
-	return runAbandonWithOutput(ctx, w, workspaceName, force, storeBaseDir, outputFormat)
+	return runAbandonWithOutput(ctx, w, workspaceName, force, yes, storeBaseDir, outputFormat)
 }
 
 // runAbandonWithOutput executes the abandon command with explicit output format.
-func runAbandonWithOutput(ctx context.Context, w io.Writer, workspaceName string, force bool, storeBaseDir, outputFormat string) error {
+// Confirmation is skipped when force or yes is set.
+func runAbandonWithOutput(ctx context.Context, w io.Writer, workspaceName string, force, yes bool, storeBaseDir, outputFormat string) error {
 	logger := Logger()
 	tui.CheckNoColor()
 
@@ -100,8 +109,9 @@ func runAbandonWithOutput(ctx context.Context, w io.Writer, workspaceName string
 		return err
 	}
 
-	if !force {
-		if err := confirmAbandonmentInteractive(workspaceName, currentTask, outputFormat, w); err != nil {
+	if !force && !yes {
+		confirmed, err := confirmAbandonmentInteractive(workspaceName, currentTask, outputFormat, w)
+		if err != nil || !confirmed {
 			return err
 		}
 	}
@@ -179,25 +189,33 @@ func validateAbandonability(status constants.TaskStatus, force bool, outputForma
 	return nil
 }
 
-// confirmAbandonmentInteractive handles interactive confirmation.
-func confirmAbandonmentInteractive(workspaceName string, currentTask *domain.Task, outputFormat string, w io.Writer) error {
-	if !terminalCheck() {
-		return handleAbandonError(outputFormat, w, workspaceName, currentTask.ID,
-			fmt.Errorf("cannot abandon task: %w", errors.ErrNonInteractiveMode))
-	}
+// confirmAbandonmentInteractive asks the user to type the workspace name to confirm.
+// Returns false with a nil error if the user declined.
+func confirmAbandonmentInteractive(workspaceName string, currentTask *domain.Task, outputFormat string, w io.Writer) (bool, error) {
+	out := tui.NewOutput(w, outputFormat)
 
-	confirmed, err := confirmAbandon(workspaceName, currentTask.Status == constants.TaskStatusRunning)
+	prompt := abandonConfirmPrompt(workspaceName, currentTask.Status == constants.TaskStatusRunning)
+	confirmed, err := tui.ConfirmDestructive(out, prompt, workspaceName)
 	if err != nil {
-		return handleAbandonError(outputFormat, w, workspaceName, currentTask.ID,
-			fmt.Errorf("failed to get confirmation: %w", err))
+		return false, handleAbandonError(outputFormat, w, workspaceName, currentTask.ID,
+			fmt.Errorf("cannot abandon task: %w", err))
 	}
 
 	if !confirmed {
-		out := tui.NewOutput(w, outputFormat)
 		out.Info("Abandonment canceled")
-		return nil
+		return false, nil
 	}
-	return nil
+	return true, nil
+}
+
+// abandonConfirmPrompt builds the abandon confirmation prompt, warning first
+// when the task is still running.
+func abandonConfirmPrompt(workspaceName string, isRunning bool) string {
+	prompt := fmt.Sprintf("Abandon task in workspace '%s'? Branch and worktree will be preserved for manual work.", workspaceName)
+	if isRunning {
+		prompt = "⚠️  WARNING: Task is currently running. This will attempt to terminate processes and mark the task as abandoned.\n\n" + prompt
+	}
+	return prompt
 }
 
 // executeAbandon performs the actual abandonment and updates workspace.
@@ -225,48 +243,11 @@ func executeAbandon(ctx context.Context, w io.Writer, wsMgr workspace.Manager, t
 	return nil
 }
 
-// createAbandonConfirmForm is the default factory for creating abandon confirmation forms.
-// This variable can be overridden in tests to inject mock forms.
-//
-//nolint:gochecknoglobals // Test injection point - standard Go testing pattern
-var createAbandonConfirmForm = defaultCreateAbandonConfirmForm
-
 // formRunner is an interface that matches huh.Form's Run method.
 type formRunner interface {
 	Run() error
 }
 
-// defaultCreateAbandonConfirmForm creates the actual Charm Huh form for abandon confirmation.
-func defaultCreateAbandonConfirmForm(workspaceName string, isRunning bool, confirm *bool) formRunner {
-	description := "Branch and worktree will be preserved for manual work."
-	if isRunning {
-		description = "⚠️  WARNING: Task is currently running. This will attempt to terminate processes and mark the task as abandoned.\n\n" + description
-	}
-
-	return huh.NewForm(
-		huh.NewGroup(
-			huh.NewConfirm().
-				Title(fmt.Sprintf("Abandon task in workspace '%s'?", workspaceName)).
-				Description(description).
-				Affirmative("Yes, abandon").
-				Negative("No, cancel").
-				Value(confirm),
-		),
-	)
-}
-
-// confirmAbandon prompts the user for confirmation before abandoning a task.
-func confirmAbandon(workspaceName string, isRunning bool) (bool, error) {
-	var confirm bool
-	form := createAbandonConfirmForm(workspaceName, isRunning, &confirm)
-
-	if err := form.Run(); err != nil {
-		return false, err
-	}
-
-	return confirm, nil
-}
-
 // abandonResult represents the JSON output for abandon operations.
 type abandonResult struct {
 	Status       string `json:"status"`
@@ -283,7 +264,7 @@ func handleAbandonError(format string, w io.Writer, workspaceName, taskID string
 		Status:    "error",
 		Workspace: workspaceName,
 		TaskID:    taskID,
-		Error:     err.Error(),
+		Error:     errors.RedactString(err.Error()),
 	}, err)
 }
 
@@ -307,6 +288,6 @@ func outputAbandonErrorJSON(w io.Writer, workspaceName, taskID, errMsg string) e
 		Status:    "error",
 		Workspace: workspaceName,
 		TaskID:    taskID,
-		Error:     errMsg,
+		Error:     errors.RedactString(errMsg),
 	})
 }
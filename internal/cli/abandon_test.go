@@ -92,7 +92,7 @@ func TestRunAbandon_WorkspaceNotFound(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon with nonexistent workspace
-	err := runAbandonWithOutput(context.Background(), &buf, "nonexistent", true, tmpDir, "text")
+	err := runAbandonWithOutput(context.Background(), &buf, "nonexistent", true, false, tmpDir, "text")
 
 	// Should return an error with wrapped sentinel
 	require.Error(t, err)
@@ -137,7 +137,7 @@ func TestRunAbandon_NoTasksInWorkspace(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon - workspace exists but has no tasks
-	err = runAbandonWithOutput(context.Background(), &buf, "empty-ws", true, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "empty-ws", true, false, tmpDir, "text")
 
 	// Should return ErrNoTasksFound
 	require.Error(t, err)
@@ -183,7 +183,7 @@ func TestRunAbandon_TaskNotAbandonable(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon - task is in completed state (terminal state)
-	err = runAbandonWithOutput(context.Background(), &buf, "completed-ws", true, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "completed-ws", true, false, tmpDir, "text")
 
 	// Should return ErrInvalidTransition
 	require.Error(t, err)
@@ -231,7 +231,7 @@ func TestRunAbandon_Success(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon with force flag
-	err = runAbandonWithOutput(context.Background(), &buf, "abandon-ws", true, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "abandon-ws", true, false, tmpDir, "text")
 	require.NoError(t, err)
 
 	// Verify success message contains expected elements
@@ -291,7 +291,7 @@ func TestRunAbandon_JSONOutput(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon with JSON output
-	err = runAbandonWithOutput(context.Background(), &buf, "json-ws", true, tmpDir, OutputJSON)
+	err = runAbandonWithOutput(context.Background(), &buf, "json-ws", true, false, tmpDir, OutputJSON)
 	require.NoError(t, err)
 
 	// Parse JSON output
@@ -315,7 +315,7 @@ func TestRunAbandon_JSONOutput_Error(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon with nonexistent workspace and JSON output
-	err := runAbandonWithOutput(context.Background(), &buf, "nonexistent", true, tmpDir, OutputJSON)
+	err := runAbandonWithOutput(context.Background(), &buf, "nonexistent", true, false, tmpDir, OutputJSON)
 
 	// Should return ErrJSONErrorOutput for non-zero exit code
 	require.ErrorIs(t, err, errors.ErrJSONErrorOutput)
@@ -342,7 +342,7 @@ func TestRunAbandon_ContextCancellation(t *testing.T) {
 	cancel() // Cancel immediately
 
 	// Execute with canceled context
-	err := runAbandonWithOutput(ctx, &buf, "test-ws", true, tmpDir, "text")
+	err := runAbandonWithOutput(ctx, &buf, "test-ws", true, false, tmpDir, "text")
 
 	// Should return context.Canceled error
 	require.Error(t, err)
@@ -394,10 +394,10 @@ func TestRunAbandon_NonInteractiveWithoutForce(t *testing.T) {
 	defer func() { terminalCheck = originalTerminalCheck }()
 
 	// Execute abandon WITHOUT --force in non-interactive mode
-	err = runAbandonWithOutput(context.Background(), &buf, "noforce-ws", false, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "noforce-ws", false, false, tmpDir, "text")
 
-	// Should return ErrNonInteractiveMode
-	require.ErrorIs(t, err, errors.ErrNonInteractiveMode)
+	// Should require --yes
+	require.ErrorIs(t, err, errors.ErrConfirmationRequired)
 
 	// Task should still be in original state (not abandoned)
 	unchangedTask, err := taskStore.Get(context.Background(), "noforce-ws", taskID)
@@ -405,6 +405,52 @@ func TestRunAbandon_NonInteractiveWithoutForce(t *testing.T) {
 	assert.Equal(t, constants.TaskStatusCIFailed, unchangedTask.Status)
 }
 
+func TestRunAbandon_NonInteractiveWithYes(t *testing.T) {
+	// Set up a temporary atlas directory
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+
+	taskID := testTaskID("100009")
+
+	wsStore, err := workspace.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	now := time.Now()
+	ws := &domain.Workspace{
+		Name:         "yes-ws",
+		WorktreePath: "/tmp/yes-ws",
+		Branch:       "fix/yes",
+		Status:       constants.WorkspaceStatusActive,
+		Tasks:        []domain.TaskRef{{ID: taskID}},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	require.NoError(t, wsStore.Create(context.Background(), ws))
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	failedTask := &domain.Task{
+		ID:          taskID,
+		WorkspaceID: "yes-ws",
+		Status:      constants.TaskStatusCIFailed,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Transitions: []domain.Transition{},
+	}
+	require.NoError(t, taskStore.Create(context.Background(), "yes-ws", failedTask))
+
+	var buf bytes.Buffer
+
+	// Execute abandon with --yes (no --force) in non-interactive mode
+	err = runAbandonWithOutput(context.Background(), &buf, "yes-ws", false, true, tmpDir, "text")
+	require.NoError(t, err)
+
+	abandonedTask, err := taskStore.Get(context.Background(), "yes-ws", taskID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusAbandoned, abandonedTask.Status)
+}
+
 func TestRunAbandon_NonInteractiveWithoutForce_JSON(t *testing.T) {
 	// Set up a temporary atlas directory
 	tmpDir := t.TempDir()
@@ -450,7 +496,7 @@ func TestRunAbandon_NonInteractiveWithoutForce_JSON(t *testing.T) {
 	defer func() { terminalCheck = originalTerminalCheck }()
 
 	// Execute abandon WITHOUT --force in non-interactive mode with JSON output
-	err = runAbandonWithOutput(context.Background(), &buf, "noforce-json-ws", false, tmpDir, OutputJSON)
+	err = runAbandonWithOutput(context.Background(), &buf, "noforce-json-ws", false, false, tmpDir, OutputJSON)
 
 	// Should return ErrJSONErrorOutput for proper exit code
 	require.ErrorIs(t, err, errors.ErrJSONErrorOutput)
@@ -461,7 +507,7 @@ func TestRunAbandon_NonInteractiveWithoutForce_JSON(t *testing.T) {
 	require.NoError(t, unmarshalErr)
 
 	assert.Equal(t, "error", result.Status)
-	assert.Contains(t, result.Error, "use --force in non-interactive mode")
+	assert.Contains(t, result.Error, "use --yes in non-interactive mode")
 }
 
 func TestRunAbandon_FromCITimeout(t *testing.T) {
@@ -504,7 +550,7 @@ func TestRunAbandon_FromCITimeout(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon
-	err = runAbandonWithOutput(context.Background(), &buf, "timeout-ws", true, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "timeout-ws", true, false, tmpDir, "text")
 	require.NoError(t, err)
 
 	// Verify task was transitioned to abandoned
@@ -589,7 +635,7 @@ func TestRunAbandon_WorkspaceStatusUpdatedToPaused(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon
-	err = runAbandonWithOutput(context.Background(), &buf, "paused-ws", true, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "paused-ws", true, false, tmpDir, "text")
 	require.NoError(t, err)
 
 	// Verify workspace status is now paused (AC #6)
@@ -650,7 +696,7 @@ func TestRunAbandon_TaskArtifactsPreserved(t *testing.T) {
 	var buf bytes.Buffer
 
 	// Execute abandon
-	err = runAbandonWithOutput(context.Background(), &buf, "artifacts-ws", true, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "artifacts-ws", true, false, tmpDir, "text")
 	require.NoError(t, err)
 
 	// Verify task metadata is preserved (AC #4)
@@ -662,15 +708,6 @@ func TestRunAbandon_TaskArtifactsPreserved(t *testing.T) {
 	assert.Len(t, updatedTask.Steps, 2)
 }
 
-func TestConfirmAbandon_FormStructure(_ *testing.T) {
-	// This test verifies the confirmAbandon function exists and has the expected signature
-	// We can't easily test the interactive form, but we can verify the function is defined
-	// The actual form testing would require mocking huh forms
-
-	// Verify confirmAbandon is a function with expected signature
-	_ = confirmAbandon // Function exists
-}
-
 // Phase 2 Quick Wins: Test entry point functions
 
 func TestRunAbandon_ExtractsTextOutputFlag(t *testing.T) {
@@ -692,7 +729,7 @@ func TestRunAbandon_ExtractsTextOutputFlag(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	// Call runAbandon - it should extract the "text" flag and call runAbandonWithOutput
-	err = runAbandon(context.Background(), abandonCmd, &buf, "nonexistent", true, tmpDir)
+	err = runAbandon(context.Background(), abandonCmd, &buf, "nonexistent", true, false, tmpDir)
 
 	// Should error because workspace doesn't exist, but that means the function executed
 	require.Error(t, err)
@@ -723,7 +760,7 @@ func TestRunAbandon_ExtractsJSONOutputFlag(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	// Call runAbandon - it should extract the "json" flag and call runAbandonWithOutput
-	err = runAbandon(context.Background(), abandonCmd, &buf, "nonexistent", true, tmpDir)
+	err = runAbandon(context.Background(), abandonCmd, &buf, "nonexistent", true, false, tmpDir)
 
 	// Should error because workspace doesn't exist
 	require.Error(t, err)
@@ -756,7 +793,7 @@ func TestRunAbandon_RespectsContextCancellation(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	// Call runAbandon with canceled context
-	err = runAbandon(ctx, abandonCmd, &buf, "test-workspace", true, tmpDir)
+	err = runAbandon(ctx, abandonCmd, &buf, "test-workspace", true, false, tmpDir)
 
 	// Should return context.Canceled error
 	require.Error(t, err)
@@ -805,120 +842,27 @@ func TestNewAbandonCmd_ShortAndLongDesc(t *testing.T) {
 
 // Phase 3: Form Interactions - Test confirmation forms
 
-func TestConfirmAbandon_UserConfirms(t *testing.T) {
-	// Save and restore original form factory
-	originalFactory := createAbandonConfirmForm
-	defer func() { createAbandonConfirmForm = originalFactory }()
-
-	// Mock the form to simulate user confirming
-	var capturedWorkspace string
-	var capturedRunning bool
-	createAbandonConfirmForm = func(ws string, running bool, confirm *bool) formRunner {
-		capturedWorkspace = ws
-		capturedRunning = running
-		*confirm = true // Simulate user clicking "Yes, abandon"
-		return &mockFormRunner{}
-	}
-
-	confirmed, err := confirmAbandon("test-workspace", false)
-
-	require.NoError(t, err)
-	assert.True(t, confirmed, "should return true when user confirms")
-	assert.Equal(t, "test-workspace", capturedWorkspace)
-	assert.False(t, capturedRunning)
-}
-
-func TestConfirmAbandon_UserCancels(t *testing.T) {
-	// Save and restore original form factory
-	originalFactory := createAbandonConfirmForm
-	defer func() { createAbandonConfirmForm = originalFactory }()
-
-	// Mock the form to simulate user canceling
-	createAbandonConfirmForm = func(_ string, _ bool, confirm *bool) formRunner {
-		*confirm = false // Simulate user clicking "No, cancel"
-		return &mockFormRunner{}
-	}
-
-	confirmed, err := confirmAbandon("test-workspace", false)
-
-	require.NoError(t, err)
-	assert.False(t, confirmed, "should return false when user cancels")
-}
-
-func TestConfirmAbandon_RunningTaskShowsWarning(t *testing.T) {
-	// Save and restore original form factory
-	originalFactory := createAbandonConfirmForm
-	defer func() { createAbandonConfirmForm = originalFactory }()
-
-	// Mock the form to capture the isRunning parameter
-	var capturedRunning bool
-	createAbandonConfirmForm = func(_ string, running bool, confirm *bool) formRunner {
-		capturedRunning = running
-		*confirm = true
-		return &mockFormRunner{}
-	}
-
-	_, err := confirmAbandon("test-workspace", true)
-
-	require.NoError(t, err)
-	assert.True(t, capturedRunning, "should pass isRunning=true to form factory")
-}
-
-func TestConfirmAbandon_FormError(t *testing.T) {
-	// Save and restore original form factory
-	originalFactory := createAbandonConfirmForm
-	defer func() { createAbandonConfirmForm = originalFactory }()
-
-	// Mock the form to return an error
-	expectedErr := errors.ErrUserAbandoned
-	createAbandonConfirmForm = func(_ string, _ bool, _ *bool) formRunner {
-		return &mockFormRunner{runErr: expectedErr}
-	}
-
-	confirmed, err := confirmAbandon("test-workspace", false)
-
-	require.Error(t, err)
-	assert.Equal(t, expectedErr, err)
-	assert.False(t, confirmed, "should return false on error")
-}
-
-func TestConfirmAbandon_PassesWorkspaceName(t *testing.T) {
-	// Save and restore original form factory
-	originalFactory := createAbandonConfirmForm
-	defer func() { createAbandonConfirmForm = originalFactory }()
-
-	tests := []struct {
-		name          string
-		workspaceName string
-	}{
-		{"simple name", "my-workspace"},
-		{"with dashes", "feature-auth-fix"},
-		{"with numbers", "hotfix-123"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var capturedWorkspace string
-			createAbandonConfirmForm = func(ws string, _ bool, confirm *bool) formRunner {
-				capturedWorkspace = ws
-				*confirm = true
-				return &mockFormRunner{}
-			}
-
-			_, err := confirmAbandon(tt.workspaceName, false)
-
-			require.NoError(t, err)
-			assert.Equal(t, tt.workspaceName, capturedWorkspace)
-		})
-	}
-}
-
 func TestConfirmAbandonmentInteractive_WithForceSkipsConfirmation(_ *testing.T) {
 	// This function should not be called when force=true
 	// The runAbandon flow skips confirmAbandonmentInteractive entirely
 	// This is already tested in TestRunAbandon_Success with force=true
 }
 
+func TestAbandonConfirmPrompt(t *testing.T) {
+	t.Run("running task is warned about", func(t *testing.T) {
+		prompt := abandonConfirmPrompt("my-ws", true)
+		assert.Regexp(t, `^⚠️  WARNING: Task is currently running\.`, prompt)
+		assert.Contains(t, prompt, "terminate processes")
+		assert.Contains(t, prompt, "Abandon task in workspace 'my-ws'?")
+	})
+
+	t.Run("stopped task has no warning", func(t *testing.T) {
+		prompt := abandonConfirmPrompt("my-ws", false)
+		assert.NotContains(t, prompt, "WARNING")
+		assert.Contains(t, prompt, "Branch and worktree will be preserved")
+	})
+}
+
 func TestConfirmAbandonmentInteractive_NonInteractiveModeErrors(t *testing.T) {
 	// Save and restore terminal check
 	cleanup := mockTerminalCheckFunc(false)
@@ -957,10 +901,10 @@ func TestConfirmAbandonmentInteractive_NonInteractiveModeErrors(t *testing.T) {
 	require.NoError(t, err)
 
 	// Try to run without force in non-interactive mode
-	err = runAbandonWithOutput(context.Background(), &buf, "test-ws", false, tmpDir, "text")
+	err = runAbandonWithOutput(context.Background(), &buf, "test-ws", false, false, tmpDir, "text")
 
 	require.Error(t, err)
-	assert.ErrorIs(t, err, errors.ErrNonInteractiveMode)
+	assert.ErrorIs(t, err, errors.ErrConfirmationRequired)
 }
 
 func TestAbandonCommand_RunEExecution(t *testing.T) {
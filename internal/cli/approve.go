@@ -2,6 +2,7 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -21,6 +22,7 @@ import (
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/git"
+	"github.com/mrz1836/atlas/internal/ignore"
 	"github.com/mrz1836/atlas/internal/task"
 	"github.com/mrz1836/atlas/internal/tui"
 	"github.com/mrz1836/atlas/internal/workspace"
@@ -74,7 +76,8 @@ Examples:
   atlas approve my-feature --close         # Approve and close workspace
   atlas approve my-feature --message "Merged by CI"  # Custom merge message
   atlas approve -o json my-feature  # Output result as JSON`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts := approveOptions{
 				autoApprove:  autoApprove,
@@ -601,7 +604,7 @@ func executeApprovalAction(ctx context.Context, out tui.Output, taskStore task.S
 		return true, nil
 
 	case actionViewDiff:
-		if err := viewDiff(ctx, ws.WorktreePath); err != nil {
+		if err := viewDiff(ctx, ws.WorktreePath, t.BaseBranch); err != nil {
 			out.Warning(fmt.Sprintf("Could not display diff: %v", err))
 		}
 		return false, nil
@@ -696,30 +699,82 @@ func approveTask(ctx context.Context, taskStore task.Store, t *domain.Task) erro
 }
 
 // viewDiff displays the git diff in a pager.
-func viewDiff(ctx context.Context, worktreePath string) error {
+// If baseBranch is set, shows all changes since the branch diverged from it;
+// otherwise shows the most recent commit's changes.
+func viewDiff(ctx context.Context, worktreePath, baseBranch string) error {
 	if worktreePath == "" {
 		return fmt.Errorf("failed to view diff: %w", atlaserrors.ErrEmptyValue)
 	}
 
-	// Get diff of recent changes
-	gitCmd := execCommandContextFunc(ctx, "git", "-C", worktreePath, "diff", "HEAD~1")
+	gitOutput, err := collectDiff(ctx, worktreePath, baseBranch)
+	if err != nil {
+		return err
+	}
+
+	if len(gitOutput) == 0 {
+		_, _ = os.Stdout.WriteString("No changes to display.\n")
+		return nil
+	}
+
+	// Pipe to less with color support
+	colored := tui.NewDiffColorizer(os.Stdout).Colorize(string(gitOutput))
+	return pipeToLess(ctx, []byte(colored))
+}
+
+// collectDiff returns the worktree's diff against baseBranch (or of the most
+// recent commit when baseBranch is empty), without files listed in .atlasignore.
+func collectDiff(ctx context.Context, worktreePath, baseBranch string) ([]byte, error) {
+	// Get diff against the task's base branch, or of recent changes
+	diffRange := "HEAD~1"
+	if baseBranch != "" {
+		diffRange = baseBranch + "...HEAD"
+	}
+	gitCmd := execCommandContextFunc(ctx, "git", "-C", worktreePath, "diff", diffRange)
 	gitOutput, err := gitCmd.Output()
 	if err != nil {
 		// Try without HEAD~1 for new repos
 		gitCmd = execCommandContextFunc(ctx, "git", "-C", worktreePath, "diff")
 		gitOutput, err = gitCmd.Output()
 		if err != nil {
-			return fmt.Errorf("failed to get diff: %w", err)
+			return nil, fmt.Errorf("failed to get diff: %w", err)
 		}
 	}
 
-	if len(gitOutput) == 0 {
-		_, _ = os.Stdout.WriteString("No changes to display.\n")
-		return nil
+	// Leave out generated or vendored files listed in .atlasignore
+	if ignored, ignoreErr := ignore.Load(worktreePath); ignoreErr == nil {
+		gitOutput = filterIgnoredDiff(gitOutput, ignored)
 	}
 
-	// Pipe to less with color support
-	return pipeToLess(ctx, gitOutput)
+	return gitOutput, nil
+}
+
+// filterIgnoredDiff removes the sections of a git diff whose file matches
+// the ignore patterns.
+func filterIgnoredDiff(diff []byte, ignored *ignore.Matcher) []byte {
+	if ignored.Empty() || len(diff) == 0 {
+		return diff
+	}
+
+	var out bytes.Buffer
+	keep := true
+	for _, line := range bytes.SplitAfter(diff, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("diff --git ")) {
+			keep = !ignored.Match(diffHeaderPath(string(line)))
+		}
+		if keep {
+			out.Write(line)
+		}
+	}
+	return out.Bytes()
+}
+
+// diffHeaderPath returns the new file path from a "diff --git a/x b/x" header.
+func diffHeaderPath(header string) string {
+	header = strings.TrimRight(header, "\n")
+	if i := strings.LastIndex(header, " b/"); i >= 0 {
+		return header[i+len(" b/"):]
+	}
+	return ""
 }
 
 // viewLogs displays the task log in a pager.
@@ -924,7 +979,7 @@ func handleApproveError(format string, w io.Writer, workspaceName string, err er
 		Workspace: workspaceInfo{
 			Name: workspaceName,
 		},
-		Error: err.Error(),
+		Error: atlaserrors.RedactString(err.Error()),
 	}, err)
 }
 
@@ -938,7 +993,7 @@ func outputApproveErrorJSON(w io.Writer, workspaceName, taskID, errMsg string) e
 		Task: taskInfo{
 			ID: taskID,
 		},
-		Error: errMsg,
+		Error: atlaserrors.RedactString(errMsg),
 	}, atlaserrors.ErrJSONErrorOutput)
 }
 
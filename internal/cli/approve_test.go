@@ -19,6 +19,7 @@ import (
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/ignore"
 	"github.com/mrz1836/atlas/internal/tui"
 )
 
@@ -150,6 +151,10 @@ func (m *mockTaskStoreForApprove) SaveArtifact(_ context.Context, _, _, _ string
 	return nil
 }
 
+func (m *mockTaskStoreForApprove) SaveStepArtifact(_ context.Context, _, _, _, _ string, _ []byte) error {
+	return nil
+}
+
 func (m *mockTaskStoreForApprove) SaveVersionedArtifact(_ context.Context, _, _, _ string, _ []byte) (string, error) {
 	return "", nil
 }
@@ -587,7 +592,7 @@ func TestViewDiff_EmptyWorktreePath(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	err := viewDiff(ctx, "")
+	err := viewDiff(ctx, "", "")
 	require.Error(t, err)
 	assert.ErrorIs(t, err, atlaserrors.ErrEmptyValue)
 }
@@ -1700,13 +1705,58 @@ func TestViewDiff_NoDiff(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	err := viewDiff(ctx, "/path/to/worktree")
+	err := viewDiff(ctx, "/path/to/worktree", "")
 
 	assert.True(t, cmdCalled)
 	// Should complete without error but show "No changes"
 	assert.NoError(t, err)
 }
 
+// TestViewDiff_AgainstBaseBranch tests the diff is taken against the task's base branch.
+func TestViewDiff_AgainstBaseBranch(t *testing.T) {
+	// Not parallel because it modifies global execCommandContextFunc
+
+	var gotArgs []string
+	oldExecFunc := execCommandContextFunc
+	defer func() { execCommandContextFunc = oldExecFunc }()
+
+	execCommandContextFunc = func(ctx context.Context, _ string, args ...string) *exec.Cmd {
+		gotArgs = args
+		return exec.CommandContext(ctx, "true")
+	}
+
+	err := viewDiff(context.Background(), "/path/to/worktree", "develop")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-C", "/path/to/worktree", "diff", "develop...HEAD"}, gotArgs)
+}
+
+// TestFilterIgnoredDiff tests diff sections for ignored files are dropped.
+func TestFilterIgnoredDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := "diff --git a/main.go b/main.go\n" +
+		"+package main\n" +
+		"diff --git a/api/service.pb.go b/api/service.pb.go\n" +
+		"+// generated\n" +
+		"diff --git a/vendor/lib/lib.go b/vendor/lib/lib.go\n" +
+		"+package lib\n" +
+		"diff --git a/util.go b/util.go\n" +
+		"+package util\n"
+
+	got := filterIgnoredDiff([]byte(diff), ignore.New([]string{"*.pb.go", "vendor/"}))
+
+	assert.Equal(t, "diff --git a/main.go b/main.go\n"+
+		"+package main\n"+
+		"diff --git a/util.go b/util.go\n"+
+		"+package util\n", string(got))
+
+	t.Run("no patterns leaves diff unchanged", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, diff, string(filterIgnoredDiff([]byte(diff), ignore.New(nil))))
+	})
+}
+
 // TestViewLogs_EmptyLog tests viewing empty log file.
 func TestViewLogs_EmptyLog(t *testing.T) {
 	t.Parallel()
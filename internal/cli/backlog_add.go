@@ -437,7 +437,7 @@ func outputBacklogError(w io.Writer, format, command string, err error) error {
 		if encErr := encoder.Encode(map[string]any{
 			"success": false,
 			"command": "backlog " + command,
-			"error":   err.Error(),
+			"error":   atlaserrors.RedactString(err.Error()),
 		}); encErr != nil {
 			return fmt.Errorf("failed to encode JSON: %w", encErr)
 		}
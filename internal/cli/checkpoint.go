@@ -12,7 +12,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
-	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/hook"
@@ -81,11 +80,10 @@ func runCheckpoint(ctx context.Context, cmd *cobra.Command, w io.Writer, descrip
 	out := tui.NewOutput(w, outputFormat)
 
 	// Get base path
-	homeDir, err := os.UserHomeDir()
+	baseDir, err := workspace.StateDir()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return err
 	}
-	baseDir := filepath.Join(homeDir, constants.AtlasHome)
 
 	// Find active hook
 	hookPath, taskID, workspaceID, err := findActiveHookPath(ctx, baseDir)
@@ -234,7 +232,7 @@ func outputCheckpointErrorJSON(w io.Writer, errMsg string) error {
 	out := tui.NewOutput(w, OutputJSON)
 	if err := out.JSON(map[string]any{
 		"success": false,
-		"error":   errMsg,
+		"error":   atlaserrors.RedactString(errMsg),
 	}); err != nil {
 		return fmt.Errorf("failed to encode JSON: %w", err)
 	}
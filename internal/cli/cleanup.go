@@ -6,16 +6,15 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/mrz1836/atlas/internal/config"
-	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	"github.com/mrz1836/atlas/internal/hook"
 	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // Cleanup retention defaults (overridden by config).
@@ -107,11 +106,10 @@ func setupCleanup(ctx context.Context) (*hook.FileStore, *config.Config, error)
 	}
 
 	// Get base path
-	homeDir, err := os.UserHomeDir()
+	baseDir, err := workspace.StateDir()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get home directory: %w", err)
+		return nil, nil, err
 	}
-	baseDir := filepath.Join(homeDir, constants.AtlasHome)
 
 	// Create hook store
 	hookStore := hook.NewFileStore(baseDir)
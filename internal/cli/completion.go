@@ -3,13 +3,17 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // shellType represents supported shell types.
@@ -19,8 +23,22 @@ type shellType string
 var (
 	errUnsupportedShell = errors.New("unsupported shell (supported: zsh, bash, fish)")
 	errNoShellDetected  = errors.New("could not detect shell from $SHELL environment variable; use --shell flag")
+	errUnknownShell     = errors.New("unknown shell")
 )
 
+// completionShells lists the shells "atlas completion <shell>" generates scripts for.
+//
+//nolint:gochecknoglobals // Read-only list shared by the command and its error message
+var completionShells = []string{"bash", "fish", "powershell", "zsh"}
+
+// newCompletionWorkspaceStore opens the store used for workspace name completion.
+// Tests replace it to complete from a temporary store.
+//
+//nolint:gochecknoglobals // Replaceable for testing
+var newCompletionWorkspaceStore = func() (workspace.Store, error) {
+	return workspace.NewFileStore("")
+}
+
 const (
 	shellZsh     shellType = "zsh"
 	shellBash    shellType = "bash"
@@ -48,6 +66,8 @@ To generate completion scripts manually:
   atlas completion zsh
   atlas completion fish
   atlas completion powershell`,
+		ValidArgs: slices.Clone(completionShells), // Cobra sorts ValidArgs in place
+		RunE:      runCompletion,
 	}
 
 	// Add shell-specific generation subcommands
@@ -60,6 +80,44 @@ To generate completion scripts manually:
 	rootCmd.AddCommand(completionCmd)
 }
 
+// runCompletion handles "atlas completion" without a known shell subcommand.
+// Cobra only routes to this command when the argument matches no subcommand.
+func runCompletion(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmd.Help()
+	}
+	return fmt.Errorf("%w %q (supported: %s)", errUnknownShell, args[0], strings.Join(completionShells, ", "))
+}
+
+// completeWorkspaceNames completes the workspace argument of commands such as
+// resume and abandon with the names of existing workspaces.
+func completeWorkspaceNames(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	store, err := newCompletionWorkspaceStore()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	workspaces, err := store.List(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]cobra.Completion, 0, len(workspaces))
+	for _, ws := range workspaces {
+		if strings.HasPrefix(ws.Name, toComplete) {
+			names = append(names, cobra.CompletionWithDesc(ws.Name, string(ws.Status)))
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
 func newBashCompletionCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "bash",
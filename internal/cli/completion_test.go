@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +10,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // TestAddCompletionCommand verifies that the completion command is added to the root command.
@@ -59,6 +64,71 @@ func TestBashCompletionCmd(t *testing.T) {
 	assert.Contains(t, output, "atlas")
 }
 
+// TestCompletionCmd_UnknownShell verifies an unknown shell lists the supported ones.
+func TestCompletionCmd_UnknownShell(t *testing.T) {
+	t.Parallel()
+
+	rootCmd := &cobra.Command{Use: "atlas"}
+	AddCompletionCommand(rootCmd)
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"completion", "tcsh"})
+
+	err := rootCmd.Execute()
+	require.ErrorIs(t, err, errUnknownShell)
+	assert.Equal(t, `unknown shell "tcsh" (supported: bash, fish, powershell, zsh)`, err.Error())
+}
+
+// TestCompletionCmd_NoShellShowsHelp verifies the bare command prints usage.
+func TestCompletionCmd_NoShellShowsHelp(t *testing.T) {
+	t.Parallel()
+
+	rootCmd := &cobra.Command{Use: "atlas"}
+	AddCompletionCommand(rootCmd)
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"completion"})
+
+	require.NoError(t, rootCmd.Execute())
+	assert.Contains(t, buf.String(), "atlas completion install")
+}
+
+// TestCompleteWorkspaceNames verifies workspace arguments complete from the store.
+func TestCompleteWorkspaceNames(t *testing.T) {
+	ctx := context.Background()
+	store, err := workspace.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"auth-fix", "auth-refactor", "docs"} {
+		require.NoError(t, store.Create(ctx, &domain.Workspace{Name: name, Status: constants.WorkspaceStatusActive}))
+	}
+
+	original := newCompletionWorkspaceStore
+	newCompletionWorkspaceStore = func() (workspace.Store, error) { return store, nil }
+	t.Cleanup(func() { newCompletionWorkspaceStore = original })
+
+	cmd := &cobra.Command{Use: "resume"}
+	cmd.SetContext(ctx)
+
+	names, directive := completeWorkspaceNames(cmd, nil, "auth")
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.ElementsMatch(t, []cobra.Completion{"auth-fix\tactive", "auth-refactor\tactive"}, names)
+
+	names, _ = completeWorkspaceNames(cmd, []string{"auth-fix"}, "")
+	assert.Empty(t, names)
+}
+
+// TestResumeCmd_CompletesWorkspaceNames verifies the completion is wired into commands.
+func TestResumeCmd_CompletesWorkspaceNames(t *testing.T) {
+	t.Parallel()
+
+	cmd := newResumeCmd()
+	assert.NotNil(t, cmd.ValidArgsFunction)
+}
+
 // TestZshCompletionCmd tests the zsh completion generation command.
 func TestZshCompletionCmd(t *testing.T) {
 	t.Parallel()
@@ -60,12 +60,14 @@ func newConfigCmd() *cobra.Command {
 
 Subcommands:
   show          Display effective configuration with sources
+  validate      Check that the configuration is valid
   ai            Configure AI provider settings
   validation    Configure validation command settings
   notifications Configure notification settings
 
 Example:
   atlas config show          # Show current config with source annotations
+  atlas config validate      # Check the merged configuration
   atlas config ai            # Configure AI settings interactively
   atlas config validation    # Configure validation commands interactively
   atlas config notifications # Configure notification settings interactively`,
@@ -84,6 +86,9 @@ Example:
 	// Add show subcommand
 	AddConfigShowCommand(cmd)
 
+	// Add validate subcommand
+	AddConfigValidateCommand(cmd)
+
 	return cmd
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"charm.land/lipgloss/v2"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/mrz1836/atlas/internal/config"
 	"github.com/mrz1836/atlas/internal/constants"
@@ -22,6 +23,10 @@ import (
 type ConfigShowFlags struct {
 	// OutputFormat specifies the output format (yaml or json).
 	OutputFormat string
+
+	// Resolved prints the complete merged configuration instead of the
+	// annotated summary.
+	Resolved bool
 }
 
 // newConfigShowCmd creates the 'config show' subcommand for displaying configuration.
@@ -39,9 +44,13 @@ Shows the current configuration values and indicates where each value comes from
 
 Sensitive values (API keys, tokens) are masked in the output.
 
+Use --resolved to print every setting after defaults, global, project, and
+environment have been merged. Fields marked sensitive are redacted.
+
 Examples:
   atlas config show           # Display config in YAML format with sources
-  atlas config show --output json   # Display config in JSON format`,
+  atlas config show --output json   # Display config in JSON format
+  atlas config show --resolved      # Display the full resolved config`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return runConfigShow(cmd.Context(), cmd.OutOrStdout(), flags)
 		},
@@ -49,6 +58,7 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&flags.OutputFormat, "output", "o", "yaml", "output format (yaml or json)")
+	cmd.Flags().BoolVar(&flags.Resolved, "resolved", false, "print the full resolved configuration with secrets redacted")
 
 	return cmd
 }
@@ -148,6 +158,10 @@ func runConfigShow(ctx context.Context, w io.Writer, flags *ConfigShowFlags) err
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
+	if flags.Resolved {
+		return outputResolvedConfig(w, config.Redacted(cfg), flags.OutputFormat)
+	}
+
 	// Build annotated configuration with sources
 	annotated := buildAnnotatedConfig(cfg)
 
@@ -195,6 +209,7 @@ func buildAnnotatedConfig(cfg *config.Config) *AnnotatedConfig {
 	// Worktree section
 	annotated.Worktree["base_dir"] = determineSource("worktree.base_dir", cfg.Worktree.BaseDir, globalCfg, projectCfg, "")
 	annotated.Worktree["naming_suffix"] = determineSource("worktree.naming_suffix", cfg.Worktree.NamingSuffix, globalCfg, projectCfg, "")
+	annotated.Worktree["path_template"] = determineSource("worktree.path_template", cfg.Worktree.PathTemplate, globalCfg, projectCfg, constants.DefaultWorktreePathTemplate)
 
 	// CI section
 	annotated.CI["timeout"] = determineSource("ci.timeout", cfg.CI.Timeout.String(), globalCfg, projectCfg, constants.DefaultCITimeout.String())
@@ -300,6 +315,31 @@ func outputJSON(w io.Writer, annotated *AnnotatedConfig) error {
 	return encoder.Encode(annotated)
 }
 
+// outputResolvedConfig writes the full configuration as YAML or JSON.
+// JSON is produced from the YAML form so both use the same snake_case keys.
+func outputResolvedConfig(w io.Writer, cfg *config.Config, format string) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	switch strings.ToLower(format) {
+	case "yaml":
+		_, err = w.Write(data)
+		return err
+	case "json":
+		var generic map[string]any
+		if err := yaml.Unmarshal(data, &generic); err != nil {
+			return fmt.Errorf("failed to convert configuration: %w", err)
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(generic)
+	default:
+		return fmt.Errorf("%w: %s (use yaml or json)", errors.ErrUnsupportedOutputFormat, format)
+	}
+}
+
 // outputYAML outputs the configuration in YAML format with source comments.
 func outputYAML(w io.Writer, cfg *config.Config, annotated *AnnotatedConfig) error {
 	styles := newConfigShowStyles()
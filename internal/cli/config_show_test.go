@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/atlas/internal/config"
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/errors"
 )
@@ -151,6 +153,45 @@ git:
 	assert.Contains(t, output, "# project")
 }
 
+func TestOutputResolvedConfig_RedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.DefaultConfig()
+	cfg.Redis.Password = "hunter2"
+	cfg.Git.BaseBranch = "develop"
+
+	for _, format := range []string{"yaml", "json"} {
+		var buf bytes.Buffer
+		require.NoError(t, outputResolvedConfig(&buf, config.Redacted(cfg), format))
+
+		output := buf.String()
+		assert.NotContains(t, output, "hunter2", format)
+		assert.Contains(t, output, config.RedactedValue, format)
+		assert.Contains(t, output, "develop", format)
+		assert.Contains(t, output, "base_branch", format)
+	}
+}
+
+func TestOutputResolvedConfig_JSONIsValid(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, outputResolvedConfig(&buf, config.DefaultConfig(), "json"))
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Contains(t, decoded, "redis")
+	assert.Contains(t, decoded, "ai")
+}
+
+func TestOutputResolvedConfig_UnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	err := outputResolvedConfig(&bytes.Buffer{}, config.DefaultConfig(), "xml")
+
+	require.ErrorIs(t, err, errors.ErrUnsupportedOutputFormat)
+}
+
 func TestFormatConfigValue(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,80 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"charm.land/lipgloss/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	"github.com/mrz1836/atlas/internal/errors"
+)
+
+// ConfigValidateFlags holds flags specific to the config validate command.
+type ConfigValidateFlags struct {
+	// File validates a single config file instead of the merged configuration.
+	File string
+}
+
+// newConfigValidateCmd creates the 'config validate' subcommand for checking configuration.
+func newConfigValidateCmd(flags *ConfigValidateFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check that the configuration is valid",
+		Long: `Load the effective ATLAS configuration and run its validation rules.
+
+By default the merged configuration (defaults, global, project, and environment)
+is checked. Use --file to check a single config file on top of the defaults.
+
+Exits non-zero if the configuration cannot be loaded or is invalid.
+
+Examples:
+  atlas config validate                          # Validate merged configuration
+  atlas config validate --file .atlas/config.yaml # Validate one file`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runConfigValidate(cmd.Context(), cmd.OutOrStdout(), flags)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringVar(&flags.File, "file", "", "validate a single config file")
+
+	return cmd
+}
+
+// AddConfigValidateCommand adds the 'validate' subcommand to the config command.
+func AddConfigValidateCommand(configCmd *cobra.Command) {
+	flags := &ConfigValidateFlags{}
+	configCmd.AddCommand(newConfigValidateCmd(flags))
+}
+
+// runConfigValidate executes the config validate command.
+// Loading runs config.Validate, so any load error means the config is unusable.
+func runConfigValidate(ctx context.Context, w io.Writer, flags *ConfigValidateFlags) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	var err error
+	if flags.File != "" {
+		// LoadFromPaths tolerates missing files, but an explicit --file must exist
+		if _, statErr := os.Stat(flags.File); statErr != nil {
+			return fmt.Errorf("%w: %s", errors.ErrConfigNotFound, flags.File)
+		}
+		_, err = config.LoadFromPaths(ctx, flags.File, "")
+	} else {
+		_, err = config.Load(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("configuration is invalid: %w", err)
+	}
+
+	success := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF87")).Bold(true)
+	_, _ = fmt.Fprintln(w, success.Render("✓ Configuration is valid"))
+	return nil
+}
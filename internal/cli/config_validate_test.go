@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/errors"
+)
+
+func TestNewConfigValidateCmd(t *testing.T) {
+	t.Parallel()
+
+	cmd := newConfigValidateCmd(&ConfigValidateFlags{})
+
+	assert.Equal(t, "validate", cmd.Use)
+	require.NotNil(t, cmd.Flags().Lookup("file"))
+}
+
+func TestRunConfigValidate_ValidFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ai:\n  max_turns: 20\n"), 0o600))
+
+	var buf bytes.Buffer
+	err := runConfigValidate(context.Background(), &buf, &ConfigValidateFlags{File: path})
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "Configuration is valid")
+}
+
+func TestRunConfigValidate_InvalidFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ai:\n  max_turns: 500\n"), 0o600))
+
+	var buf bytes.Buffer
+	err := runConfigValidate(context.Background(), &buf, &ConfigValidateFlags{File: path})
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, errors.ErrConfigInvalidAI)
+	assert.NotContains(t, buf.String(), "Configuration is valid")
+}
+
+func TestRunConfigValidate_CommandExitsNonZero(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ai:\n  max_turns: 0\n"), 0o600))
+
+	cmd := newConfigValidateCmd(&ConfigValidateFlags{})
+	cmd.SetArgs([]string{"--file", path})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.ExecuteContext(context.Background())
+
+	require.Error(t, err)
+	assert.Equal(t, ExitError, ExitCodeForError(err))
+}
+
+func TestRunConfigValidate_MissingFile(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := runConfigValidate(context.Background(), &buf, &ConfigValidateFlags{File: filepath.Join(t.TempDir(), "missing.yaml")})
+
+	require.ErrorIs(t, err, errors.ErrConfigNotFound)
+}
+
+func TestRunConfigValidate_ContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := runConfigValidate(ctx, &bytes.Buffer{}, &ConfigValidateFlags{})
+
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,85 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mrz1836/atlas/internal/config"
+)
+
+//nolint:gochecknoglobals // Shared by loggers built while a command watches its config
+var (
+	// configWatchInterval is how often watchConfig checks the config file.
+	// Tests shorten it.
+	configWatchInterval = config.DefaultWatchInterval
+
+	// liveLogLevel is set while watchConfig owns the log level. Loggers built
+	// meanwhile log every level and leave filtering to zerolog's global level.
+	liveLogLevel atomic.Bool
+)
+
+// watchConfig starts a config.Watcher on the global config file for a
+// long-running command, so runtime settings edited while it runs take
+// effect without a restart. logging.level is applied through zerolog's
+// global level; an explicit --log-level flag keeps precedence over it.
+//
+// It returns logger with filtering handed to the global level, and a stop
+// function that ends the watch and restores the previous global level. If the
+// config cannot be loaded, nothing is watched and logger is returned unchanged.
+func watchConfig(ctx context.Context, logger zerolog.Logger) (zerolog.Logger, func()) {
+	path, err := config.GlobalConfigPath()
+	if err != nil {
+		return logger, func() {}
+	}
+	cfg, err := config.Load(ctx)
+	if err != nil {
+		logger.Debug().Err(err).Msg("config not watched, failed to load it")
+		return logger, func() {}
+	}
+
+	globalLoggerMu.RLock()
+	flagLevel := globalLogFlags.level
+	globalLoggerMu.RUnlock()
+
+	base := logger.GetLevel()
+	previous := zerolog.GlobalLevel()
+	applyLevel := func(c *config.Config) {
+		zerolog.SetGlobalLevel(configLogLevel(c, flagLevel, base))
+	}
+	applyLevel(cfg)
+	liveLogLevel.Store(true)
+
+	watcher := config.NewWatcher(path, cfg, config.WithWatchInterval(configWatchInterval))
+	watcher.OnReload(applyLevel)
+
+	watchCtx, cancel := context.WithCancel(logger.WithContext(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = watcher.Run(watchCtx)
+	}()
+
+	stop := func() {
+		cancel()
+		<-done
+		liveLogLevel.Store(false)
+		zerolog.SetGlobalLevel(previous)
+	}
+	return logger.Level(zerolog.TraceLevel), stop
+}
+
+// configLogLevel returns the log level for cfg: the --log-level flag when
+// given, then logging.level, then base (from --verbose and --quiet).
+func configLogLevel(cfg *config.Config, flagLevel string, base zerolog.Level) zerolog.Level {
+	if flagLevel != "" || cfg.Logging.Level == "" {
+		return base
+	}
+	level, err := zerolog.ParseLevel(cfg.Logging.Level)
+	if err != nil {
+		return base
+	}
+	return level
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/config"
+)
+
+// setupWatchedConfig points HOME at a temp dir holding a global config with
+// content, shortens the watch interval, and returns the config path.
+func setupWatchedConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(home)
+
+	path := filepath.Join(home, ".atlas", "config.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	interval := configWatchInterval
+	configWatchInterval = 10 * time.Millisecond
+	previous := zerolog.GlobalLevel()
+	t.Cleanup(func() {
+		configWatchInterval = interval
+		zerolog.SetGlobalLevel(previous)
+	})
+	return path
+}
+
+// rewriteWatchedConfig replaces the config at path with a later mtime so the
+// watcher sees the change even on filesystems with coarse timestamps.
+func rewriteWatchedConfig(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	stamp := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, stamp, stamp))
+}
+
+// TestWatchConfig_ReloadsLogLevel tests that editing logging.level changes
+// what a running command logs, and that stopping restores the global level.
+func TestWatchConfig_ReloadsLogLevel(t *testing.T) {
+	path := setupWatchedConfig(t, "logging:\n  level: warn\n")
+	previous := zerolog.GlobalLevel()
+
+	var buf bytes.Buffer
+	logger, stop := watchConfig(context.Background(), zerolog.New(&buf).Level(zerolog.InfoLevel))
+	defer stop()
+
+	assert.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+	logger.Info().Msg("hidden at warn")
+	assert.Empty(t, buf.String())
+
+	rewriteWatchedConfig(t, path, "logging:\n  level: debug\n")
+	require.Eventually(t, func() bool { return zerolog.GlobalLevel() == zerolog.DebugLevel },
+		5*time.Second, 10*time.Millisecond)
+
+	logger.Debug().Msg("shown at debug")
+	assert.Contains(t, buf.String(), "shown at debug")
+
+	stop()
+	assert.Equal(t, previous, zerolog.GlobalLevel())
+	assert.False(t, liveLogLevel.Load())
+}
+
+// TestWatchConfig_FlagLevelWins tests that an explicit --log-level is kept
+// when the config sets logging.level.
+func TestWatchConfig_FlagLevelWins(t *testing.T) {
+	setupWatchedConfig(t, "logging:\n  level: debug\n")
+
+	globalLoggerMu.Lock()
+	flagLevel := globalLogFlags.level
+	globalLogFlags.level = "error"
+	globalLoggerMu.Unlock()
+	t.Cleanup(func() {
+		globalLoggerMu.Lock()
+		globalLogFlags.level = flagLevel
+		globalLoggerMu.Unlock()
+	})
+
+	_, stop := watchConfig(context.Background(), zerolog.Nop().Level(zerolog.ErrorLevel))
+	defer stop()
+
+	assert.Equal(t, zerolog.ErrorLevel, zerolog.GlobalLevel())
+}
+
+// TestConfigLogLevel tests the level chosen for a config.
+func TestConfigLogLevel(t *testing.T) {
+	t.Parallel()
+
+	cfg := func(level string) *config.Config {
+		c := config.DefaultConfig()
+		c.Logging.Level = level
+		return c
+	}
+
+	assert.Equal(t, zerolog.InfoLevel, configLogLevel(cfg(""), "", zerolog.InfoLevel))
+	assert.Equal(t, zerolog.DebugLevel, configLogLevel(cfg("debug"), "", zerolog.InfoLevel))
+	assert.Equal(t, zerolog.WarnLevel, configLogLevel(cfg("debug"), "warn", zerolog.WarnLevel))
+}
@@ -0,0 +1,113 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/signal"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// AddContinueCommand adds the continue command to the root command.
+func AddContinueCommand(root *cobra.Command) {
+	root.AddCommand(newContinueCmd())
+}
+
+// newContinueCmd creates the continue command.
+func newContinueCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "continue <workspace>",
+		Short: "Approve the paused step and resume the task",
+		Long: `Approve the step a task is paused on and resume execution, without the
+interactive approval flow. This is the non-interactive counterpart to
+choosing "proceed" when a human review step asks for approval.
+
+The task must be awaiting approval. Steps that ask for a specific choice
+(for example how to handle unexpected files) still need 'atlas approve'.
+
+Examples:
+  atlas continue auth-fix            # Approve the review step and keep going
+  atlas continue auth-fix -o json    # Output the result as JSON`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runContinue(cmd.Context(), cmd, os.Stdout, args[0])
+		},
+	}
+}
+
+// runContinue approves the current step of the workspace's latest task and resumes it.
+func runContinue(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceName string) error {
+	logger := Logger()
+	outputFormat := cmd.Flag("output").Value.String()
+	tui.CheckNoColor()
+	out := tui.NewOutput(w, outputFormat)
+
+	sigHandler := signal.NewHandler(ctx)
+	defer sigHandler.Stop()
+	ctx = sigHandler.Context()
+
+	_, ws, err := setupWorkspace(ctx, workspaceName, "", outputFormat, w, logger)
+	if err != nil {
+		return fmt.Errorf("setup workspace: %w", err)
+	}
+
+	taskStore, currentTask, err := getLatestTask(ctx, workspaceName, "", outputFormat, w, logger)
+	if err != nil {
+		return fmt.Errorf("get latest task: %w", err)
+	}
+
+	if err = checkContinuable(currentTask, workspaceName); err != nil {
+		return handleResumeError(outputFormat, w, workspaceName, currentTask.ID, err)
+	}
+
+	ws, wsStore, err := prepareResumeWorkspace(ctx, ws, currentTask, outputFormat, w, out, logger)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := prepareResumeTemplate(currentTask, resumeOptions{}, outputFormat, w, workspaceName)
+	if err != nil {
+		return err
+	}
+
+	engine, state, err := createResumeEngine(ctx, ws, taskStore, currentTask, logger, out)
+	if err != nil {
+		return handleResumeError(outputFormat, w, workspaceName, currentTask.ID, err)
+	}
+
+	if err = engine.ApproveStep(ctx, currentTask); err != nil {
+		return handleResumeError(outputFormat, w, workspaceName, currentTask.ID, err)
+	}
+	out.Info(fmt.Sprintf("Approved step %d/%d, continuing...", currentTask.CurrentStep, len(currentTask.Steps)))
+
+	if currentTask.Metadata == nil {
+		currentTask.Metadata = make(map[string]any)
+	}
+	currentTask.Metadata["worktree_dir"] = ws.WorktreePath
+
+	return executeResumeAndHandleResult(ctx, engine, currentTask, tmpl, state, sigHandler, out, ws, wsStore, outputFormat, w, workspaceName, logger)
+}
+
+// checkContinuable returns an error unless t is paused awaiting a plain
+// approval that continue can give.
+func checkContinuable(t *domain.Task, workspaceName string) error {
+	if t.Status != constants.TaskStatusAwaitingApproval {
+		return fmt.Errorf("%w: task %s is %s, not awaiting approval", atlaserrors.ErrInvalidStatus, t.ID, t.Status)
+	}
+	if t.CurrentStep >= len(t.Steps) {
+		return fmt.Errorf("%w: all steps are done, run 'atlas approve %s' to complete the task", atlaserrors.ErrInvalidStatus, workspaceName)
+	}
+	if hasStepLevelApproval(t) {
+		return fmt.Errorf("%w: the current step needs a choice, run 'atlas approve %s'", atlaserrors.ErrInvalidStatus, workspaceName)
+	}
+	return nil
+}
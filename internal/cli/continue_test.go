@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func continueTestTask(status constants.TaskStatus) *domain.Task {
+	return &domain.Task{
+		ID:          "task-continue",
+		Status:      status,
+		CurrentStep: 1,
+		Steps: []domain.Step{
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess},
+			{Name: "review", Type: domain.StepTypeHuman, Status: constants.StepStatusAwaitingApproval},
+			{Name: "git_commit", Type: domain.StepTypeGit, Status: constants.StepStatusPending},
+		},
+		StepResults: []domain.StepResult{
+			{StepIndex: 1, StepName: "review", Status: constants.StepStatusAwaitingApproval, Output: "Review required"},
+		},
+	}
+}
+
+// TestCheckContinuable tests which tasks the continue command accepts.
+func TestCheckContinuable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("awaiting approval", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, checkContinuable(continueTestTask(constants.TaskStatusAwaitingApproval), "auth"))
+	})
+
+	t.Run("running task rejected", func(t *testing.T) {
+		t.Parallel()
+		err := checkContinuable(continueTestTask(constants.TaskStatusRunning), "auth")
+		require.ErrorIs(t, err, atlaserrors.ErrInvalidStatus)
+		assert.Contains(t, err.Error(), "not awaiting approval")
+	})
+
+	t.Run("step needing a choice rejected", func(t *testing.T) {
+		t.Parallel()
+		task := continueTestTask(constants.TaskStatusAwaitingApproval)
+		task.StepResults[0].ApprovalOptions = []domain.ApprovalOption{{Key: "remove", Label: "Remove files"}}
+
+		err := checkContinuable(task, "auth")
+		require.ErrorIs(t, err, atlaserrors.ErrInvalidStatus)
+		assert.Contains(t, err.Error(), "atlas approve auth")
+	})
+
+	t.Run("all steps done rejected", func(t *testing.T) {
+		t.Parallel()
+		task := continueTestTask(constants.TaskStatusAwaitingApproval)
+		task.CurrentStep = len(task.Steps)
+
+		err := checkContinuable(task, "auth")
+		require.ErrorIs(t, err, atlaserrors.ErrInvalidStatus)
+		assert.Contains(t, err.Error(), "atlas approve auth")
+	})
+}
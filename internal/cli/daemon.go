@@ -11,7 +11,9 @@ import (
 
 	"github.com/mrz1836/atlas/internal/cli/workflow"
 	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/daemon"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // Compile-time check: DaemonTaskExecutor implements daemon.TaskExecutor.
@@ -73,6 +75,10 @@ func runDaemonStart(cmd *cobra.Command, _ []string) error {
 	//nolint:gosec // G204: exe is from os.Executable, which is the current binary
 	daemonCmd := exec.CommandContext(context.Background(), exe, "--daemon")
 	setDaemonSysProcAttr(daemonCmd)
+	// The daemon skips flag parsing, so hand it the state dir --base-dir resolved
+	if stateDir, dirErr := workspace.StateDir(); dirErr == nil {
+		daemonCmd.Env = append(os.Environ(), constants.AtlasHomeEnvVar+"="+stateDir)
+	}
 	daemonCmd.Stdout = nil
 	daemonCmd.Stderr = nil
 	daemonCmd.Stdin = nil
@@ -231,7 +237,8 @@ func RunDaemonProcess(ctx context.Context) error {
 		cfg = config.DefaultConfig()
 	}
 
-	logger := InitLogger(false, false)
+	logger, stopConfigWatch := watchConfig(ctx, InitLogger(false, false))
+	defer stopConfigWatch()
 	executor := workflow.NewDaemonTaskExecutor(cfg, logger)
 	d := daemon.New(cfg, logger, daemon.WithExecutor(executor))
 	return d.Run(ctx)
@@ -0,0 +1,104 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// AddDiffCommand adds the diff command to the root command.
+func AddDiffCommand(root *cobra.Command) {
+	root.AddCommand(newDiffCmd())
+}
+
+// diffResponse is the JSON output of the diff command.
+type diffResponse struct {
+	Workspace  string `json:"workspace"`
+	Branch     string `json:"branch"`
+	BaseBranch string `json:"base_branch,omitempty"`
+	Diff       string `json:"diff"`
+}
+
+// newDiffCmd creates the diff command.
+func newDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <workspace>",
+		Short: "Show the changes made in a workspace",
+		Long: `Show the git diff of a workspace's worktree against the base branch of its
+most recent task, or of its latest commit when no base branch is known.
+
+On a terminal, additions are shown in green, deletions in red, and hunk
+headers in cyan, paged through $PAGER. Colors are off when NO_COLOR is set,
+when output is piped, and with --output json.
+
+Files matching .atlasignore are left out.
+
+Examples:
+  atlas diff auth-fix             # Colored diff in a pager
+  atlas diff auth-fix | less      # Plain diff
+  atlas diff auth-fix -o json     # Diff as JSON`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiff(cmd.Context(), cmd, os.Stdout, args[0], "")
+		},
+	}
+}
+
+// runDiff executes the diff command.
+func runDiff(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceName, storeBaseDir string) error {
+	outputFormat := cmd.Flag("output").Value.String()
+	return runDiffWithOutput(ctx, w, workspaceName, storeBaseDir, outputFormat, tui.NewDiffColorizer(w))
+}
+
+// runDiffWithOutput executes the diff command with explicit output format
+// and colorizer.
+func runDiffWithOutput(ctx context.Context, w io.Writer, workspaceName, storeBaseDir, outputFormat string, colorizer *tui.DiffColorizer) error {
+	wsStore, err := newWorkspaceStore(storeBaseDir)
+	if err != nil {
+		return fmt.Errorf("failed to create workspace store: %w", err)
+	}
+	ws, err := wsStore.Get(ctx, workspaceName)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace '%s': %w", workspaceName, err)
+	}
+
+	if ws.WorktreePath == "" {
+		return fmt.Errorf("workspace '%s' has no worktree: %w", workspaceName, atlaserrors.ErrEmptyValue)
+	}
+
+	baseBranch := ""
+	if taskStore, storeErr := newTaskStore(storeBaseDir); storeErr == nil {
+		if tasks, listErr := taskStore.List(ctx, workspaceName); listErr == nil && len(tasks) > 0 {
+			baseBranch = tasks[0].BaseBranch
+		}
+	}
+
+	diff, err := collectDiff(ctx, ws.WorktreePath, baseBranch)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat == OutputJSON {
+		return encodeJSONIndented(w, diffResponse{
+			Workspace:  ws.Name,
+			Branch:     ws.Branch,
+			BaseBranch: baseBranch,
+			Diff:       string(diff),
+		})
+	}
+
+	if len(diff) == 0 {
+		_, err := fmt.Fprintln(w, "No changes to display.")
+		return err
+	}
+
+	return tui.NewPager(w).Page(ctx, colorizer.Colorize(string(diff)))
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// setupDiffWorkspace creates a workspace whose worktree has an uncommitted change.
+func setupDiffWorkspace(t *testing.T) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	repo := initGitRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "test.txt"), []byte("changed\n"), 0o600))
+
+	wsStore, err := workspace.NewFileStore(tmpDir)
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, wsStore.Create(context.Background(), &domain.Workspace{
+		Name:         "diff-ws",
+		WorktreePath: repo,
+		Branch:       "feat/diff",
+		Status:       constants.WorkspaceStatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}))
+	return tmpDir
+}
+
+// TestRunDiff_ColoredOnTTY tests that additions and deletions are colored on a terminal.
+func TestRunDiff_ColoredOnTTY(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("NO_COLOR", "")
+	require.NoError(t, os.Unsetenv("NO_COLOR"))
+	tmpDir := setupDiffWorkspace(t)
+
+	var buf bytes.Buffer
+	err := runDiffWithOutput(context.Background(), &buf, "diff-ws", tmpDir, OutputText,
+		tui.NewDiffColorizer(&buf, tui.WithDiffTTY(true)))
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "\x1b[32m+changed")
+	assert.Contains(t, buf.String(), "\x1b[31m-test")
+}
+
+// TestRunDiff_PlainWhenNotTTY tests that piped diff output has no colors.
+func TestRunDiff_PlainWhenNotTTY(t *testing.T) {
+	tmpDir := setupDiffWorkspace(t)
+
+	var buf bytes.Buffer
+	err := runDiffWithOutput(context.Background(), &buf, "diff-ws", tmpDir, OutputText, tui.NewDiffColorizer(&buf))
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "+changed")
+	assert.NotContains(t, buf.String(), "\x1b[")
+}
+
+// TestRunDiff_JSONHasNoColors tests that JSON output carries the plain diff.
+func TestRunDiff_JSONHasNoColors(t *testing.T) {
+	tmpDir := setupDiffWorkspace(t)
+
+	var buf bytes.Buffer
+	err := runDiffWithOutput(context.Background(), &buf, "diff-ws", tmpDir, OutputJSON,
+		tui.NewDiffColorizer(&buf, tui.WithDiffTTY(true)))
+
+	require.NoError(t, err)
+	var resp diffResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "diff-ws", resp.Workspace)
+	assert.Equal(t, "feat/diff", resp.Branch)
+	assert.Contains(t, resp.Diff, "+changed")
+	assert.NotContains(t, resp.Diff, "\x1b[")
+}
+
+// TestRunDiff_WorkspaceNotFound tests the error for an unknown workspace.
+func TestRunDiff_WorkspaceNotFound(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := runDiffWithOutput(context.Background(), &buf, "missing", t.TempDir(), OutputText, tui.NewDiffColorizer(&buf))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing")
+}
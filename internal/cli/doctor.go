@@ -0,0 +1,371 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/cli/workflow"
+	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// Doctor check statuses.
+const (
+	doctorStatusPass = "pass"
+	doctorStatusWarn = "warn"
+	doctorStatusFail = "fail"
+)
+
+// doctorFlags holds the flags for the doctor command.
+type doctorFlags struct {
+	json bool
+}
+
+// doctorCheck is the result of a single environment check.
+type doctorCheck struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	Hint     string `json:"hint,omitempty"`
+	Critical bool   `json:"critical"`
+}
+
+// doctorReport is the full result of atlas doctor.
+type doctorReport struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []doctorCheck `json:"checks"`
+}
+
+// doctorDeps holds the dependencies used by the doctor checks.
+// Tests replace them to stub out git, PATH lookups, and the environment.
+type doctorDeps struct {
+	findRepo func(ctx context.Context) (string, error)
+	executor config.CommandExecutor
+	getenv   func(key string) string
+	agent    domain.Agent
+	apiKey   string // API key environment variable for the agent
+	stateDir string
+}
+
+// AddDoctorCommand adds the doctor command to the root command.
+func AddDoctorCommand(root *cobra.Command) {
+	root.AddCommand(newDoctorCmd())
+}
+
+// newDoctorCmd creates the doctor command.
+func newDoctorCmd() *cobra.Command {
+	flags := &doctorFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that your environment is ready for ATLAS",
+		Long: `Check the environment ATLAS depends on and report a pass/fail line per check.
+
+Checks:
+  - Current directory is inside a git repository
+  - Git is installed and meets the minimum version
+  - The configured AI agent CLI is on PATH and has credentials
+  - GitHub CLI (gh) is installed and authenticated
+  - The ATLAS state directory is writable
+
+Exits non-zero if any critical check fails.
+
+Examples:
+  atlas doctor          # Run all checks
+  atlas doctor --json   # Output results as JSON`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			err := runDoctor(cmd.Context(), cmd, cmd.OutOrStdout(), newDoctorDeps(cmd.Context()), flags)
+			// Failed checks are already reported; only the exit code is needed
+			if errors.Is(err, atlaserrors.ErrDoctorChecksFailed) {
+				cmd.SilenceErrors = true
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&flags.json, "json", false, "Output results as JSON")
+
+	return cmd
+}
+
+// newDoctorDeps builds the production dependencies for the doctor checks.
+func newDoctorDeps(ctx context.Context) doctorDeps {
+	cfg, err := config.Load(ctx)
+	if err != nil {
+		cfg = config.DefaultConfig()
+	}
+
+	agent := domain.Agent(cfg.AI.Agent)
+	if agent == "" {
+		agent = domain.AgentClaude
+	}
+
+	stateDir, _ := workspace.StateDir()
+
+	return doctorDeps{
+		findRepo: workflow.FindGitRepository,
+		executor: &config.DefaultCommandExecutor{},
+		getenv:   os.Getenv,
+		agent:    agent,
+		apiKey:   cfg.AI.GetAPIKeyEnvVar(string(agent)),
+		stateDir: stateDir,
+	}
+}
+
+// runDoctor runs all checks and reports the results.
+// Returns ErrDoctorChecksFailed if any critical check failed.
+func runDoctor(ctx context.Context, cmd *cobra.Command, w io.Writer, deps doctorDeps, flags *doctorFlags) error {
+	outputFormat := getOutputFormat(cmd, flags.json)
+	out := tui.NewOutput(w, outputFormat)
+
+	report := runDoctorChecks(ctx, deps)
+
+	if outputFormat == OutputJSON {
+		if err := out.JSON(report); err != nil {
+			return err
+		}
+	} else {
+		displayDoctorReport(out, report)
+	}
+
+	if !report.Healthy {
+		return atlaserrors.ErrDoctorChecksFailed
+	}
+	return nil
+}
+
+// runDoctorChecks executes each check in order and summarizes the result.
+func runDoctorChecks(ctx context.Context, deps doctorDeps) doctorReport {
+	checks := []doctorCheck{
+		checkGitRepository(ctx, deps),
+		checkGitVersion(ctx, deps),
+		checkAgentBinary(deps),
+		checkAgentAuth(deps),
+		checkGHBinary(deps),
+		checkGHAuth(ctx, deps),
+		checkStateDirWritable(deps),
+	}
+
+	healthy := true
+	for _, check := range checks {
+		if check.Critical && check.Status == doctorStatusFail {
+			healthy = false
+		}
+	}
+
+	return doctorReport{Healthy: healthy, Checks: checks}
+}
+
+// displayDoctorReport prints one line per check for terminal output.
+func displayDoctorReport(out tui.Output, report doctorReport) {
+	for _, check := range report.Checks {
+		line := fmt.Sprintf("%s: %s", check.Name, check.Message)
+		switch check.Status {
+		case doctorStatusPass:
+			out.Success(line)
+		case doctorStatusWarn:
+			out.Warning(line)
+		default:
+			out.Error(tui.NewActionableError(line, check.Hint))
+		}
+	}
+
+	out.Info("")
+	if report.Healthy {
+		out.Success("All critical checks passed")
+	} else {
+		out.Error(tui.NewActionableError("One or more critical checks failed", "Fix the issues above and run 'atlas doctor' again"))
+	}
+}
+
+// checkGitRepository verifies the current directory is inside a git repository.
+func checkGitRepository(ctx context.Context, deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: "git repository", Critical: true}
+
+	repoPath, err := deps.findRepo(ctx)
+	if err != nil {
+		check.Status = doctorStatusFail
+		check.Message = "not inside a git repository"
+		check.Hint = "Run atlas from within a git repository"
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = repoPath
+	return check
+}
+
+// checkGitVersion verifies git is installed and meets the minimum version.
+func checkGitVersion(ctx context.Context, deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: "git version", Critical: true}
+
+	output, err := deps.executor.Run(ctx, constants.ToolGit, constants.VersionFlagStandard)
+	if err != nil {
+		check.Status = doctorStatusFail
+		check.Message = "git is not installed"
+		check.Hint = "Install Git from https://git-scm.com/downloads"
+		return check
+	}
+
+	version := config.ParseGitVersion(output)
+	if version == "" {
+		check.Status = doctorStatusFail
+		check.Message = "could not determine git version"
+		return check
+	}
+
+	if config.CompareVersions(version, constants.MinVersionGit) < 0 {
+		check.Status = doctorStatusFail
+		check.Message = fmt.Sprintf("git %s is older than the required %s", version, constants.MinVersionGit)
+		check.Hint = "Upgrade Git from https://git-scm.com/downloads"
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = version
+	return check
+}
+
+// checkAgentBinary verifies the configured AI agent CLI is on PATH.
+func checkAgentBinary(deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: fmt.Sprintf("%s CLI", deps.agent), Critical: true}
+
+	if deps.agent.IsBuiltin() {
+		check.Status = doctorStatusPass
+		check.Message = "built in, no CLI required"
+		return check
+	}
+
+	tool := deps.agent.ToolName()
+	if tool == "" {
+		check.Status = doctorStatusFail
+		check.Message = fmt.Sprintf("unknown AI agent %q", deps.agent)
+		check.Hint = "Set ai.agent to claude, gemini, or codex"
+		return check
+	}
+
+	path, err := deps.executor.LookPath(tool)
+	if err != nil {
+		check.Status = doctorStatusFail
+		check.Message = fmt.Sprintf("%s not found on PATH", tool)
+		check.Hint = deps.agent.InstallHint()
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = path
+	return check
+}
+
+// checkAgentAuth verifies the AI agent's API key is available.
+// The agent CLI may also be logged in interactively, so a missing key is a warning.
+func checkAgentAuth(deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: fmt.Sprintf("%s credentials", deps.agent)}
+
+	if deps.agent.IsBuiltin() {
+		check.Status = doctorStatusPass
+		check.Message = "no credentials required"
+		return check
+	}
+
+	if deps.apiKey == "" {
+		check.Status = doctorStatusWarn
+		check.Message = "no API key environment variable configured"
+		return check
+	}
+
+	if deps.getenv(deps.apiKey) == "" {
+		check.Status = doctorStatusWarn
+		check.Message = fmt.Sprintf("%s is not set; the CLI must be logged in", deps.apiKey)
+		check.Hint = fmt.Sprintf("Export %s or log in with the %s CLI", deps.apiKey, deps.agent.ToolName())
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = fmt.Sprintf("%s is set", deps.apiKey)
+	return check
+}
+
+// checkGHBinary verifies the GitHub CLI is on PATH.
+func checkGHBinary(deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: "gh CLI", Critical: true}
+
+	path, err := deps.executor.LookPath(constants.ToolGH)
+	if err != nil {
+		check.Status = doctorStatusFail
+		check.Message = "gh not found on PATH"
+		check.Hint = "Install GitHub CLI: brew install gh"
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = path
+	return check
+}
+
+// checkGHAuth verifies the GitHub CLI is authenticated.
+func checkGHAuth(ctx context.Context, deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: "gh auth", Critical: true}
+
+	if _, err := deps.executor.Run(ctx, constants.ToolGH, "auth", "status"); err != nil {
+		check.Status = doctorStatusFail
+		check.Message = "gh is not authenticated"
+		check.Hint = "Run 'gh auth login'"
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = "authenticated"
+	return check
+}
+
+// checkStateDirWritable verifies ATLAS can write to its state directory.
+func checkStateDirWritable(deps doctorDeps) doctorCheck {
+	check := doctorCheck{Name: "state directory", Critical: true}
+
+	if deps.stateDir == "" {
+		check.Status = doctorStatusFail
+		check.Message = "could not determine state directory"
+		return check
+	}
+
+	if err := probeWritableDir(deps.stateDir); err != nil {
+		check.Status = doctorStatusFail
+		check.Message = fmt.Sprintf("%s is not writable: %v", deps.stateDir, err)
+		check.Hint = fmt.Sprintf("Check the permissions of %s", deps.stateDir)
+		return check
+	}
+
+	check.Status = doctorStatusPass
+	check.Message = deps.stateDir
+	return check
+}
+
+// probeWritableDir creates dir if needed and writes then removes a temp file in it.
+func probeWritableDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	closeErr := f.Close()
+	removeErr := os.Remove(name)
+	if closeErr != nil {
+		return closeErr
+	}
+	return removeErr
+}
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/errors"
+)
+
+// newHealthyDoctorDeps returns doctor dependencies where every check passes.
+func newHealthyDoctorDeps(t *testing.T) doctorDeps {
+	t.Helper()
+	return doctorDeps{
+		findRepo: func(_ context.Context) (string, error) { return "/repo", nil },
+		executor: &mockCommandExecutor{
+			lookPathResults: map[string]string{
+				"claude": "/usr/local/bin/claude",
+				"gh":     "/usr/local/bin/gh",
+			},
+			runResults: map[string]string{
+				"git --version":  "git version 2.43.0",
+				"gh auth status": "Logged in to github.com",
+			},
+		},
+		getenv: func(key string) string {
+			if key == "ANTHROPIC_API_KEY" {
+				return "sk-test"
+			}
+			return ""
+		},
+		agent:    domain.AgentClaude,
+		apiKey:   "ANTHROPIC_API_KEY",
+		stateDir: filepath.Join(t.TempDir(), ".atlas"),
+	}
+}
+
+// findDoctorCheck returns the named check from the report.
+func findDoctorCheck(t *testing.T, report doctorReport, name string) doctorCheck {
+	t.Helper()
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	require.Failf(t, "check not found", "no check named %q", name)
+	return doctorCheck{}
+}
+
+// TestAddDoctorCommand tests the doctor command is registered with its flags.
+func TestAddDoctorCommand(t *testing.T) {
+	t.Parallel()
+
+	root := &cobra.Command{Use: "atlas"}
+	AddDoctorCommand(root)
+
+	doctorCmd, _, err := root.Find([]string{"doctor"})
+	require.NoError(t, err)
+	assert.Equal(t, "doctor", doctorCmd.Name())
+	assert.NotNil(t, doctorCmd.Flags().Lookup("json"))
+}
+
+// TestRunDoctorChecks_AllPass tests every check passes with a healthy environment.
+func TestRunDoctorChecks_AllPass(t *testing.T) {
+	t.Parallel()
+
+	report := runDoctorChecks(context.Background(), newHealthyDoctorDeps(t))
+
+	assert.True(t, report.Healthy)
+	require.Len(t, report.Checks, 7)
+	for _, check := range report.Checks {
+		assert.Equal(t, doctorStatusPass, check.Status, "check %q", check.Name)
+	}
+	assert.Equal(t, "2.43.0", findDoctorCheck(t, report, "git version").Message)
+}
+
+// TestRunDoctorChecks_MockAgent tests the built-in mock agent needs no CLI or credentials.
+func TestRunDoctorChecks_MockAgent(t *testing.T) {
+	t.Parallel()
+
+	deps := newHealthyDoctorDeps(t)
+	deps.agent = domain.AgentMock
+	deps.apiKey = ""
+	deps.getenv = func(string) string { return "" }
+
+	report := runDoctorChecks(context.Background(), deps)
+
+	assert.True(t, report.Healthy)
+	assert.Equal(t, doctorStatusPass, findDoctorCheck(t, report, "mock CLI").Status)
+	assert.Equal(t, doctorStatusPass, findDoctorCheck(t, report, "mock credentials").Status)
+}
+
+// TestRunDoctorChecks_Failures tests each check's failure path.
+func TestRunDoctorChecks_Failures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		check      string
+		modify     func(t *testing.T, deps *doctorDeps)
+		wantStatus string
+		healthy    bool
+	}{
+		{
+			name:  "not in git repository",
+			check: "git repository",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				deps.findRepo = func(_ context.Context) (string, error) { return "", errors.ErrNotGitRepo }
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "git missing",
+			check: "git version",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				deps.executor.(*mockCommandExecutor).runErrors = map[string]error{"git --version": exec.ErrNotFound}
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "git too old",
+			check: "git version",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				deps.executor.(*mockCommandExecutor).runResults["git --version"] = "git version 2.10.0"
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "agent binary missing",
+			check: "claude CLI",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				delete(deps.executor.(*mockCommandExecutor).lookPathResults, "claude")
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "unknown agent",
+			check: "unknown CLI",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				deps.agent = domain.Agent("unknown")
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "agent API key missing is a warning",
+			check: "claude credentials",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				deps.getenv = func(string) string { return "" }
+			},
+			wantStatus: doctorStatusWarn,
+			healthy:    true,
+		},
+		{
+			name:  "gh missing",
+			check: "gh CLI",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				delete(deps.executor.(*mockCommandExecutor).lookPathResults, "gh")
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "gh not authenticated",
+			check: "gh auth",
+			modify: func(_ *testing.T, deps *doctorDeps) {
+				deps.executor.(*mockCommandExecutor).runErrors = map[string]error{"gh auth status": errors.ErrGitHubOperation}
+			},
+			wantStatus: doctorStatusFail,
+		},
+		{
+			name:  "state directory not writable",
+			check: "state directory",
+			modify: func(t *testing.T, deps *doctorDeps) {
+				// A regular file in place of a parent directory cannot be created into
+				blocker := filepath.Join(t.TempDir(), "blocker")
+				require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o600))
+				deps.stateDir = filepath.Join(blocker, ".atlas")
+			},
+			wantStatus: doctorStatusFail,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := newHealthyDoctorDeps(t)
+			tc.modify(t, &deps)
+
+			report := runDoctorChecks(context.Background(), deps)
+
+			check := findDoctorCheck(t, report, tc.check)
+			assert.Equal(t, tc.wantStatus, check.Status)
+			assert.NotEmpty(t, check.Message)
+			assert.Equal(t, tc.healthy, report.Healthy)
+		})
+	}
+}
+
+// TestRunDoctor_JSONOutput tests the JSON report and non-zero result on failure.
+func TestRunDoctor_JSONOutput(t *testing.T) {
+	t.Parallel()
+
+	deps := newHealthyDoctorDeps(t)
+	delete(deps.executor.(*mockCommandExecutor).lookPathResults, "gh")
+
+	var buf bytes.Buffer
+	err := runDoctor(context.Background(), &cobra.Command{}, &buf, deps, &doctorFlags{json: true})
+	require.ErrorIs(t, err, errors.ErrDoctorChecksFailed)
+
+	var report doctorReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	assert.False(t, report.Healthy)
+	check := findDoctorCheck(t, report, "gh CLI")
+	assert.Equal(t, doctorStatusFail, check.Status)
+	assert.True(t, check.Critical)
+	assert.NotEmpty(t, check.Hint)
+}
+
+// TestRunDoctor_TextOutput tests a pass/fail line is printed per check.
+func TestRunDoctor_TextOutput(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", OutputText, "output format")
+
+	var buf bytes.Buffer
+	err := runDoctor(context.Background(), cmd, &buf, newHealthyDoctorDeps(t), &doctorFlags{})
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.Contains(t, output, "git repository: /repo")
+	assert.Contains(t, output, "git version: 2.43.0")
+	assert.Contains(t, output, "claude CLI: /usr/local/bin/claude")
+	assert.Contains(t, output, "gh auth: authenticated")
+	assert.Contains(t, output, "All critical checks passed")
+}
@@ -0,0 +1,227 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+)
+
+// Export report formats.
+const (
+	exportFormatMarkdown = "markdown"
+	exportFormatJSON     = "json"
+)
+
+// AddExportCommand adds the export command to the root command.
+func AddExportCommand(root *cobra.Command) {
+	root.AddCommand(newExportCmd())
+}
+
+// exportReport is a shareable summary of a task run.
+type exportReport struct {
+	Workspace    string                `json:"workspace"`
+	TaskID       string                `json:"task_id"`
+	Template     string                `json:"template"`
+	Description  string                `json:"description"`
+	Status       constants.TaskStatus  `json:"status"`
+	Branch       string                `json:"branch,omitempty"`
+	StartedAt    time.Time             `json:"started_at"`
+	FinishedAt   time.Time             `json:"finished_at"`
+	DurationMs   int64                 `json:"duration_ms"`
+	Steps        []task.RunSummaryStep `json:"steps"`
+	FilesChanged []string              `json:"files_changed"`
+	LastError    string                `json:"last_error,omitempty"`
+}
+
+// newExportCmd creates the export command.
+func newExportCmd() *cobra.Command {
+	var taskID, format string
+
+	cmd := &cobra.Command{
+		Use:   "export <workspace>",
+		Short: "Export a task report as markdown or JSON",
+		Long: `Export a report of a workspace's most recent task, or of the task given with
+--task, for PR descriptions and standups.
+
+The report covers the task description, final status, the outcome of every
+step, files changed, and duration. It uses the run summary saved when the
+task finished, or builds one from the task record if there is none.
+
+Examples:
+  atlas export auth-fix                          # Markdown report of the latest task
+  atlas export auth-fix --task task-123 > r.md   # Report of a specific task
+  atlas export auth-fix --format json            # Report as JSON`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("format") && cmd.Flag("output").Value.String() == OutputJSON {
+				format = exportFormatJSON
+			}
+			return runExport(cmd.Context(), os.Stdout, args[0], taskID, format, "")
+		},
+	}
+
+	cmd.Flags().StringVar(&taskID, "task", "", "Export a specific task ID (default: most recent task)")
+	cmd.Flags().StringVar(&format, "format", exportFormatMarkdown, "Report format: markdown or json")
+
+	return cmd
+}
+
+// runExport writes the report for a workspace's task in the given format.
+func runExport(ctx context.Context, w io.Writer, workspaceName, taskID, format, storeBaseDir string) error {
+	format = strings.ToLower(format)
+	if format == "md" {
+		format = exportFormatMarkdown
+	}
+	if format != exportFormatMarkdown && format != exportFormatJSON {
+		return fmt.Errorf("%w: %s (use markdown or json)", atlaserrors.ErrUnsupportedOutputFormat, format)
+	}
+
+	taskStore, err := newTaskStore(storeBaseDir)
+	if err != nil {
+		return fmt.Errorf("failed to create task store: %w", err)
+	}
+
+	t, err := findExportTask(ctx, taskStore, workspaceName, taskID)
+	if err != nil {
+		return err
+	}
+
+	report := buildExportReport(t, loadRunSummary(ctx, taskStore, t))
+
+	if format == exportFormatJSON {
+		return encodeJSONIndented(w, report)
+	}
+	_, err = io.WriteString(w, renderExportMarkdown(report))
+	return err
+}
+
+// findExportTask returns the task with taskID, or the most recent task when
+// taskID is empty.
+func findExportTask(ctx context.Context, taskStore task.Store, workspaceName, taskID string) (*domain.Task, error) {
+	if taskID != "" {
+		t, err := taskStore.Get(ctx, workspaceName, taskID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get task '%s': %w", taskID, err)
+		}
+		return t, nil
+	}
+
+	tasks, err := taskStore.List(ctx, workspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no tasks found in workspace '%s': %w", workspaceName, atlaserrors.ErrNoTasksFound)
+	}
+	return tasks[0], nil
+}
+
+// loadRunSummary returns the task's saved run summary, or one built from the
+// task record when the artifact is missing or unreadable.
+func loadRunSummary(ctx context.Context, taskStore task.Store, t *domain.Task) task.RunSummary {
+	data, err := taskStore.GetArtifact(ctx, t.WorkspaceID, t.ID, task.RunSummaryArtifact)
+	if err == nil {
+		var summary task.RunSummary
+		if json.Unmarshal(data, &summary) == nil {
+			return summary
+		}
+	} else if !errors.Is(err, atlaserrors.ErrArtifactNotFound) {
+		logger := Logger()
+		logger.Debug().Err(err).Str("task_id", t.ID).Msg("failed to read run summary")
+	}
+
+	finished := t.UpdatedAt
+	if t.CompletedAt != nil {
+		finished = *t.CompletedAt
+	}
+	return task.BuildRunSummary(t, finished)
+}
+
+// buildExportReport combines the task record with its run summary.
+func buildExportReport(t *domain.Task, summary task.RunSummary) exportReport {
+	files := make([]string, 0)
+	for _, result := range t.StepResults {
+		for _, f := range result.FilesChanged {
+			if !slices.Contains(files, f) {
+				files = append(files, f)
+			}
+		}
+	}
+	slices.Sort(files)
+
+	branch, _ := t.Metadata["branch"].(string)
+
+	return exportReport{
+		Workspace:    t.WorkspaceID,
+		TaskID:       t.ID,
+		Template:     t.TemplateID,
+		Description:  t.Description,
+		Status:       t.Status,
+		Branch:       branch,
+		StartedAt:    summary.StartedAt,
+		FinishedAt:   summary.FinishedAt,
+		DurationMs:   summary.DurationMs,
+		Steps:        summary.Steps,
+		FilesChanged: files,
+		LastError:    summary.LastError,
+	}
+}
+
+// renderExportMarkdown formats a report as markdown.
+func renderExportMarkdown(r exportReport) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Task Report: %s\n\n", r.Workspace)
+	if r.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", r.Description)
+	}
+
+	fmt.Fprintf(&b, "- **Task:** `%s`\n", r.TaskID)
+	fmt.Fprintf(&b, "- **Template:** %s\n", r.Template)
+	fmt.Fprintf(&b, "- **Status:** %s\n", r.Status)
+	if r.Branch != "" {
+		fmt.Fprintf(&b, "- **Branch:** `%s`\n", r.Branch)
+	}
+	fmt.Fprintf(&b, "- **Duration:** %s\n", formatExportDuration(r.DurationMs))
+	if r.LastError != "" {
+		fmt.Fprintf(&b, "- **Last error:** %s\n", r.LastError)
+	}
+
+	b.WriteString("\n## Steps\n\n")
+	b.WriteString("| Step | Type | Status | Attempts | Duration |\n")
+	b.WriteString("|------|------|--------|----------|----------|\n")
+	for _, s := range r.Steps {
+		fmt.Fprintf(&b, "| %s | %s | %s | %d | %s |\n",
+			s.Name, s.Type, s.Status, s.Attempts, formatExportDuration(s.DurationMs))
+	}
+
+	b.WriteString("\n## Files Changed\n\n")
+	if len(r.FilesChanged) == 0 {
+		b.WriteString("No files changed.\n")
+	}
+	for _, f := range r.FilesChanged {
+		fmt.Fprintf(&b, "- `%s`\n", f)
+	}
+
+	return b.String()
+}
+
+// formatExportDuration renders milliseconds rounded to the second.
+func formatExportDuration(ms int64) string {
+	return (time.Duration(ms) * time.Millisecond).Round(time.Second).String()
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// setupExportTask creates a workspace with one finished task and returns the
+// store directory and task ID.
+func setupExportTask(t *testing.T, withSummary bool) (string, string) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	taskID := testTaskID("300001")
+	started := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	finished := started.Add(90 * time.Second)
+
+	wsStore, err := workspace.NewFileStore(tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, wsStore.Create(context.Background(), &domain.Workspace{
+		Name:      "export-ws",
+		Branch:    "fix/export",
+		Status:    constants.WorkspaceStatusActive,
+		Tasks:     []domain.TaskRef{{ID: taskID}},
+		CreatedAt: started,
+		UpdatedAt: started,
+	}))
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+	tk := &domain.Task{
+		ID:          taskID,
+		WorkspaceID: "export-ws",
+		TemplateID:  "bugfix",
+		Description: "fix null pointer in parseConfig",
+		Status:      constants.TaskStatusAwaitingApproval,
+		Steps: []domain.Step{
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess, Attempts: 2},
+			{Name: "validate", Type: domain.StepTypeValidation, Status: constants.StepStatusFailed, Attempts: 1, Error: "lint failed"},
+		},
+		StepResults: []domain.StepResult{
+			{StepName: "implement", Status: constants.StepStatusSuccess, DurationMs: 30000, FilesChanged: []string{"config.go", "parse.go"}},
+			{StepName: "validate", Status: constants.StepStatusFailed, DurationMs: 5000, FilesChanged: []string{"config.go"}},
+		},
+		Metadata:    map[string]any{"branch": "fix/export"},
+		CreatedAt:   started,
+		UpdatedAt:   finished,
+		CompletedAt: &finished,
+	}
+	require.NoError(t, taskStore.Create(context.Background(), "export-ws", tk))
+
+	if withSummary {
+		summary := task.BuildRunSummary(tk, finished)
+		summary.LastError = "from summary"
+		data, err := json.Marshal(summary)
+		require.NoError(t, err)
+		require.NoError(t, taskStore.SaveArtifact(context.Background(), "export-ws", taskID, task.RunSummaryArtifact, data))
+	}
+	return tmpDir, taskID
+}
+
+// TestRunExport_Markdown tests the markdown report lists every step's status.
+func TestRunExport_Markdown(t *testing.T) {
+	t.Parallel()
+	tmpDir, taskID := setupExportTask(t, false)
+
+	var buf bytes.Buffer
+	require.NoError(t, runExport(context.Background(), &buf, "export-ws", "", "markdown", tmpDir))
+
+	out := buf.String()
+	assert.Contains(t, out, "# Task Report: export-ws")
+	assert.Contains(t, out, "fix null pointer in parseConfig")
+	assert.Contains(t, out, "`"+taskID+"`")
+	assert.Contains(t, out, "**Status:** awaiting_approval")
+	assert.Contains(t, out, "**Duration:** 1m30s")
+	assert.Contains(t, out, "| implement | ai | success | 2 | 30s |")
+	assert.Contains(t, out, "| validate | validation | failed | 1 | 5s |")
+	assert.Contains(t, out, "- `config.go`\n- `parse.go`\n")
+}
+
+// TestRunExport_JSONRoundTrip tests the JSON report decodes back into the same report.
+func TestRunExport_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+	tmpDir, taskID := setupExportTask(t, true)
+
+	var buf bytes.Buffer
+	require.NoError(t, runExport(context.Background(), &buf, "export-ws", taskID, "json", tmpDir))
+
+	var report exportReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	assert.Equal(t, taskID, report.TaskID)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, report.Status)
+	assert.Equal(t, "fix/export", report.Branch)
+	assert.Equal(t, int64(90000), report.DurationMs)
+	assert.Equal(t, []string{"config.go", "parse.go"}, report.FilesChanged)
+	assert.Equal(t, "from summary", report.LastError, "saved run summary is preferred")
+	require.Len(t, report.Steps, 2)
+	assert.Equal(t, constants.StepStatusFailed, report.Steps[1].Status)
+
+	again, err := json.MarshalIndent(report, "", "  ")
+	require.NoError(t, err)
+	assert.JSONEq(t, buf.String(), string(again))
+}
+
+// TestRunExport_Errors tests unknown formats, tasks, and empty workspaces are rejected.
+func TestRunExport_Errors(t *testing.T) {
+	t.Parallel()
+	tmpDir, _ := setupExportTask(t, false)
+
+	var buf bytes.Buffer
+	err := runExport(context.Background(), &buf, "export-ws", "", "html", tmpDir)
+	require.ErrorIs(t, err, atlaserrors.ErrUnsupportedOutputFormat)
+
+	err = runExport(context.Background(), &buf, "export-ws", testTaskID("399999"), "markdown", tmpDir)
+	require.ErrorIs(t, err, atlaserrors.ErrTaskNotFound)
+
+	err = runExport(context.Background(), &buf, "missing-ws", "", "markdown", tmpDir)
+	require.Error(t, err)
+	assert.Empty(t, buf.String())
+}
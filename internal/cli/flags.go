@@ -2,13 +2,19 @@
 package cli
 
 import (
+	"context"
 	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // Exit codes for the CLI.
@@ -19,6 +25,14 @@ const (
 	ExitError = 1
 	// ExitInvalidInput indicates invalid user input.
 	ExitInvalidInput = 2
+	// ExitUserAbort indicates the user abandoned the task or canceled a prompt.
+	ExitUserAbort = 3
+	// ExitTransient indicates a temporary failure worth retrying (matches EX_TEMPFAIL).
+	ExitTransient = 75
+	// ExitTimeout indicates the run exceeded its --timeout (matches timeout(1)).
+	ExitTimeout = 124
+	// ExitInterrupted indicates the run was interrupted, e.g. by Ctrl+C (128 + SIGINT).
+	ExitInterrupted = 130
 )
 
 // Output format constants.
@@ -37,6 +51,16 @@ type GlobalFlags struct {
 	Verbose bool
 	// Quiet suppresses non-essential output (warn level only).
 	Quiet bool
+	// BaseDir overrides where workspace and task state is stored (default ~/.atlas).
+	BaseDir string
+	// SharedState writes workspace state group-readable and group-writable.
+	SharedState bool
+	// UTC displays timestamps in UTC instead of the local time zone.
+	UTC bool
+	// LogFormat selects the stderr log format (auto, text, or json).
+	LogFormat string
+	// LogLevel sets the log level (debug, info, warn, error), overriding --verbose and --quiet.
+	LogLevel string
 }
 
 // AddGlobalFlags adds global flags to a command.
@@ -45,9 +69,51 @@ func AddGlobalFlags(cmd *cobra.Command, flags *GlobalFlags) {
 	cmd.PersistentFlags().StringVarP(&flags.Output, "output", "o", OutputText, "output format (text|json)")
 	cmd.PersistentFlags().BoolVarP(&flags.Verbose, "verbose", "v", false, "enable verbose output")
 	cmd.PersistentFlags().BoolVarP(&flags.Quiet, "quiet", "q", false, "suppress non-essential output")
+	cmd.PersistentFlags().StringVar(&flags.BaseDir, "base-dir", "", "directory for workspace and task state and logs (env: "+constants.StateDirEnvVar+" or "+constants.AtlasHomeEnvVar+", default ~/.atlas)")
+	cmd.PersistentFlags().BoolVar(&flags.SharedState, "shared-state", false, "write workspace state with group read/write access for shared team or CI setups")
+	cmd.PersistentFlags().BoolVar(&flags.UTC, "utc", false, "display timestamps in UTC instead of local time")
+	cmd.PersistentFlags().StringVar(&flags.LogFormat, "log-format", LogFormatAuto, "stderr log format (auto|text|json)")
+	cmd.PersistentFlags().StringVar(&flags.LogLevel, "log-level", "", "log level (debug|info|warn|error), overrides --verbose and --quiet")
 	cmd.MarkFlagsMutuallyExclusive("verbose", "quiet")
 }
 
+// ApplyStateDir resolves the state directory override from --base-dir, then
+// ATLAS_STATE_DIR, then ATLAS_HOME, verifies it is writable, and sets it with
+// workspace.SetStateDir so every workspace, task, and hook store created
+// afterwards roots its data there. Does nothing when none is set.
+func ApplyStateDir(baseDir string) error {
+	if baseDir == "" {
+		baseDir = os.Getenv(constants.StateDirEnvVar)
+	}
+	if baseDir == "" {
+		baseDir = os.Getenv(constants.AtlasHomeEnvVar)
+	}
+	if baseDir == "" {
+		return nil
+	}
+
+	absDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", errors.ErrStateDirNotWritable, baseDir, err)
+	}
+
+	if err := probeWritableDir(absDir); err != nil {
+		return fmt.Errorf("%w: %s: %w", errors.ErrStateDirNotWritable, absDir, err)
+	}
+
+	workspace.SetStateDir(absDir)
+	return nil
+}
+
+// ApplyStatePermissions makes every workspace store created afterwards write
+// group-shared state when shared is set. Does nothing otherwise.
+func ApplyStatePermissions(shared bool) error {
+	if !shared {
+		return nil
+	}
+	return workspace.SetDefaultStatePermissions(constants.SharedStateFilePerm, constants.SharedStateDirPerm)
+}
+
 // BindGlobalFlags binds global flags to Viper for configuration file and
 // environment variable support. The ATLAS_ prefix is used for environment
 // variables (e.g., ATLAS_OUTPUT, ATLAS_VERBOSE).
@@ -89,18 +155,39 @@ func IsValidOutputFormat(format string) bool {
 }
 
 // ExitCodeForError returns the appropriate exit code for the given error.
-// Returns ExitSuccess (0) for nil errors, ExitInvalidInput (2) for user input
-// errors (invalid flags, bad arguments), and ExitError (1) for all other errors.
+// Returns ExitSuccess (0) for nil errors, ExitTimeout (124) when a run exceeded
+// its --timeout, ExitInvalidInput (2) for user input errors (invalid flags,
+// bad arguments), ExitInterrupted (130) when the run was interrupted,
+// ExitUserAbort (3) when the user abandoned or canceled, ExitTransient (75)
+// for temporary failures worth retrying, and ExitError (1) for all other errors.
 func ExitCodeForError(err error) int {
 	if err == nil {
 		return ExitSuccess
 	}
 
+	// Only the run-level --timeout maps to ExitTimeout; step and AI timeouts
+	// also wrap context.DeadlineExceeded but are ordinary failures.
+	if stderrors.Is(err, errors.ErrRunTimeout) {
+		return ExitTimeout
+	}
+
 	// Check for our custom exit code 2 error wrapper
 	if errors.IsExitCode2Error(err) {
 		return ExitInvalidInput
 	}
 
+	if stderrors.Is(err, errors.ErrTaskInterrupted) || stderrors.Is(err, context.Canceled) {
+		return ExitInterrupted
+	}
+
+	if errors.IsUserAbort(err) {
+		return ExitUserAbort
+	}
+
+	if errors.IsTransient(err) {
+		return ExitTransient
+	}
+
 	// Check for our custom invalid input error
 	if stderrors.Is(err, errors.ErrInvalidOutputFormat) {
 		return ExitInvalidInput
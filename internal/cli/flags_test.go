@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"context"
 	stderrors "errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -10,7 +13,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
 	"github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 func TestExitCodes(t *testing.T) {
@@ -89,6 +96,96 @@ func TestAddGlobalFlags(t *testing.T) {
 	require.NotNil(t, quietFlag)
 	assert.Equal(t, "q", quietFlag.Shorthand)
 	assert.Equal(t, "false", quietFlag.DefValue)
+
+	baseDirFlag := cmd.PersistentFlags().Lookup("base-dir")
+	require.NotNil(t, baseDirFlag)
+	assert.Empty(t, baseDirFlag.DefValue)
+}
+
+// TestApplyStateDir tests --base-dir is validated and applied to the stores
+// without touching the environment.
+func TestApplyStateDir(t *testing.T) {
+	t.Cleanup(func() { workspace.SetStateDir("") })
+
+	t.Run("flag sets state dir for stores", func(t *testing.T) {
+		t.Setenv(constants.StateDirEnvVar, "")
+		t.Setenv(constants.AtlasHomeEnvVar, "")
+		baseDir := filepath.Join(t.TempDir(), "state")
+
+		require.NoError(t, ApplyStateDir(baseDir))
+		assert.Empty(t, os.Getenv(constants.StateDirEnvVar), "child processes must not inherit the flag")
+		assert.Empty(t, os.Getenv(constants.AtlasHomeEnvVar), "child processes must not inherit the flag")
+		dir, err := workspace.StateDir()
+		require.NoError(t, err)
+		assert.Equal(t, baseDir, dir)
+
+		wsStore, err := workspace.NewFileStore("")
+		require.NoError(t, err)
+		require.NoError(t, wsStore.Create(context.Background(), &domain.Workspace{
+			Name:   "isolated",
+			Status: constants.WorkspaceStatusActive,
+		}))
+		_, err = os.Stat(filepath.Join(baseDir, constants.WorkspacesDir, "isolated", constants.WorkspaceFileName))
+		require.NoError(t, err)
+
+		taskStore, err := task.NewFileStore("")
+		require.NoError(t, err)
+		require.NoError(t, taskStore.Create(context.Background(), "isolated", &domain.Task{
+			ID: "task-00000000-0000-4000-8000-000000000b01", WorkspaceID: "isolated",
+		}))
+		_, err = os.Stat(filepath.Join(baseDir, constants.WorkspacesDir, "isolated", constants.TasksDir))
+		assert.NoError(t, err)
+	})
+
+	t.Run("env var is used when flag is empty", func(t *testing.T) {
+		workspace.SetStateDir("")
+		baseDir := t.TempDir()
+		t.Setenv(constants.StateDirEnvVar, "")
+		t.Setenv(constants.AtlasHomeEnvVar, baseDir)
+
+		require.NoError(t, ApplyStateDir(""))
+		dir, err := workspace.StateDir()
+		require.NoError(t, err)
+		assert.Equal(t, baseDir, dir)
+	})
+
+	t.Run("ATLAS_STATE_DIR takes precedence over ATLAS_HOME", func(t *testing.T) {
+		workspace.SetStateDir("")
+		stateDir := t.TempDir()
+		t.Setenv(constants.StateDirEnvVar, stateDir)
+		t.Setenv(constants.AtlasHomeEnvVar, t.TempDir())
+
+		require.NoError(t, ApplyStateDir(""))
+		dir, err := workspace.StateDir()
+		require.NoError(t, err)
+		assert.Equal(t, stateDir, dir)
+	})
+
+	t.Run("neither set is a no-op", func(t *testing.T) {
+		workspace.SetStateDir("")
+		t.Setenv(constants.StateDirEnvVar, "")
+		t.Setenv(constants.AtlasHomeEnvVar, "")
+		t.Setenv("HOME", t.TempDir())
+
+		require.NoError(t, ApplyStateDir(""))
+		dir, err := workspace.StateDir()
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(os.Getenv("HOME"), constants.AtlasHome), dir)
+	})
+
+	t.Run("unwritable dir is rejected", func(t *testing.T) {
+		workspace.SetStateDir("")
+		t.Setenv(constants.StateDirEnvVar, "")
+		t.Setenv(constants.AtlasHomeEnvVar, "")
+		blocker := filepath.Join(t.TempDir(), "blocker")
+		require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o600))
+
+		err := ApplyStateDir(filepath.Join(blocker, "state"))
+		require.ErrorIs(t, err, errors.ErrStateDirNotWritable)
+		dir, dirErr := workspace.StateDir()
+		require.NoError(t, dirErr)
+		assert.NotContains(t, dir, blocker)
+	})
 }
 
 func TestAddGlobalFlags_ParsesCorrectly(t *testing.T) {
@@ -252,6 +349,71 @@ func TestExitCodeForError(t *testing.T) {
 			err:          nil,
 			expectedCode: ExitSuccess,
 		},
+		{
+			name:         "wrapped ErrRunTimeout returns timeout",
+			err:          fmt.Errorf("resume: %w", errors.ErrRunTimeout),
+			expectedCode: ExitTimeout,
+		},
+		{
+			name:         "step deadline exceeded returns general error",
+			err:          fmt.Errorf("ai step timed out: %w", context.DeadlineExceeded),
+			expectedCode: ExitError,
+		},
+		{
+			name:         "ErrTaskInterrupted returns interrupted",
+			err:          errors.ErrTaskInterrupted,
+			expectedCode: ExitInterrupted,
+		},
+		{
+			name:         "wrapped ErrTaskInterrupted returns interrupted",
+			err:          fmt.Errorf("start: %w", errors.ErrTaskInterrupted),
+			expectedCode: ExitInterrupted,
+		},
+		{
+			name:         "wrapped context canceled returns interrupted",
+			err:          fmt.Errorf("step: %w", context.Canceled),
+			expectedCode: ExitInterrupted,
+		},
+		{
+			name:         "ErrUserAbandoned returns user abort",
+			err:          errors.ErrUserAbandoned,
+			expectedCode: ExitUserAbort,
+		},
+		{
+			name:         "wrapped ErrOperationCanceled returns user abort",
+			err:          fmt.Errorf("prompt: %w", errors.ErrOperationCanceled),
+			expectedCode: ExitUserAbort,
+		},
+		{
+			name:         "wrapped ErrMenuCanceled returns user abort",
+			err:          fmt.Errorf("menu: %w", errors.ErrMenuCanceled),
+			expectedCode: ExitUserAbort,
+		},
+		{
+			name:         "ErrGHRateLimited returns transient",
+			err:          errors.ErrGHRateLimited,
+			expectedCode: ExitTransient,
+		},
+		{
+			name:         "wrapped ErrLockTimeout returns transient",
+			err:          fmt.Errorf("lock: %w", errors.ErrLockTimeout),
+			expectedCode: ExitTransient,
+		},
+		{
+			name:         "wrapped ErrCITimeout returns transient",
+			err:          fmt.Errorf("ci: %w", errors.ErrCITimeout),
+			expectedCode: ExitTransient,
+		},
+		{
+			name:         "wrapped TransientError returns transient",
+			err:          fmt.Errorf("push: %w", errors.NewTransientError(errors.ErrGitOperation)),
+			expectedCode: ExitTransient,
+		},
+		{
+			name:         "ExitCode2Error wins over user abort",
+			err:          errors.NewExitCode2Error(errors.ErrUserAbandoned),
+			expectedCode: ExitInvalidInput,
+		},
 		{
 			name:         "ErrInvalidOutputFormat returns invalid input",
 			err:          errors.ErrInvalidOutputFormat,
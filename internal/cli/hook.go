@@ -579,7 +579,7 @@ func outputHookErrorJSON(w io.Writer, command, errMsg string) error {
 	if err := encoder.Encode(map[string]any{
 		"success": false,
 		"command": command,
-		"error":   errMsg,
+		"error":   atlaserrors.RedactString(errMsg),
 	}); err != nil {
 		return fmt.Errorf("failed to encode JSON: %w", err)
 	}
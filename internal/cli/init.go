@@ -32,6 +32,11 @@ type InitFlags struct {
 	Global bool
 	// Project forces configuration to be saved to project config only.
 	Project bool
+	// TemplateDir switches init to scaffold mode: a commented starter
+	// .atlas/config.yaml and a sample custom template in this directory.
+	TemplateDir string
+	// Force allows scaffold mode to overwrite existing files.
+	Force bool
 }
 
 // AtlasConfig represents the user's ATLAS configuration.
@@ -182,8 +187,16 @@ Project config is recommended for shared projects with team settings.
 
 Use --no-interactive for automated setups with sensible defaults.
 Use --global to save only to global config (skip project config prompt).
-Use --project to save only to project config (requires being in a project directory).`,
+Use --project to save only to project config (requires being in a project directory).
+
+Use --template-dir to skip the wizard and scaffold a commented starter
+.atlas/config.yaml plus a sample custom template (derived from the built-in
+bug template) in the given directory. Existing files are left alone unless
+--force is set.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if flags.TemplateDir != "" {
+				return runInitScaffold(cmd.Context(), cmd.OutOrStdout(), flags)
+			}
 			err := runInit(cmd.Context(), cmd.OutOrStdout(), flags)
 			if errors.Is(err, atlaserrors.ErrMissingRequiredTools) {
 				// Exit with error code but don't print error again (already displayed)
@@ -198,7 +211,10 @@ Use --project to save only to project config (requires being in a project direct
 	cmd.Flags().BoolVar(&flags.NoInteractive, "no-interactive", false, "skip all prompts and use default values")
 	cmd.Flags().BoolVar(&flags.Global, "global", false, "save to global config only (~/.atlas/config.yaml)")
 	cmd.Flags().BoolVar(&flags.Project, "project", false, "save to project config only (.atlas/config.yaml)")
+	cmd.Flags().StringVar(&flags.TemplateDir, "template-dir", "", "scaffold a starter config and sample template into this directory instead of running the wizard")
+	cmd.Flags().BoolVar(&flags.Force, "force", false, "overwrite existing files when scaffolding with --template-dir")
 	cmd.MarkFlagsMutuallyExclusive("global", "project")
+	cmd.MarkFlagsMutuallyExclusive("template-dir", "global")
 
 	return cmd
 }
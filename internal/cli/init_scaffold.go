@@ -0,0 +1,250 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// scaffoldTemplateName is the name of the sample template written by
+// 'atlas init --template-dir'.
+const scaffoldTemplateName = "my-bugfix"
+
+// scaffoldTemplate is the sample custom template. It mirrors the built-in
+// bug template so new users start from a workflow that already works.
+const scaffoldTemplate = `# Custom ATLAS template, derived from the built-in "bug" template.
+# Edit the steps below, then run it with:
+#   atlas start "describe the bug" --template my-bugfix
+#
+# Step types: ai, validation, git, human, sdd, ci, verify, loop.
+# Timeouts use Go durations (e.g. 90s, 15m, 1h).
+
+name: my-bugfix
+description: Fix a described bug, validate, and open a pull request
+branch_prefix: fix
+default_agent: claude
+default_model: sonnet
+
+# Run with --verify to enable the optional verify step.
+verify: false
+verify_model: opus
+
+steps:
+  # Runs validation first to find issues when no description is given.
+  - name: detect
+    type: validation
+    description: Run validation commands to detect issues (skipped if bug description provided)
+    required: true
+    timeout: 10m
+    config:
+      detect_only: true
+      skip_condition: has_description
+
+  - name: analyze
+    type: ai
+    description: Analyze the bug report and identify root cause (skipped if no description)
+    required: true
+    timeout: 15m
+    retry_count: 2
+    config:
+      permission_mode: plan
+      prompt_template: analyze_bug
+      skip_condition: no_description
+
+  - name: implement
+    type: ai
+    description: Implement the fix for the identified issue
+    required: true
+    timeout: 30m
+    retry_count: 3
+    config:
+      permission_mode: default
+      prompt_template: implement_fix
+      include_previous_errors: true
+
+  - name: verify
+    type: verify
+    description: Optional AI verification of implementation
+    required: false
+    timeout: 5m
+    config:
+      agent: gemini
+      checks:
+        - code_correctness
+
+  # Uses validation.commands from config.yaml unless
+  # validation_commands is set on this template.
+  - name: validate
+    type: validation
+    description: Run format, lint, and test commands
+    required: true
+    timeout: 10m
+    retry_count: 1
+
+  - name: git_commit
+    type: git
+    description: Create commit with fix changes
+    required: true
+    timeout: 1m
+    config:
+      operation: commit
+
+  - name: git_push
+    type: git
+    description: Push branch to remote
+    required: true
+    timeout: 2m
+    retry_count: 3
+    config:
+      operation: push
+
+  - name: git_pr
+    type: git
+    description: Create pull request
+    required: true
+    timeout: 2m
+    retry_count: 2
+    config:
+      operation: create_pr
+
+  - name: ci_wait
+    type: ci
+    description: Wait for CI pipeline to complete
+    required: true
+    timeout: 30m
+
+  - name: review
+    type: human
+    description: Human review of completed fix
+    required: true
+    config:
+      prompt: Review the fix and approve or reject
+`
+
+// scaffoldConfigFormat is the starter config.yaml. The only %s verbs are the
+// generation time and the sample template path.
+const scaffoldConfigFormat = `# ATLAS Project Configuration
+# Generated by atlas init on %s
+# Settings left commented out use their defaults; run 'atlas config show'
+# to see the effective configuration.
+
+# ai:
+#   agent: claude        # claude, gemini, or codex
+#   model: sonnet
+#   timeout: 30m
+
+# validation:
+#   commands:
+#     format: ["magex format:fix"]
+#     lint: ["magex lint"]
+#     test: ["magex test:race"]
+
+templates:
+  # Template used when 'atlas start' is run without --template.
+  # default_template: my-bugfix
+
+  # Custom templates by name. Relative paths are resolved from the
+  # repository root.
+  custom_templates:
+    my-bugfix: %s
+`
+
+// scaffoldFile is a file written by 'atlas init --template-dir'.
+type scaffoldFile struct {
+	path    string
+	content string
+}
+
+// runInitScaffold writes a commented starter config and a sample custom
+// template instead of running the setup wizard.
+func runInitScaffold(ctx context.Context, w io.Writer, flags *InitFlags) error {
+	root := findGitRoot(ctx)
+	if root == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get working directory: %w", err)
+		}
+		root = cwd
+	}
+
+	written, err := scaffoldInit(root, flags.TemplateDir, flags.Force)
+	if err != nil {
+		return err
+	}
+
+	styles := newInitStyles()
+	_, _ = fmt.Fprintln(w, styles.success.Render("✓ ATLAS starter files created"))
+	_, _ = fmt.Fprintln(w)
+	for _, path := range written {
+		_, _ = fmt.Fprintln(w, styles.dim.Render("  "+path))
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, styles.info.Render("Suggested next commands:"))
+	_, _ = fmt.Fprintln(w, styles.dim.Render("  atlas template show "+scaffoldTemplateName+"  - Check the sample template"))
+	_, _ = fmt.Fprintln(w, styles.dim.Render("  atlas start \"...\" --template "+scaffoldTemplateName))
+	return nil
+}
+
+// scaffoldInit writes .atlas/config.yaml under root and the sample template
+// into templateDir, which is resolved against root when relative. Existing
+// files are only replaced with force; the check covers every file before
+// anything is written, so a refused run leaves the tree untouched.
+// Returns the paths written.
+func scaffoldInit(root, templateDir string, force bool) ([]string, error) {
+	if !filepath.IsAbs(templateDir) {
+		templateDir = filepath.Join(root, templateDir)
+	}
+
+	configPath := filepath.Join(root, constants.AtlasHome, constants.GlobalConfigName)
+	templatePath := filepath.Join(templateDir, scaffoldTemplateName+".yaml")
+
+	files := []scaffoldFile{
+		{
+			path: configPath,
+			content: fmt.Sprintf(scaffoldConfigFormat,
+				time.Now().Format(constants.TimeFormatISO),
+				strconv.Quote(scaffoldTemplateRef(root, templatePath))),
+		},
+		{path: templatePath, content: scaffoldTemplate},
+	}
+
+	if !force {
+		for _, f := range files {
+			if _, err := os.Stat(f.path); err == nil {
+				return nil, fmt.Errorf("%w: %s (use --force to overwrite)", atlaserrors.ErrScaffoldFileExists, f.path)
+			}
+		}
+	}
+
+	written := make([]string, 0, len(files))
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create directory for %s: %w", f.path, err)
+		}
+		if err := os.WriteFile(f.path, []byte(f.content), 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", f.path, err)
+		}
+		written = append(written, f.path)
+	}
+
+	return written, nil
+}
+
+// scaffoldTemplateRef returns the path to record in custom_templates:
+// relative to root when the template lives inside it, absolute otherwise.
+func scaffoldTemplateRef(root, templatePath string) string {
+	rel, err := filepath.Rel(root, templatePath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return templatePath
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mrz1836/atlas/internal/config"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template"
+)
+
+func TestScaffoldInit_WritesValidTemplateAndConfig(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	written, err := scaffoldInit(root, filepath.Join(".atlas", "templates"), false)
+	require.NoError(t, err)
+
+	configPath := filepath.Join(root, ".atlas", "config.yaml")
+	templatePath := filepath.Join(root, ".atlas", "templates", "my-bugfix.yaml")
+	assert.Equal(t, []string{configPath, templatePath}, written)
+
+	// The scaffolded template loads and passes validation
+	tmpl, err := template.NewLoader(root).LoadFromFile(templatePath)
+	require.NoError(t, err)
+	require.NoError(t, template.ValidateTemplate(tmpl))
+	assert.Equal(t, "my-bugfix", tmpl.Name)
+	assert.Equal(t, "fix", tmpl.BranchPrefix)
+	assert.Len(t, tmpl.Steps, len(template.NewBugTemplate().Steps))
+
+	// The starter config points at the template through a repo-relative path
+	data, err := os.ReadFile(configPath) //nolint:gosec // test file path
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# ai:")
+
+	var cfg config.Config
+	require.NoError(t, yaml.Unmarshal(data, &cfg))
+	assert.Equal(t, map[string]string{"my-bugfix": ".atlas/templates/my-bugfix.yaml"}, cfg.Templates.CustomTemplates)
+
+	registry, err := template.NewRegistryWithConfig(root, cfg.Templates.CustomTemplates)
+	require.NoError(t, err)
+	_, err = registry.Get("my-bugfix")
+	require.NoError(t, err)
+}
+
+func TestScaffoldInit_AbsoluteTemplateDirOutsideRoot(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	templateDir := t.TempDir()
+
+	_, err := scaffoldInit(root, templateDir, false)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(root, ".atlas", "config.yaml")) //nolint:gosec // test file path
+	require.NoError(t, err)
+
+	var cfg config.Config
+	require.NoError(t, yaml.Unmarshal(data, &cfg))
+	assert.Equal(t, filepath.Join(templateDir, "my-bugfix.yaml"), cfg.Templates.CustomTemplates["my-bugfix"])
+}
+
+func TestScaffoldInit_RefusesToOverwriteWithoutForce(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	configPath := filepath.Join(root, ".atlas", "config.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0o700))
+	require.NoError(t, os.WriteFile(configPath, []byte("ai:\n  model: opus\n"), 0o600))
+
+	_, err := scaffoldInit(root, "templates", false)
+	require.ErrorIs(t, err, atlaserrors.ErrScaffoldFileExists)
+	assert.Contains(t, err.Error(), configPath)
+	assert.Contains(t, err.Error(), "--force")
+
+	// Existing config is untouched and nothing else was written
+	data, err := os.ReadFile(configPath) //nolint:gosec // test file path
+	require.NoError(t, err)
+	assert.Equal(t, "ai:\n  model: opus\n", string(data))
+	_, err = os.Stat(filepath.Join(root, "templates", "my-bugfix.yaml"))
+	assert.True(t, os.IsNotExist(err), "template should not be written when refusing")
+}
+
+func TestScaffoldInit_RefusesExistingTemplateWithoutForce(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	templatePath := filepath.Join(root, "templates", "my-bugfix.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(templatePath), 0o700))
+	require.NoError(t, os.WriteFile(templatePath, []byte("name: mine\n"), 0o600))
+
+	_, err := scaffoldInit(root, "templates", false)
+	require.ErrorIs(t, err, atlaserrors.ErrScaffoldFileExists)
+
+	data, err := os.ReadFile(templatePath) //nolint:gosec // test file path
+	require.NoError(t, err)
+	assert.Equal(t, "name: mine\n", string(data))
+	_, err = os.Stat(filepath.Join(root, ".atlas", "config.yaml"))
+	assert.True(t, os.IsNotExist(err), "config should not be written when refusing")
+}
+
+func TestScaffoldInit_ForceOverwrites(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	templatePath := filepath.Join(root, "templates", "my-bugfix.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(templatePath), 0o700))
+	require.NoError(t, os.WriteFile(templatePath, []byte("name: mine\n"), 0o600))
+
+	_, err := scaffoldInit(root, "templates", true)
+	require.NoError(t, err)
+
+	tmpl, err := template.NewLoader(root).LoadFromFile(templatePath)
+	require.NoError(t, err)
+	assert.Equal(t, "my-bugfix", tmpl.Name)
+}
+
+func TestNewInitCmd_ScaffoldFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := newInitCmd(&InitFlags{})
+
+	templateDir := cmd.Flags().Lookup("template-dir")
+	require.NotNil(t, templateDir)
+	assert.Empty(t, templateDir.DefValue)
+
+	force := cmd.Flags().Lookup("force")
+	require.NotNil(t, force)
+	assert.Equal(t, "false", force.DefValue)
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -17,8 +18,10 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/mrz1836/atlas/internal/constants"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/logging"
 	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // LogFileWriter holds the log file writer for cleanup purposes.
@@ -43,6 +46,59 @@ func configureZerologGlobals() {
 	})
 }
 
+// Log format values for the --log-format flag.
+const (
+	// LogFormatAuto uses a human-readable console format on a terminal and JSON otherwise.
+	LogFormatAuto = "auto"
+	// LogFormatText always uses the human-readable console format.
+	LogFormatText = "text"
+	// LogFormatJSON always emits one JSON object per log event, for log pipelines.
+	LogFormatJSON = "json"
+)
+
+// ValidLogFormats returns the list of valid --log-format values.
+func ValidLogFormats() []string {
+	return []string{LogFormatAuto, LogFormatText, LogFormatJSON}
+}
+
+// ValidLogLevels returns the list of valid --log-level values.
+func ValidLogLevels() []string {
+	return []string{"debug", "info", "warn", "error"}
+}
+
+// LogOptions selects the level and format of CLI logs. Logs always go to
+// stderr so they never mix with a command's result on stdout.
+type LogOptions struct {
+	Verbose bool   // Debug level unless Level is set
+	Quiet   bool   // Warn level unless Level or Verbose is set
+	Format  string // One of ValidLogFormats; empty means LogFormatAuto
+	Level   string // One of ValidLogLevels; overrides Verbose and Quiet
+}
+
+// Validate returns an error if Format or Level is not a recognized value.
+func (o LogOptions) Validate() error {
+	if o.Format != "" && !slices.Contains(ValidLogFormats(), o.Format) {
+		return fmt.Errorf("%w: --log-format %q must be one of %v",
+			atlaserrors.ErrInvalidArgument, o.Format, ValidLogFormats())
+	}
+	if o.Level != "" && !slices.Contains(ValidLogLevels(), o.Level) {
+		return fmt.Errorf("%w: --log-level %q must be one of %v",
+			atlaserrors.ErrInvalidArgument, o.Level, ValidLogLevels())
+	}
+	return nil
+}
+
+// level returns the log level, preferring an explicit Level over the
+// verbose and quiet flags.
+func (o LogOptions) level() zerolog.Level {
+	if o.Level != "" {
+		if lvl, err := zerolog.ParseLevel(o.Level); err == nil {
+			return lvl
+		}
+	}
+	return selectLevel(o.Verbose, o.Quiet)
+}
+
 // loggerSetup holds the common components needed to create a logger.
 type loggerSetup struct {
 	level      zerolog.Level
@@ -55,12 +111,17 @@ type loggerSetup struct {
 // Returns the setup and any error from file writer creation.
 // The error is non-fatal - callers can proceed with console-only logging.
 func prepareLoggerSetup(verbose, quiet bool) (*loggerSetup, error) {
+	return prepareLoggerSetupWithOptions(LogOptions{Verbose: verbose, Quiet: quiet})
+}
+
+// prepareLoggerSetupWithOptions creates the common logger components for opts.
+func prepareLoggerSetupWithOptions(opts LogOptions) (*loggerSetup, error) {
 	configureZerologGlobals()
 
 	setup := &loggerSetup{
-		level:   selectLevel(verbose, quiet),
+		level:   opts.level(),
 		hook:    logging.NewSensitiveDataHook(),
-		console: selectOutput(),
+		console: selectOutputForFormat(opts.Format),
 	}
 
 	fileWriter, err := createLogFileWriter()
@@ -70,9 +131,15 @@ func prepareLoggerSetup(verbose, quiet bool) (*loggerSetup, error) {
 	return setup, err
 }
 
-// buildLogger creates a zerolog.Logger from the setup and writer.
+// buildLogger creates a zerolog.Logger from the setup and writer. While a
+// config watch owns the level (see watchConfig), the global level filters
+// instead.
 func buildLogger(setup *loggerSetup, writer io.Writer) zerolog.Logger {
-	return zerolog.New(writer).Level(setup.level).Hook(setup.hook).With().Timestamp().Logger()
+	level := setup.level
+	if liveLogLevel.Load() {
+		level = zerolog.TraceLevel
+	}
+	return zerolog.New(writer).Level(level).Hook(setup.hook).With().Timestamp().Logger()
 }
 
 // InitLogger creates and configures a zerolog.Logger based on verbosity flags.
@@ -89,7 +156,13 @@ func buildLogger(setup *loggerSetup, writer io.Writer) zerolog.Logger {
 // The logger also writes to ~/.atlas/logs/atlas.log with rotation enabled.
 // If the log file cannot be created, the logger will continue with console-only output.
 func InitLogger(verbose, quiet bool) zerolog.Logger {
-	setup, err := prepareLoggerSetup(verbose, quiet)
+	return InitLoggerWithOptions(LogOptions{Verbose: verbose, Quiet: quiet})
+}
+
+// InitLoggerWithOptions creates and configures a zerolog.Logger like
+// InitLogger, with the level and console format taken from opts.
+func InitLoggerWithOptions(opts LogOptions) zerolog.Logger {
+	setup, err := prepareLoggerSetupWithOptions(opts)
 
 	var writer io.Writer
 	if err != nil || setup.fileWriter == nil {
@@ -143,7 +216,12 @@ type TaskLogAppender interface {
 // Log entries with workspace_name and task_id fields are written to the task's log file.
 // All logs continue to go to console and global log file as normal.
 func InitLoggerWithTaskStore(verbose, quiet bool, store TaskLogAppender) zerolog.Logger {
-	setup, err := prepareLoggerSetup(verbose, quiet)
+	return initLoggerWithTaskStoreOptions(LogOptions{Verbose: verbose, Quiet: quiet}, store)
+}
+
+// initLoggerWithTaskStoreOptions creates a task-log-persisting logger for opts.
+func initLoggerWithTaskStoreOptions(opts LogOptions, store TaskLogAppender) zerolog.Logger {
+	setup, err := prepareLoggerSetupWithOptions(opts)
 
 	var baseWriter io.Writer
 	if err != nil || setup.fileWriter == nil {
@@ -286,6 +364,33 @@ func selectLevel(verbose, quiet bool) zerolog.Level {
 	}
 }
 
+// selectOutputForFormat returns the stderr writer for a --log-format value.
+func selectOutputForFormat(format string) io.Writer {
+	if format == "" || format == LogFormatAuto {
+		return selectOutput()
+	}
+	isTTY := term.IsTerminal(int(os.Stderr.Fd())) //nolint:gosec // G115: uintptr->int for term.IsTerminal, file descriptors fit in int on all supported platforms
+	return newFormatWriter(os.Stderr, format, !isTTY || os.Getenv("NO_COLOR") != "")
+}
+
+// newFormatWriter returns a log writer to w for an explicit text or json format.
+// JSON events are written unchanged, one object per line.
+func newFormatWriter(w io.Writer, format string, noColor bool) io.Writer {
+	if format == LogFormatJSON {
+		return w
+	}
+	return newConsoleLogWriter(newSpinnerAwareWriter(w, tui.GlobalSpinnerManager()), noColor)
+}
+
+// newConsoleLogWriter returns a human-readable log writer to out.
+func newConsoleLogWriter(out io.Writer, noColor bool) zerolog.ConsoleWriter {
+	return zerolog.ConsoleWriter{
+		Out:        out,
+		TimeFormat: time.Kitchen,
+		NoColor:    noColor,
+	}
+}
+
 // selectOutput determines the appropriate output writer based on
 // terminal capabilities and environment settings.
 func selectOutput() io.Writer {
@@ -295,11 +400,7 @@ func selectOutput() io.Writer {
 		// Its output goes to spinnerAwareWriter which clears the spinner line before
 		// writing, preventing log/spinner line collisions.
 		// Order matters: ConsoleWriter must receive raw JSON (not ANSI-prefixed data).
-		consoleWriter := zerolog.ConsoleWriter{
-			Out:        newSpinnerAwareWriter(os.Stderr, tui.GlobalSpinnerManager()),
-			TimeFormat: time.Kitchen,
-		}
-		return consoleWriter
+		return newConsoleLogWriter(newSpinnerAwareWriter(os.Stderr, tui.GlobalSpinnerManager()), false)
 	}
 
 	// Default to JSON output for non-TTY or when NO_COLOR is set
@@ -356,20 +457,10 @@ func createLogFileWriter() (io.WriteCloser, error) {
 	}, nil
 }
 
-// getAtlasHome returns the atlas home directory path.
-// If ATLAS_HOME environment variable is set, it uses that.
-// Otherwise, it defaults to ~/.atlas.
+// getAtlasHome returns the atlas home directory path, resolved like the
+// state stores: --base-dir, then ATLAS_STATE_DIR, then ATLAS_HOME, otherwise ~/.atlas.
 func getAtlasHome() (string, error) {
-	if atlasHome := os.Getenv("ATLAS_HOME"); atlasHome != "" {
-		return atlasHome, nil
-	}
-
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get user home directory: %w", err)
-	}
-
-	return filepath.Join(home, constants.AtlasHome), nil
+	return workspace.StateDir()
 }
 
 // LogFilePath returns the path to the global CLI log file.
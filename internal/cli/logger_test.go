@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mrz1836/atlas/internal/constants"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/logging"
 	"github.com/mrz1836/atlas/internal/tui"
 )
@@ -852,3 +854,84 @@ func TestLogFilePath_HandlesGetAtlasHomeError(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, path, tmpDir)
 }
+
+// TestLogOptions_Validate tests --log-format and --log-level values are checked.
+func TestLogOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, LogOptions{}.Validate())
+	require.NoError(t, LogOptions{Format: LogFormatJSON, Level: "debug"}.Validate())
+	require.ErrorIs(t, LogOptions{Format: "xml"}.Validate(), atlaserrors.ErrInvalidArgument)
+	require.ErrorIs(t, LogOptions{Level: "trace"}.Validate(), atlaserrors.ErrInvalidArgument)
+}
+
+// TestLogOptions_Level tests an explicit level overrides --verbose and --quiet.
+func TestLogOptions_Level(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, zerolog.InfoLevel, LogOptions{}.level())
+	assert.Equal(t, zerolog.WarnLevel, LogOptions{Quiet: true}.level())
+	assert.Equal(t, zerolog.DebugLevel, LogOptions{Quiet: true, Level: "debug"}.level())
+	assert.Equal(t, zerolog.ErrorLevel, LogOptions{Verbose: true, Level: "error"}.level())
+}
+
+// TestNewFormatWriter_JSON tests a debug event is emitted as valid JSON with --log-format json.
+func TestNewFormatWriter_JSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	setup := &loggerSetup{
+		level: LogOptions{Format: LogFormatJSON, Level: "debug"}.level(),
+		hook:  logging.NewSensitiveDataHook(),
+	}
+	logger := buildLogger(setup, newFormatWriter(&buf, LogFormatJSON, true))
+
+	logger.Debug().Str("step_name", "validate").Msg("debug event")
+
+	line := bytes.TrimSpace(buf.Bytes())
+	require.True(t, json.Valid(line), "log line should be JSON: %s", line)
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(line, &entry))
+	assert.Equal(t, "debug", entry["level"])
+	assert.Equal(t, "validate", entry["step_name"])
+}
+
+// TestNewFormatWriter_Text tests --log-format text stays human-readable.
+func TestNewFormatWriter_Text(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	setup := &loggerSetup{
+		level: LogOptions{}.level(),
+		hook:  logging.NewSensitiveDataHook(),
+	}
+	logger := buildLogger(setup, newFormatWriter(&buf, LogFormatText, true))
+
+	logger.Info().Str("step_name", "validate").Msg("info event")
+
+	line := bytes.TrimSpace(buf.Bytes())
+	assert.False(t, json.Valid(line), "log line should not be JSON: %s", line)
+	assert.Contains(t, string(line), "step_name=validate")
+}
+
+// TestSelectOutputForFormat_JSON tests --log-format json writes straight to stderr.
+func TestSelectOutputForFormat_JSON(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, os.Stderr, selectOutputForFormat(LogFormatJSON))
+}
+
+// TestRootCmd_InvalidLogFormat tests an unknown --log-format is rejected as invalid input.
+func TestRootCmd_InvalidLogFormat(t *testing.T) {
+	t.Setenv("ATLAS_HOME", t.TempDir())
+
+	cmd := newRootCmd(&GlobalFlags{}, BuildInfo{})
+	cmd.SetArgs([]string{"--log-format", "xml"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	err := cmd.Execute()
+
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidArgument)
+	assert.Equal(t, ExitInvalidInput, ExitCodeForError(err))
+}
@@ -0,0 +1,149 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// notifyTestBackendBell names the terminal bell backend in notify-test output.
+const notifyTestBackendBell = "bell"
+
+// eventNotifier delivers a notification for a named event.
+// It allows notify-test to be exercised with a stub instead of a real bell.
+type eventNotifier interface {
+	Notify(event string)
+}
+
+// bellEventNotifier fires the terminal bell for any event.
+type bellEventNotifier struct {
+	notifier *tui.Notifier
+}
+
+// Notify emits the terminal bell.
+func (b bellEventNotifier) Notify(string) {
+	b.notifier.Bell()
+}
+
+// notifyTestResponse is the output of the notify-test command.
+type notifyTestResponse struct {
+	Event       string   `json:"event"`
+	Fired       bool     `json:"fired"`
+	BellEnabled bool     `json:"bell_enabled"`
+	Subscribed  bool     `json:"subscribed"`
+	Backends    []string `json:"backends"`
+	Reason      string   `json:"reason,omitempty"`
+}
+
+// AddNotifyTestCommand adds the notify-test command to the root command.
+func AddNotifyTestCommand(root *cobra.Command) {
+	root.AddCommand(newNotifyTestCmd())
+}
+
+// newNotifyTestCmd creates the notify-test command.
+func newNotifyTestCmd() *cobra.Command {
+	var event string
+
+	cmd := &cobra.Command{
+		Use:   "notify-test",
+		Short: "Fire a test notification using your configuration",
+		Long: `Fire the configured notifications for an event so you can confirm
+your notification setup works without running a full task.
+
+The notification fires only when it would fire for a real task: the
+terminal bell must be enabled (notifications.bell) and the event must be
+listed in notifications.events. Otherwise the command explains why nothing
+fired.
+
+Valid events: ` + strings.Join(AllNotificationEvents(), ", ") + `
+
+Examples:
+  atlas notify-test                            # Test the awaiting_approval event
+  atlas notify-test --event validation_failed  # Test a specific event
+  atlas notify-test -o json                    # Report the result as JSON
+
+Exit codes:
+  0: Success
+  1: General error
+  2: Invalid input (unknown event)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			outputFormat := cmd.Flag("output").Value.String()
+
+			cfg, err := config.Load(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			// Keep the bell off stdout when it carries JSON
+			bellWriter := io.Writer(os.Stdout)
+			if outputFormat == OutputJSON {
+				bellWriter = os.Stderr
+			}
+			notifier := bellEventNotifier{notifier: tui.NewNotifierWithWriter(cfg.Notifications.Bell, false, bellWriter)}
+
+			return runNotifyTest(ctx, os.Stdout, event, outputFormat, &cfg.Notifications, notifier)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringVar(&event, "event", NotifyEventAwaitingApproval, "notification event to fire")
+
+	return cmd
+}
+
+// runNotifyTest fires the notifier for event if the configuration would
+// notify for it, then reports the outcome.
+func runNotifyTest(ctx context.Context, w io.Writer, event, outputFormat string, cfg *config.NotificationsConfig, notifier eventNotifier) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	if !slices.Contains(AllNotificationEvents(), event) {
+		return atlaserrors.NewExitCode2Error(
+			fmt.Errorf("%w: unknown event %q, must be one of: %s",
+				atlaserrors.ErrInvalidArgument, event, strings.Join(AllNotificationEvents(), ", ")))
+	}
+
+	resp := notifyTestResponse{
+		Event:       event,
+		BellEnabled: cfg.Bell,
+		Subscribed:  slices.Contains(cfg.Events, event),
+		Backends:    []string{},
+	}
+
+	switch {
+	case !resp.BellEnabled:
+		resp.Reason = "terminal bell is disabled (notifications.bell)"
+	case !resp.Subscribed:
+		resp.Reason = fmt.Sprintf("event %q is not listed in notifications.events", event)
+	default:
+		notifier.Notify(event)
+		resp.Fired = true
+		resp.Backends = append(resp.Backends, notifyTestBackendBell)
+	}
+
+	if outputFormat == OutputJSON {
+		return encodeJSONIndented(w, resp)
+	}
+
+	if resp.Fired {
+		_, _ = fmt.Fprintf(w, "✓ Fired %s notification (%s)\n", event, strings.Join(resp.Backends, ", "))
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "⚠ No notification fired for %s: %s\n", event, resp.Reason)
+	_, _ = fmt.Fprintln(w, "  Run 'atlas config notifications' to update your settings.")
+	return nil
+}
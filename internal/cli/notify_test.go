@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/config"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// stubEventNotifier records the events it is asked to deliver.
+type stubEventNotifier struct {
+	events []string
+}
+
+func (s *stubEventNotifier) Notify(event string) {
+	s.events = append(s.events, event)
+}
+
+// TestRunNotifyTest_FiresConfiguredEvent tests that a subscribed event fires the notifier.
+func TestRunNotifyTest_FiresConfiguredEvent(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubEventNotifier{}
+	cfg := &config.NotificationsConfig{Bell: true, Events: []string{NotifyEventAwaitingApproval, NotifyEventCIFailed}}
+
+	var buf bytes.Buffer
+	err := runNotifyTest(context.Background(), &buf, NotifyEventCIFailed, OutputText, cfg, stub)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{NotifyEventCIFailed}, stub.events)
+	assert.Contains(t, buf.String(), "Fired ci_failed notification (bell)")
+}
+
+// TestRunNotifyTest_NotFired tests that nothing fires when the configuration would not notify.
+func TestRunNotifyTest_NotFired(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		cfg        config.NotificationsConfig
+		wantReason string
+	}{
+		{
+			name:       "bell disabled",
+			cfg:        config.NotificationsConfig{Bell: false, Events: []string{NotifyEventAwaitingApproval}},
+			wantReason: "terminal bell is disabled",
+		},
+		{
+			name:       "event not subscribed",
+			cfg:        config.NotificationsConfig{Bell: true, Events: []string{NotifyEventCIFailed}},
+			wantReason: "not listed in notifications.events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stub := &stubEventNotifier{}
+			var buf bytes.Buffer
+			err := runNotifyTest(context.Background(), &buf, NotifyEventAwaitingApproval, OutputText, &tt.cfg, stub)
+
+			require.NoError(t, err)
+			assert.Empty(t, stub.events)
+			assert.Contains(t, buf.String(), tt.wantReason)
+		})
+	}
+}
+
+// TestRunNotifyTest_UnknownEvent tests that an unknown event errors with the valid set.
+func TestRunNotifyTest_UnknownEvent(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubEventNotifier{}
+	cfg := &config.NotificationsConfig{Bell: true, Events: AllNotificationEvents()}
+
+	var buf bytes.Buffer
+	err := runNotifyTest(context.Background(), &buf, "task.completed", OutputText, cfg, stub)
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidArgument)
+	assert.True(t, atlaserrors.IsExitCode2Error(err))
+	for _, event := range AllNotificationEvents() {
+		assert.Contains(t, err.Error(), event)
+	}
+	assert.Empty(t, stub.events)
+}
+
+// TestRunNotifyTest_JSON tests the JSON report.
+func TestRunNotifyTest_JSON(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubEventNotifier{}
+	cfg := &config.NotificationsConfig{Bell: true, Events: []string{NotifyEventValidationFailed}}
+
+	var buf bytes.Buffer
+	err := runNotifyTest(context.Background(), &buf, NotifyEventValidationFailed, OutputJSON, cfg, stub)
+	require.NoError(t, err)
+
+	var resp notifyTestResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, NotifyEventValidationFailed, resp.Event)
+	assert.True(t, resp.Fired)
+	assert.True(t, resp.Subscribed)
+	assert.Equal(t, []string{notifyTestBackendBell}, resp.Backends)
+	assert.Equal(t, []string{NotifyEventValidationFailed}, stub.events)
+}
+
+// TestRunNotifyTest_Canceled tests that a canceled context returns an error.
+func TestRunNotifyTest_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stub := &stubEventNotifier{}
+	err := runNotifyTest(ctx, &bytes.Buffer{}, NotifyEventAwaitingApproval, OutputText,
+		&config.NotificationsConfig{Bell: true, Events: AllNotificationEvents()}, stub)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, stub.events)
+}
@@ -0,0 +1,163 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// Open command targets.
+const (
+	openTargetPR = "pr"
+	openTargetCI = "ci"
+)
+
+// browserOpener opens a URL in the user's browser.
+type browserOpener func(ctx context.Context, url string) error
+
+// openResponse is the JSON output of the open command.
+type openResponse struct {
+	Workspace string `json:"workspace"`
+	Target    string `json:"target"`
+	URL       string `json:"url,omitempty"`
+}
+
+// AddOpenCommand adds the open command to the root command.
+func AddOpenCommand(root *cobra.Command) {
+	root.AddCommand(newOpenCmd())
+}
+
+// newOpenCmd creates the open command.
+func newOpenCmd() *cobra.Command {
+	var pr, ci bool
+
+	cmd := &cobra.Command{
+		Use:   "open <workspace>",
+		Short: "Open a workspace's pull request or CI run in the browser",
+		Long: `Open the pull request (--pr, the default) or CI run (--ci) of a workspace's
+most recent task in the default browser.
+
+The PR URL comes from the task's recorded pull request, or is built from
+the workspace's repository and branch. The CI URL comes from the task's
+recorded CI run, falling back to the PR's checks page. When no browser can
+be launched, the URL is printed instead.
+
+Examples:
+  atlas open auth-fix           # Open the pull request
+  atlas open auth-fix --ci      # Open the CI run
+  atlas open auth-fix -o json   # Print the URL as JSON without opening it`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := openTargetPR
+			if ci {
+				target = openTargetCI
+			}
+			outputFormat := cmd.Flag("output").Value.String()
+			return runOpen(cmd.Context(), os.Stdout, args[0], target, outputFormat, "", openInBrowser)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().BoolVar(&pr, "pr", false, "open the pull request (default)")
+	cmd.Flags().BoolVar(&ci, "ci", false, "open the CI run")
+	cmd.MarkFlagsMutuallyExclusive("pr", "ci")
+
+	return cmd
+}
+
+// runOpen resolves the workspace's URL for target and opens it with opener.
+// In JSON mode the URL is reported without opening a browser.
+func runOpen(ctx context.Context, w io.Writer, workspaceName, target, outputFormat, storeBaseDir string, opener browserOpener) error {
+	wsStore, err := newWorkspaceStore(storeBaseDir)
+	if err != nil {
+		return fmt.Errorf("failed to create workspace store: %w", err)
+	}
+	ws, err := wsStore.Get(ctx, workspaceName)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace '%s': %w", workspaceName, err)
+	}
+
+	var latest *domain.Task
+	if taskStore, storeErr := newTaskStore(storeBaseDir); storeErr == nil {
+		if tasks, listErr := taskStore.List(ctx, workspaceName); listErr == nil && len(tasks) > 0 {
+			latest = tasks[0]
+		}
+	}
+
+	targetURL := resolvePRURL(ws, latest)
+	if target == openTargetCI {
+		targetURL = resolveCIURL(latest)
+	}
+
+	if outputFormat == OutputJSON {
+		return encodeJSONIndented(w, openResponse{Workspace: ws.Name, Target: target, URL: targetURL})
+	}
+
+	out := tui.NewOutput(w, outputFormat)
+	if targetURL == "" {
+		out.Warning(fmt.Sprintf("No %s URL available for workspace '%s'.", openTargetLabel(target), ws.Name))
+		if target == openTargetCI {
+			out.Info("CI runs are recorded once a task has opened a pull request and started CI.")
+		} else {
+			out.Info("Pull requests are recorded once a task has pushed its branch and opened a PR.")
+		}
+		return nil
+	}
+
+	if err := opener(ctx, targetURL); err != nil {
+		out.Warning(fmt.Sprintf("Could not open browser: %v", err))
+		out.Info(fmt.Sprintf("URL: %s", targetURL))
+		return nil
+	}
+	out.Info(fmt.Sprintf("Opened %s in browser.", targetURL))
+	return nil
+}
+
+// resolvePRURL returns the pull request URL recorded on the task, or one
+// built from the workspace repository and the PR number or branch.
+func resolvePRURL(ws *domain.Workspace, t *domain.Task) string {
+	if prURL := extractPRURL(t); prURL != "" {
+		return prURL
+	}
+
+	repo := extractRepoInfo(ws)
+	if repo == "" {
+		return ""
+	}
+	if number := extractPRNumber(t); number > 0 {
+		return fmt.Sprintf("https://github.com/%s/pull/%d", repo, number)
+	}
+	if ws.Branch != "" {
+		return fmt.Sprintf("https://github.com/%s/pulls?q=%s", repo, url.QueryEscape("is:pr head:"+ws.Branch))
+	}
+	return ""
+}
+
+// resolveCIURL returns the CI run URL recorded on the task, falling back to
+// the checks page of its pull request.
+func resolveCIURL(t *domain.Task) string {
+	if ciURL := extractGitHubActionsURL(t); ciURL != "" {
+		return ciURL
+	}
+	if prURL := extractPRURL(t); prURL != "" {
+		return prURL + "/checks"
+	}
+	return ""
+}
+
+// openTargetLabel returns the display name of an open target.
+func openTargetLabel(target string) string {
+	if target == openTargetCI {
+		return "CI"
+	}
+	return "PR"
+}
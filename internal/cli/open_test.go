@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// setupOpenWorkspace creates a workspace with one task carrying taskMetadata.
+func setupOpenWorkspace(t *testing.T, wsMetadata, taskMetadata map[string]any) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	now := time.Now()
+	taskID := testTaskID("200001")
+
+	wsStore, err := workspace.NewFileStore(tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, wsStore.Create(context.Background(), &domain.Workspace{
+		Name:         "open-ws",
+		WorktreePath: "/tmp/open-ws",
+		Branch:       "feat/open",
+		Status:       constants.WorkspaceStatusActive,
+		Tasks:        []domain.TaskRef{{ID: taskID}},
+		Metadata:     wsMetadata,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}))
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, taskStore.Create(context.Background(), "open-ws", &domain.Task{
+		ID:          taskID,
+		WorkspaceID: "open-ws",
+		Status:      constants.TaskStatusCIFailed,
+		Metadata:    taskMetadata,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}))
+	return tmpDir
+}
+
+// recordingOpener returns a browser opener that records opened URLs.
+func recordingOpener(opened *[]string, err error) browserOpener {
+	return func(_ context.Context, url string) error {
+		*opened = append(*opened, url)
+		return err
+	}
+}
+
+// TestResolvePRURL tests PR URL resolution from task and workspace metadata.
+func TestResolvePRURL(t *testing.T) {
+	t.Parallel()
+
+	repoWS := &domain.Workspace{Branch: "feat/x", Metadata: map[string]any{"repository": "acme/widgets"}}
+
+	tests := []struct {
+		name string
+		ws   *domain.Workspace
+		task *domain.Task
+		want string
+	}{
+		{
+			name: "recorded PR URL",
+			ws:   repoWS,
+			task: &domain.Task{Metadata: map[string]any{"pr_url": "https://github.com/acme/widgets/pull/7"}},
+			want: "https://github.com/acme/widgets/pull/7",
+		},
+		{
+			name: "repository and PR number",
+			ws:   repoWS,
+			task: &domain.Task{Metadata: map[string]any{"pr_number": float64(12)}},
+			want: "https://github.com/acme/widgets/pull/12",
+		},
+		{
+			name: "repository and branch",
+			ws:   repoWS,
+			task: nil,
+			want: "https://github.com/acme/widgets/pulls?q=is%3Apr+head%3Afeat%2Fx",
+		},
+		{
+			name: "no repository",
+			ws:   &domain.Workspace{Branch: "feat/x"},
+			task: &domain.Task{},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, resolvePRURL(tt.ws, tt.task))
+		})
+	}
+}
+
+// TestResolveCIURL tests CI URL resolution from task metadata.
+func TestResolveCIURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		task *domain.Task
+		want string
+	}{
+		{
+			name: "recorded CI URL",
+			task: &domain.Task{Metadata: map[string]any{"ci_url": "https://github.com/acme/widgets/actions/runs/1"}},
+			want: "https://github.com/acme/widgets/actions/runs/1",
+		},
+		{
+			name: "PR checks fallback",
+			task: &domain.Task{Metadata: map[string]any{"pr_url": "https://github.com/acme/widgets/pull/7"}},
+			want: "https://github.com/acme/widgets/pull/7/checks",
+		},
+		{
+			name: "nothing recorded",
+			task: &domain.Task{},
+			want: "",
+		},
+		{
+			name: "no task",
+			task: nil,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, resolveCIURL(tt.task))
+		})
+	}
+}
+
+// TestRunOpen_OpensResolvedURL tests that the resolved URL is opened for each target.
+func TestRunOpen_OpensResolvedURL(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := setupOpenWorkspace(t, nil, map[string]any{
+		"pr_url": "https://github.com/acme/widgets/pull/7",
+		"ci_url": "https://github.com/acme/widgets/actions/runs/1",
+	})
+
+	var opened []string
+	var buf bytes.Buffer
+	require.NoError(t, runOpen(context.Background(), &buf, "open-ws", openTargetPR, OutputText, tmpDir, recordingOpener(&opened, nil)))
+	require.NoError(t, runOpen(context.Background(), &buf, "open-ws", openTargetCI, OutputText, tmpDir, recordingOpener(&opened, nil)))
+
+	assert.Equal(t, []string{
+		"https://github.com/acme/widgets/pull/7",
+		"https://github.com/acme/widgets/actions/runs/1",
+	}, opened)
+	assert.Contains(t, buf.String(), "Opened https://github.com/acme/widgets/pull/7 in browser.")
+}
+
+// TestRunOpen_NoURLAvailable tests the message shown when no URL is known.
+func TestRunOpen_NoURLAvailable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{target: openTargetPR, want: "No PR URL available for workspace 'open-ws'."},
+		{target: openTargetCI, want: "No CI URL available for workspace 'open-ws'."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			t.Parallel()
+
+			tmpDir := setupOpenWorkspace(t, nil, nil)
+
+			var opened []string
+			var buf bytes.Buffer
+			err := runOpen(context.Background(), &buf, "open-ws", tt.target, OutputText, tmpDir, recordingOpener(&opened, nil))
+
+			require.NoError(t, err)
+			assert.Empty(t, opened)
+			assert.Contains(t, buf.String(), tt.want)
+		})
+	}
+}
+
+// TestRunOpen_BrowserFails tests that the URL is printed when no browser can be launched.
+func TestRunOpen_BrowserFails(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := setupOpenWorkspace(t, map[string]any{"repository": "acme/widgets"}, map[string]any{"pr_number": 3})
+
+	var opened []string
+	var buf bytes.Buffer
+	err := runOpen(context.Background(), &buf, "open-ws", openTargetPR, OutputText, tmpDir,
+		recordingOpener(&opened, atlaserrors.ErrCommandFailed))
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "Could not open browser")
+	assert.Contains(t, buf.String(), "URL: https://github.com/acme/widgets/pull/3")
+}
+
+// TestRunOpen_JSON tests that JSON mode reports the URL without opening a browser.
+func TestRunOpen_JSON(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := setupOpenWorkspace(t, nil, map[string]any{"ci_url": "https://github.com/acme/widgets/actions/runs/9"})
+
+	var opened []string
+	var buf bytes.Buffer
+	require.NoError(t, runOpen(context.Background(), &buf, "open-ws", openTargetCI, OutputJSON, tmpDir, recordingOpener(&opened, nil)))
+
+	var resp openResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "https://github.com/acme/widgets/actions/runs/9", resp.URL)
+	assert.Equal(t, openTargetCI, resp.Target)
+	assert.Empty(t, opened)
+}
+
+// TestRunOpen_WorkspaceNotFound tests that a missing workspace returns an error.
+func TestRunOpen_WorkspaceNotFound(t *testing.T) {
+	t.Parallel()
+
+	var opened []string
+	err := runOpen(context.Background(), &bytes.Buffer{}, "missing", openTargetPR, OutputText, t.TempDir(), recordingOpener(&opened, nil))
+
+	require.Error(t, err)
+	assert.Empty(t, opened)
+}
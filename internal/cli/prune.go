@@ -0,0 +1,297 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// workspaceReconciler finds and removes worktrees and branches no workspace owns.
+type workspaceReconciler interface {
+	Reconcile(ctx context.Context, opts workspace.ReconcileOptions) (*workspace.ReconcileResult, error)
+}
+
+// pruneOrphan is one orphan in the JSON output of the prune command.
+type pruneOrphan struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Branch  string `json:"branch,omitempty"`
+	Removed bool   `json:"removed"`
+	Skipped string `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// pruneStaleWorktree is one stale worktree entry in the JSON output of the prune command.
+type pruneStaleWorktree struct {
+	Path   string `json:"path"`
+	Branch string `json:"branch,omitempty"`
+	Reason string `json:"reason"`
+	Locked bool   `json:"locked"`
+}
+
+// pruneResponse is the JSON output of the prune command.
+type pruneResponse struct {
+	DryRun         bool                 `json:"dry_run"`
+	Orphans        []pruneOrphan        `json:"orphans"`
+	StaleWorktrees []pruneStaleWorktree `json:"stale_worktrees"`
+	Failed         int                  `json:"failed"`
+}
+
+// AddPruneCommand adds the prune command to the root command.
+func AddPruneCommand(root *cobra.Command) {
+	root.AddCommand(newPruneCmd())
+}
+
+// newPruneCmd creates the prune command.
+func newPruneCmd() *cobra.Command {
+	var dryRun bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove git worktrees and branches left behind by failed runs",
+		Long: `Remove git worktrees and branches (fix/*, feat/*, fork/*, ...) that atlas
+created but no workspace record owns, such as those left behind by failed runs.
+
+Only branches atlas recorded creating are considered; branches you created
+yourself, and worktrees on them, are never touched. Nothing is forced: a
+worktree with uncommitted changes or a branch with unmerged commits is kept
+and reported. Stale worktree entries are pruned; locked entries are reported
+but kept. Use --dry-run to list what would be removed, including the reason
+git gives for each stale worktree entry.
+
+Removal asks for confirmation; use --force to skip it, e.g. in scripts.
+
+Examples:
+  atlas prune --dry-run    # List orphaned worktrees and branches
+  atlas prune              # Confirm and remove them
+  atlas prune --force      # Remove them without confirmation
+
+Exit codes:
+  0: Nothing to prune, or everything was removed
+  1: One or more items could not be removed`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat := cmd.Flag("output").Value.String()
+			reconciler, err := newPruneReconciler(cmd.Context())
+			if err != nil {
+				return err
+			}
+			err = runPrune(cmd.Context(), os.Stdout, dryRun, force, outputFormat, reconciler)
+			if stderrors.Is(err, errors.ErrJSONErrorOutput) {
+				cmd.SilenceErrors = true
+			}
+			return err
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List orphaned worktrees and branches without removing them")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation prompt")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "force")
+
+	return cmd
+}
+
+// newPruneReconciler creates a workspace manager for the current repository.
+func newPruneReconciler(ctx context.Context) (workspaceReconciler, error) {
+	logger := Logger()
+
+	repoPath, err := detectRepoPath()
+	if err != nil {
+		return nil, fmt.Errorf("not in a git repository: %w", err)
+	}
+	wsStore, err := newWorkspaceStore("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace store: %w", err)
+	}
+	wtRunner, err := workspace.NewGitWorktreeRunner(ctx, repoPath, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worktree runner: %w", err)
+	}
+	return workspace.NewManager(wsStore, wtRunner, logger), nil
+}
+
+// runPrune reconciles worktrees and branches against workspace records and
+// reports (and, unless dryRun, removes) the orphans. Removal needs
+// confirmation unless force is set.
+func runPrune(ctx context.Context, w io.Writer, dryRun, force bool, outputFormat string, reconciler workspaceReconciler) error {
+	if !dryRun && !force {
+		proceed, err := confirmPrune(ctx, w, outputFormat, reconciler)
+		if err != nil || !proceed {
+			return err
+		}
+	}
+
+	result, err := reconciler.Reconcile(ctx, workspace.ReconcileOptions{DryRun: dryRun})
+	if result == nil {
+		return fmt.Errorf("failed to prune: %w", err)
+	}
+
+	if outputFormat == OutputJSON {
+		resp := pruneResponse{
+			DryRun:         result.DryRun,
+			Orphans:        []pruneOrphan{},
+			StaleWorktrees: []pruneStaleWorktree{},
+			Failed:         result.Failed(),
+		}
+		for _, o := range result.Orphans {
+			resp.Orphans = append(resp.Orphans, pruneOrphan(o))
+		}
+		for _, p := range result.Prunable {
+			resp.StaleWorktrees = append(resp.StaleWorktrees, pruneStaleWorktree(p))
+		}
+		if encErr := encodeJSONIndented(w, resp); encErr != nil {
+			return encErr
+		}
+		if err != nil {
+			return errors.ErrJSONErrorOutput
+		}
+		return nil
+	}
+
+	out := tui.NewOutput(w, outputFormat)
+	if len(result.Orphans) == 0 && len(result.Prunable) == 0 {
+		out.Success("No orphaned worktrees or branches found.")
+		return nil
+	}
+
+	if result.DryRun {
+		printPrunePreview(out, result)
+		out.Info("Run 'atlas prune' without --dry-run to remove them.")
+		return nil
+	}
+
+	for _, p := range result.Prunable {
+		if p.Locked {
+			out.Warning("Kept locked worktree entry " + describePrunable(p))
+			continue
+		}
+		out.Info("Pruned stale worktree entry " + describePrunable(p))
+	}
+	if len(result.Orphans) == 0 {
+		if err != nil {
+			return fmt.Errorf("failed to prune: %w", err)
+		}
+		out.Success("Pruned stale worktree entries.")
+		return nil
+	}
+
+	removed, kept := 0, 0
+	for _, o := range result.Orphans {
+		switch {
+		case o.Error != "":
+			out.Warning(fmt.Sprintf("Failed to remove %s: %s", describeOrphan(o), o.Error))
+		case o.Skipped != "":
+			kept++
+			out.Warning(fmt.Sprintf("Kept %s: %s", describeOrphan(o), o.Skipped))
+		default:
+			removed++
+			out.Info("Removed " + describeOrphan(o))
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove %d of %d orphaned item(s): %w", result.Failed(), len(result.Orphans), err)
+	}
+	out.Success(fmt.Sprintf("Pruned %d orphaned item(s).", removed))
+	if kept > 0 {
+		out.Info(fmt.Sprintf("Kept %d item(s) with work git would lose; remove them with git once they are no longer needed.", kept))
+	}
+	return nil
+}
+
+// confirmPrune previews what prune would remove and asks before removing it.
+// It returns false without an error when there is nothing to remove or the
+// user declines.
+func confirmPrune(ctx context.Context, w io.Writer, outputFormat string, reconciler workspaceReconciler) (bool, error) {
+	if outputFormat == OutputJSON || !terminalCheck() {
+		return false, fmt.Errorf("cannot prune without confirmation; use --force or --dry-run: %w", errors.ErrNonInteractiveMode)
+	}
+
+	preview, err := reconciler.Reconcile(ctx, workspace.ReconcileOptions{DryRun: true})
+	if err != nil {
+		return false, fmt.Errorf("failed to prune: %w", err)
+	}
+	out := tui.NewOutput(w, outputFormat)
+	if len(preview.Orphans) == 0 && len(preview.Prunable) == 0 {
+		out.Success("No orphaned worktrees or branches found.")
+		return false, nil
+	}
+	printPrunePreview(out, preview)
+
+	confirmed, err := confirmPruneRemoval()
+	if err != nil {
+		return false, fmt.Errorf("failed to get confirmation: %w", err)
+	}
+	if !confirmed {
+		_, _ = fmt.Fprintln(w, "Operation canceled.")
+	}
+	return confirmed, nil
+}
+
+// confirmPruneRemoval prompts before prune removes anything.
+// It is a variable so tests can answer the prompt.
+//
+//nolint:gochecknoglobals // Test seam for the interactive prompt
+var confirmPruneRemoval = func() (bool, error) {
+	var confirm bool
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Remove these worktrees and branches?").
+				Description("Worktrees with uncommitted changes and branches with unmerged commits are kept.").
+				Affirmative("Yes, prune").
+				Negative("No, cancel").
+				Value(&confirm),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return false, err
+	}
+
+	return confirm, nil
+}
+
+// printPrunePreview lists the stale entries and orphans prune would remove.
+func printPrunePreview(out tui.Output, result *workspace.ReconcileResult) {
+	if len(result.Prunable) > 0 {
+		out.Info(fmt.Sprintf("Would prune %d stale worktree entr(ies):", len(result.Prunable)))
+		for _, p := range result.Prunable {
+			out.Info("  " + describePrunable(p))
+		}
+	}
+	if len(result.Orphans) > 0 {
+		out.Info(fmt.Sprintf("Would remove %d orphaned item(s):", len(result.Orphans)))
+		for _, o := range result.Orphans {
+			out.Info("  " + describeOrphan(o))
+		}
+	}
+}
+
+// describePrunable returns a one-line description of a stale worktree entry.
+func describePrunable(p workspace.PrunableWorktree) string {
+	if p.Locked {
+		return fmt.Sprintf("%s (%s; kept until unlocked)", p.Path, p.Reason)
+	}
+	return fmt.Sprintf("%s (%s)", p.Path, p.Reason)
+}
+
+// describeOrphan returns a one-line description of an orphan.
+func describeOrphan(o workspace.Orphan) string {
+	if o.Kind == workspace.OrphanKindWorktree && o.Branch != "" {
+		return fmt.Sprintf("worktree %s (%s)", o.Name, o.Branch)
+	}
+	return fmt.Sprintf("%s %s", o.Kind, o.Name)
+}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// stubReconciler returns a fixed reconcile result and records the options it got.
+type stubReconciler struct {
+	result *workspace.ReconcileResult
+	err    error
+	opts   []workspace.ReconcileOptions
+}
+
+func (s *stubReconciler) Reconcile(_ context.Context, opts workspace.ReconcileOptions) (*workspace.ReconcileResult, error) {
+	s.opts = append(s.opts, opts)
+	if s.result != nil {
+		s.result.DryRun = opts.DryRun
+	}
+	return s.result, s.err
+}
+
+// orphanResult returns a reconcile result with an orphaned worktree and branch.
+func orphanResult() *workspace.ReconcileResult {
+	return &workspace.ReconcileResult{Orphans: []workspace.Orphan{
+		{Kind: workspace.OrphanKindWorktree, Name: "/tmp/repo-failed", Branch: "fix/failed"},
+		{Kind: workspace.OrphanKindBranch, Name: "fix/stale"},
+	}}
+}
+
+// TestRunPrune_DryRun tests that dry-run lists orphans without removing them.
+func TestRunPrune_DryRun(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubReconciler{result: orphanResult()}
+	var buf bytes.Buffer
+	require.NoError(t, runPrune(context.Background(), &buf, true, false, OutputText, stub))
+
+	require.Len(t, stub.opts, 1)
+	assert.True(t, stub.opts[0].DryRun)
+	assert.Contains(t, buf.String(), "Would remove 2 orphaned item(s)")
+	assert.Contains(t, buf.String(), "worktree /tmp/repo-failed (fix/failed)")
+	assert.Contains(t, buf.String(), "branch fix/stale")
+}
+
+// TestRunPrune_Removes tests that removed orphans are reported.
+func TestRunPrune_Removes(t *testing.T) {
+	t.Parallel()
+
+	result := orphanResult()
+	for i := range result.Orphans {
+		result.Orphans[i].Removed = true
+	}
+	stub := &stubReconciler{result: result}
+
+	var buf bytes.Buffer
+	require.NoError(t, runPrune(context.Background(), &buf, false, true, OutputText, stub))
+
+	assert.False(t, stub.opts[0].DryRun)
+	assert.Contains(t, buf.String(), "Removed worktree /tmp/repo-failed (fix/failed)")
+	assert.Contains(t, buf.String(), "Pruned 2 orphaned item(s)")
+}
+
+// TestRunPrune_PartialFailure tests that per-item failures are reported and returned.
+func TestRunPrune_PartialFailure(t *testing.T) {
+	t.Parallel()
+
+	result := orphanResult()
+	result.Orphans[0].Removed = true
+	result.Orphans[1].Error = "branch is locked"
+	stub := &stubReconciler{result: result, err: atlaserrors.ErrGitOperation}
+
+	var buf bytes.Buffer
+	err := runPrune(context.Background(), &buf, false, true, OutputText, stub)
+
+	require.ErrorIs(t, err, atlaserrors.ErrGitOperation)
+	assert.Contains(t, err.Error(), "failed to remove 1 of 2")
+	assert.Contains(t, buf.String(), "Failed to remove branch fix/stale: branch is locked")
+}
+
+// TestRunPrune_NothingToPrune tests the message shown when there are no orphans.
+func TestRunPrune_NothingToPrune(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, runPrune(context.Background(), &buf, false, true, OutputText, &stubReconciler{result: &workspace.ReconcileResult{}}))
+	assert.Contains(t, buf.String(), "No orphaned worktrees or branches found.")
+}
+
+// TestRunPrune_JSON tests the JSON report.
+func TestRunPrune_JSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, runPrune(context.Background(), &buf, true, false, OutputJSON, &stubReconciler{result: orphanResult()}))
+
+	var resp pruneResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.True(t, resp.DryRun)
+	require.Len(t, resp.Orphans, 2)
+	assert.Equal(t, "fix/failed", resp.Orphans[0].Branch)
+	assert.Zero(t, resp.Failed)
+}
+
+// TestRunPrune_DryRunReportsStaleEntries tests that stale worktree entries are
+// listed with the reason git gives before anything is pruned.
+func TestRunPrune_DryRunReportsStaleEntries(t *testing.T) {
+	t.Parallel()
+
+	result := &workspace.ReconcileResult{Prunable: []workspace.PrunableWorktree{
+		{Path: "/tmp/repo-gone", Branch: "feat/gone", Reason: "gitdir file points to non-existent location"},
+		{Path: "/tmp/repo-usb", Reason: "locked: on usb", Locked: true},
+	}}
+	var buf bytes.Buffer
+	require.NoError(t, runPrune(context.Background(), &buf, true, false, OutputText, &stubReconciler{result: result}))
+
+	assert.Contains(t, buf.String(), "Would prune 2 stale worktree entr(ies)")
+	assert.Contains(t, buf.String(), "/tmp/repo-gone (gitdir file points to non-existent location)")
+	assert.Contains(t, buf.String(), "/tmp/repo-usb (locked: on usb; kept until unlocked)")
+	assert.NotContains(t, buf.String(), "No orphaned worktrees")
+
+	buf.Reset()
+	require.NoError(t, runPrune(context.Background(), &buf, true, false, OutputJSON, &stubReconciler{result: result}))
+	var resp pruneResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.StaleWorktrees, 2)
+	assert.Equal(t, "gitdir file points to non-existent location", resp.StaleWorktrees[0].Reason)
+	assert.True(t, resp.StaleWorktrees[1].Locked)
+}
+
+// TestRunPrune_ReconcileError tests that a failure to find orphans is returned.
+func TestRunPrune_ReconcileError(t *testing.T) {
+	t.Parallel()
+
+	err := runPrune(context.Background(), &bytes.Buffer{}, true, false, OutputText, &stubReconciler{err: atlaserrors.ErrWorktreeRunnerNotAvailable})
+	require.ErrorIs(t, err, atlaserrors.ErrWorktreeRunnerNotAvailable)
+}
+
+// TestRunPrune_ReportsKept tests that orphans kept to protect work are reported.
+func TestRunPrune_ReportsKept(t *testing.T) {
+	t.Parallel()
+
+	result := orphanResult()
+	result.Orphans[0].Removed = true
+	result.Orphans[1].Skipped = "unmerged commits"
+
+	var buf bytes.Buffer
+	require.NoError(t, runPrune(context.Background(), &buf, false, true, OutputText, &stubReconciler{result: result}))
+
+	assert.Contains(t, buf.String(), "Kept branch fix/stale: unmerged commits")
+	assert.Contains(t, buf.String(), "Pruned 1 orphaned item(s)")
+	assert.Contains(t, buf.String(), "Kept 1 item(s)")
+}
+
+// TestRunPrune_NonInteractiveRequiresForce tests that removal without --force
+// fails when no confirmation can be asked for.
+func TestRunPrune_NonInteractiveRequiresForce(t *testing.T) {
+	originalTerminalCheck := terminalCheck
+	terminalCheck = func() bool { return false }
+	defer func() { terminalCheck = originalTerminalCheck }()
+
+	for _, format := range []string{OutputText, OutputJSON} {
+		stub := &stubReconciler{result: orphanResult()}
+		err := runPrune(context.Background(), &bytes.Buffer{}, false, false, format, stub)
+
+		require.ErrorIs(t, err, atlaserrors.ErrNonInteractiveMode)
+		assert.Empty(t, stub.opts, "nothing should be reconciled without confirmation")
+	}
+}
+
+// TestRunPrune_Confirmation tests that removal waits for confirmation.
+func TestRunPrune_Confirmation(t *testing.T) {
+	originalTerminalCheck := terminalCheck
+	originalConfirm := confirmPruneRemoval
+	terminalCheck = func() bool { return true }
+	defer func() {
+		terminalCheck = originalTerminalCheck
+		confirmPruneRemoval = originalConfirm
+	}()
+
+	t.Run("declined", func(t *testing.T) {
+		confirmPruneRemoval = func() (bool, error) { return false, nil }
+		stub := &stubReconciler{result: orphanResult()}
+
+		var buf bytes.Buffer
+		require.NoError(t, runPrune(context.Background(), &buf, false, false, OutputText, stub))
+
+		require.Len(t, stub.opts, 1)
+		assert.True(t, stub.opts[0].DryRun)
+		assert.Contains(t, buf.String(), "Would remove 2 orphaned item(s)")
+		assert.Contains(t, buf.String(), "Operation canceled.")
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		confirmPruneRemoval = func() (bool, error) { return true, nil }
+		stub := &stubReconciler{result: orphanResult()}
+
+		require.NoError(t, runPrune(context.Background(), &bytes.Buffer{}, false, false, OutputText, stub))
+
+		require.Len(t, stub.opts, 2)
+		assert.True(t, stub.opts[0].DryRun)
+		assert.False(t, stub.opts[1].DryRun)
+	})
+
+	t.Run("nothing to prune", func(t *testing.T) {
+		confirmPruneRemoval = func() (bool, error) {
+			t.Fatal("should not prompt when there is nothing to prune")
+			return false, nil
+		}
+		stub := &stubReconciler{result: &workspace.ReconcileResult{}}
+
+		var buf bytes.Buffer
+		require.NoError(t, runPrune(context.Background(), &buf, false, false, OutputText, stub))
+
+		require.Len(t, stub.opts, 1)
+		assert.Contains(t, buf.String(), "No orphaned worktrees or branches found.")
+	})
+}
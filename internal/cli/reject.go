@@ -67,7 +67,8 @@ Examples:
   atlas reject                    # Interactive selection if multiple tasks
   atlas reject my-feature         # Reject task in my-feature workspace
   atlas reject -o json my-feature --done  # JSON output, reject done`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.workspace = args[0]
@@ -560,7 +561,7 @@ func handleRejectError(format string, w io.Writer, workspaceName string, err err
 	return HandleCommandError(format, w, rejectResponse{
 		Success:       false,
 		WorkspaceName: workspaceName,
-		Error:         err.Error(),
+		Error:         atlaserrors.RedactString(err.Error()),
 	}, err)
 }
 
@@ -570,6 +571,6 @@ func outputRejectErrorJSON(w io.Writer, workspaceName, taskID, errMsg string) er
 		Success:       false,
 		WorkspaceName: workspaceName,
 		TaskID:        taskID,
-		Error:         errMsg,
+		Error:         atlaserrors.RedactString(errMsg),
 	}, atlaserrors.ErrJSONErrorOutput)
 }
@@ -95,6 +95,10 @@ func (m *mockTaskStoreForReject) SaveArtifact(_ context.Context, workspaceName,
 	return nil
 }
 
+func (m *mockTaskStoreForReject) SaveStepArtifact(_ context.Context, _, _, _, _ string, _ []byte) error {
+	return nil
+}
+
 func (m *mockTaskStoreForReject) SaveVersionedArtifact(_ context.Context, _, _, _ string, _ []byte) (string, error) {
 	return "", nil
 }
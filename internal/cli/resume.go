@@ -2,12 +2,16 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/huh"
 	"github.com/rs/zerolog"
@@ -37,9 +41,13 @@ func AddResumeCommand(root *cobra.Command) {
 
 // resumeOptions contains all options for the resume command.
 type resumeOptions struct {
-	aiFix bool
-	retry bool // Skip recovery menu and directly retry
-	menu  bool // Force recovery menu even for interrupted tasks
+	aiFix   bool
+	retry   bool          // Skip recovery menu and directly retry
+	menu    bool          // Force recovery menu even for interrupted tasks
+	action  string        // Recovery action to execute without the interactive menu
+	all     bool          // Resume every resumable task in the workspace
+	taskID  string        // Task to resume when the workspace has several resumable tasks
+	timeout time.Duration // Wall-clock cap for the whole run; zero means no limit
 }
 
 // newResumeCmd creates the resume command.
@@ -47,9 +55,13 @@ func newResumeCmd() *cobra.Command {
 	var aiFix bool
 	var retry bool
 	var menu bool
+	var action string
+	var all bool
+	var taskID string
+	var timeout time.Duration
 
 	cmd := &cobra.Command{
-		Use:   "resume <workspace>",
+		Use:   "resume [workspace]",
 		Short: "Resume a paused or failed task",
 		Long: `Resume execution of a task that was paused or failed.
 
@@ -67,6 +79,15 @@ Error states handled:
 Interactive mode (default):
   atlas resume auth-fix
 
+  Without a workspace name, the workspace is detected from the current
+  directory: the worktree it is inside, or else the branch checked out.
+  If nothing or several workspaces match, you are asked for the name
+  (interactive) or the command fails (non-interactive).
+
+  If the workspace has several resumable tasks, you pick one from a list
+  showing each task's status and step (interactive), or pass --task <id>.
+  Without --task the command fails (non-interactive).
+
   For interrupted tasks, directly resumes. For error tasks, shows menu with options:
   - Retry with AI fix - AI attempts to fix based on error context
   - Fix manually - Edit files in worktree, then resume
@@ -78,17 +99,34 @@ Interactive mode (default):
 Power user flags:
   atlas resume auth-fix --retry   # Skip menu, directly retry
   atlas resume auth-fix --menu    # Force menu for interrupted tasks
+  atlas resume auth-fix --action abandon  # Run a recovery action without the menu
+  atlas resume auth-fix --all     # Resume every resumable task, one at a time
+  atlas resume auth-fix --task task-20260101-120000  # Resume a specific task
+  atlas resume auth-fix --timeout 30m  # Save as interrupted if still running after 30m
+
+Recovery actions for --action (must apply to the task's state):
+  retry_ai, retry_gh, retry_commit, rebase_retry, fix_manually,
+  view_errors, view_logs, continue_waiting, abandon
 
 Examples:
   atlas resume auth-fix           # Smart resume (menu for errors, direct for interrupted)
   atlas resume auth-fix --ai-fix  # Resume with AI attempting to fix errors
   atlas resume auth-fix --retry   # Skip menu and directly retry`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runResume(cmd.Context(), cmd, os.Stdout, args[0], resumeOptions{
-				aiFix: aiFix,
-				retry: retry,
-				menu:  menu,
+			workspaceName := ""
+			if len(args) > 0 {
+				workspaceName = args[0]
+			}
+			return runResume(cmd.Context(), cmd, os.Stdout, workspaceName, resumeOptions{
+				aiFix:   aiFix,
+				retry:   retry,
+				menu:    menu,
+				action:  action,
+				all:     all,
+				taskID:  taskID,
+				timeout: timeout,
 			})
 		},
 	}
@@ -96,6 +134,12 @@ Examples:
 	cmd.Flags().BoolVar(&aiFix, "ai-fix", false, "Retry with AI attempting to fix errors")
 	cmd.Flags().BoolVarP(&retry, "retry", "r", false, "Skip recovery menu and directly retry")
 	cmd.Flags().BoolVar(&menu, "menu", false, "Show recovery menu even for interrupted tasks")
+	cmd.Flags().StringVar(&action, "action", "", "Execute a recovery action without the interactive menu")
+	cmd.Flags().BoolVar(&all, "all", false, "Resume every resumable task in the workspace")
+	cmd.Flags().StringVar(&taskID, "task", "", "ID of the task to resume when the workspace has several")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "Maximum wall-clock time for the run (e.g. 30m); on expiry the task is saved as interrupted")
+	cmd.MarkFlagsMutuallyExclusive("action", "retry", "menu")
+	cmd.MarkFlagsMutuallyExclusive("task", "all")
 
 	return cmd
 }
@@ -117,17 +161,49 @@ func runResume(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceNa
 	tui.CheckNoColor()
 	out := tui.NewOutput(w, outputFormat)
 
+	if opts.timeout < 0 {
+		return atlaserrors.NewExitCode2Error(
+			fmt.Errorf("%w: --timeout must not be negative", atlaserrors.ErrInvalidArgument))
+	}
+
+	if workspaceName == "" {
+		detected, err := resolveResumeWorkspaceFromDir(ctx, outputFormat)
+		if err != nil {
+			return handleResumeError(outputFormat, w, "", "", err)
+		}
+		workspaceName = detected
+		out.Info(fmt.Sprintf("Resuming workspace '%s' (detected from current directory)", workspaceName))
+	}
+
 	// Setup signal handler
 	sigHandler := signal.NewHandler(ctx)
 	defer sigHandler.Stop()
 	ctx = sigHandler.Context()
 
+	// Apply --timeout; expiry is handled like an interrupt
+	ctx, cancelTimeout := withRunTimeout(ctx, opts.timeout)
+	defer cancelTimeout()
+
+	// Apply config edits, such as logging.level, while the task runs
+	logger, stopConfigWatch := watchConfig(ctx, logger)
+	defer stopConfigWatch()
+
+	if opts.all {
+		return runResumeAll(ctx, cmd, w, out, sigHandler, workspaceName, opts, outputFormat, logger) //nolint:contextcheck // ctx inherits from parent via signal.NewHandler
+	}
+
 	// Setup workspace and task
-	ws, currentTask, taskStore, wsStore, err := setupResumeWorkspaceAndTask(ctx, workspaceName, outputFormat, w, out, logger) //nolint:contextcheck // ctx inherits from parent via signal.NewHandler
+	ws, currentTask, taskStore, wsStore, err := setupResumeWorkspaceAndTask(ctx, workspaceName, opts.taskID, outputFormat, w, out, logger) //nolint:contextcheck // ctx inherits from parent via signal.NewHandler
 	if err != nil {
 		return err
 	}
 
+	return resumeTask(ctx, cmd, w, out, sigHandler, ws, currentTask, taskStore, wsStore, opts, outputFormat, workspaceName, logger) //nolint:contextcheck // ctx inherits from parent via signal.NewHandler
+}
+
+// resumeTask resumes a single task whose workspace has already been prepared,
+// routing it by status to direct resume, the recovery menu, or the --action flag.
+func resumeTask(ctx context.Context, cmd *cobra.Command, w io.Writer, out tui.Output, sigHandler *signal.Handler, ws *domain.Workspace, currentTask *domain.Task, taskStore *task.FileStore, wsStore workspace.Store, opts resumeOptions, outputFormat, workspaceName string, logger zerolog.Logger) error {
 	// Get template
 	tmpl, err := prepareResumeTemplate(currentTask, opts, outputFormat, w, workspaceName)
 	if err != nil {
@@ -140,6 +216,12 @@ func runResume(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceNa
 		return handleResumeError(outputFormat, w, workspaceName, currentTask.ID, err)
 	}
 
+	// Explicit recovery action bypasses the interactive menu entirely
+	if opts.action != "" {
+		//nolint:contextcheck // context properly propagated through function calls
+		return handleRecoveryActionFlag(ctx, out, taskStore, ws, currentTask, engine, tmpl, state, sigHandler, wsStore, outputFormat, w, workspaceName, logger, opts.action)
+	}
+
 	// Intelligent status-based behavior routing
 	//nolint:exhaustive // Only handling specific resumable states
 	switch currentTask.Status {
@@ -194,18 +276,143 @@ func runResume(ctx context.Context, cmd *cobra.Command, w io.Writer, workspaceNa
 	}
 }
 
+// resumeTaskFunc resumes a single task, writing its output to w and out.
+type resumeTaskFunc func(ctx context.Context, w io.Writer, out tui.Output, t *domain.Task) error
+
+// runResumeAll resumes every resumable task in the workspace, one at a time.
+func runResumeAll(ctx context.Context, cmd *cobra.Command, w io.Writer, out tui.Output, sigHandler *signal.Handler, workspaceName string, opts resumeOptions, outputFormat string, logger zerolog.Logger) error {
+	_, ws, err := setupWorkspace(ctx, workspaceName, "", outputFormat, w, logger)
+	if err != nil {
+		return fmt.Errorf("setup workspace: %w", err)
+	}
+
+	taskStore, err := newTaskStore("")
+	if err != nil {
+		return handleResumeError(outputFormat, w, workspaceName, "", fmt.Errorf("failed to create task store: %w", err))
+	}
+
+	tasks, err := taskStore.ListByStatus(ctx, workspaceName, resumableStatuses()...)
+	if err != nil {
+		return handleResumeError(outputFormat, w, workspaceName, "", fmt.Errorf("failed to list tasks: %w", err))
+	}
+
+	return resumeTasks(ctx, w, out, outputFormat, workspaceName, tasks, func(ctx context.Context, w io.Writer, out tui.Output, t *domain.Task) error {
+		taskWs, wsStore, err := prepareResumeWorkspace(ctx, ws, t, outputFormat, w, out, logger)
+		if err != nil {
+			return err
+		}
+		return resumeTask(ctx, cmd, w, out, sigHandler, taskWs, t, taskStore, wsStore, opts, outputFormat, workspaceName, logger)
+	})
+}
+
+// resumeTasks resumes tasks in order, continuing past failures. An interrupt or
+// cancellation stops the remaining tasks. In JSON mode each task's response is
+// captured and all responses are written as a single array.
+func resumeTasks(ctx context.Context, w io.Writer, out tui.Output, outputFormat, workspaceName string, tasks []*domain.Task, resume resumeTaskFunc) error {
+	responses := make([]resumeResponse, 0, len(tasks))
+	var errs []error
+
+	if len(tasks) == 0 && outputFormat != OutputJSON {
+		out.Info(fmt.Sprintf("No resumable tasks in workspace '%s'", workspaceName))
+	}
+
+	for i, t := range tasks {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		var err error
+		if outputFormat == OutputJSON {
+			var buf bytes.Buffer
+			err = resume(ctx, &buf, tui.NewOutput(&buf, outputFormat), t)
+			responses = append(responses, captureResumeResponse(&buf, workspaceName, t, err))
+		} else {
+			out.Info(fmt.Sprintf("[%d/%d] Resuming task %s", i+1, len(tasks), t.ID))
+			err = resume(ctx, w, out, t)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("task %s: %w", t.ID, err))
+			if errors.Is(err, atlaserrors.ErrTaskInterrupted) || errors.Is(err, atlaserrors.ErrOperationCanceled) {
+				break
+			}
+		}
+	}
+
+	if outputFormat == OutputJSON {
+		if err := out.JSON(responses); err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		if len(errs) > 0 {
+			return atlaserrors.ErrJSONErrorOutput
+		}
+		return nil
+	}
+
+	return errors.Join(errs...)
+}
+
+// captureResumeResponse extracts the resume response a task wrote to buf. When the
+// task wrote none (e.g. a recovery action that does not auto-resume), the response
+// is built from the task's current state and the returned error.
+func captureResumeResponse(buf *bytes.Buffer, workspaceName string, t *domain.Task, resumeErr error) resumeResponse {
+	var captured resumeResponse
+	dec := json.NewDecoder(buf)
+	for {
+		var resp resumeResponse
+		if err := dec.Decode(&resp); err != nil {
+			break
+		}
+		if resp.Task.ID != "" {
+			captured = resp
+		}
+	}
+	if captured.Task.ID != "" {
+		return captured
+	}
+
+	resp := resumeResponse{
+		Success:   resumeErr == nil,
+		Workspace: workspaceInfo{Name: workspaceName},
+		Task: taskInfo{
+			ID:           t.ID,
+			TemplateName: t.TemplateID,
+			Description:  t.Description,
+			Status:       string(t.Status),
+			CurrentStep:  t.CurrentStep,
+			TotalSteps:   len(t.Steps),
+		},
+	}
+	if resumeErr != nil {
+		resp.Error = resumeErr.Error()
+	}
+	return resp
+}
+
+// resumableStatuses returns every task status that resume accepts.
+func resumableStatuses() []constants.TaskStatus {
+	statuses := make([]constants.TaskStatus, 0, len(task.ValidTransitions))
+	for status := range task.ValidTransitions {
+		if isResumableStatus(status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
 // setupResumeWorkspaceAndTask sets up the workspace, task, and stores for resume.
-func setupResumeWorkspaceAndTask(ctx context.Context, workspaceName, outputFormat string, w io.Writer, out tui.Output, logger zerolog.Logger) (*domain.Workspace, *domain.Task, *task.FileStore, workspace.Store, error) {
+func setupResumeWorkspaceAndTask(ctx context.Context, workspaceName, taskID, outputFormat string, w io.Writer, out tui.Output, logger zerolog.Logger) (*domain.Workspace, *domain.Task, *task.FileStore, workspace.Store, error) {
 	// Setup workspace
 	_, ws, err := setupWorkspace(ctx, workspaceName, "", outputFormat, w, logger)
 	if err != nil {
 		return nil, nil, nil, nil, fmt.Errorf("setup workspace: %w", err)
 	}
 
-	// Get task store and latest task
-	taskStore, currentTask, err := getLatestTask(ctx, workspaceName, "", outputFormat, w, logger)
+	// Get task store and the task to resume
+	taskStore, currentTask, err := getResumeTask(ctx, workspaceName, taskID, "", outputFormat, w, logger)
 	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("get latest task: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("get resume task: %w", err)
 	}
 
 	// Validate task is in resumable state
@@ -214,6 +421,19 @@ func setupResumeWorkspaceAndTask(ctx context.Context, workspaceName, outputForma
 			fmt.Errorf("%w: task status %s is not resumable", atlaserrors.ErrInvalidTransition, currentTask.Status))
 	}
 
+	ws, wsStore, err := prepareResumeWorkspace(ctx, ws, currentTask, outputFormat, w, out, logger)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return ws, currentTask, taskStore, wsStore, nil
+}
+
+// prepareResumeWorkspace shows any hook recovery context for the task, ensures the
+// worktree exists, and marks the workspace active.
+func prepareResumeWorkspace(ctx context.Context, ws *domain.Workspace, currentTask *domain.Task, outputFormat string, w io.Writer, out tui.Output, logger zerolog.Logger) (*domain.Workspace, workspace.Store, error) {
+	workspaceName := ws.Name
+
 	// Check for hook-based recovery context
 	if shouldShowRecoveryContext(ctx, currentTask, out, outputFormat, logger) {
 		proceed, recoveryErr := showRecoveryContextAndPrompt(ctx, currentTask, out, logger)
@@ -221,14 +441,14 @@ func setupResumeWorkspaceAndTask(ctx context.Context, workspaceName, outputForma
 			logger.Warn().Err(recoveryErr).Msg("failed to show recovery context")
 		}
 		if !proceed {
-			return nil, nil, nil, nil, atlaserrors.ErrOperationCanceled
+			return nil, nil, atlaserrors.ErrOperationCanceled
 		}
 	}
 
 	// Ensure worktree exists
-	ws, err = ensureWorktreeExists(ctx, ws, out, logger)
+	ws, err := ensureWorktreeExists(ctx, ws, out, logger)
 	if err != nil {
-		return nil, nil, nil, nil, handleResumeError(outputFormat, w, workspaceName, currentTask.ID,
+		return nil, nil, handleResumeError(outputFormat, w, workspaceName, currentTask.ID,
 			fmt.Errorf("failed to ensure worktree exists: %w", err))
 	}
 
@@ -243,6 +463,10 @@ func setupResumeWorkspaceAndTask(ctx context.Context, workspaceName, outputForma
 		logger.Warn().Msg("failed to create workspace store for status updates")
 	}
 
+	if wsStore != nil {
+		warnWorktreeHealth(ctx, ws, wsStore, out, logger)
+	}
+
 	ws.Status = constants.WorkspaceStatusActive
 	if wsStore != nil {
 		if updateErr := wsStore.Update(ctx, ws); updateErr != nil {
@@ -252,7 +476,30 @@ func setupResumeWorkspaceAndTask(ctx context.Context, workspaceName, outputForma
 		}
 	}
 
-	return ws, currentTask, taskStore, wsStore, nil
+	return ws, wsStore, nil
+}
+
+// warnWorktreeHealth runs a read-only healthcheck on the workspace's worktree
+// and prints a warning for each issue found. Failures to run the check are
+// logged and never block the resume.
+func warnWorktreeHealth(ctx context.Context, ws *domain.Workspace, wsStore workspace.Store, out tui.Output, logger zerolog.Logger) {
+	if ws.RepoPath == "" {
+		return
+	}
+	runner, err := workspace.NewGitWorktreeRunner(ctx, ws.RepoPath, logger)
+	if err != nil {
+		logger.Debug().Err(err).Str("workspace_name", ws.Name).Msg("skipping worktree healthcheck")
+		return
+	}
+
+	report, err := workspace.NewManager(wsStore, runner, logger).Healthcheck(ctx, ws.Name)
+	if err != nil {
+		logger.Warn().Err(err).Str("workspace_name", ws.Name).Msg("worktree healthcheck failed")
+		return
+	}
+	for _, issue := range report.Issues {
+		out.Warning(fmt.Sprintf("Worktree check: %s", issue))
+	}
 }
 
 // prepareResumeTemplate gets the template and checks AI fix option.
@@ -277,13 +524,14 @@ func prepareResumeTemplate(currentTask *domain.Task, opts resumeOptions, outputF
 // executeResumeAndHandleResult executes the resume and handles the result/interruption.
 // The state parameter contains the AI runner for process termination on interrupt.
 func executeResumeAndHandleResult(ctx context.Context, engine *task.Engine, currentTask *domain.Task, tmpl *domain.Template, state *progressState, sigHandler *signal.Handler, out tui.Output, ws *domain.Workspace, wsStore workspace.Store, outputFormat string, w io.Writer, workspaceName string, logger zerolog.Logger) error {
+	// Let webhook deliveries finish before the command exits
+	defer waitForWebhooks(ctx, engine)
+
 	// Resume task execution
 	if err := engine.Resume(ctx, currentTask, tmpl); err != nil {
-		// Check if we were interrupted by Ctrl+C
-		select {
-		case <-sigHandler.Interrupted():
+		// Check if we were interrupted by Ctrl+C or --timeout
+		if wasInterrupted(ctx, sigHandler) {
 			return handleResumeInterruption(ctx, out, ws, currentTask, state, wsStore, logger)
-		default:
 		}
 
 		if currentTask.Status == constants.TaskStatusValidationFailed {
@@ -292,11 +540,9 @@ func executeResumeAndHandleResult(ctx context.Context, engine *task.Engine, curr
 		return handleResumeError(outputFormat, w, workspaceName, currentTask.ID, err)
 	}
 
-	// Check if we were interrupted by Ctrl+C (even if no error)
-	select {
-	case <-sigHandler.Interrupted():
+	// Check if we were interrupted by Ctrl+C or --timeout (even if no error)
+	if wasInterrupted(ctx, sigHandler) {
 		return handleResumeInterruption(ctx, out, ws, currentTask, state, wsStore, logger)
-	default:
 	}
 
 	// Handle JSON output format
@@ -339,7 +585,7 @@ func outputResumeErrorJSON(w io.Writer, workspaceName, taskID, errMsg string) er
 		Task: taskInfo{
 			ID: taskID,
 		},
-		Error: errMsg,
+		Error: atlaserrors.RedactString(errMsg),
 	}); err != nil {
 		return fmt.Errorf("failed to encode JSON: %w", err)
 	}
@@ -421,6 +667,9 @@ func createResumeEngine(ctx context.Context, ws *domain.Workspace, taskStore *ta
 	)
 	ciFailureHandler := task.NewCIFailureHandler(hubRunner)
 
+	// Resume the checklist from the task's recorded step statuses
+	state.checklist = slices.Clone(currentTask.Steps)
+
 	// Create progress callback for both engine and executors (uses shared state)
 	progressCallback := createProgressCallback(ctx, out, ws.Name, state)
 
@@ -476,6 +725,7 @@ func createResumeEngine(ctx context.Context, ws *domain.Workspace, taskStore *ta
 	engineOpts := []task.EngineOption{
 		task.WithNotifier(stateNotifier),
 		task.WithOperationsConfig(&cfg.Operations),
+		task.WithWebhooks(cfg.Notifications.Webhooks, nil),
 	}
 	if validationRetryHandler != nil {
 		engineOpts = append(engineOpts, task.WithValidationRetryHandler(validationRetryHandler))
@@ -487,17 +737,21 @@ func createResumeEngine(ctx context.Context, ws *domain.Workspace, taskStore *ta
 	return task.NewEngine(taskStore, execRegistry, engineCfg, logger, engineOpts...), state, nil
 }
 
-// handleResumeInterruption handles graceful shutdown when user presses Ctrl+C during resume.
+// handleResumeInterruption handles graceful shutdown when user presses Ctrl+C or
+// the run hits --timeout during resume.
 // It saves the task and workspace state so the user can resume later.
 // The state parameter contains the AI runner for process termination.
 // The wsStore parameter allows dependency injection for testing - pass nil to skip persistence.
 func handleResumeInterruption(ctx context.Context, out tui.Output, ws *domain.Workspace, t *domain.Task, state *progressState, wsStore workspace.Store, logger zerolog.Logger) error {
+	notice, reason, interruptErr := interruptNotice(ctx)
+
 	logger.Info().
 		Str("workspace_name", ws.Name).
 		Str("task_id", t.ID).
+		Str("reason", reason).
 		Msg("received interrupt signal during resume, initiating graceful shutdown")
 
-	out.Warning("\n⚠ Interrupt received - saving state...")
+	out.Warning(notice)
 
 	// Terminate any running AI process first to prevent orphaned processes
 	terminateAIProcess(state, logger)
@@ -505,33 +759,36 @@ func handleResumeInterruption(ctx context.Context, out tui.Output, ws *domain.Wo
 	// Use a context without cancellation for cleanup since the original is canceled
 	cleanupCtx := context.WithoutCancel(ctx)
 
-	// Save interrupted task state (reuse the function from start.go)
-	saveInterruptedTaskState(cleanupCtx, ws, t, logger)
-
 	// Update workspace to paused
 	ws.Status = constants.WorkspaceStatusPaused
 
-	// Persist workspace state if store is provided (allows nil for testing)
-	if wsStore != nil {
-		if updateErr := wsStore.Update(cleanupCtx, ws); updateErr != nil {
-			logger.Warn().Err(updateErr).
-				Str("workspace_name", ws.Name).
-				Msg("failed to persist workspace pause status")
-		}
-	}
+	// Save interrupted task state together with the paused workspace
+	// (reuse the function from start.go; a nil store skips the workspace for testing)
+	saveInterruptedTaskState(cleanupCtx, ws, t, reason, wsStore, logger)
 
 	// Display summary (reuse the function from start.go)
 	displayInterruptionSummary(out, ws, t)
 
-	return atlaserrors.ErrTaskInterrupted
+	return interruptErr
 }
 
 // displayResumeInfo displays information about the task being resumed.
 func displayResumeInfo(out tui.Output, workspaceName string, currentTask *domain.Task) {
+	displayResumeInfoIn(out, workspaceName, currentTask, displayLocation())
+}
+
+// displayResumeInfoIn displays resume information with timestamps in the given zone.
+func displayResumeInfoIn(out tui.Output, workspaceName string, currentTask *domain.Task, loc *time.Location) {
 	out.Info(fmt.Sprintf("Resuming task in workspace '%s'...", workspaceName))
 	out.Info(fmt.Sprintf("  Task ID: %s", currentTask.ID))
 	out.Info(fmt.Sprintf("  Status: %s → running", currentTask.Status))
 	out.Info(fmt.Sprintf("  Current Step: %d/%d", currentTask.CurrentStep+1, len(currentTask.Steps)))
+	if !currentTask.CreatedAt.IsZero() {
+		out.Info(fmt.Sprintf("  Started: %s", tui.FormatTimestamp(currentTask.CreatedAt, loc)))
+	}
+	if !currentTask.UpdatedAt.IsZero() {
+		out.Info(fmt.Sprintf("  Last Updated: %s", tui.FormatTimestamp(currentTask.UpdatedAt, loc)))
+	}
 
 	// Show specific message for interrupted tasks
 	if currentTask.Status == constants.TaskStatusInterrupted {
@@ -619,8 +876,15 @@ func ensureWorktreeExists(ctx context.Context, ws *domain.Workspace, out tui.Out
 		return nil, fmt.Errorf("%w: %s", atlaserrors.ErrBranchNotFound, ws.Branch)
 	}
 
-	// Calculate worktree path (sibling to main repo)
-	worktreePath := calculateWorktreePath(repoPath, ws.Name)
+	// Calculate worktree path from the configured template (sibling to main repo by default)
+	pathTemplate := ""
+	if cfg, cfgErr := config.Load(ctx); cfgErr == nil {
+		pathTemplate = cfg.Worktree.PathTemplate
+	}
+	worktreePath, err := calculateWorktreePath(pathTemplate, repoPath, ws.Name)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create worktree for existing branch
 	if createErr := createWorktreeForBranch(ctx, repoPath, worktreePath, ws.Branch); createErr != nil {
@@ -684,11 +948,10 @@ func checkBranchExists(ctx context.Context, repoPath, branchName string) bool {
 	return remoteErr == nil
 }
 
-// calculateWorktreePath calculates the worktree path as a sibling to the main repo.
-func calculateWorktreePath(repoPath, workspaceName string) string {
-	parentDir := filepath.Dir(repoPath)
-	repoBaseName := filepath.Base(repoPath)
-	return filepath.Join(parentDir, repoBaseName+"-"+workspaceName)
+// calculateWorktreePath calculates the worktree path from the path template,
+// a sibling to the main repo when the template is empty.
+func calculateWorktreePath(pathTemplate, repoPath, workspaceName string) (string, error) {
+	return workspace.ResolveWorktreePath(pathTemplate, repoPath, workspaceName)
 }
 
 // createWorktreeForBranch creates a worktree for an existing branch.
@@ -717,11 +980,10 @@ func shouldShowRecoveryContext(ctx context.Context, t *domain.Task, _ tui.Output
 	}
 
 	// Get base path for hook store
-	homeDir, err := os.UserHomeDir()
+	baseDir, err := workspace.StateDir()
 	if err != nil {
 		return false
 	}
-	baseDir := filepath.Join(homeDir, constants.AtlasHome)
 
 	// Try to get the hook
 	hookStore := hook.NewFileStore(baseDir)
@@ -732,11 +994,10 @@ func shouldShowRecoveryContext(ctx context.Context, t *domain.Task, _ tui.Output
 // showRecoveryContextAndPrompt displays recovery context from hook and prompts for confirmation.
 func showRecoveryContextAndPrompt(ctx context.Context, t *domain.Task, out tui.Output, _ zerolog.Logger) (bool, error) {
 	// Get base path for hook store
-	homeDir, err := os.UserHomeDir()
+	baseDir, err := workspace.StateDir()
 	if err != nil {
 		return true, err
 	}
-	baseDir := filepath.Join(homeDir, constants.AtlasHome)
 
 	// Get the hook
 	hookStore := hook.NewFileStore(baseDir)
@@ -788,20 +1049,14 @@ func showRecoveryContextAndPrompt(ctx context.Context, t *domain.Task, out tui.O
 // handleRecoveryMenu shows the interactive recovery menu and executes the chosen action with auto-resume.
 // The state parameter contains the AI runner for process termination on interrupt.
 func handleRecoveryMenu(ctx context.Context, _ *cobra.Command, out tui.Output, taskStore *task.FileStore, ws *domain.Workspace, t *domain.Task, engine *task.Engine, tmpl *domain.Template, state *progressState, sigHandler *signal.Handler, wsStore workspace.Store, outputFormat string, w io.Writer, workspaceName string, logger zerolog.Logger) error {
-	// Load config for notification settings
-	cfg, err := config.Load(ctx)
-	if err != nil {
-		logger.Warn().Err(err).Msg("failed to load config, using default notification settings")
-		cfg = config.DefaultConfig()
-	}
-	notifier := tui.NewNotifier(cfg.Notifications.Bell, false)
+	notifier, disabled := loadRecoverySettings(ctx, logger)
 
 	// Display error context
 	displayRecoveryErrorContext(out, ws, t)
 
 	// Action menu loop - view actions return to menu
 	for {
-		action, err := selectRecoveryAction(t)
+		action, err := selectRecoveryAction(t, disabled)
 		if err != nil {
 			if errors.Is(err, tui.ErrMenuCanceled) {
 				out.Info("Recovery canceled.")
@@ -817,16 +1072,7 @@ func handleRecoveryMenu(ctx context.Context, _ *cobra.Command, out tui.Output, t
 		if done {
 			// Check if we should auto-resume
 			if autoResume {
-				// Display info and prepare task for execution
-				displayResumeInfo(out, workspaceName, t)
-				if t.Metadata == nil {
-					t.Metadata = make(map[string]any)
-				}
-				t.Metadata["worktree_dir"] = ws.WorktreePath
-
-				// Auto-resume execution
-				out.Info("Auto-resuming task execution...")
-				return executeResumeAndHandleResult(ctx, engine, t, tmpl, state, sigHandler, out, ws, wsStore, outputFormat, w, workspaceName, logger)
+				return autoResumeAfterRecovery(ctx, engine, t, tmpl, state, sigHandler, out, ws, wsStore, outputFormat, w, workspaceName, logger)
 			}
 			return nil
 		}
@@ -834,6 +1080,109 @@ func handleRecoveryMenu(ctx context.Context, _ *cobra.Command, out tui.Output, t
 	}
 }
 
+// handleRecoveryActionFlag executes the recovery action given via --action without
+// showing the interactive menu, auto-resuming when the action calls for it.
+func handleRecoveryActionFlag(ctx context.Context, out tui.Output, taskStore *task.FileStore, ws *domain.Workspace, t *domain.Task, engine *task.Engine, tmpl *domain.Template, state *progressState, sigHandler *signal.Handler, wsStore workspace.Store, outputFormat string, w io.Writer, workspaceName string, logger zerolog.Logger, actionName string) error {
+	notifier, disabled := loadRecoverySettings(ctx, logger)
+	autoResume, err := applyRecoveryAction(ctx, out, taskStore, ws, t, notifier, disabled, actionName)
+	if err != nil {
+		return handleResumeError(outputFormat, w, workspaceName, t.ID, err)
+	}
+	if autoResume {
+		return autoResumeAfterRecovery(ctx, engine, t, tmpl, state, sigHandler, out, ws, wsStore, outputFormat, w, workspaceName, logger)
+	}
+	return nil
+}
+
+// applyRecoveryAction validates a recovery action by name against the task's state and the
+// disabled actions, and executes it.
+// Returns true if the task should automatically resume execution afterwards.
+func applyRecoveryAction(ctx context.Context, out tui.Output, taskStore *task.FileStore, ws *domain.Workspace, t *domain.Task, notifier *tui.Notifier, disabled []string, actionName string) (bool, error) {
+	action, err := resolveRecoveryAction(t, actionName, disabled)
+	if err != nil {
+		return false, err
+	}
+
+	done, autoResume, err := executeRecoveryActionWithResume(ctx, out, taskStore, ws, t, notifier, action)
+	if err != nil {
+		return false, err
+	}
+	return done && autoResume, nil
+}
+
+// resolveRecoveryAction returns the recovery action named actionName if it applies to the task
+// and is not disabled. The error lists the valid actions for the task's current state.
+func resolveRecoveryAction(t *domain.Task, actionName string, disabled []string) (tui.RecoveryAction, error) {
+	if slices.Contains(disabled, actionName) {
+		return "", fmt.Errorf("%w: %q is disabled by recovery.disabled_actions", atlaserrors.ErrInvalidRecoveryAction, actionName)
+	}
+
+	valid := recoveryActionsForTask(t, disabled)
+	if len(valid) == 0 {
+		return "", fmt.Errorf("%w: no recovery actions available for %s tasks", atlaserrors.ErrInvalidRecoveryAction, t.Status)
+	}
+
+	names := make([]string, len(valid))
+	for i, action := range valid {
+		if string(action) == actionName {
+			return action, nil
+		}
+		names[i] = string(action)
+	}
+
+	return "", fmt.Errorf("%w: %q for %s tasks (valid: %s)",
+		atlaserrors.ErrInvalidRecoveryAction, actionName, t.Status, strings.Join(names, ", "))
+}
+
+// recoveryActionsForTask returns the recovery actions the menu would offer for the task,
+// leaving out disabled ones.
+func recoveryActionsForTask(t *domain.Task, disabled []string) []tui.RecoveryAction {
+	var options []tui.ErrorRecoveryOption
+	if t.Status == constants.TaskStatusGHFailed {
+		// Mirror selectGHFailedRecovery: push error options take precedence
+		if pushErrorType, ok := t.Metadata["push_error_type"].(string); ok && pushErrorType != "" {
+			options = tui.GHFailedOptionsForPushError(pushErrorType)
+		}
+		if len(options) == 0 {
+			options = tui.OptionsForGHFailedStep(getTaskStepName(t))
+		}
+	} else {
+		options = tui.OptionsForStatus(t.Status)
+	}
+	options = tui.FilterRecoveryOptions(options, disabled)
+
+	actions := make([]tui.RecoveryAction, len(options))
+	for i, opt := range options {
+		actions[i] = opt.Action
+	}
+	return actions
+}
+
+// loadRecoverySettings returns the notifier used by recovery actions and the
+// recovery actions disabled in config.
+func loadRecoverySettings(ctx context.Context, logger zerolog.Logger) (*tui.Notifier, []string) {
+	cfg, err := config.Load(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to load config, using default recovery settings")
+		cfg = config.DefaultConfig()
+	}
+	return tui.NewNotifier(cfg.Notifications.Bell, false), cfg.Recovery.DisabledActions
+}
+
+// autoResumeAfterRecovery prepares the task and resumes execution after a recovery action.
+func autoResumeAfterRecovery(ctx context.Context, engine *task.Engine, t *domain.Task, tmpl *domain.Template, state *progressState, sigHandler *signal.Handler, out tui.Output, ws *domain.Workspace, wsStore workspace.Store, outputFormat string, w io.Writer, workspaceName string, logger zerolog.Logger) error {
+	// Display info and prepare task for execution
+	displayResumeInfo(out, workspaceName, t)
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]any)
+	}
+	t.Metadata["worktree_dir"] = ws.WorktreePath
+
+	// Auto-resume execution
+	out.Info("Auto-resuming task execution...")
+	return executeResumeAndHandleResult(ctx, engine, t, tmpl, state, sigHandler, out, ws, wsStore, outputFormat, w, workspaceName, logger)
+}
+
 // displayRecoveryErrorContext shows the error state and relevant information before the recovery menu.
 func displayRecoveryErrorContext(out tui.Output, ws *domain.Workspace, t *domain.Task) {
 	out.Info("")
@@ -919,14 +1268,15 @@ func displayCIContext(out tui.Output, t *domain.Task) {
 }
 
 // selectRecoveryAction selects the appropriate recovery menu based on task state.
-func selectRecoveryAction(t *domain.Task) (tui.RecoveryAction, error) {
+// Disabled actions are left out of the menu.
+func selectRecoveryAction(t *domain.Task, disabled []string) (tui.RecoveryAction, error) {
 	// For GH failed state, use step-aware recovery
 	if t.Status == constants.TaskStatusGHFailed {
-		return selectGHFailedRecovery(t)
+		return selectGHFailedRecovery(t, disabled)
 	}
 
 	// Default: use standard recovery menu
-	return tui.SelectErrorRecovery(t.Status)
+	return tui.SelectErrorRecovery(t.Status, disabled...)
 }
 
 // getTaskStepName returns the current step name from the task, or empty string if unavailable.
@@ -938,18 +1288,21 @@ func getTaskStepName(t *domain.Task) string {
 }
 
 // selectGHFailedRecovery shows step-aware recovery options for gh_failed status.
-func selectGHFailedRecovery(t *domain.Task) (tui.RecoveryAction, error) {
+func selectGHFailedRecovery(t *domain.Task, disabled []string) (tui.RecoveryAction, error) {
 	// Get step name for context-aware options
 	stepName := getTaskStepName(t)
 
 	// Check for specific push error type (existing logic for rebase option)
-	action, handled, err := trySelectPushErrorRecovery(t, stepName)
+	action, handled, err := trySelectPushErrorRecovery(t, stepName, disabled)
 	if handled {
 		return action, err
 	}
 
 	// Use step-aware options and title
-	options := tui.OptionsForGHFailedStep(stepName)
+	options := tui.FilterRecoveryOptions(tui.OptionsForGHFailedStep(stepName), disabled)
+	if len(options) == 0 {
+		return "", tui.ErrMenuCanceled
+	}
 	baseOptions := make([]tui.Option, len(options))
 	for i, opt := range options {
 		baseOptions[i] = opt.Option
@@ -966,7 +1319,7 @@ func selectGHFailedRecovery(t *domain.Task) (tui.RecoveryAction, error) {
 
 // trySelectPushErrorRecovery attempts to handle push-specific error recovery.
 // Returns (action, handled, error) where handled indicates if push error was found.
-func trySelectPushErrorRecovery(t *domain.Task, stepName string) (tui.RecoveryAction, bool, error) {
+func trySelectPushErrorRecovery(t *domain.Task, stepName string, disabled []string) (tui.RecoveryAction, bool, error) {
 	if t.Metadata == nil {
 		return "", false, nil
 	}
@@ -976,7 +1329,7 @@ func trySelectPushErrorRecovery(t *domain.Task, stepName string) (tui.RecoveryAc
 		return "", false, nil
 	}
 
-	options := tui.GHFailedOptionsForPushError(pushErrorType)
+	options := tui.FilterRecoveryOptions(tui.GHFailedOptionsForPushError(pushErrorType), disabled)
 	if len(options) == 0 {
 		return "", false, nil
 	}
@@ -1166,10 +1519,26 @@ func handleViewErrors(ctx context.Context, out tui.Output, taskStore *task.FileS
 		return nil
 	}
 
+	// Summarize structured results; anything else is shown raw
+	summary, err := tui.RenderValidationSummary(data)
+	if err != nil {
+		logger := Logger()
+		logger.Debug().Err(err).Str("task_id", taskID).Msg("showing raw validation artifact")
+	}
+
+	// Long validation output is unreadable when dumped to a terminal, so page it
+	pager := tui.NewPager(os.Stdout)
+	if pager.Enabled() {
+		if err := pager.Page(ctx, summary); err != nil {
+			out.Warning(fmt.Sprintf("Could not display validation results: %v", err))
+		}
+		return nil
+	}
+
 	// Display the validation output
 	out.Info("")
 	out.Info("--- Validation Output ---")
-	out.Info(string(data))
+	out.Info(strings.TrimRight(summary, "\n"))
 	out.Info("-------------------------")
 	out.Info("")
 
@@ -0,0 +1,134 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/git"
+	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// promptResumeWorkspace asks the user which workspace to resume. candidates
+// holds the workspaces that matched the current directory; when it is empty
+// the user types a name. Replaced in tests.
+//
+//nolint:gochecknoglobals // Required for test injection of the prompt
+var promptResumeWorkspace = func(candidates []*domain.Workspace) (string, error) {
+	if len(candidates) == 0 {
+		return tui.Input("Workspace to resume", "")
+	}
+	options := make([]tui.Option, len(candidates))
+	for i, ws := range candidates {
+		options[i] = tui.Option{Label: ws.Name, Description: ws.Branch, Value: ws.Name}
+	}
+	return tui.Select("Several workspaces match this directory. Which one?", options)
+}
+
+// resolveResumeWorkspaceFromDir detects the workspace to resume from the
+// current directory, prompting only for text output on a terminal.
+func resolveResumeWorkspaceFromDir(ctx context.Context, outputFormat string) (string, error) {
+	dir, err := currentDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	wsStore, err := newWorkspaceStore("")
+	if err != nil {
+		return "", fmt.Errorf("failed to create workspace store: %w", err)
+	}
+	interactive := outputFormat != OutputJSON && terminalCheck()
+	return detectResumeWorkspace(ctx, wsStore, dir, interactive)
+}
+
+// detectResumeWorkspace resolves the workspace to resume when none was named,
+// from the worktree containing dir or, failing that, the branch checked out
+// in dir. If nothing or more than one workspace matches, it prompts when
+// interactive and returns an error otherwise.
+func detectResumeWorkspace(ctx context.Context, wsStore workspace.Store, dir string, interactive bool) (string, error) {
+	workspaces, err := wsStore.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	branch, _ := git.RunCommand(ctx, dir, "rev-parse", "--abbrev-ref", "HEAD")
+	matches := matchWorkspacesForDir(workspaces, dir, branch)
+	if len(matches) == 1 {
+		return matches[0].Name, nil
+	}
+
+	if interactive {
+		name, promptErr := promptResumeWorkspace(matches)
+		if promptErr != nil {
+			return "", promptErr
+		}
+		if name = strings.TrimSpace(name); name == "" {
+			return "", fmt.Errorf("%w: workspace name", atlaserrors.ErrEmptyValue)
+		}
+		return name, nil
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("%w: no workspace given and %s is not inside a workspace worktree",
+			atlaserrors.ErrWorkspaceNotFound, dir)
+	}
+	names := make([]string, len(matches))
+	for i, ws := range matches {
+		names[i] = ws.Name
+	}
+	return "", fmt.Errorf("%w: several workspaces match %s (%s); pass the workspace name",
+		atlaserrors.ErrInvalidArgument, dir, strings.Join(names, ", "))
+}
+
+// matchWorkspacesForDir returns the open workspaces whose worktree contains
+// dir. If none do, it returns those on branch, the branch checked out in dir.
+func matchWorkspacesForDir(workspaces []*domain.Workspace, dir, branch string) []*domain.Workspace {
+	dir = resolvePath(dir)
+
+	var byPath, byBranch []*domain.Workspace
+	for _, ws := range workspaces {
+		if ws == nil || ws.Status == constants.WorkspaceStatusClosed || ws.Status == constants.WorkspaceStatusTrashed {
+			continue
+		}
+		if ws.WorktreePath != "" && isWithinDir(dir, resolvePath(ws.WorktreePath)) {
+			byPath = append(byPath, ws)
+			continue
+		}
+		if branch != "" && branch != "HEAD" && ws.Branch == branch {
+			byBranch = append(byBranch, ws)
+		}
+	}
+
+	if len(byPath) > 0 {
+		return byPath
+	}
+	return byBranch
+}
+
+// isWithinDir returns true if path is root or inside it.
+func isWithinDir(path, root string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// resolvePath returns path with symlinks resolved, or cleaned if it cannot be resolved.
+func resolvePath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
+
+// currentDir returns the working directory. Replaced in tests.
+//
+//nolint:gochecknoglobals // Required for test injection of the working directory
+var currentDir = os.Getwd
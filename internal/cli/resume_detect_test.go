@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// newDetectStore creates a workspace store holding the given workspaces.
+func newDetectStore(t *testing.T, workspaces ...*domain.Workspace) workspace.Store {
+	t.Helper()
+	store, err := workspace.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	for _, ws := range workspaces {
+		require.NoError(t, store.Create(context.Background(), ws))
+	}
+	return store
+}
+
+// TestMatchWorkspacesForDir tests matching workspaces by worktree path and branch.
+func TestMatchWorkspacesForDir(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	authPath := filepath.Join(root, "repo-auth")
+	authNested := filepath.Join(authPath, "internal", "pkg")
+	authPrefix := filepath.Join(root, "repo-auth-v2")
+	for _, dir := range []string{authNested, authPrefix} {
+		require.NoError(t, os.MkdirAll(dir, 0o750))
+	}
+
+	auth := &domain.Workspace{Name: "auth", WorktreePath: authPath, Branch: "feat/auth", Status: constants.WorkspaceStatusActive}
+	authV2 := &domain.Workspace{Name: "auth-v2", WorktreePath: authPrefix, Branch: "feat/auth-v2", Status: constants.WorkspaceStatusActive}
+	closed := &domain.Workspace{Name: "old", WorktreePath: authPath, Branch: "feat/old", Status: constants.WorkspaceStatusClosed}
+	all := []*domain.Workspace{auth, authV2, closed}
+
+	tests := []struct {
+		name   string
+		dir    string
+		branch string
+		want   []string
+	}{
+		{name: "worktree root", dir: authPath, want: []string{"auth"}},
+		{name: "nested directory", dir: authNested, want: []string{"auth"}},
+		{name: "sibling with shared prefix", dir: authPrefix, want: []string{"auth-v2"}},
+		{name: "branch fallback", dir: root, branch: "feat/auth-v2", want: []string{"auth-v2"}},
+		{name: "closed workspace branch ignored", dir: root, branch: "feat/old"},
+		{name: "detached head ignored", dir: root, branch: "HEAD"},
+		{name: "path match wins over branch", dir: authNested, branch: "feat/auth-v2", want: []string{"auth"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, ws := range matchWorkspacesForDir(all, tc.dir, tc.branch) {
+				got = append(got, ws.Name)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// TestDetectResumeWorkspace_InsideWorktree tests that a directory inside a
+// registered worktree resolves to its workspace.
+func TestDetectResumeWorkspace_InsideWorktree(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	wtPath := filepath.Join(root, "repo-payment")
+	subdir := filepath.Join(wtPath, "cmd")
+	require.NoError(t, os.MkdirAll(subdir, 0o750))
+
+	store := newDetectStore(t,
+		&domain.Workspace{Name: "payment", WorktreePath: wtPath, Branch: "fix/payment", Status: constants.WorkspaceStatusPaused},
+		&domain.Workspace{Name: "other", WorktreePath: filepath.Join(root, "repo-other"), Branch: "feat/other", Status: constants.WorkspaceStatusActive},
+	)
+
+	name, err := detectResumeWorkspace(context.Background(), store, subdir, false)
+	require.NoError(t, err)
+	assert.Equal(t, "payment", name)
+}
+
+// TestDetectResumeWorkspace_NoMatchNonInteractive tests the error when the
+// directory is outside every workspace.
+func TestDetectResumeWorkspace_NoMatchNonInteractive(t *testing.T) {
+	t.Parallel()
+
+	store := newDetectStore(t,
+		&domain.Workspace{Name: "payment", WorktreePath: filepath.Join(t.TempDir(), "wt"), Branch: "fix/payment", Status: constants.WorkspaceStatusActive},
+	)
+
+	_, err := detectResumeWorkspace(context.Background(), store, t.TempDir(), false)
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+}
+
+// TestDetectResumeWorkspace_AmbiguousNonInteractive tests that several
+// matching workspaces are reported instead of guessed.
+func TestDetectResumeWorkspace_AmbiguousNonInteractive(t *testing.T) {
+	t.Parallel()
+
+	wtPath := t.TempDir()
+	store := newDetectStore(t,
+		&domain.Workspace{Name: "first", WorktreePath: wtPath, Branch: "a", Status: constants.WorkspaceStatusActive},
+		&domain.Workspace{Name: "second", WorktreePath: wtPath, Branch: "b", Status: constants.WorkspaceStatusPaused},
+	)
+
+	_, err := detectResumeWorkspace(context.Background(), store, wtPath, false)
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "first")
+	assert.Contains(t, err.Error(), "second")
+}
+
+// TestDetectResumeWorkspace_AmbiguousInteractive tests that the user is asked
+// to choose between matching workspaces.
+func TestDetectResumeWorkspace_AmbiguousInteractive(t *testing.T) {
+	original := promptResumeWorkspace
+	defer func() { promptResumeWorkspace = original }()
+
+	var offered []string
+	promptResumeWorkspace = func(candidates []*domain.Workspace) (string, error) {
+		for _, ws := range candidates {
+			offered = append(offered, ws.Name)
+		}
+		return "second", nil
+	}
+
+	wtPath := t.TempDir()
+	store := newDetectStore(t,
+		&domain.Workspace{Name: "first", WorktreePath: wtPath, Branch: "a", Status: constants.WorkspaceStatusActive},
+		&domain.Workspace{Name: "second", WorktreePath: wtPath, Branch: "b", Status: constants.WorkspaceStatusPaused},
+	)
+
+	name, err := detectResumeWorkspace(context.Background(), store, wtPath, true)
+	require.NoError(t, err)
+	assert.Equal(t, "second", name)
+	assert.ElementsMatch(t, []string{"first", "second"}, offered)
+}
+
+// TestDetectResumeWorkspace_NoMatchInteractive tests that the user is asked
+// for a name when nothing matches.
+func TestDetectResumeWorkspace_NoMatchInteractive(t *testing.T) {
+	original := promptResumeWorkspace
+	defer func() { promptResumeWorkspace = original }()
+
+	promptResumeWorkspace = func(candidates []*domain.Workspace) (string, error) {
+		assert.Empty(t, candidates)
+		return "  typed  ", nil
+	}
+
+	store := newDetectStore(t)
+	name, err := detectResumeWorkspace(context.Background(), store, t.TempDir(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "typed", name)
+}
+
+// TestMainRepoFromGitFile tests resolving a linked worktree to its main repository.
+func TestMainRepoFromGitFile(t *testing.T) {
+	t.Parallel()
+
+	mainRepo := t.TempDir()
+	wtDir := t.TempDir()
+	gitFile := filepath.Join(wtDir, ".git")
+
+	require.NoError(t, os.WriteFile(gitFile,
+		[]byte("gitdir: "+filepath.Join(mainRepo, ".git", "worktrees", "feature")+"\n"), 0o600))
+	got, ok := mainRepoFromGitFile(wtDir, gitFile)
+	require.True(t, ok)
+	assert.Equal(t, mainRepo, got)
+
+	// A submodule's .git file does not point into a worktrees directory
+	require.NoError(t, os.WriteFile(gitFile,
+		[]byte("gitdir: "+filepath.Join(mainRepo, ".git", "modules", "sub")+"\n"), 0o600))
+	_, ok = mainRepoFromGitFile(wtDir, gitFile)
+	assert.False(t, ok)
+}
+
+// TestResumeCommand_OptionalWorkspaceArg tests that the workspace argument may be omitted.
+func TestResumeCommand_OptionalWorkspaceArg(t *testing.T) {
+	t.Parallel()
+
+	cmd := newResumeCmd()
+	require.NoError(t, cmd.Args(cmd, []string{}))
+	require.NoError(t, cmd.Args(cmd, []string{"auth"}))
+	require.Error(t, cmd.Args(cmd, []string{"auth", "extra"}))
+}
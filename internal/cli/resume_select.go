@@ -0,0 +1,117 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// promptResumeTask asks the user which of several resumable tasks to resume
+// and returns its ID. Replaced in tests.
+//
+//nolint:gochecknoglobals // Required for test injection of the prompt
+var promptResumeTask = func(candidates []*domain.Task) (string, error) {
+	options := make([]tui.Option, len(candidates))
+	for i, t := range candidates {
+		options[i] = tui.Option{Label: t.ID, Description: resumeTaskSummary(t), Value: t.ID}
+	}
+	return tui.Select("Several tasks can be resumed. Which one?", options)
+}
+
+// resumeTaskSummary describes a task's status and step progress for the picker.
+func resumeTaskSummary(t *domain.Task) string {
+	summary := fmt.Sprintf("%s · step %d/%d", t.Status, t.CurrentStep+1, len(t.Steps))
+	if t.CurrentStep >= 0 && t.CurrentStep < len(t.Steps) {
+		summary += " (" + t.Steps[t.CurrentStep].Name + ")"
+	}
+	return summary
+}
+
+// getResumeTask loads the workspace's tasks and picks the one to resume with
+// selectResumeTask, prompting only for text output on a terminal.
+func getResumeTask(ctx context.Context, workspaceName, taskID, storeBaseDir, outputFormat string, w io.Writer, logger zerolog.Logger) (*task.FileStore, *domain.Task, error) {
+	taskStore, err := newTaskStore(storeBaseDir)
+	if err != nil {
+		return nil, nil, handleResumeError(outputFormat, w, workspaceName, taskID, fmt.Errorf("failed to create task store: %w", err))
+	}
+
+	tasks, err := taskStore.List(ctx, workspaceName)
+	if err != nil {
+		return nil, nil, handleResumeError(outputFormat, w, workspaceName, taskID, fmt.Errorf("failed to list tasks: %w", err))
+	}
+
+	interactive := outputFormat != OutputJSON && terminalCheck()
+	currentTask, err := selectResumeTask(workspaceName, tasks, taskID, interactive)
+	if err != nil {
+		return nil, nil, handleResumeError(outputFormat, w, workspaceName, taskID, err)
+	}
+
+	logger.Debug().
+		Str("workspace_name", workspaceName).
+		Str("task_id", currentTask.ID).
+		Str("status", string(currentTask.Status)).
+		Msg("found task to resume")
+
+	return taskStore, currentTask, nil
+}
+
+// selectResumeTask picks the task to resume from tasks, newest first. With
+// taskID set it returns that task. Otherwise a single resumable task is
+// chosen directly, several are offered in a picker when interactive and
+// reported as ambiguous otherwise. With none resumable the latest task is
+// returned so the caller reports why it cannot be resumed.
+func selectResumeTask(workspaceName string, tasks []*domain.Task, taskID string, interactive bool) (*domain.Task, error) {
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no tasks found in workspace '%s': %w", workspaceName, atlaserrors.ErrNoTasksFound)
+	}
+
+	if taskID != "" {
+		for _, t := range tasks {
+			if t.ID == taskID {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: task '%s' in workspace '%s'", atlaserrors.ErrTaskNotFound, taskID, workspaceName)
+	}
+
+	var resumable []*domain.Task
+	for _, t := range tasks {
+		if isResumableStatus(t.Status) {
+			resumable = append(resumable, t)
+		}
+	}
+
+	switch {
+	case len(resumable) == 0:
+		return tasks[0], nil
+	case len(resumable) == 1:
+		return resumable[0], nil
+	case interactive:
+		id, err := promptResumeTask(resumable)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range resumable {
+			if t.ID == id {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: task '%s' in workspace '%s'", atlaserrors.ErrTaskNotFound, id, workspaceName)
+	}
+
+	ids := make([]string, len(resumable))
+	for i, t := range resumable {
+		ids[i] = t.ID
+	}
+	return nil, fmt.Errorf("%w: workspace '%s' has several resumable tasks (%s); pass --task <id> or --all",
+		atlaserrors.ErrInvalidArgument, workspaceName, strings.Join(ids, ", "))
+}
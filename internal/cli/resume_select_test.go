@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+)
+
+// resumeSelectTasks returns tasks newest first: two resumable and one completed.
+func resumeSelectTasks() []*domain.Task {
+	return []*domain.Task{
+		{ID: testTaskID("300003"), Status: constants.TaskStatusValidationFailed, CurrentStep: 1,
+			Steps: []domain.Step{{Name: "implement"}, {Name: "validate"}}},
+		{ID: testTaskID("300002"), Status: constants.TaskStatusCompleted},
+		{ID: testTaskID("300001"), Status: constants.TaskStatusInterrupted,
+			Steps: []domain.Step{{Name: "implement"}, {Name: "validate"}}},
+	}
+}
+
+// TestSelectResumeTask_TaskFlag tests that --task selects the named task.
+func TestSelectResumeTask_TaskFlag(t *testing.T) {
+	t.Parallel()
+
+	got, err := selectResumeTask("ws", resumeSelectTasks(), testTaskID("300001"), false)
+	require.NoError(t, err)
+	assert.Equal(t, testTaskID("300001"), got.ID)
+
+	_, err = selectResumeTask("ws", resumeSelectTasks(), testTaskID("999999"), false)
+	require.ErrorIs(t, err, atlaserrors.ErrTaskNotFound)
+}
+
+// TestSelectResumeTask_AmbiguousNonInteractive tests that several resumable
+// tasks are reported instead of guessed.
+func TestSelectResumeTask_AmbiguousNonInteractive(t *testing.T) {
+	t.Parallel()
+
+	_, err := selectResumeTask("ws", resumeSelectTasks(), "", false)
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), testTaskID("300003"))
+	assert.Contains(t, err.Error(), testTaskID("300001"))
+	assert.NotContains(t, err.Error(), testTaskID("300002"))
+	assert.Contains(t, err.Error(), "--task")
+}
+
+// TestSelectResumeTask_AmbiguousInteractive tests that the user picks among
+// the resumable tasks.
+func TestSelectResumeTask_AmbiguousInteractive(t *testing.T) {
+	original := promptResumeTask
+	defer func() { promptResumeTask = original }()
+
+	var offered []string
+	promptResumeTask = func(candidates []*domain.Task) (string, error) {
+		for _, c := range candidates {
+			offered = append(offered, c.ID)
+		}
+		return testTaskID("300001"), nil
+	}
+
+	got, err := selectResumeTask("ws", resumeSelectTasks(), "", true)
+	require.NoError(t, err)
+	assert.Equal(t, testTaskID("300001"), got.ID)
+	assert.Equal(t, []string{testTaskID("300003"), testTaskID("300001")}, offered)
+}
+
+// TestSelectResumeTask_SingleOrNoneResumable tests the unambiguous cases.
+func TestSelectResumeTask_SingleOrNoneResumable(t *testing.T) {
+	t.Parallel()
+
+	tasks := resumeSelectTasks()[1:]
+	got, err := selectResumeTask("ws", tasks, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, testTaskID("300001"), got.ID, "the only resumable task is chosen")
+
+	got, err = selectResumeTask("ws", tasks[:1], "", false)
+	require.NoError(t, err)
+	assert.Equal(t, testTaskID("300002"), got.ID, "the latest task is returned for the caller to reject")
+
+	_, err = selectResumeTask("ws", nil, "", false)
+	require.ErrorIs(t, err, atlaserrors.ErrNoTasksFound)
+}
+
+// TestResumeTaskSummary tests the picker description of a task.
+func TestResumeTaskSummary(t *testing.T) {
+	t.Parallel()
+
+	tasks := resumeSelectTasks()
+	assert.Equal(t, "validation_failed · step 2/2 (validate)", resumeTaskSummary(tasks[0]))
+	assert.Equal(t, "completed · step 1/0", resumeTaskSummary(tasks[1]))
+}
+
+// TestGetResumeTask_TaskFlag tests selecting a task by ID from the task store.
+func TestGetResumeTask_TaskFlag(t *testing.T) {
+	tmpDir := t.TempDir()
+	original := terminalCheck
+	terminalCheck = func() bool { return false }
+	defer func() { terminalCheck = original }()
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+	now := time.Now()
+	for i, tk := range resumeSelectTasks() {
+		tk.WorkspaceID = "resume-ws"
+		tk.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		tk.UpdatedAt = tk.CreatedAt
+		require.NoError(t, taskStore.Create(context.Background(), "resume-ws", tk))
+	}
+
+	var buf bytes.Buffer
+	_, got, err := getResumeTask(context.Background(), "resume-ws", testTaskID("300001"), tmpDir, "text", &buf, zerolog.Nop())
+	require.NoError(t, err)
+	assert.Equal(t, testTaskID("300001"), got.ID)
+
+	_, _, err = getResumeTask(context.Background(), "resume-ws", "", tmpDir, "text", &buf, zerolog.Nop())
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidArgument)
+}
+
+// TestResumeCommand_TaskFlag tests that --task is registered and excludes --all.
+func TestResumeCommand_TaskFlag(t *testing.T) {
+	t.Parallel()
+
+	cmd := newResumeCmd()
+	require.NotNil(t, cmd.Flags().Lookup("task"))
+
+	cmd.SetArgs([]string{"ws", "--task", "x", "--all"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "none of the others can be")
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -127,10 +129,10 @@ func TestNewResumeCmd(t *testing.T) {
 	cmd := newResumeCmd()
 
 	t.Run("command has correct use", func(t *testing.T) {
-		assert.Equal(t, "resume <workspace>", cmd.Use)
+		assert.Equal(t, "resume [workspace]", cmd.Use)
 	})
 
-	t.Run("command requires exactly one argument", func(t *testing.T) {
+	t.Run("command accepts an optional workspace argument", func(t *testing.T) {
 		assert.NotNil(t, cmd.Args)
 	})
 
@@ -140,6 +142,12 @@ func TestNewResumeCmd(t *testing.T) {
 		assert.Equal(t, "false", flag.DefValue)
 	})
 
+	t.Run("command has action flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("action")
+		assert.NotNil(t, flag)
+		assert.Empty(t, flag.DefValue)
+	})
+
 	t.Run("command has short description", func(t *testing.T) {
 		assert.Equal(t, "Resume a paused or failed task", cmd.Short)
 	})
@@ -646,7 +654,8 @@ func TestCalculateWorktreePath(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := calculateWorktreePath(tc.repoPath, tc.workspaceName)
+			result, err := calculateWorktreePath("", tc.repoPath, tc.workspaceName)
+			require.NoError(t, err)
 			assert.Equal(t, tc.expected, result)
 		})
 	}
@@ -769,7 +778,7 @@ func TestSelectRecoveryAction_NonGHFailed(t *testing.T) {
 
 			// Since we can't easily mock the tui.Select call, we verify the function
 			// returns an error (ErrMenuCanceled) when not in a terminal
-			action, err := selectRecoveryAction(task)
+			action, err := selectRecoveryAction(task, nil)
 
 			// Should return error since there's no terminal for huh forms
 			require.Error(t, err)
@@ -1046,6 +1055,26 @@ func TestDisplayResumeInfo(t *testing.T) {
 	}
 }
 
+func TestDisplayResumeInfoIn_Timestamps(t *testing.T) {
+	var buf bytes.Buffer
+	out := tui.NewOutput(&buf, "text")
+
+	task := &domain.Task{
+		ID:          "task-abc",
+		Status:      constants.TaskStatusInterrupted,
+		CurrentStep: 0,
+		Steps:       []domain.Step{{Name: "implement"}},
+		CreatedAt:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 1, 15, 15, 45, 0, 0, time.UTC),
+	}
+
+	displayResumeInfoIn(out, "test-ws", task, time.FixedZone("EST", -5*60*60))
+
+	output := buf.String()
+	assert.Contains(t, output, "Started: 2024-01-15 09:30:00 -05:00")
+	assert.Contains(t, output, "Last Updated: 2024-01-15 10:45:00 -05:00")
+}
+
 func TestGetTaskErrorMessage(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1573,7 +1602,7 @@ func TestTrySelectPushErrorRecovery_NoMetadata(t *testing.T) {
 		Metadata: nil, // No metadata
 	}
 
-	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push")
+	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push", nil)
 	require.NoError(t, err)
 	assert.False(t, handled, "should not be handled when metadata is nil")
 	assert.Empty(t, action)
@@ -1593,7 +1622,7 @@ func TestTrySelectPushErrorRecovery_NoPushErrorType(t *testing.T) {
 		},
 	}
 
-	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push")
+	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push", nil)
 	require.NoError(t, err)
 	assert.False(t, handled, "should not be handled when push_error_type is missing")
 	assert.Empty(t, action)
@@ -1613,7 +1642,7 @@ func TestTrySelectPushErrorRecovery_EmptyPushErrorType(t *testing.T) {
 		},
 	}
 
-	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push")
+	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push", nil)
 	require.NoError(t, err)
 	assert.False(t, handled, "should not be handled when push_error_type is empty")
 	assert.Empty(t, action)
@@ -1991,6 +2020,192 @@ func TestExecuteRecoveryActionWithResume_AllActions(t *testing.T) {
 	}
 }
 
+func TestApplyRecoveryAction_GHFailed(t *testing.T) {
+	tests := []struct {
+		name         string
+		stepName     string
+		action       string
+		expectResume bool
+		expectStatus constants.TaskStatus
+	}{
+		{
+			name:         "retry_gh resumes task",
+			stepName:     "git_push",
+			action:       "retry_gh",
+			expectResume: true,
+			expectStatus: constants.TaskStatusRunning,
+		},
+		{
+			name:         "retry_commit resumes task",
+			stepName:     "git_commit",
+			action:       "retry_commit",
+			expectResume: true,
+			expectStatus: constants.TaskStatusRunning,
+		},
+		{
+			name:         "fix_manually leaves task failed",
+			stepName:     "git_push",
+			action:       "fix_manually",
+			expectResume: false,
+			expectStatus: constants.TaskStatusGHFailed,
+		},
+		{
+			name:         "abandon ends task",
+			stepName:     "git_push",
+			action:       "abandon",
+			expectResume: false,
+			expectStatus: constants.TaskStatusAbandoned,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			tmpDir := t.TempDir()
+
+			ws := &domain.Workspace{
+				Name:         "test-ws",
+				WorktreePath: tmpDir,
+				Branch:       "feat/test",
+			}
+			testTask := &domain.Task{
+				ID:          "task-123",
+				WorkspaceID: "test-ws",
+				Status:      constants.TaskStatusGHFailed,
+				CurrentStep: 1,
+				Steps: []domain.Step{
+					{Name: "implement"},
+					{Name: tc.stepName},
+				},
+			}
+
+			taskStore, err := task.NewFileStore(tmpDir)
+			require.NoError(t, err)
+			require.NoError(t, taskStore.Create(ctx, testTask.WorkspaceID, testTask))
+
+			var buf bytes.Buffer
+			out := tui.NewOutput(&buf, "text")
+			notifier := tui.NewNotifier(false, true)
+
+			autoResume, err := applyRecoveryAction(ctx, out, taskStore, ws, testTask, notifier, nil, tc.action)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectResume, autoResume)
+
+			saved, err := taskStore.Get(ctx, testTask.WorkspaceID, testTask.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectStatus, saved.Status)
+		})
+	}
+}
+
+func TestApplyRecoveryAction_InvalidAction(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	ws := &domain.Workspace{Name: "test-ws", WorktreePath: tmpDir}
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	out := tui.NewOutput(&buf, "text")
+	notifier := tui.NewNotifier(false, true)
+
+	t.Run("inapplicable action lists valid set", func(t *testing.T) {
+		testTask := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test-ws",
+			Status:      constants.TaskStatusGHFailed,
+			Steps:       []domain.Step{{Name: "git_push"}},
+		}
+
+		_, err := applyRecoveryAction(ctx, out, taskStore, ws, testTask, notifier, nil, "continue_waiting")
+		require.ErrorIs(t, err, errors.ErrInvalidRecoveryAction)
+		assert.Contains(t, err.Error(), "retry_gh, fix_manually, abandon")
+		assert.Equal(t, constants.TaskStatusGHFailed, testTask.Status)
+	})
+
+	t.Run("unknown action lists valid set", func(t *testing.T) {
+		testTask := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test-ws",
+			Status:      constants.TaskStatusGHFailed,
+			Steps:       []domain.Step{{Name: "git_push"}},
+			Metadata:    map[string]any{"push_error_type": "non_fast_forward"},
+		}
+
+		_, err := applyRecoveryAction(ctx, out, taskStore, ws, testTask, notifier, nil, "bogus")
+		require.ErrorIs(t, err, errors.ErrInvalidRecoveryAction)
+		assert.Contains(t, err.Error(), "rebase_retry, retry_gh, fix_manually, abandon")
+	})
+
+	t.Run("disabled action is rejected", func(t *testing.T) {
+		testTask := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test-ws",
+			Status:      constants.TaskStatusGHFailed,
+			Steps:       []domain.Step{{Name: "git_push"}},
+			Metadata:    map[string]any{"push_error_type": "non_fast_forward"},
+		}
+
+		_, err := applyRecoveryAction(ctx, out, taskStore, ws, testTask, notifier, []string{"abandon", "rebase_retry"}, "rebase_retry")
+		require.ErrorIs(t, err, errors.ErrInvalidRecoveryAction)
+		assert.Contains(t, err.Error(), `"rebase_retry" is disabled by recovery.disabled_actions`)
+		assert.Equal(t, constants.TaskStatusGHFailed, testTask.Status)
+
+		_, err = applyRecoveryAction(ctx, out, taskStore, ws, testTask, notifier, []string{"abandon", "rebase_retry"}, "bogus")
+		require.ErrorIs(t, err, errors.ErrInvalidRecoveryAction)
+		assert.Contains(t, err.Error(), "(valid: retry_gh, fix_manually)")
+	})
+
+	t.Run("non-error status has no actions", func(t *testing.T) {
+		testTask := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test-ws",
+			Status:      constants.TaskStatusInterrupted,
+		}
+
+		_, err := applyRecoveryAction(ctx, out, taskStore, ws, testTask, notifier, nil, "abandon")
+		require.ErrorIs(t, err, errors.ErrInvalidRecoveryAction)
+		assert.Contains(t, err.Error(), "no recovery actions available")
+	})
+}
+
+func TestRecoveryActionsForTask_DisabledActions(t *testing.T) {
+	disabled := []string{"abandon", "rebase_retry"}
+
+	tests := []struct {
+		name string
+		task *domain.Task
+		want []tui.RecoveryAction
+	}{
+		{
+			name: "push rejected",
+			task: &domain.Task{
+				Status:   constants.TaskStatusGHFailed,
+				Steps:    []domain.Step{{Name: "git_push"}},
+				Metadata: map[string]any{"push_error_type": "non_fast_forward"},
+			},
+			want: []tui.RecoveryAction{tui.RecoveryActionRetryGH, tui.RecoveryActionFixManually},
+		},
+		{
+			name: "commit failed",
+			task: &domain.Task{Status: constants.TaskStatusGHFailed, Steps: []domain.Step{{Name: "git_commit"}}},
+			want: []tui.RecoveryAction{tui.RecoveryActionRetryCommit, tui.RecoveryActionFixManually},
+		},
+		{
+			name: "validation failed",
+			task: &domain.Task{Status: constants.TaskStatusValidationFailed},
+			want: []tui.RecoveryAction{tui.RecoveryActionRetryAI, tui.RecoveryActionFixManually, tui.RecoveryActionViewErrors},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, recoveryActionsForTask(tc.task, disabled))
+		})
+	}
+}
+
 func TestExecuteRecoveryActionWithResume_ViewActions(t *testing.T) {
 	ctx := context.Background()
 	tmpDir := t.TempDir()
@@ -2263,7 +2478,7 @@ func TestTrySelectPushErrorRecovery_AllCases(t *testing.T) {
 				Metadata: tc.metadata,
 			}
 
-			_, handled, err := trySelectPushErrorRecovery(testTask, "git_push")
+			_, handled, err := trySelectPushErrorRecovery(testTask, "git_push", nil)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expectHandled, handled)
 		})
@@ -2577,7 +2792,7 @@ func TestTrySelectPushErrorRecovery_WithNonFastForwardError(t *testing.T) {
 		},
 	}
 
-	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push")
+	action, handled, err := trySelectPushErrorRecovery(testTask, "git_push", nil)
 	// This would require TTY interaction, so we just verify it's handled
 	// In real test this would hang without terminal
 	_ = action
@@ -2687,6 +2902,36 @@ func TestHandleViewErrors_AlternateFilename(t *testing.T) {
 	assert.Contains(t, output, "validation error from alternate file")
 }
 
+func TestHandleViewErrors_StructuredArtifactSummary(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	testTask := &domain.Task{
+		ID:          "task-789",
+		WorkspaceID: "test-ws",
+		Status:      constants.TaskStatusValidationFailed,
+	}
+	require.NoError(t, taskStore.Create(ctx, "test-ws", testTask))
+
+	artifactData := []byte(`{"success": false, "failed_step": "lint", "lint_results": [{"command": "magex lint", "success": false, "exit_code": 1, "stdout": "main.go:3:1: missing doc comment"}]}`)
+	require.NoError(t, taskStore.SaveArtifact(ctx, "test-ws", "task-789", "validation.json", artifactData))
+
+	var buf bytes.Buffer
+	out := tui.NewOutput(&buf, "text")
+
+	err = handleViewErrors(ctx, out, taskStore, "test-ws", "task-789")
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.Contains(t, output, "Validation failed at lint (1 error in 1 file)")
+	assert.Contains(t, output, "3:1  missing doc comment")
+	assert.NotContains(t, output, `"lint_results"`)
+}
+
 func TestHandleViewLogs_FallbackToRepoURL(t *testing.T) {
 	ctx := context.Background()
 
@@ -2749,3 +2994,188 @@ func TestResumeOptions_AllFlags(t *testing.T) {
 	assert.True(t, opts.retry)
 	assert.True(t, opts.menu)
 }
+
+func TestResumeTasks_AllResumableTasks(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	ws := &domain.Workspace{Name: "test-ws", WorktreePath: tmpDir, Branch: "feat/test"}
+	for i, status := range []constants.TaskStatus{
+		constants.TaskStatusValidationFailed,
+		constants.TaskStatusCompleted,
+		constants.TaskStatusCIFailed,
+	} {
+		require.NoError(t, taskStore.Create(ctx, ws.Name, &domain.Task{
+			ID:          fmt.Sprintf("task-00000000-0000-4000-8000-00000000000%d", i),
+			WorkspaceID: ws.Name,
+			Status:      status,
+			CreatedAt:   time.Now().Add(time.Duration(i) * time.Minute),
+			Steps:       []domain.Step{{Name: "validate"}},
+		}))
+	}
+
+	tasks, err := taskStore.ListByStatus(ctx, ws.Name, resumableStatuses()...)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+
+	// Mirrors --action abandon: each task is handled without the interactive menu
+	var processed []string
+	resume := func(ctx context.Context, _ io.Writer, out tui.Output, tk *domain.Task) error {
+		processed = append(processed, tk.ID)
+		_, err := applyRecoveryAction(ctx, out, taskStore, ws, tk, tui.NewNotifier(false, true), nil, "abandon")
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = resumeTasks(ctx, &buf, tui.NewOutput(&buf, OutputJSON), OutputJSON, ws.Name, tasks, resume)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"task-00000000-0000-4000-8000-000000000002",
+		"task-00000000-0000-4000-8000-000000000000",
+	}, processed)
+
+	var responses []resumeResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &responses))
+	require.Len(t, responses, 2)
+	for i, resp := range responses {
+		assert.True(t, resp.Success)
+		assert.Equal(t, processed[i], resp.Task.ID)
+		assert.Equal(t, string(constants.TaskStatusAbandoned), resp.Task.Status)
+	}
+}
+
+func TestResumeTasks_VisitsHigherPriorityFirst(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	taskStore, err := task.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	ws := &domain.Workspace{Name: "test-ws", WorktreePath: tmpDir, Branch: "feat/test"}
+	for i, priority := range []int{0, 3, 0} {
+		require.NoError(t, taskStore.Create(ctx, ws.Name, &domain.Task{
+			ID:          fmt.Sprintf("task-00000000-0000-4000-8000-00000000010%d", i),
+			WorkspaceID: ws.Name,
+			Status:      constants.TaskStatusInterrupted,
+			Priority:    priority,
+			CreatedAt:   time.Now().Add(time.Duration(i) * time.Minute),
+			Steps:       []domain.Step{{Name: "implement"}},
+		}))
+	}
+
+	tasks, err := taskStore.ListByStatus(ctx, ws.Name, resumableStatuses()...)
+	require.NoError(t, err)
+
+	var processed []string
+	resume := func(_ context.Context, _ io.Writer, _ tui.Output, tk *domain.Task) error {
+		processed = append(processed, tk.ID)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, resumeTasks(ctx, &buf, tui.NewOutput(&buf, OutputText), OutputText, ws.Name, tasks, resume))
+
+	assert.Equal(t, []string{
+		"task-00000000-0000-4000-8000-000000000101",
+		"task-00000000-0000-4000-8000-000000000102",
+		"task-00000000-0000-4000-8000-000000000100",
+	}, processed)
+}
+
+func TestResumeTasks_CapturesTaskJSONAndErrors(t *testing.T) {
+	ctx := context.Background()
+	ws := &domain.Workspace{Name: "test-ws", Branch: "feat/test"}
+	tasks := []*domain.Task{
+		{ID: "task-a", Status: constants.TaskStatusInterrupted},
+		{ID: "task-b", Status: constants.TaskStatusGHFailed},
+	}
+
+	resume := func(_ context.Context, w io.Writer, out tui.Output, tk *domain.Task) error {
+		if tk.ID == "task-b" {
+			return handleResumeError(OutputJSON, w, ws.Name, tk.ID, errors.ErrInvalidRecoveryAction)
+		}
+		out.Info("resuming")
+		tk.Status = constants.TaskStatusAwaitingApproval
+		return outputResumeSuccessJSON(out, ws, tk)
+	}
+
+	var buf bytes.Buffer
+	err := resumeTasks(ctx, &buf, tui.NewOutput(&buf, OutputJSON), OutputJSON, ws.Name, tasks, resume)
+	require.ErrorIs(t, err, errors.ErrJSONErrorOutput)
+
+	var responses []resumeResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &responses))
+	require.Len(t, responses, 2)
+	assert.True(t, responses[0].Success)
+	assert.Equal(t, "feat/test", responses[0].Workspace.Branch)
+	assert.Equal(t, string(constants.TaskStatusAwaitingApproval), responses[0].Task.Status)
+	assert.False(t, responses[1].Success)
+	assert.Equal(t, "task-b", responses[1].Task.ID)
+	assert.Contains(t, responses[1].Error, errors.ErrInvalidRecoveryAction.Error())
+}
+
+func TestResumeTasks_TextModeStopsOnInterrupt(t *testing.T) {
+	ctx := context.Background()
+	tasks := []*domain.Task{{ID: "task-a"}, {ID: "task-b"}, {ID: "task-c"}}
+
+	var processed []string
+	resume := func(_ context.Context, _ io.Writer, _ tui.Output, tk *domain.Task) error {
+		processed = append(processed, tk.ID)
+		if tk.ID == "task-b" {
+			return errors.ErrTaskInterrupted
+		}
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := resumeTasks(ctx, &buf, tui.NewOutput(&buf, "text"), "text", "test-ws", tasks, resume)
+	require.ErrorIs(t, err, errors.ErrTaskInterrupted)
+	assert.Equal(t, []string{"task-a", "task-b"}, processed)
+	assert.Contains(t, buf.String(), "[1/3] Resuming task task-a")
+}
+
+func TestNewResumeCmd_AllFlag(t *testing.T) {
+	cmd := newResumeCmd()
+	flag := cmd.Flags().Lookup("all")
+	require.NotNil(t, flag)
+	assert.Equal(t, "false", flag.DefValue)
+}
+
+// TestWarnWorktreeHealth_BranchMismatch tests resume warns when the worktree
+// is not on the workspace's recorded branch.
+func TestWarnWorktreeHealth_BranchMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := initGitRepo(t)
+
+	wsStore, err := workspace.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ws := &domain.Workspace{
+		Name:         "health-ws",
+		RepoPath:     repo,
+		WorktreePath: repo,
+		Branch:       "feat/expected",
+		Status:       constants.WorkspaceStatusPaused,
+	}
+	require.NoError(t, wsStore.Create(ctx, ws))
+
+	var buf bytes.Buffer
+	warnWorktreeHealth(ctx, ws, wsStore, tui.NewOutput(&buf, "text"), zerolog.Nop())
+
+	assert.Contains(t, buf.String(), `expected "feat/expected"`)
+}
+
+// TestWarnWorktreeHealth_NoRepoPath tests the check is skipped without a repo path.
+func TestWarnWorktreeHealth_NoRepoPath(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	ws := &domain.Workspace{Name: "health-ws", WorktreePath: "/nonexistent", Branch: "feat/x"}
+	warnWorktreeHealth(context.Background(), ws, nil, tui.NewOutput(&buf, "text"), zerolog.Nop())
+
+	assert.Empty(t, buf.String())
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -38,7 +40,12 @@ var (
 	globalLogFlags struct { //nolint:gochecknoglobals // CLI flags require global access
 		verbose bool
 		quiet   bool
+		format  string
+		level   string
 	}
+
+	// globalDisplayUTC records the --utc flag for timestamp display helpers.
+	globalDisplayUTC atomic.Bool //nolint:gochecknoglobals // CLI flags require global access
 )
 
 // Logger returns the initialized logger for use by subcommands.
@@ -62,6 +69,13 @@ func Logger() zerolog.Logger {
 	return globalLogger
 }
 
+// displayLocation returns the zone used for human-readable timestamps:
+// UTC when --utc was given, otherwise the local zone.
+// JSON output always uses RFC3339 in UTC regardless of this setting.
+func displayLocation() *time.Location {
+	return tui.DisplayLocation(globalDisplayUTC.Load())
+}
+
 // LoggerWithTaskStore returns a logger configured to persist task-specific logs.
 // Log entries containing workspace_name and task_id fields will be written to
 // the task's log file in addition to the console and global log.
@@ -70,12 +84,16 @@ func Logger() zerolog.Logger {
 func LoggerWithTaskStore(store TaskLogAppender) zerolog.Logger {
 	// Read the global flags with lock protection
 	globalLoggerMu.RLock()
-	verbose := globalLogFlags.verbose
-	quiet := globalLogFlags.quiet
+	opts := LogOptions{
+		Verbose: globalLogFlags.verbose,
+		Quiet:   globalLogFlags.quiet,
+		Format:  globalLogFlags.format,
+		Level:   globalLogFlags.level,
+	}
 	globalLoggerMu.RUnlock()
 
-	// Call InitLoggerWithTaskStore without holding the lock to avoid deadlock
-	return InitLoggerWithTaskStore(verbose, quiet, store)
+	// Create the logger without holding the lock to avoid deadlock
+	return initLoggerWithTaskStoreOptions(opts, store)
 }
 
 // newRootCmd creates and returns the root command for the atlas CLI.
@@ -105,11 +123,33 @@ func newRootCmd(flags *GlobalFlags, info BuildInfo) *cobra.Command {
 				return fmt.Errorf("%w: %q must be one of %v", errors.ErrInvalidOutputFormat, flags.Output, ValidOutputFormats())
 			}
 
+			// Relocate workspace/task state before any command opens a store
+			if err := ApplyStateDir(flags.BaseDir); err != nil {
+				return err
+			}
+			if err := ApplyStatePermissions(flags.SharedState); err != nil {
+				return err
+			}
+
+			logOpts := LogOptions{
+				Verbose: flags.Verbose,
+				Quiet:   flags.Quiet,
+				Format:  flags.LogFormat,
+				Level:   flags.LogLevel,
+			}
+			if err := logOpts.Validate(); err != nil {
+				return errors.NewExitCode2Error(err)
+			}
+
+			globalDisplayUTC.Store(flags.UTC)
+
 			// Initialize logger based on flags (protected by mutex for thread safety)
 			globalLoggerMu.Lock()
-			globalLogger = InitLogger(flags.Verbose, flags.Quiet)
+			globalLogger = InitLoggerWithOptions(logOpts)
 			globalLogFlags.verbose = flags.Verbose
 			globalLogFlags.quiet = flags.Quiet
+			globalLogFlags.format = flags.LogFormat
+			globalLogFlags.level = flags.LogLevel
 			logger := globalLogger // Get a copy while holding the lock
 			globalLoggerMu.Unlock()
 
@@ -130,12 +170,15 @@ func newRootCmd(flags *GlobalFlags, info BuildInfo) *cobra.Command {
 
 	// Add subcommands
 	AddInitCommand(cmd)
+	AddDoctorCommand(cmd)
 	AddConfigCommand(cmd)
 	AddUpgradeCommand(cmd)
 	AddWorkspaceCommand(cmd)
 	AddStartCommand(cmd)
+	AddTemplateCommand(cmd)
 	AddStatusCommand(cmd)
 	AddResumeCommand(cmd)
+	AddContinueCommand(cmd)
 	AddAbandonCommand(cmd)
 	AddValidateCommand(cmd)
 	AddFormatCommand(cmd)
@@ -146,10 +189,17 @@ func newRootCmd(flags *GlobalFlags, info BuildInfo) *cobra.Command {
 	AddCompletionCommand(cmd)
 	AddHookCommand(cmd)
 	AddCheckpointCommand(cmd)
+	AddDiffCommand(cmd)
+	AddExportCommand(cmd)
+	AddOpenCommand(cmd)
+	AddNotifyTestCommand(cmd)
 	AddCleanupCommand(cmd)
+	AddPruneCommand(cmd)
+	AddSchemaCommand(cmd)
 	AddBacklogCommand(cmd)
 	AddDaemonCommand(cmd)
 	AddUICommand(cmd)
+	AddVersionCommand(cmd, info)
 
 	return cmd
 }
@@ -0,0 +1,84 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/jsonschema"
+)
+
+// schemaTarget is a JSON response a schema can be published for.
+type schemaTarget struct {
+	title    string
+	response any
+}
+
+// schemaTargets maps schema names to the response written by the command of
+// the same name with --output json. resume-all is the array resume --all writes.
+func schemaTargets() map[string]schemaTarget {
+	return map[string]schemaTarget{
+		"start":      {title: "atlas start --output json", response: startResponse{}},
+		"resume":     {title: "atlas resume --output json", response: resumeResponse{}},
+		"resume-all": {title: "atlas resume --all --output json", response: []resumeResponse{}},
+		"status":     {title: "atlas status --output json", response: hierarchicalJSONOutput{}},
+	}
+}
+
+// schemaNames returns the available schema names in sorted order.
+func schemaNames() []string {
+	targets := schemaTargets()
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// AddSchemaCommand adds the schema command to the root command.
+func AddSchemaCommand(root *cobra.Command) {
+	root.AddCommand(newSchemaCmd())
+}
+
+// newSchemaCmd creates the schema command.
+func newSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema <start|resume|resume-all|status>",
+		Short: "Print the JSON Schema of a command's JSON output",
+		Long: `Print a JSON Schema (draft 2020-12) describing the JSON a command writes
+with --output json, so integrations can validate what they parse.
+
+The schema is generated from the response types, so it always matches the
+installed version of atlas. Optional fields are not listed as required, and
+unknown fields are rejected.
+
+Examples:
+  atlas schema start         # Schema of 'atlas start --output json'
+  atlas schema resume        # Schema of 'atlas resume --output json'
+  atlas schema resume-all    # Schema of 'atlas resume --all --output json'
+  atlas schema status        # Schema of 'atlas status --output json'`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: schemaNames(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchema(os.Stdout, args[0])
+		},
+		SilenceUsage: true,
+	}
+}
+
+// runSchema writes the schema with the given name.
+func runSchema(w io.Writer, name string) error {
+	target, ok := schemaTargets()[name]
+	if !ok {
+		return fmt.Errorf("%w: unknown schema %q (available: %s)",
+			atlaserrors.ErrInvalidArgument, name, strings.Join(schemaNames(), ", "))
+	}
+	return encodeJSONIndented(w, jsonschema.Reflect(target.response, target.title))
+}
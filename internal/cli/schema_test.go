@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/jsonschema"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// loadSchema runs the schema command and decodes its output.
+func loadSchema(t *testing.T, name string) *jsonschema.Schema {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, runSchema(&buf, name))
+
+	var s jsonschema.Schema
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &s))
+	return &s
+}
+
+// TestRunSchema_ValidatesRealResponses tests that each published schema
+// accepts the JSON its command actually writes.
+func TestRunSchema_ValidatesRealResponses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("start", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, encodeJSONIndented(&buf, startResponse{
+			Success:   true,
+			Workspace: workspaceInfo{Name: "auth-fix", Branch: "fix/auth-fix", WorktreePath: "/tmp/repo-auth-fix", Status: "active"},
+			Task:      taskInfo{ID: "task-20260101-000000", TemplateName: "bugfix", Description: "fix auth", Status: "completed", CurrentStep: 5, TotalSteps: 5},
+		}))
+		require.NoError(t, loadSchema(t, "start").Validate(buf.Bytes()))
+	})
+
+	t.Run("resume error", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		err := outputResumeErrorJSON(&buf, "auth-fix", "task-20260101-000000", "no resumable task")
+		require.ErrorIs(t, err, atlaserrors.ErrJSONErrorOutput)
+		require.NoError(t, loadSchema(t, "resume").Validate(buf.Bytes()))
+	})
+
+	t.Run("resume all", func(t *testing.T) {
+		t.Parallel()
+
+		ws := &domain.Workspace{Name: "auth-fix", Branch: "fix/auth-fix", WorktreePath: "/tmp/repo-auth-fix", Status: constants.WorkspaceStatusActive}
+		tasks := []*domain.Task{
+			{ID: "task-20260101-000000", TemplateID: "bugfix", Status: constants.TaskStatusInterrupted},
+			{ID: "task-20260101-000001", TemplateID: "bugfix", Status: constants.TaskStatusGHFailed},
+		}
+		resume := func(_ context.Context, w io.Writer, out tui.Output, tk *domain.Task) error {
+			if tk.ID == "task-20260101-000001" {
+				return handleResumeError(OutputJSON, w, ws.Name, tk.ID, atlaserrors.ErrInvalidRecoveryAction)
+			}
+			tk.Status = constants.TaskStatusAwaitingApproval
+			return outputResumeSuccessJSON(out, ws, tk)
+		}
+
+		var buf bytes.Buffer
+		err := resumeTasks(context.Background(), &buf, tui.NewOutput(&buf, OutputJSON), OutputJSON, ws.Name, tasks, resume)
+		require.ErrorIs(t, err, atlaserrors.ErrJSONErrorOutput)
+
+		schema := loadSchema(t, "resume-all")
+		require.NoError(t, schema.Validate(buf.Bytes()))
+		require.ErrorIs(t, loadSchema(t, "resume").Validate(buf.Bytes()), atlaserrors.ErrSchemaViolation)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, outputHierarchicalJSON(&buf, []tui.WorkspaceGroup{{
+			Name:       "auth-fix",
+			Branch:     "fix/auth-fix",
+			Status:     constants.TaskStatusValidationFailed,
+			TotalTasks: 1,
+			Tasks: []tui.TaskInfo{{
+				ID:          "task-20260101-000000",
+				Template:    "bugfix",
+				Status:      constants.TaskStatusValidationFailed,
+				CurrentStep: 2,
+				TotalSteps:  5,
+				UpdatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			}},
+		}}))
+		require.NoError(t, loadSchema(t, "status").Validate(buf.Bytes()))
+	})
+}
+
+// TestRunSchema_RejectsMalformedResponse tests that a response with a wrong
+// field type, a missing field, and an unknown field fails validation.
+func TestRunSchema_RejectsMalformedResponse(t *testing.T) {
+	t.Parallel()
+
+	malformed := []byte(`{
+  "success": "yes",
+  "workspace": {"name": "auth-fix", "branch": "fix/auth-fix", "worktree_path": "/tmp/x", "status": "active"},
+  "task": {"task_id": "t", "template_name": "bugfix", "description": "d", "status": "running", "current_step": "2"},
+  "surprise": 1
+}`)
+
+	err := loadSchema(t, "start").Validate(malformed)
+	require.ErrorIs(t, err, atlaserrors.ErrSchemaViolation)
+	assert.Contains(t, err.Error(), "$.success: expected boolean, got string")
+	assert.Contains(t, err.Error(), "$.task.current_step: expected integer, got string")
+	assert.Contains(t, err.Error(), `$.task: missing required property "total_steps"`)
+	assert.Contains(t, err.Error(), "$.surprise: unexpected property")
+}
+
+// TestRunSchema_UnknownName tests that an unknown schema lists the available ones.
+func TestRunSchema_UnknownName(t *testing.T) {
+	t.Parallel()
+
+	err := runSchema(&bytes.Buffer{}, "approve")
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "available: resume, resume-all, start, status")
+}
@@ -4,10 +4,13 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -48,8 +51,10 @@ type startOptions struct {
 	verify        bool
 	noVerify      bool
 	dryRun        bool
-	fromBacklogID string // Discovery ID to link and promote after task creation
-	fromPRNumber  int    // GitHub PR number to resolve to head branch (mutually exclusive with baseBranch/targetBranch)
+	fromBacklogID string        // Discovery ID to link and promote after task creation
+	fromPRNumber  int           // GitHub PR number to resolve to head branch (mutually exclusive with baseBranch/targetBranch)
+	timeout       time.Duration // Wall-clock cap for the whole run; zero means no limit
+	priority      int           // Resume order among the workspace's tasks; higher first
 }
 
 // newStartCmd creates the start command.
@@ -68,6 +73,8 @@ func newStartCmd() *cobra.Command {
 		dryRun        bool
 		fromBacklogID string
 		fromPRNumber  int
+		timeout       time.Duration
+		priority      int
 	)
 
 	cmd := &cobra.Command{
@@ -85,7 +92,9 @@ Examples:
   atlas start "fix from develop" --template bug --branch develop
   atlas start "review changes" --template bug --dry-run
   atlas start "fix lint errors" --template patch --target feat/my-feature
-  atlas start "fix CI failures" --template patch --from-pr 123`,
+  atlas start "fix CI failures" --template patch --from-pr 123
+  atlas start "fix flaky test" --template bug --timeout 30m
+  atlas start "fix prod outage" --template bug --priority 10`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runStart(cmd.Context(), cmd, cmd.OutOrStdout(), args[0], startOptions{
@@ -102,6 +111,8 @@ Examples:
 				dryRun:        dryRun,
 				fromBacklogID: fromBacklogID,
 				fromPRNumber:  fromPRNumber,
+				timeout:       timeout,
+				priority:      priority,
 			})
 		},
 	}
@@ -111,9 +122,9 @@ Examples:
 	cmd.Flags().StringVarP(&workspaceName, "workspace", "w", "",
 		"Custom workspace name")
 	cmd.Flags().StringVarP(&agent, "agent", "a", "",
-		"AI agent/CLI to use (claude, gemini, codex)")
+		"AI agent/CLI to use (claude, gemini, codex, mock)")
 	cmd.Flags().StringVarP(&model, "model", "m", "",
-		"AI model to use (claude: sonnet, opus, haiku; gemini: flash, pro; codex: codex, max, mini)")
+		"AI model to use (claude: sonnet, opus, haiku; gemini: flash, pro; codex: codex, max, mini; mock: echo)")
 	cmd.Flags().StringVarP(&baseBranch, "branch", "b", "",
 		"Base branch to create workspace from (fetches from remote by default)")
 	cmd.Flags().StringVar(&targetBranch, "target", "",
@@ -132,6 +143,10 @@ Examples:
 		"Link this task to a backlog discovery (auto-promotes the discovery)")
 	cmd.Flags().IntVar(&fromPRNumber, "from-pr", 0,
 		"GitHub PR number to checkout and fix (resolves head branch, mutually exclusive with --branch and --target)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0,
+		"Maximum wall-clock time for the run (e.g. 30m); on expiry the task is saved as interrupted")
+	cmd.Flags().IntVar(&priority, "priority", 0,
+		"Task priority; resume --all visits higher-priority tasks first")
 
 	return cmd
 }
@@ -220,7 +235,13 @@ func runStart(ctx context.Context, cmd *cobra.Command, w io.Writer, description
 	defer sigHandler.Stop()
 	ctx = sigHandler.Context()
 
-	logger := Logger()
+	// Apply --timeout; expiry is handled like an interrupt
+	ctx, cancelTimeout := withRunTimeout(ctx, opts.timeout)
+	defer cancelTimeout()
+
+	// Apply config edits, such as logging.level, while the task runs
+	logger, stopConfigWatch := watchConfig(ctx, Logger())
+	defer stopConfigWatch()
 	outputFormat := cmd.Flag("output").Value.String()
 
 	// Respect NO_COLOR environment variable
@@ -265,11 +286,12 @@ func runStart(ctx context.Context, cmd *cobra.Command, w io.Writer, description
 
 	// Handle dry-run mode early
 	if opts.dryRun {
+		workflow.ApplyAgentModelOverrides(tmpl, opts.agent, opts.model)
 		return runDryRun(ctx, sc, tmpl, description, wsName, cfg, logger) //nolint:contextcheck // context is properly checked and used
 	}
 
 	// Create workspace and execute task
-	return executeTask(ctx, sc, sigHandler, orchestrator, repoPath, outputFormat, tmpl, description, wsName, opts, logger, out) //nolint:contextcheck // context is properly checked and used
+	return executeTask(ctx, sc, sigHandler, orchestrator, cfg, repoPath, outputFormat, tmpl, description, wsName, opts, logger, out) //nolint:contextcheck // context is properly checked and used
 }
 
 // validateStartOptions validates all CLI option flags.
@@ -305,6 +327,10 @@ func validateStartFlags(opts startOptions) error {
 		return atlaserrors.NewExitCode2Error(
 			fmt.Errorf("%w: --from-pr must be a positive integer", atlaserrors.ErrInvalidArgument))
 	}
+	if opts.timeout < 0 {
+		return atlaserrors.NewExitCode2Error(
+			fmt.Errorf("%w: --timeout must not be negative", atlaserrors.ErrInvalidArgument))
+	}
 	return nil
 }
 
@@ -370,7 +396,8 @@ func setupConfigAndTemplate(ctx context.Context, sc *startContext, logger zerolo
 		return nil, nil, "", sc.handleError("", fmt.Errorf("failed to load templates: %w", err))
 	}
 
-	// Select template
+	// Select template, falling back to the configured default in non-interactive mode
+	orchestrator.Prompter().SetDefaultTemplate(cfg.Templates.DefaultTemplate)
 	tmpl, err := orchestrator.Prompter().SelectTemplate(ctx, registry, opts.templateName, opts.noInteractive, sc.outputFormat)
 	if err != nil {
 		return nil, nil, "", sc.handleError("", err)
@@ -396,7 +423,7 @@ func setupConfigAndTemplate(ctx context.Context, sc *startContext, logger zerolo
 }
 
 // executeTask creates workspace and executes the task.
-func executeTask(ctx context.Context, sc *startContext, sigHandler *signal.Handler, orchestrator *workflow.Orchestrator, repoPath, outputFormat string, tmpl *domain.Template, description, wsName string, opts startOptions, logger zerolog.Logger, out tui.Output) error {
+func executeTask(ctx context.Context, sc *startContext, sigHandler *signal.Handler, orchestrator *workflow.Orchestrator, cfg *config.Config, repoPath, outputFormat string, tmpl *domain.Template, description, wsName string, opts startOptions, logger zerolog.Logger, out tui.Output) error {
 	// Create and configure workspace
 	ws, err := orchestrator.Initializer().CreateWorkspace(ctx, workflow.WorkspaceOptions{
 		Name:          wsName,
@@ -408,6 +435,8 @@ func executeTask(ctx context.Context, sc *startContext, sigHandler *signal.Handl
 		NoInteractive: opts.noInteractive,
 		OutputFormat:  outputFormat,
 		ErrorHandler:  sc.handleError,
+
+		WorktreePathTemplate: cfg.Worktree.PathTemplate,
 	})
 	if err != nil {
 		return fmt.Errorf("create workspace: %w", err)
@@ -420,16 +449,15 @@ func executeTask(ctx context.Context, sc *startContext, sigHandler *signal.Handl
 		Msg("workspace created")
 
 	// Start task execution
-	t, taskStore, state, err := startTaskExecution(ctx, ws, tmpl, description, opts.agent, opts.model, opts.fromBacklogID, logger, out)
+	t, taskStore, state, err := startTaskExecution(ctx, ws, tmpl, description, opts.agent, opts.model, opts.fromBacklogID, logger, out,
+		task.WithBaseBranch(opts.baseBranch), task.WithTargetBranch(opts.targetBranch), task.WithPriority(opts.priority))
 
 	// Store CLI overrides in task metadata for resume (if task was created)
 	storeCLIOverridesIfNeeded(ctx, t, taskStore, ws.Name, &opts, logger)
 
-	// Check if we were interrupted by Ctrl+C
-	select {
-	case <-sigHandler.Interrupted():
+	// Check if we were interrupted by Ctrl+C or --timeout
+	if wasInterrupted(ctx, sigHandler) {
 		return handleInterruption(ctx, sc, ws, t, state, logger, out)
-	default:
 	}
 
 	if err != nil {
@@ -508,7 +536,7 @@ func (sc *startContext) handleTaskStartError(ctx context.Context, ws *domain.Wor
 			Str("task_status", string(t.Status)).
 			Msg("preserving workspace for resume (task exists)")
 
-		sc.updateWorkspaceStatusToPaused(ctx, ws, logger)
+		sc.updateWorkspaceStatusToPaused(ctx, ws, t, logger)
 		return
 	}
 
@@ -525,7 +553,8 @@ func (sc *startContext) handleTaskStartError(ctx context.Context, ws *domain.Wor
 }
 
 // updateWorkspaceStatusToPaused updates the workspace status to paused to preserve it for resume.
-func (sc *startContext) updateWorkspaceStatusToPaused(ctx context.Context, ws *domain.Workspace, logger zerolog.Logger) {
+// When t is non-nil the task is saved together with the workspace so the two records stay in step.
+func (sc *startContext) updateWorkspaceStatusToPaused(ctx context.Context, ws *domain.Workspace, t *domain.Task, logger zerolog.Logger) {
 	ws.Status = constants.WorkspaceStatusPaused
 	wsStore, err := workspace.NewRepoScopedFileStore(ws.RepoPath)
 	if err != nil {
@@ -535,7 +564,7 @@ func (sc *startContext) updateWorkspaceStatusToPaused(ctx context.Context, ws *d
 		return
 	}
 
-	updateErr := wsStore.Update(ctx, ws)
+	updateErr := savePausedWorkspace(ctx, wsStore, ws, t)
 	if updateErr != nil {
 		logger.Error().Err(updateErr).
 			Str("workspace_name", ws.Name).
@@ -559,6 +588,24 @@ func (sc *startContext) updateWorkspaceStatusToPaused(ctx context.Context, ws *d
 	}
 }
 
+// savePausedWorkspace saves ws, together with t when the task has been persisted.
+func savePausedWorkspace(ctx context.Context, wsStore workspace.Store, ws *domain.Workspace, t *domain.Task) error {
+	if t == nil {
+		return wsStore.Update(ctx, ws)
+	}
+
+	taskStore, err := task.NewRepoScopedFileStore(ws.RepoPath)
+	if err != nil {
+		return err
+	}
+	err = taskStore.WithWorkspaceStore(wsStore).UpdateWithWorkspace(ctx, ws.Name, t, ws)
+	if errors.Is(err, atlaserrors.ErrTaskNotFound) {
+		// The task never reached the store, so only the workspace needs saving
+		return wsStore.Update(ctx, ws)
+	}
+	return err
+}
+
 // storeCLIOverridesIfNeeded stores CLI overrides and backlog metadata in the task if present.
 func storeCLIOverridesIfNeeded(ctx context.Context, t *domain.Task, taskStore *task.FileStore, workspaceName string, opts *startOptions, logger zerolog.Logger) {
 	if t == nil || taskStore == nil {
@@ -606,15 +653,52 @@ func terminateAIProcess(state *progressState, logger zerolog.Logger) {
 	}
 }
 
-// handleInterruption handles graceful shutdown when user presses Ctrl+C.
-// It saves the task and workspace state so the user can resume later.
+// withRunTimeout derives a context that expires after timeout with
+// ErrRunTimeout as its cause. A zero timeout returns ctx unchanged.
+func withRunTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeoutCause(ctx, timeout, atlaserrors.ErrRunTimeout)
+}
+
+// runTimedOut reports whether ctx expired because of --timeout.
+func runTimedOut(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), atlaserrors.ErrRunTimeout)
+}
+
+// wasInterrupted reports whether the run was stopped by Ctrl+C or --timeout.
+func wasInterrupted(ctx context.Context, sigHandler *signal.Handler) bool {
+	select {
+	case <-sigHandler.Interrupted():
+		return true
+	default:
+		return runTimedOut(ctx)
+	}
+}
+
+// interruptNotice returns the warning shown when the run stops early, the reason
+// recorded on the task's transition, and the error returned to the caller.
+func interruptNotice(ctx context.Context) (string, string, error) {
+	if runTimedOut(ctx) {
+		return "\n⚠ Timeout reached - saving state...", "run exceeded --timeout", atlaserrors.ErrRunTimeout
+	}
+	return "\n⚠ Interrupt received - saving state...", "user pressed Ctrl+C", atlaserrors.ErrTaskInterrupted
+}
+
+// handleInterruption handles graceful shutdown when user presses Ctrl+C or
+// the run hits --timeout. It saves the task and workspace state so the user
+// can resume later.
 func handleInterruption(ctx context.Context, sc *startContext, ws *domain.Workspace, t *domain.Task, state *progressState, logger zerolog.Logger, out tui.Output) error {
+	notice, reason, interruptErr := interruptNotice(ctx)
+
 	logger.Info().
 		Str("workspace_name", ws.Name).
 		Str("task_id", safeTaskID(t)).
+		Str("reason", reason).
 		Msg("received interrupt signal, initiating graceful shutdown")
 
-	out.Warning("\n⚠ Interrupt received - saving state...")
+	out.Warning(notice)
 
 	// Terminate any running AI process first to prevent orphaned processes
 	terminateAIProcess(state, logger)
@@ -622,21 +706,35 @@ func handleInterruption(ctx context.Context, sc *startContext, ws *domain.Worksp
 	// Use a context without cancellation for cleanup since the original is canceled
 	cleanupCtx := context.WithoutCancel(ctx)
 
-	// Save interrupted task state
+	// Save interrupted task state together with the paused workspace
 	if t != nil {
-		saveInterruptedTaskState(cleanupCtx, ws, t, logger)
+		ws.Status = constants.WorkspaceStatusPaused
+		saveInterruptedTaskState(cleanupCtx, ws, t, reason, pauseWorkspaceStore(ws, logger), logger)
+	} else {
+		sc.updateWorkspaceStatusToPaused(cleanupCtx, ws, nil, logger)
 	}
 
-	// Update workspace to paused
-	sc.updateWorkspaceStatusToPaused(cleanupCtx, ws, logger)
-
 	// Display summary
 	displayInterruptionSummary(out, ws, t)
 
-	return atlaserrors.ErrTaskInterrupted
+	return interruptErr
 }
 
-// saveInterruptedTaskState saves the task state when interrupted by Ctrl+C.
+// pauseWorkspaceStore opens the workspace store used to save a paused
+// workspace alongside its task. It returns nil if the store cannot be
+// opened, in which case only the task is saved.
+func pauseWorkspaceStore(ws *domain.Workspace, logger zerolog.Logger) workspace.Store {
+	wsStore, err := workspace.NewRepoScopedFileStore(ws.RepoPath)
+	if err != nil {
+		logger.Error().Err(err).
+			Str("workspace_name", ws.Name).
+			Msg("CRITICAL: failed to create workspace store for pause update - workspace may not be resumable")
+		return nil
+	}
+	return wsStore
+}
+
+// handleHookInterrupt transitions hook state to awaiting_human on interrupt.
 func handleHookInterrupt(ctx context.Context, hookManager task.HookManager, t *domain.Task, logger zerolog.Logger) {
 	// Determine current step name
 	stepName := ""
@@ -663,7 +761,8 @@ func handleHookInterrupt(ctx context.Context, hookManager task.HookManager, t *d
 	}
 }
 
-func saveInterruptedTaskState(ctx context.Context, ws *domain.Workspace, t *domain.Task, logger zerolog.Logger) {
+// saveInterruptedTaskState saves the task state when interrupted by Ctrl+C.
+func saveInterruptedTaskState(ctx context.Context, ws *domain.Workspace, t *domain.Task, reason string, wsStore workspace.Store, logger zerolog.Logger) {
 	// Transition hook state to awaiting_human before task state transition.
 	// This ensures resume can properly transition hook from awaiting_human → step_running.
 	cfg, cfgErr := config.Load(ctx)
@@ -678,7 +777,7 @@ func saveInterruptedTaskState(ctx context.Context, ws *domain.Workspace, t *doma
 
 	// Transition task to interrupted status if it's running or validating
 	if t.Status == constants.TaskStatusRunning || t.Status == constants.TaskStatusValidating {
-		if err := task.Transition(ctx, t, constants.TaskStatusInterrupted, "user pressed Ctrl+C"); err != nil {
+		if err := task.Transition(ctx, t, constants.TaskStatusInterrupted, reason); err != nil {
 			logger.Error().Err(err).
 				Str("task_id", t.ID).
 				Str("from_status", string(t.Status)).
@@ -693,7 +792,14 @@ func saveInterruptedTaskState(ctx context.Context, ws *domain.Workspace, t *doma
 		return
 	}
 
-	if saveErr := taskStore.Update(ctx, ws.Name, t); saveErr != nil {
+	// With a workspace store the workspace record is saved together with the task
+	var saveErr error
+	if wsStore != nil {
+		saveErr = taskStore.WithWorkspaceStore(wsStore).UpdateWithWorkspace(ctx, ws.Name, t, ws)
+	} else {
+		saveErr = taskStore.Update(ctx, ws.Name, t)
+	}
+	if saveErr != nil {
 		logger.Error().Err(saveErr).
 			Str("task_id", t.ID).
 			Str("workspace_name", ws.Name).
@@ -739,7 +845,7 @@ func safeTaskID(t *domain.Task) string {
 // startTaskExecution creates and starts the task engine.
 // Returns the task, task store (for subsequent updates), progress state, and any error.
 // The progress state contains the AI runner for process termination on interrupt.
-func startTaskExecution(ctx context.Context, ws *domain.Workspace, tmpl *domain.Template, description, agent, model, fromBacklogID string, logger zerolog.Logger, out tui.Output) (*domain.Task, *task.FileStore, *progressState, error) {
+func startTaskExecution(ctx context.Context, ws *domain.Workspace, tmpl *domain.Template, description, agent, model, fromBacklogID string, logger zerolog.Logger, out tui.Output, startOpts ...task.StartOption) (*domain.Task, *task.FileStore, *progressState, error) {
 	// Create service factory (repo-scoped)
 	services := workflow.NewServiceFactory(logger).WithRepoPath(ws.RepoPath)
 
@@ -791,6 +897,7 @@ func startTaskExecution(ctx context.Context, ws *domain.Workspace, tmpl *domain.
 
 	// Create git stats provider for live status display
 	state.gitStatsProvider = git.NewStatsProvider(ws.WorktreePath)
+	state.checklist = newStepChecklist(tmpl.Steps)
 
 	// Create progress callback for both engine and executors (uses shared state)
 	progressCallback := createProgressCallback(ctx, out, ws.Name, state)
@@ -855,8 +962,9 @@ func startTaskExecution(ctx context.Context, ws *domain.Workspace, tmpl *domain.
 	// Apply agent and model overrides to template
 	workflow.ApplyAgentModelOverrides(tmpl, agent, model)
 
-	// Start task
-	t, err := startTask(ctx, engine, ws, tmpl, description, fromBacklogID, logger)
+	// Start task, recording the configured base branch unless --branch overrides it
+	startOpts = append([]task.StartOption{task.WithBaseBranch(cfg.Git.BaseBranch)}, startOpts...)
+	t, err := startTask(ctx, engine, ws, tmpl, description, fromBacklogID, logger, startOpts...)
 	return t, taskStore, state, err
 }
 
@@ -868,6 +976,7 @@ type progressState struct {
 	gitStatsProvider *git.StatsProvider // Provider for live git stats display
 	aiRunner         ai.Runner          // AI runner for process termination on interrupt
 	showGitStats     bool               // Only true during AI implementation steps (steps with Agent set)
+	checklist        []domain.Step      // Step statuses for the live checklist; empty disables it
 }
 
 // createProgressCallback creates the progress callback for UI feedback.
@@ -905,6 +1014,8 @@ func handleProgressStart(ctx context.Context, out tui.Output, event task.StepPro
 		*logPathShown = true
 	}
 
+	updateStepChecklist(out, event.StepName, constants.StepStatusRunning, state)
+
 	msg := buildStepStartMessage(event)
 
 	// Store base message for activity updates
@@ -935,6 +1046,12 @@ func handleProgressComplete(out tui.Output, event task.StepProgressEvent, state
 	state.baseMessage = ""
 	state.showGitStats = false
 
+	status := event.Status
+	if status == "" {
+		status = constants.StepStatusSuccess
+	}
+	updateStepChecklist(out, event.StepName, status, state)
+
 	// Check if step is awaiting approval vs completed
 	if event.Status == constants.StepStatusAwaitingApproval {
 		statusMsg := fmt.Sprintf("Step %d/%d: %s requires approval",
@@ -965,6 +1082,25 @@ func handleProgressComplete(out tui.Output, event task.StepProgressEvent, state
 	displayPRURL(out, event.Output)
 }
 
+// newStepChecklist returns a pending checklist entry for each template step.
+func newStepChecklist(defs []domain.StepDefinition) []domain.Step {
+	checklist := make([]domain.Step, len(defs))
+	for i, def := range defs {
+		checklist[i] = domain.Step{Name: def.Name, Type: def.Type, Status: constants.StepStatusPending}
+	}
+	return checklist
+}
+
+// updateStepChecklist records the status of the named step and redraws the checklist.
+func updateStepChecklist(out tui.Output, stepName, status string, state *progressState) {
+	i := slices.IndexFunc(state.checklist, func(s domain.Step) bool { return s.Name == stepName })
+	if i < 0 {
+		return
+	}
+	state.checklist[i].Status = status
+	out.RenderChecklist(state.checklist)
+}
+
 // displayPRURL displays PR URLs from the output if present.
 func displayPRURL(out tui.Output, output string) {
 	if output != "" && strings.Contains(output, "Created PR #") {
@@ -1124,12 +1260,24 @@ func buildAutoFixStartMessage(event task.StepProgressEvent) string {
 	return "Auto-fixing verification issues..."
 }
 
+// waitForWebhooks waits for the engine's webhook deliveries. ctx is usually
+// already canceled by --timeout or Ctrl+C when the run ends, so the wait is
+// detached from it and bounded by task.WebhookDeadline instead.
+func waitForWebhooks(ctx context.Context, engine *task.Engine) {
+	waitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), task.WebhookDeadline)
+	defer cancel()
+	engine.WaitForWebhooks(waitCtx)
+}
+
 // startTask starts the task execution and handles errors.
-func startTask(ctx context.Context, engine *task.Engine, ws *domain.Workspace, tmpl *domain.Template, description, fromBacklogID string, logger zerolog.Logger) (*domain.Task, error) {
+func startTask(ctx context.Context, engine *task.Engine, ws *domain.Workspace, tmpl *domain.Template, description, fromBacklogID string, logger zerolog.Logger, opts ...task.StartOption) (*domain.Task, error) {
 	// Enrich description with backlog discovery metadata
 	enrichedDescription := enrichDescriptionFromBacklog(ctx, description, fromBacklogID, logger)
 
-	t, err := engine.Start(ctx, ws.Name, ws.Branch, ws.WorktreePath, tmpl, enrichedDescription, fromBacklogID)
+	// Let webhook deliveries finish before the command exits
+	defer waitForWebhooks(ctx, engine)
+
+	t, err := engine.Start(ctx, ws.Name, ws.Branch, ws.WorktreePath, tmpl, enrichedDescription, fromBacklogID, opts...)
 	if err != nil {
 		logger.Error().Err(err).
 			Str("workspace_name", ws.Name).
@@ -1261,7 +1409,7 @@ func validateAgent(agent string) error {
 	}
 	if !isValidAgent(agent) {
 		return atlaserrors.NewExitCode2Error(
-			fmt.Errorf("%w: '%s' (must be one of claude, gemini, codex)", atlaserrors.ErrAgentNotFound, agent))
+			fmt.Errorf("%w: '%s' (must be one of claude, gemini, codex, mock)", atlaserrors.ErrAgentNotFound, agent))
 	}
 	return nil
 }
@@ -1364,7 +1512,7 @@ func outputStartErrorJSON(w io.Writer, workspaceName, taskID, errMsg string) err
 		Task: taskInfo{
 			ID: taskID,
 		},
-		Error: errMsg,
+		Error: atlaserrors.RedactString(errMsg),
 	})
 }
 
@@ -1372,6 +1520,8 @@ func outputStartErrorJSON(w io.Writer, workspaceName, taskID, errMsg string) err
 type dryRunResponse struct {
 	DryRun    bool                `json:"dry_run"`
 	Template  string              `json:"template"`
+	Agent     string              `json:"agent,omitempty"`
+	Model     string              `json:"model,omitempty"`
 	Workspace dryRunWorkspaceInfo `json:"workspace"`
 	Steps     []dryRunStepInfo    `json:"steps"`
 	Summary   dryRunSummary       `json:"summary"`
@@ -1393,6 +1543,7 @@ type dryRunStepInfo struct {
 	Required    bool           `json:"required"`
 	Status      string         `json:"status"`
 	WouldDo     []string       `json:"would_do"`
+	SideEffect  string         `json:"side_effect,omitempty"`
 	Config      map[string]any `json:"config,omitempty"`
 }
 
@@ -1506,6 +1657,7 @@ func runDryRun(ctx context.Context, sc *startContext, tmpl *domain.Template, des
 			}
 		}
 
+		sideEffect := getSideEffectForStepType(step)
 		stepPlans = append(stepPlans, dryRunStepInfo{
 			Index:       i,
 			Name:        step.Name,
@@ -1514,11 +1666,12 @@ func runDryRun(ctx context.Context, sc *startContext, tmpl *domain.Template, des
 			Required:    step.Required,
 			Status:      "would_execute",
 			WouldDo:     wouldDo,
+			SideEffect:  sideEffect,
 			Config:      stepConfig,
 		})
 
 		// Track side effects that would occur
-		if sideEffect := getSideEffectForStepType(step); sideEffect != "" {
+		if sideEffect != "" {
 			sideEffects = append(sideEffects, sideEffect)
 		}
 	}
@@ -1526,19 +1679,34 @@ func runDryRun(ctx context.Context, sc *startContext, tmpl *domain.Template, des
 	// Add workspace creation to side effects
 	sideEffects = append([]string{"Workspace creation (git worktree)"}, sideEffects...)
 
+	agent, model := resolveDryRunAgentModel(tmpl)
+
 	// Output results
 	if sc.outputFormat == OutputJSON {
-		return outputDryRunJSON(sc.w, tmpl.Name, wsName, simulatedBranch, stepPlans, sideEffects)
+		return outputDryRunJSON(sc.w, tmpl.Name, agent, model, wsName, simulatedBranch, stepPlans, sideEffects)
 	}
 
-	return outputDryRunTTY(sc.out, tmpl, wsName, simulatedBranch, stepPlans, sideEffects)
+	return outputDryRunTTY(sc.out, tmpl, agent, model, wsName, simulatedBranch, stepPlans, sideEffects)
+}
+
+// resolveDryRunAgentModel returns the agent and model the task would run
+// with: the template defaults after --agent and --model overrides, with the
+// agent's default model when the template names none.
+func resolveDryRunAgentModel(tmpl *domain.Template) (string, string) {
+	model := tmpl.DefaultModel
+	if model == "" && tmpl.DefaultAgent != "" {
+		model = tmpl.DefaultAgent.DefaultModel()
+	}
+	return string(tmpl.DefaultAgent), model
 }
 
 // outputDryRunJSON outputs the dry-run results as JSON.
-func outputDryRunJSON(w io.Writer, templateName, wsName, branch string, stepPlans []dryRunStepInfo, sideEffects []string) error {
+func outputDryRunJSON(w io.Writer, templateName, agent, model, wsName, branch string, stepPlans []dryRunStepInfo, sideEffects []string) error {
 	resp := dryRunResponse{
 		DryRun:   true,
 		Template: templateName,
+		Agent:    agent,
+		Model:    model,
 		Workspace: dryRunWorkspaceInfo{
 			Name:        wsName,
 			Branch:      branch,
@@ -1557,7 +1725,7 @@ func outputDryRunJSON(w io.Writer, templateName, wsName, branch string, stepPlan
 }
 
 // outputDryRunTTY outputs the dry-run results for terminal display.
-func outputDryRunTTY(out tui.Output, tmpl *domain.Template, wsName, branch string, stepPlans []dryRunStepInfo, sideEffects []string) error {
+func outputDryRunTTY(out tui.Output, tmpl *domain.Template, agent, model, wsName, branch string, stepPlans []dryRunStepInfo, sideEffects []string) error {
 	// Header
 	out.Info("=== DRY-RUN MODE ===")
 	out.Info("Showing what would happen without making changes.\n")
@@ -1587,12 +1755,19 @@ func outputDryRunTTY(out tui.Output, tmpl *domain.Template, wsName, branch strin
 			}
 		}
 
+		if step.SideEffect != "" {
+			out.Info(fmt.Sprintf("      Side effect: %s", step.SideEffect))
+		}
+
 		out.Info(fmt.Sprintf("      Status: %s\n", step.Status))
 	}
 
 	// Summary
 	out.Info("=== Summary ===")
 	out.Info(fmt.Sprintf("Template: %s", tmpl.Name))
+	if agent != "" {
+		out.Info(fmt.Sprintf("Agent: %s (model: %s)", agent, model))
+	}
 	out.Info(fmt.Sprintf("Steps: %d total", len(stepPlans)))
 	out.Info("Side Effects Prevented:")
 	for _, effect := range sideEffects {
@@ -12,6 +12,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/template"
 )
 
 // initGitRepo creates a temporary git repository for testing
@@ -445,3 +449,56 @@ func TestDryRun_ContextCancellation(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "context canceled")
 }
+
+func TestDryRun_PlannedStepsMatchTemplate(t *testing.T) {
+	repoDir := initGitRepo(t)
+	stateDir := t.TempDir()
+	t.Setenv(constants.AtlasHomeEnvVar, stateDir)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repoDir))
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	var buf bytes.Buffer
+	cmd := newStartCmd()
+	cmd.SetOut(&buf)
+	cmd.SetArgs([]string{"implement feature", "--template", "feature", "--model", "opus", "--dry-run"})
+	cmd.PersistentFlags().String("output", "json", "")
+
+	err = cmd.ExecuteContext(context.Background())
+	require.NoError(t, err)
+
+	var response dryRunResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &response))
+
+	// The planned steps are the template's steps, in order
+	tmpl, err := template.NewDefaultRegistry().Get("feature")
+	require.NoError(t, err)
+	require.Len(t, response.Steps, len(tmpl.Steps))
+	for i, step := range tmpl.Steps {
+		assert.Equal(t, i, response.Steps[i].Index)
+		assert.Equal(t, step.Name, response.Steps[i].Name)
+		assert.Equal(t, string(step.Type), response.Steps[i].Type)
+		assert.Equal(t, getSideEffectForStepType(step), response.Steps[i].SideEffect)
+	}
+
+	// The agent and model reflect the template default and the --model override
+	assert.Equal(t, string(tmpl.DefaultAgent), response.Agent)
+	assert.Equal(t, "opus", response.Model)
+
+	// Nothing was written to the workspace or task store
+	entries, err := os.ReadDir(stateDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "dry-run should not create workspace state")
+}
+
+func TestResolveDryRunAgentModel(t *testing.T) {
+	agent, model := resolveDryRunAgentModel(&domain.Template{DefaultAgent: domain.AgentClaude})
+	assert.Equal(t, "claude", agent)
+	assert.Equal(t, domain.AgentClaude.DefaultModel(), model)
+
+	agent, model = resolveDryRunAgentModel(&domain.Template{DefaultAgent: domain.AgentGemini, DefaultModel: "pro"})
+	assert.Equal(t, "gemini", agent)
+	assert.Equal(t, "pro", model)
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,6 +28,7 @@ import (
 	"github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/task"
 	"github.com/mrz1836/atlas/internal/template"
+	"github.com/mrz1836/atlas/internal/template/steps"
 	"github.com/mrz1836/atlas/internal/tui"
 	"github.com/mrz1836/atlas/internal/workspace"
 )
@@ -593,6 +597,9 @@ func TestValidateModelWithAgent(t *testing.T) {
 		{"gemini flash", "gemini", "flash", false},
 		{"gemini pro", "gemini", "pro", false},
 		{"gemini invalid model", "gemini", "sonnet", true},
+		// Mock agent
+		{"mock echo", "mock", "echo", false},
+		{"mock invalid model", "mock", "sonnet", true},
 		// Empty agent (checks all)
 		{"empty agent valid claude model", "", "sonnet", false},
 		{"empty agent valid gemini model", "", "flash", false},
@@ -2882,13 +2889,56 @@ func TestUpdateWorkspaceStatusToPaused(t *testing.T) {
 		}
 
 		sc := &startContext{outputFormat: "text"}
-		sc.updateWorkspaceStatusToPaused(context.Background(), ws, zerolog.Nop())
+		sc.updateWorkspaceStatusToPaused(context.Background(), ws, nil, zerolog.Nop())
 
 		// Verify the in-memory status was updated
 		assert.Equal(t, constants.WorkspaceStatusPaused, ws.Status)
 	})
 }
 
+// TestSavePausedWorkspace tests the paused workspace is saved together with its task.
+func TestSavePausedWorkspace(t *testing.T) {
+	t.Setenv(constants.AtlasHomeEnvVar, t.TempDir())
+	repoPath := t.TempDir()
+	ctx := context.Background()
+
+	wsStore, err := workspace.NewRepoScopedFileStore(repoPath)
+	require.NoError(t, err)
+	taskStore, err := task.NewRepoScopedFileStore(repoPath)
+	require.NoError(t, err)
+
+	t.Run("saves task and workspace together", func(t *testing.T) {
+		ws := &domain.Workspace{Name: "paused-ws", RepoPath: repoPath, Status: constants.WorkspaceStatusActive}
+		require.NoError(t, wsStore.Create(ctx, ws))
+		tk := &domain.Task{ID: "task-00000000-0000-4000-8000-000000000f01", WorkspaceID: ws.Name, Status: constants.TaskStatusRunning}
+		require.NoError(t, taskStore.Create(ctx, ws.Name, tk))
+
+		ws.Status = constants.WorkspaceStatusPaused
+		tk.Status = constants.TaskStatusValidationFailed
+		require.NoError(t, savePausedWorkspace(ctx, wsStore, ws, tk))
+
+		savedWs, err := wsStore.Get(ctx, ws.Name)
+		require.NoError(t, err)
+		assert.Equal(t, constants.WorkspaceStatusPaused, savedWs.Status)
+		savedTask, err := taskStore.Get(ctx, ws.Name, tk.ID)
+		require.NoError(t, err)
+		assert.Equal(t, constants.TaskStatusValidationFailed, savedTask.Status)
+	})
+
+	t.Run("saves only the workspace when the task was never stored", func(t *testing.T) {
+		ws := &domain.Workspace{Name: "unstored-ws", RepoPath: repoPath, Status: constants.WorkspaceStatusActive}
+		require.NoError(t, wsStore.Create(ctx, ws))
+		tk := &domain.Task{ID: "task-00000000-0000-4000-8000-000000000f02", WorkspaceID: ws.Name}
+
+		ws.Status = constants.WorkspaceStatusPaused
+		require.NoError(t, savePausedWorkspace(ctx, wsStore, ws, tk))
+
+		savedWs, err := wsStore.Get(ctx, ws.Name)
+		require.NoError(t, err)
+		assert.Equal(t, constants.WorkspaceStatusPaused, savedWs.Status)
+	})
+}
+
 // TestGetGitStatsString tests the getGitStatsString helper
 func TestGetGitStatsString(t *testing.T) {
 	t.Run("nil provider returns empty string", func(t *testing.T) {
@@ -2896,3 +2946,151 @@ func TestGetGitStatsString(t *testing.T) {
 		assert.Empty(t, result)
 	})
 }
+
+// slowExecutor blocks until its context is done, simulating a long-running step.
+type slowExecutor struct{}
+
+func (slowExecutor) Type() domain.StepType { return domain.StepTypeAI }
+
+func (slowExecutor) Execute(ctx context.Context, _ *domain.Task, _ *domain.StepDefinition) (*domain.StepResult, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// TestRunTimeout_InterruptsTask tests that a run exceeding --timeout saves the
+// task as interrupted and exits with the timeout exit code.
+func TestRunTimeout_InterruptsTask(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv(constants.AtlasHomeEnvVar, stateDir)
+	repoPath := t.TempDir()
+
+	taskStore, err := task.NewRepoScopedFileStore(repoPath)
+	require.NoError(t, err)
+	registry := steps.NewExecutorRegistry()
+	registry.Register(slowExecutor{})
+	engine := task.NewEngine(taskStore, registry, task.DefaultEngineConfig(), zerolog.Nop())
+
+	tmpl := &domain.Template{
+		Name:  "slow",
+		Steps: []domain.StepDefinition{{Name: "implement", Type: domain.StepTypeAI, Required: true}},
+	}
+
+	ctx, cancel := withRunTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	tk, err := engine.Start(ctx, "slow-ws", "feat/slow", repoPath, tmpl, "slow task", "")
+	require.Error(t, err)
+	require.NotNil(t, tk)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	require.True(t, runTimedOut(ctx))
+
+	ws := &domain.Workspace{Name: "slow-ws", RepoPath: repoPath, WorktreePath: repoPath}
+	var buf bytes.Buffer
+	sc := &startContext{ctx: ctx, outputFormat: OutputText, out: tui.NewOutput(&buf, OutputText), w: &buf}
+
+	err = handleInterruption(ctx, sc, ws, tk, nil, zerolog.Nop(), sc.out)
+	require.ErrorIs(t, err, errors.ErrRunTimeout)
+	assert.Equal(t, ExitTimeout, ExitCodeForError(err))
+	assert.Contains(t, buf.String(), "Timeout reached")
+
+	saved, err := taskStore.Get(context.Background(), "slow-ws", tk.ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusInterrupted, saved.Status)
+	require.NotEmpty(t, saved.Transitions)
+	assert.Equal(t, "run exceeded --timeout", saved.Transitions[len(saved.Transitions)-1].Reason)
+}
+
+// TestWithRunTimeout_Zero tests a zero timeout leaves the context unchanged.
+func TestWithRunTimeout_Zero(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := withRunTimeout(context.Background(), 0)
+	defer cancel()
+
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+	assert.False(t, runTimedOut(ctx))
+}
+
+// TestValidateStartFlags_NegativeTimeout tests --timeout rejects negative durations.
+func TestValidateStartFlags_NegativeTimeout(t *testing.T) {
+	t.Parallel()
+
+	err := validateStartFlags(startOptions{timeout: -time.Second})
+	require.ErrorIs(t, err, errors.ErrInvalidArgument)
+	assert.Equal(t, ExitInvalidInput, ExitCodeForError(err))
+}
+
+// TestProgressCallback_RendersChecklist tests step start and completion
+// events update the live step checklist.
+func TestProgressCallback_RendersChecklist(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	var buf bytes.Buffer
+	out := tui.NewOutput(&buf, OutputText)
+	state := &progressState{checklist: newStepChecklist([]domain.StepDefinition{
+		{Name: "implement", Type: domain.StepTypeAI},
+		{Name: "validate", Type: domain.StepTypeValidation},
+	})}
+	callback := createProgressCallback(context.Background(), out, "test-ws", state)
+
+	callback(task.StepProgressEvent{Type: "start", StepIndex: 0, TotalSteps: 2, StepName: "implement"})
+	callback(task.StepProgressEvent{Type: "complete", StepIndex: 0, TotalSteps: 2, StepName: "implement", Status: constants.StepStatusSuccess})
+	callback(task.StepProgressEvent{Type: "start", StepIndex: 1, TotalSteps: 2, StepName: "validate"})
+	if state.activeSpinner != nil {
+		state.activeSpinner.Stop()
+	}
+
+	output := buf.String()
+	assert.Contains(t, output, "● implement (running)")
+	assert.Contains(t, output, "✓ implement (success)")
+	assert.Contains(t, output, "● validate (running)")
+	assert.Equal(t, constants.StepStatusSuccess, state.checklist[0].Status)
+	assert.Equal(t, constants.StepStatusRunning, state.checklist[1].Status)
+}
+
+// instantExecutor completes every step immediately.
+type instantExecutor struct{}
+
+func (instantExecutor) Type() domain.StepType { return domain.StepTypeAI }
+
+func (instantExecutor) Execute(_ context.Context, _ *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	now := time.Now().UTC()
+	return &domain.StepResult{StepName: step.Name, Status: "success", StartedAt: now, CompletedAt: now}, nil
+}
+
+// TestWaitForWebhooks_CanceledContext tests that deliveries still finish when
+// the command context was already canceled by --timeout or Ctrl+C.
+func TestWaitForWebhooks_CanceledContext(t *testing.T) {
+	t.Setenv(constants.AtlasHomeEnvVar, t.TempDir())
+	repoPath := t.TempDir()
+
+	var delivered atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		delivered.Store(true)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	taskStore, err := task.NewRepoScopedFileStore(repoPath)
+	require.NoError(t, err)
+	registry := steps.NewExecutorRegistry()
+	registry.Register(instantExecutor{})
+	engine := task.NewEngine(taskStore, registry, task.DefaultEngineConfig(), zerolog.Nop(),
+		task.WithWebhooks([]config.WebhookConfig{{URL: srv.URL}}, nil))
+
+	tmpl := &domain.Template{
+		Name:  "instant",
+		Steps: []domain.StepDefinition{{Name: "implement", Type: domain.StepTypeAI, Required: true}},
+	}
+	_, err = engine.Start(context.Background(), "hook-ws", "feat/hook", repoPath, tmpl, "hook task", "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	waitForWebhooks(ctx, engine)
+
+	assert.True(t, delivered.Load(), "webhook delivery should finish before the wait returns")
+}
@@ -51,6 +51,8 @@ type statusOptions struct {
 	WatchMode     bool
 	WatchInterval time.Duration
 	ShowProgress  bool
+	Since         string // --since time expression
+	Until         string // --until time expression
 }
 
 // StatusRenderOptions contains display-related options for status rendering.
@@ -59,6 +61,12 @@ type StatusRenderOptions struct {
 	Output       string
 	Quiet        bool
 	ShowProgress bool
+	// Location is the zone for text timestamps (nil means local time).
+	// JSON output always uses UTC.
+	Location *time.Location
+	// Query limits the tasks shown. Workspaces with no matching tasks are
+	// omitted when the query has bounds.
+	Query task.Query
 }
 
 // StatusDeps contains dependencies for status command execution.
@@ -73,6 +81,7 @@ func AddStatusCommand(parent *cobra.Command) {
 	var watchMode bool
 	var watchInterval time.Duration
 	var showProgress bool
+	var since, until string
 
 	cmd := &cobra.Command{
 		Use:   "status",
@@ -100,12 +109,15 @@ Examples:
   atlas status --watch      # Live updating dashboard
   atlas status -w --interval 5s # Update every 5 seconds
   atlas status --progress   # Show progress bars for active tasks
-  atlas status -w -p        # Watch mode with progress bars`,
+  atlas status -w -p        # Watch mode with progress bars
+  atlas status --since 24h  # Only tasks created in the last day`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return runStatus(cmd.Context(), cmd, os.Stdout, statusOptions{
 				WatchMode:     watchMode,
 				WatchInterval: watchInterval,
 				ShowProgress:  showProgress,
+				Since:         since,
+				Until:         until,
 			})
 		},
 	}
@@ -114,6 +126,7 @@ Examples:
 	cmd.Flags().BoolVarP(&watchMode, "watch", "w", false, "Enable watch mode with live updates")
 	cmd.Flags().DurationVar(&watchInterval, "interval", DefaultWatchInterval, "Refresh interval in watch mode (minimum 500ms)")
 	cmd.Flags().BoolVarP(&showProgress, "progress", "p", false, "Show progress bars for active tasks")
+	addTimeBoundFlags(cmd, &since, &until)
 
 	parent.AddCommand(cmd)
 }
@@ -131,6 +144,14 @@ func runStatus(ctx context.Context, cmd *cobra.Command, w io.Writer, opts status
 	output := cmd.Flag("output").Value.String()
 	quiet := cmd.Flag("quiet").Value.String() == "true"
 
+	query, err := parseTimeBounds(opts.Since, opts.Until, time.Now())
+	if err != nil {
+		return err
+	}
+	if opts.WatchMode && !query.IsZero() {
+		return fmt.Errorf("%w: --since and --until cannot be used with --watch", errors.ErrInvalidArgument)
+	}
+
 	// Daemon-aware: if the daemon is running, show queue stats as a header.
 	// Falls through to workspace-based status regardless (no breaking change).
 	if output != OutputJSON && !quiet && !opts.WatchMode {
@@ -154,7 +175,7 @@ func runStatus(ctx context.Context, cmd *cobra.Command, w io.Writer, opts status
 		return fmt.Errorf("failed to create workspace store: %w", err)
 	}
 
-	wsMgr := workspace.NewManager(wsStore, nil, logger)
+	wsMgr := workspace.NewManager(wsStore, nil, logger).ReadOnly()
 
 	taskStore, err := task.NewRepoScopedFileStore(repoPath)
 	if err != nil {
@@ -180,6 +201,8 @@ func runStatus(ctx context.Context, cmd *cobra.Command, w io.Writer, opts status
 		Output:       output,
 		Quiet:        quiet,
 		ShowProgress: opts.ShowProgress,
+		Location:     displayLocation(),
+		Query:        query,
 	}
 	deps := StatusDeps{
 		WorkspaceMgr: wsMgr,
@@ -247,7 +270,7 @@ func runStatusWithDeps(
 	}
 
 	// Build hierarchical workspace groups
-	groups, err := buildWorkspaceGroups(ctx, workspaces, deps.TaskStore)
+	groups, err := buildWorkspaceGroups(ctx, workspaces, deps.TaskStore, opts.Query)
 	if err != nil {
 		return fmt.Errorf("failed to build workspace groups: %w", err)
 	}
@@ -260,7 +283,7 @@ func runStatusWithDeps(
 		return outputHierarchicalJSON(w, groups)
 	}
 
-	return outputHierarchicalTable(w, groups, opts.Quiet, opts.ShowProgress)
+	return outputHierarchicalTable(w, groups, opts.Quiet, opts.ShowProgress, opts.Location)
 }
 
 // buildWorkspaceGroups builds hierarchical workspace groups from workspaces.
@@ -269,6 +292,7 @@ func buildWorkspaceGroups(
 	ctx context.Context,
 	workspaces []*domain.Workspace,
 	taskStore TaskLister,
+	query task.Query,
 ) ([]tui.WorkspaceGroup, error) {
 	groups := make([]tui.WorkspaceGroup, 0, len(workspaces))
 
@@ -288,6 +312,12 @@ func buildWorkspaceGroups(
 
 		// Load all tasks for the workspace
 		tasks, err := taskStore.List(ctx, ws.Name)
+		if err == nil {
+			tasks = query.Filter(tasks)
+		}
+		if !query.IsZero() && len(tasks) == 0 {
+			continue
+		}
 		if err == nil && len(tasks) > 0 {
 			group.TotalTasks = len(tasks)
 			group.Status = tasks[0].Status // Aggregate status from most recent
@@ -302,6 +332,7 @@ func buildWorkspaceGroups(
 					Status:      t.Status,
 					CurrentStep: t.CurrentStep + 1, // 1-indexed for display
 					TotalSteps:  len(t.Steps),
+					UpdatedAt:   t.UpdatedAt,
 				}
 			}
 		}
@@ -314,13 +345,13 @@ func buildWorkspaceGroups(
 
 // taskPath computes the full file system path to a task directory.
 // Used for generating clickable hyperlinks in terminals that support OSC 8.
-// Task data is stored in ~/.atlas/, not the project directory.
+// Task data is stored in the state directory (~/.atlas/), not the project directory.
 func taskPath(workspaceName, taskID string) string {
-	homeDir, err := os.UserHomeDir()
+	stateDir, err := workspace.StateDir()
 	if err != nil {
 		return ""
 	}
-	return filepath.Join(homeDir, ".atlas", constants.WorkspacesDir, workspaceName, constants.TasksDir, taskID)
+	return filepath.Join(stateDir, constants.WorkspacesDir, workspaceName, constants.TasksDir, taskID)
 }
 
 // sortByStatusPriority sorts rows by status priority (attention first, then running).
@@ -414,7 +445,7 @@ func outputHierarchicalJSON(w io.Writer, groups []tui.WorkspaceGroup) error {
 }
 
 // outputHierarchicalTable outputs status as hierarchical table with nested tasks.
-func outputHierarchicalTable(w io.Writer, groups []tui.WorkspaceGroup, quiet, showProgress bool) error {
+func outputHierarchicalTable(w io.Writer, groups []tui.WorkspaceGroup, quiet, showProgress bool, loc *time.Location) error {
 	table := tui.NewHierarchicalStatusTable(groups)
 
 	// Header (unless quiet)
@@ -441,7 +472,7 @@ func outputHierarchicalTable(w io.Writer, groups []tui.WorkspaceGroup, quiet, sh
 	// Footer summary (unless quiet)
 	if !quiet {
 		_, _ = fmt.Fprintln(w)
-		_, _ = fmt.Fprintln(w, buildHierarchicalFooter(groups))
+		_, _ = fmt.Fprintln(w, buildHierarchicalFooter(groups, loc))
 	}
 
 	return nil
@@ -471,7 +502,8 @@ func buildProgressRowsFromGroups(groups []tui.WorkspaceGroup) []tui.ProgressRow
 }
 
 // buildHierarchicalFooter creates the footer summary for hierarchical display.
-func buildHierarchicalFooter(groups []tui.WorkspaceGroup) string {
+// The most recent task update is shown in loc.
+func buildHierarchicalFooter(groups []tui.WorkspaceGroup, loc *time.Location) string {
 	attentionCount := 0
 	var firstAttention *tui.WorkspaceGroup
 
@@ -509,6 +541,10 @@ func buildHierarchicalFooter(groups []tui.WorkspaceGroup) string {
 		summary += fmt.Sprintf(", %d %s attention", attentionCount, needWord)
 	}
 
+	if latest := latestTaskUpdate(groups); !latest.IsZero() {
+		summary += fmt.Sprintf("\nLast activity: %s", tui.FormatTimestamp(latest, loc))
+	}
+
 	// Actionable command
 	if firstAttention != nil {
 		action := tui.SuggestedAction(firstAttention.Status)
@@ -520,6 +556,20 @@ func buildHierarchicalFooter(groups []tui.WorkspaceGroup) string {
 	return summary
 }
 
+// latestTaskUpdate returns the most recent task update across all groups,
+// or the zero time if none is recorded.
+func latestTaskUpdate(groups []tui.WorkspaceGroup) time.Time {
+	var latest time.Time
+	for _, group := range groups {
+		for _, t := range group.Tasks {
+			if t.UpdatedAt.After(latest) {
+				latest = t.UpdatedAt
+			}
+		}
+	}
+	return latest
+}
+
 // buildProgressRows converts status rows to progress rows for the dashboard.
 // Only includes rows with active tasks (running or validating states).
 // Delegates to shared helper in tui package to avoid code duplication.
@@ -1008,3 +1008,127 @@ func TestBuildProgressRows(t *testing.T) {
 	assert.InDelta(t, 3.0/7.0, progressRows[0].Percent, 0.01)
 	assert.InDelta(t, 5.0/7.0, progressRows[1].Percent, 0.01)
 }
+
+// TestStatusCommand_TimestampZones tests that text output shows the last task
+// update in the display zone while JSON keeps RFC3339 UTC.
+func TestStatusCommand_TimestampZones(t *testing.T) {
+	t.Parallel()
+
+	updated := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	workspaces := []*domain.Workspace{
+		{Name: "payment", Branch: "fix/payment", Status: constants.WorkspaceStatusActive},
+	}
+	tasks := map[string][]*domain.Task{
+		"payment": {
+			{
+				ID:          "task-1",
+				WorkspaceID: "payment",
+				Status:      constants.TaskStatusRunning,
+				Steps:       make([]domain.Step, 3),
+				UpdatedAt:   updated,
+			},
+		},
+	}
+	deps := testStatusDeps(&mockWorkspaceManager{workspaces: workspaces}, &mockTaskStore{tasks: tasks})
+	ctx := context.Background()
+
+	t.Run("text uses display zone", func(t *testing.T) {
+		t.Parallel()
+		opts := testStatusOpts("text", false, false)
+		opts.Location = time.FixedZone("UTC+2", 2*60*60)
+
+		var buf bytes.Buffer
+		require.NoError(t, runStatusWithDeps(ctx, &buf, opts, deps))
+		assert.Contains(t, buf.String(), "Last activity: 2024-01-15 16:30:00 +02:00")
+	})
+
+	t.Run("text with UTC", func(t *testing.T) {
+		t.Parallel()
+		opts := testStatusOpts("text", false, false)
+		opts.Location = time.UTC
+
+		var buf bytes.Buffer
+		require.NoError(t, runStatusWithDeps(ctx, &buf, opts, deps))
+		assert.Contains(t, buf.String(), "Last activity: 2024-01-15 14:30:00 +00:00")
+	})
+
+	t.Run("json stays UTC", func(t *testing.T) {
+		t.Parallel()
+		opts := testStatusOpts("json", false, false)
+		opts.Location = time.FixedZone("UTC+2", 2*60*60)
+
+		var buf bytes.Buffer
+		require.NoError(t, runStatusWithDeps(ctx, &buf, opts, deps))
+
+		var result hierarchicalJSONOutput
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+		require.Len(t, result.Workspaces, 1)
+		require.Len(t, result.Workspaces[0].Tasks, 1)
+		assert.Equal(t, "2024-01-15T14:30:00Z", result.Workspaces[0].Tasks[0].UpdatedAt)
+	})
+}
+
+// TestStatusCommand_TimeBounds tests that --since/--until bounds include and
+// exclude seeded tasks, dropping workspaces left with no tasks.
+func TestStatusCommand_TimeBounds(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	workspaces := []*domain.Workspace{
+		{Name: "recent", Branch: "feat/recent", Status: constants.WorkspaceStatusActive},
+		{Name: "stale", Branch: "feat/stale", Status: constants.WorkspaceStatusActive},
+	}
+	tasks := map[string][]*domain.Task{
+		"recent": {
+			{ID: "task-new", WorkspaceID: "recent", Status: constants.TaskStatusRunning, CreatedAt: now.Add(-time.Hour)},
+			{ID: "task-old", WorkspaceID: "recent", Status: constants.TaskStatusCompleted, CreatedAt: now.Add(-72 * time.Hour)},
+		},
+		"stale": {
+			{ID: "task-ancient", WorkspaceID: "stale", Status: constants.TaskStatusCompleted, CreatedAt: now.Add(-30 * 24 * time.Hour)},
+		},
+	}
+	deps := testStatusDeps(&mockWorkspaceManager{workspaces: workspaces}, &mockTaskStore{tasks: tasks})
+
+	run := func(t *testing.T, since, until string) hierarchicalJSONOutput {
+		t.Helper()
+		query, err := parseTimeBounds(since, until, now)
+		require.NoError(t, err)
+
+		opts := testStatusOpts("json", false, false)
+		opts.Query = query
+
+		var buf bytes.Buffer
+		require.NoError(t, runStatusWithDeps(context.Background(), &buf, opts, deps))
+
+		var result hierarchicalJSONOutput
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+		return result
+	}
+
+	t.Run("since relative", func(t *testing.T) {
+		t.Parallel()
+		result := run(t, "24h", "")
+		require.Len(t, result.Workspaces, 1)
+		assert.Equal(t, "recent", result.Workspaces[0].Name)
+		require.Len(t, result.Workspaces[0].Tasks, 1)
+		assert.Equal(t, "task-new", result.Workspaces[0].Tasks[0].ID)
+	})
+
+	t.Run("until absolute", func(t *testing.T) {
+		t.Parallel()
+		result := run(t, "", "2024-01-13T00:00:00Z")
+		require.Len(t, result.Workspaces, 2)
+		for _, ws := range result.Workspaces {
+			require.Len(t, ws.Tasks, 1)
+			assert.NotEqual(t, "task-new", ws.Tasks[0].ID)
+		}
+	})
+
+	t.Run("window between", func(t *testing.T) {
+		t.Parallel()
+		result := run(t, "7d", "2d")
+		require.Len(t, result.Workspaces, 1)
+		require.Len(t, result.Workspaces[0].Tasks, 1)
+		assert.Equal(t, "task-old", result.Workspaces[0].Tasks[0].ID)
+	})
+}
@@ -0,0 +1,293 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/template"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// Template source labels shown by template list and show.
+const (
+	templateSourceBuiltIn  = "built-in"
+	templateSourceCustom   = "custom"
+	templateSourceOverride = "custom (overrides built-in)"
+)
+
+// templateFlags holds the flags shared by the template subcommands.
+type templateFlags struct {
+	json bool
+}
+
+// templateSummary is the list representation of a template.
+type templateSummary struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	BranchPrefix string `json:"branch_prefix"`
+	Steps        int    `json:"steps"`
+	Source       string `json:"source"`
+}
+
+// templateDetail is the full representation of a template for template show.
+type templateDetail struct {
+	Name         string               `json:"name"`
+	Description  string               `json:"description"`
+	BranchPrefix string               `json:"branch_prefix"`
+	DefaultAgent string               `json:"default_agent,omitempty"`
+	DefaultModel string               `json:"default_model,omitempty"`
+	Source       string               `json:"source"`
+	Steps        []templateStepDetail `json:"steps"`
+}
+
+// templateStepDetail describes a single template step.
+type templateStepDetail struct {
+	Index       int    `json:"index"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+	SideEffect  string `json:"side_effect,omitempty"`
+}
+
+// AddTemplateCommand adds the template command group to the root command.
+func AddTemplateCommand(root *cobra.Command) {
+	templateCmd := &cobra.Command{
+		Use:   "template",
+		Short: "Discover available task templates",
+		Long: `Commands for discovering the task templates available to 'atlas start'.
+
+Built-in templates are compiled into the binary. Custom templates are loaded
+from the templates.custom_templates config section and take precedence over
+built-ins with the same name.
+
+Examples:
+  atlas template list          # List templates with descriptions
+  atlas template list --json   # Output as JSON array
+  atlas template show bug      # Show the steps of the bug template`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	templateCmd.AddCommand(newTemplateListCmd())
+	templateCmd.AddCommand(newTemplateShowCmd())
+
+	root.AddCommand(templateCmd)
+}
+
+// newTemplateListCmd creates the template list command.
+func newTemplateListCmd() *cobra.Command {
+	flags := &templateFlags{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List available templates",
+		Aliases: []string{"ls"},
+		Long: `List all templates available to 'atlas start', including custom
+templates from config. Custom templates that replace a built-in are marked.
+
+Examples:
+  atlas template list          # Display as table
+  atlas template list --json   # Output as JSON array`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			registry, customs, err := loadTemplateRegistry(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return runTemplateList(cmd, cmd.OutOrStdout(), registry, customs, flags)
+		},
+	}
+
+	cmd.Flags().BoolVar(&flags.json, "json", false, "Output as JSON array")
+
+	return cmd
+}
+
+// newTemplateShowCmd creates the template show command.
+func newTemplateShowCmd() *cobra.Command {
+	flags := &templateFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "show <name>",
+		Short: "Show the steps of a template",
+		Long: `Show the full step breakdown of a template, including the side
+effects each step has when the template runs.
+
+Examples:
+  atlas template show feature         # Show the feature template
+  atlas template show bug --json      # Output as JSON`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			registry, customs, err := loadTemplateRegistry(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return runTemplateShow(cmd, cmd.OutOrStdout(), registry, customs, args[0], flags)
+		},
+	}
+
+	cmd.Flags().BoolVar(&flags.json, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+// loadTemplateRegistry builds the template registry the same way 'atlas start' does.
+// Returns the registry and the custom template names from config.
+func loadTemplateRegistry(ctx context.Context) (*template.Registry, map[string]string, error) {
+	logger := Logger()
+
+	cfg, err := config.Load(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to load config, listing built-in templates only")
+		cfg = config.DefaultConfig()
+	}
+
+	basePath, err := detectRepoPath()
+	if err != nil {
+		basePath = "."
+	}
+
+	registry, err := template.NewRegistryWithConfig(basePath, cfg.Templates.CustomTemplates)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load templates: %w", err)
+	}
+
+	return registry, cfg.Templates.CustomTemplates, nil
+}
+
+// runTemplateList executes the template list command.
+func runTemplateList(cmd *cobra.Command, w io.Writer, registry *template.Registry, customs map[string]string, flags *templateFlags) error {
+	outputFormat := getOutputFormat(cmd, flags.json)
+	out := tui.NewOutput(w, outputFormat)
+
+	templates := registry.List()
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	builtins := template.NewDefaultRegistry()
+	summaries := make([]templateSummary, 0, len(templates))
+	for _, tmpl := range templates {
+		summaries = append(summaries, templateSummary{
+			Name:         tmpl.Name,
+			Description:  tmpl.Description,
+			BranchPrefix: tmpl.BranchPrefix,
+			Steps:        len(tmpl.Steps),
+			Source:       templateSource(tmpl.Name, customs, builtins),
+		})
+	}
+
+	if outputFormat == OutputJSON {
+		return out.JSON(summaries)
+	}
+
+	if len(summaries) == 0 {
+		out.Info("No templates found.")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(summaries))
+	for _, s := range summaries {
+		rows = append(rows, []string{s.Name, s.Source, fmt.Sprintf("%d", s.Steps), s.Description})
+	}
+	out.Table([]string{"NAME", "SOURCE", "STEPS", "DESCRIPTION"}, rows)
+	return nil
+}
+
+// runTemplateShow executes the template show command.
+func runTemplateShow(cmd *cobra.Command, w io.Writer, registry *template.Registry, customs map[string]string, name string, flags *templateFlags) error {
+	outputFormat := getOutputFormat(cmd, flags.json)
+	out := tui.NewOutput(w, outputFormat)
+
+	tmpl, err := registry.Get(name)
+	if err != nil {
+		return fmt.Errorf("template '%s' not found: %w", name, err)
+	}
+
+	detail := buildTemplateDetail(tmpl, templateSource(tmpl.Name, customs, template.NewDefaultRegistry()))
+
+	if outputFormat == OutputJSON {
+		return out.JSON(detail)
+	}
+
+	displayTemplateDetail(out, detail)
+	return nil
+}
+
+// buildTemplateDetail converts a template into its show representation.
+func buildTemplateDetail(tmpl *domain.Template, source string) templateDetail {
+	steps := make([]templateStepDetail, 0, len(tmpl.Steps))
+	for i, step := range tmpl.Steps {
+		steps = append(steps, templateStepDetail{
+			Index:       i + 1,
+			Name:        step.Name,
+			Type:        string(step.Type),
+			Description: step.Description,
+			Required:    step.Required,
+			SideEffect:  getSideEffectForStepType(step),
+		})
+	}
+
+	return templateDetail{
+		Name:         tmpl.Name,
+		Description:  tmpl.Description,
+		BranchPrefix: tmpl.BranchPrefix,
+		DefaultAgent: string(tmpl.DefaultAgent),
+		DefaultModel: tmpl.DefaultModel,
+		Source:       source,
+		Steps:        steps,
+	}
+}
+
+// displayTemplateDetail displays a template's step breakdown for terminal output.
+func displayTemplateDetail(out tui.Output, detail templateDetail) {
+	out.Info(fmt.Sprintf("Template:      %s", detail.Name))
+	out.Info(fmt.Sprintf("Source:        %s", detail.Source))
+	if detail.Description != "" {
+		out.Info(fmt.Sprintf("Description:   %s", detail.Description))
+	}
+	if detail.BranchPrefix != "" {
+		out.Info(fmt.Sprintf("Branch prefix: %s", detail.BranchPrefix))
+	}
+	if detail.DefaultAgent != "" {
+		out.Info(fmt.Sprintf("Agent:         %s", detail.DefaultAgent))
+	}
+	if detail.DefaultModel != "" {
+		out.Info(fmt.Sprintf("Model:         %s", detail.DefaultModel))
+	}
+	out.Info("")
+
+	for _, step := range detail.Steps {
+		requiredStr := ""
+		if !step.Required {
+			requiredStr = " (optional)"
+		}
+		out.Info(fmt.Sprintf("[%d/%d] %s Step: '%s'%s", step.Index, len(detail.Steps), step.Type, step.Name, requiredStr))
+		if step.Description != "" {
+			out.Info(fmt.Sprintf("      Description: %s", step.Description))
+		}
+		if step.SideEffect != "" {
+			out.Info(fmt.Sprintf("      Side effect: %s", step.SideEffect))
+		}
+	}
+}
+
+// templateSource labels where a template comes from.
+// Custom templates sharing a name with a built-in are marked as overrides.
+func templateSource(name string, customs map[string]string, builtins *template.Registry) string {
+	if _, isCustom := customs[name]; !isCustom {
+		return templateSourceBuiltIn
+	}
+	if _, err := builtins.Get(name); err == nil {
+		return templateSourceOverride
+	}
+	return templateSourceCustom
+}
@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/template"
+)
+
+// newCustomBugRegistry creates a registry where a custom template overrides
+// the built-in bug template and another adds a brand new template.
+func newCustomBugRegistry(t *testing.T) (*template.Registry, map[string]string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+
+	customBug := `
+name: bug
+description: Custom bug workflow
+branch_prefix: custom-fix
+steps:
+  - name: implement
+    type: ai
+    required: true
+`
+	deploy := `
+name: deploy
+description: Deploy workflow
+branch_prefix: deploy
+steps:
+  - name: push
+    type: git
+    required: true
+    config:
+      operation: push
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "bug.yaml"), []byte(customBug), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "deploy.yaml"), []byte(deploy), 0o600))
+
+	customs := map[string]string{
+		"bug":    "bug.yaml",
+		"deploy": "deploy.yaml",
+	}
+	registry, err := template.NewRegistryWithConfig(tmpDir, customs)
+	require.NoError(t, err)
+	return registry, customs
+}
+
+// TestAddTemplateCommand tests the template command group is registered.
+func TestAddTemplateCommand(t *testing.T) {
+	t.Parallel()
+
+	root := &cobra.Command{Use: "atlas"}
+	AddTemplateCommand(root)
+
+	templateCmd, _, err := root.Find([]string{"template"})
+	require.NoError(t, err)
+	assert.Equal(t, "template", templateCmd.Name())
+
+	listCmd, _, err := root.Find([]string{"template", "list"})
+	require.NoError(t, err)
+	assert.NotNil(t, listCmd.Flags().Lookup("json"))
+
+	showCmd, _, err := root.Find([]string{"template", "show"})
+	require.NoError(t, err)
+	assert.NotNil(t, showCmd.Flags().Lookup("json"))
+}
+
+// TestRunTemplateList_DefaultRegistry tests listing the built-in templates.
+func TestRunTemplateList_DefaultRegistry(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := runTemplateList(&cobra.Command{}, &buf, template.NewDefaultRegistry(), nil, &templateFlags{json: true})
+	require.NoError(t, err)
+
+	var summaries []templateSummary
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &summaries))
+	require.Len(t, summaries, len(template.NewDefaultRegistry().List()))
+
+	names := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		names = append(names, s.Name)
+		assert.Equal(t, templateSourceBuiltIn, s.Source)
+		assert.Positive(t, s.Steps)
+	}
+	assert.Contains(t, names, "bug")
+	assert.Contains(t, names, "feature")
+	assert.IsIncreasing(t, names)
+}
+
+// TestRunTemplateList_TextOutput tests the table output includes names and descriptions.
+func TestRunTemplateList_TextOutput(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", OutputText, "output format")
+
+	var buf bytes.Buffer
+	err := runTemplateList(cmd, &buf, template.NewDefaultRegistry(), nil, &templateFlags{})
+	require.NoError(t, err)
+
+	bugTmpl, err := template.NewDefaultRegistry().Get("bug")
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.Contains(t, output, "NAME")
+	assert.Contains(t, output, "DESCRIPTION")
+	assert.Contains(t, output, bugTmpl.Description)
+}
+
+// TestRunTemplateList_CustomOverride tests custom templates are marked in the list.
+func TestRunTemplateList_CustomOverride(t *testing.T) {
+	t.Parallel()
+
+	registry, customs := newCustomBugRegistry(t)
+
+	var buf bytes.Buffer
+	err := runTemplateList(&cobra.Command{}, &buf, registry, customs, &templateFlags{json: true})
+	require.NoError(t, err)
+
+	var summaries []templateSummary
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &summaries))
+
+	sources := make(map[string]string, len(summaries))
+	for _, s := range summaries {
+		sources[s.Name] = s.Source
+	}
+	assert.Equal(t, templateSourceOverride, sources["bug"])
+	assert.Equal(t, templateSourceCustom, sources["deploy"])
+	assert.Equal(t, templateSourceBuiltIn, sources["feature"])
+}
+
+// TestRunTemplateShow_DefaultTemplate tests the step breakdown of a built-in template.
+func TestRunTemplateShow_DefaultTemplate(t *testing.T) {
+	t.Parallel()
+
+	registry := template.NewDefaultRegistry()
+	tmpl, err := registry.Get("bug")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = runTemplateShow(&cobra.Command{}, &buf, registry, nil, "bug", &templateFlags{json: true})
+	require.NoError(t, err)
+
+	var detail templateDetail
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &detail))
+	assert.Equal(t, "bug", detail.Name)
+	assert.Equal(t, templateSourceBuiltIn, detail.Source)
+	require.Len(t, detail.Steps, len(tmpl.Steps))
+	for i, step := range detail.Steps {
+		assert.Equal(t, i+1, step.Index)
+		assert.Equal(t, tmpl.Steps[i].Name, step.Name)
+		assert.Equal(t, getSideEffectForStepType(tmpl.Steps[i]), step.SideEffect)
+	}
+}
+
+// TestRunTemplateShow_TextOutput tests the terminal step breakdown.
+func TestRunTemplateShow_TextOutput(t *testing.T) {
+	t.Parallel()
+
+	registry, customs := newCustomBugRegistry(t)
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", OutputText, "output format")
+
+	var buf bytes.Buffer
+	err := runTemplateShow(cmd, &buf, registry, customs, "deploy", &templateFlags{})
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.Contains(t, output, "deploy")
+	assert.Contains(t, output, templateSourceCustom)
+	assert.Contains(t, output, "git Step: 'push'")
+	assert.Contains(t, output, "Side effect: Git push to remote")
+}
+
+// TestRunTemplateShow_CustomOverride tests show reports the custom override.
+func TestRunTemplateShow_CustomOverride(t *testing.T) {
+	t.Parallel()
+
+	registry, customs := newCustomBugRegistry(t)
+
+	var buf bytes.Buffer
+	err := runTemplateShow(&cobra.Command{}, &buf, registry, customs, "bug", &templateFlags{json: true})
+	require.NoError(t, err)
+
+	var detail templateDetail
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &detail))
+	assert.Equal(t, templateSourceOverride, detail.Source)
+	assert.Equal(t, "Custom bug workflow", detail.Description)
+	require.Len(t, detail.Steps, 1)
+	assert.Equal(t, "AI execution (file modifications)", detail.Steps[0].SideEffect)
+}
+
+// TestRunTemplateShow_NotFound tests show fails for unknown templates.
+func TestRunTemplateShow_NotFound(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := runTemplateShow(&cobra.Command{}, &buf, template.NewDefaultRegistry(), nil, "does-not-exist", &templateFlags{json: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does-not-exist")
+}
@@ -0,0 +1,83 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/task"
+)
+
+// addTimeBoundFlags adds the --since and --until flags to a listing command.
+func addTimeBoundFlags(cmd *cobra.Command, since, until *string) {
+	cmd.Flags().StringVar(since, "since", "", "only include items created at or after this time (RFC3339 or relative, e.g. 24h, 7d)")
+	cmd.Flags().StringVar(until, "until", "", "only include items created at or before this time (RFC3339 or relative, e.g. 24h, 7d)")
+}
+
+// timeBoundsFromFlags parses the command's --since and --until flags.
+// Flags the command does not define are treated as unset.
+func timeBoundsFromFlags(cmd *cobra.Command, now time.Time) (task.Query, error) {
+	var since, until string
+	if f := cmd.Flags().Lookup("since"); f != nil {
+		since = f.Value.String()
+	}
+	if f := cmd.Flags().Lookup("until"); f != nil {
+		until = f.Value.String()
+	}
+	return parseTimeBounds(since, until, now)
+}
+
+// parseTimeBounds builds a task query from --since and --until values.
+// Relative durations are measured back from now.
+func parseTimeBounds(since, until string, now time.Time) (task.Query, error) {
+	var q task.Query
+	var err error
+
+	if q.CreatedAfter, err = parseTimeExpression(since, now); err != nil {
+		return task.Query{}, fmt.Errorf("--since: %w", err)
+	}
+	if q.CreatedBefore, err = parseTimeExpression(until, now); err != nil {
+		return task.Query{}, fmt.Errorf("--until: %w", err)
+	}
+	if !q.CreatedAfter.IsZero() && !q.CreatedBefore.IsZero() && q.CreatedAfter.After(q.CreatedBefore) {
+		return task.Query{}, fmt.Errorf("%w: --since %q is after --until %q", errors.ErrInvalidTimeExpression, since, until)
+	}
+	return q, nil
+}
+
+// parseTimeExpression parses an RFC3339 timestamp or a relative duration
+// such as "90m", "24h", or "7d" (meaning that long before now).
+// An empty expression returns the zero time.
+func parseTimeExpression(expr string, now time.Time) (time.Time, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, expr); err == nil {
+		return t, nil
+	}
+
+	d, err := parseRelativeDuration(expr)
+	if err != nil || d <= 0 {
+		return time.Time{}, fmt.Errorf("%w: %q (use RFC3339 like 2024-01-15T14:30:00Z or a duration like 24h or 7d)", errors.ErrInvalidTimeExpression, expr)
+	}
+	return now.Add(-d), nil
+}
+
+// parseRelativeDuration parses a Go duration, plus a whole-day "Nd" form.
+func parseRelativeDuration(expr string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(expr, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(expr)
+}
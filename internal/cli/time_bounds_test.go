@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/errors"
+)
+
+// TestParseTimeExpression tests absolute and relative time parsing.
+func TestParseTimeExpression(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected time.Time
+	}{
+		{"empty", "", time.Time{}},
+		{"rfc3339 utc", "2024-01-10T08:30:00Z", time.Date(2024, 1, 10, 8, 30, 0, 0, time.UTC)},
+		{"rfc3339 offset", "2024-01-10T10:30:00+02:00", time.Date(2024, 1, 10, 8, 30, 0, 0, time.UTC)},
+		{"hours", "24h", now.Add(-24 * time.Hour)},
+		{"minutes", "90m", now.Add(-90 * time.Minute)},
+		{"days", "7d", now.Add(-7 * 24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseTimeExpression(tt.expr, now)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "expected %v, got %v", tt.expected, got)
+		})
+	}
+}
+
+// TestParseTimeExpression_Invalid tests invalid expressions fail at parse time.
+func TestParseTimeExpression_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{"yesterday", "2024-01-10", "-24h", "0s", "xd"} {
+		_, err := parseTimeExpression(expr, time.Now())
+		require.ErrorIs(t, err, errors.ErrInvalidTimeExpression, "expression %q", expr)
+	}
+}
+
+// TestParseTimeBounds tests building a query from --since and --until.
+func TestParseTimeBounds(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	q, err := parseTimeBounds("48h", "24h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-48*time.Hour), q.CreatedAfter)
+	assert.Equal(t, now.Add(-24*time.Hour), q.CreatedBefore)
+
+	_, err = parseTimeBounds("24h", "48h", now)
+	require.ErrorIs(t, err, errors.ErrInvalidTimeExpression)
+
+	_, err = parseTimeBounds("", "tomorrow", now)
+	require.ErrorIs(t, err, errors.ErrInvalidTimeExpression)
+	assert.Contains(t, err.Error(), "--until")
+}
+
+// TestTimeBoundsFromFlags tests reading bounds from command flags.
+func TestTimeBoundsFromFlags(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	// Commands without the flags have no bounds
+	q, err := timeBoundsFromFlags(&cobra.Command{}, now)
+	require.NoError(t, err)
+	assert.True(t, q.IsZero())
+
+	var since, until string
+	cmd := &cobra.Command{}
+	addTimeBoundFlags(cmd, &since, &until)
+	require.NoError(t, cmd.Flags().Set("since", "2024-01-01T00:00:00Z"))
+
+	q, err = timeBoundsFromFlags(cmd, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), q.CreatedAfter)
+	assert.True(t, q.CreatedBefore.IsZero())
+}
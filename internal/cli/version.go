@@ -0,0 +1,151 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/tui"
+)
+
+// versionCheckTimeout bounds the latest-release lookup so an offline
+// machine does not hang the version command.
+const versionCheckTimeout = 10 * time.Second
+
+// versionResponse is the JSON output of the version command.
+type versionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+
+	// Update check fields, only set with --check.
+	LatestVersion   string `json:"latest_version,omitempty"`
+	UpdateAvailable *bool  `json:"update_available,omitempty"`
+	CheckError      string `json:"check_error,omitempty"`
+}
+
+// AddVersionCommand adds the version command to the root command.
+func AddVersionCommand(root *cobra.Command, info BuildInfo) {
+	root.AddCommand(newVersionCmd(info))
+}
+
+// newVersionCmd creates the version command.
+func newVersionCmd(info BuildInfo) *cobra.Command {
+	var jsonOutput, check bool
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Show atlas version and build information",
+		Long: `Show the atlas version, git commit, build date, and Go version.
+
+With --check, the latest GitHub release is looked up to report whether a
+newer version is available. A failed lookup (e.g. when offline) is reported
+but does not fail the command.
+
+Examples:
+  atlas version           # Show build information
+  atlas version --json    # Output as JSON
+  atlas version --check   # Also check for a newer release`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if cmd.Flag("output").Value.String() == OutputJSON {
+				jsonOutput = true
+			}
+			var client ReleaseClient
+			if check {
+				client = NewDefaultReleaseClient(&DefaultCommandExecutor{})
+			}
+			return runVersion(cmd.Context(), os.Stdout, info, jsonOutput, client)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	cmd.Flags().BoolVar(&check, "check", false, "Check GitHub for a newer release")
+
+	return cmd
+}
+
+// runVersion prints build information. A non-nil client enables the
+// latest-release check; lookup failures are reported in the output only.
+func runVersion(ctx context.Context, w io.Writer, info BuildInfo, jsonOutput bool, client ReleaseClient) error {
+	resp := buildVersionResponse(info)
+	if client != nil {
+		checkLatestVersion(ctx, client, &resp)
+	}
+
+	if jsonOutput {
+		return encodeJSONIndented(w, resp)
+	}
+
+	out := tui.NewOutput(w, "")
+	out.Info(fmt.Sprintf("atlas %s", resp.Version))
+	out.Info(fmt.Sprintf("  Commit:     %s", resp.Commit))
+	out.Info(fmt.Sprintf("  Built:      %s", resp.BuildDate))
+	out.Info(fmt.Sprintf("  Go version: %s", resp.GoVersion))
+	out.Info(fmt.Sprintf("  Platform:   %s", resp.Platform))
+
+	switch {
+	case client == nil:
+	case resp.CheckError != "":
+		out.Warning(fmt.Sprintf("Could not check for updates: %s", resp.CheckError))
+	case resp.UpdateAvailable != nil && *resp.UpdateAvailable:
+		out.Info(fmt.Sprintf("A newer version is available: %s (run 'atlas upgrade atlas')", resp.LatestVersion))
+	case resp.UpdateAvailable != nil:
+		out.Success("atlas is up to date")
+	default:
+		out.Info(fmt.Sprintf("Latest release: %s", resp.LatestVersion))
+	}
+	return nil
+}
+
+// buildVersionResponse fills build metadata, defaulting values not injected via ldflags.
+func buildVersionResponse(info BuildInfo) versionResponse {
+	resp := versionResponse{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildDate: info.Date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if resp.Version == "" {
+		resp.Version = "dev"
+	}
+	if resp.Commit == "" {
+		resp.Commit = "none"
+	}
+	if resp.BuildDate == "" {
+		resp.BuildDate = "unknown"
+	}
+	return resp
+}
+
+// checkLatestVersion records the latest release in resp. Development builds
+// have no comparable version, so only the latest release is reported.
+func checkLatestVersion(ctx context.Context, client ReleaseClient, resp *versionResponse) {
+	ctx, cancel := context.WithTimeout(ctx, versionCheckTimeout)
+	defer cancel()
+
+	release, err := client.GetLatestRelease(ctx, constants.GitHubOwner, constants.GitHubRepo)
+	if err != nil {
+		resp.CheckError = err.Error()
+		return
+	}
+
+	resp.LatestVersion = strings.TrimPrefix(release.TagName, "v")
+	if resp.Version == "dev" {
+		return
+	}
+	available := isNewerVersion(resp.Version, resp.LatestVersion)
+	resp.UpdateAvailable = &available
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunVersion_JSON tests the JSON shape of the version command.
+func TestRunVersion_JSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	info := BuildInfo{Version: "1.2.3", Commit: "abc1234", Date: "2026-01-02"}
+
+	require.NoError(t, runVersion(context.Background(), &buf, info, true, nil))
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "1.2.3", got["version"])
+	assert.Equal(t, "abc1234", got["commit"])
+	assert.Equal(t, "2026-01-02", got["build_date"])
+	assert.Equal(t, runtime.Version(), got["go_version"])
+	assert.Equal(t, runtime.GOOS+"/"+runtime.GOARCH, got["platform"])
+	assert.NotContains(t, got, "latest_version")
+	assert.NotContains(t, got, "update_available")
+	assert.NotContains(t, got, "check_error")
+}
+
+// TestRunVersion_DefaultsMissingBuildInfo tests that values not injected via ldflags get defaults.
+func TestRunVersion_DefaultsMissingBuildInfo(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, runVersion(context.Background(), &buf, BuildInfo{}, true, nil))
+
+	var got versionResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "dev", got.Version)
+	assert.Equal(t, "none", got.Commit)
+	assert.Equal(t, "unknown", got.BuildDate)
+}
+
+// TestRunVersion_CheckUpdateAvailable tests that --check reports a newer release.
+func TestRunVersion_CheckUpdateAvailable(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	client := &mockReleaseClient{release: &GitHubRelease{TagName: "v1.3.0"}}
+
+	require.NoError(t, runVersion(context.Background(), &buf, BuildInfo{Version: "1.2.3"}, true, client))
+
+	var got versionResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "1.3.0", got.LatestVersion)
+	require.NotNil(t, got.UpdateAvailable)
+	assert.True(t, *got.UpdateAvailable)
+}
+
+// TestRunVersion_CheckUpToDate tests that --check reports an up-to-date binary.
+func TestRunVersion_CheckUpToDate(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	client := &mockReleaseClient{release: &GitHubRelease{TagName: "v1.2.3"}}
+
+	require.NoError(t, runVersion(context.Background(), &buf, BuildInfo{Version: "v1.2.3"}, false, client))
+
+	assert.Contains(t, buf.String(), "up to date")
+}
+
+// TestRunVersion_CheckNetworkError tests that a failed release lookup is reported without failing.
+func TestRunVersion_CheckNetworkError(t *testing.T) {
+	t.Parallel()
+
+	client := &mockReleaseClient{err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("network is unreachable")}}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, runVersion(context.Background(), &buf, BuildInfo{Version: "1.2.3"}, true, client))
+
+		var got versionResponse
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, "1.2.3", got.Version)
+		assert.Contains(t, got.CheckError, "network is unreachable")
+		assert.Nil(t, got.UpdateAvailable)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, runVersion(context.Background(), &buf, BuildInfo{Version: "1.2.3"}, false, client))
+
+		assert.Contains(t, buf.String(), "atlas 1.2.3")
+		assert.Contains(t, buf.String(), "Could not check for updates")
+	})
+}
+
+// TestRunVersion_CheckDevBuild tests that development builds report the latest release without comparing.
+func TestRunVersion_CheckDevBuild(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	client := &mockReleaseClient{release: &GitHubRelease{TagName: "v1.3.0"}}
+
+	require.NoError(t, runVersion(context.Background(), &buf, BuildInfo{}, true, client))
+
+	var got versionResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "1.3.0", got.LatestVersion)
+	assert.Nil(t, got.UpdateAvailable)
+}
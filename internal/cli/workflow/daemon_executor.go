@@ -325,7 +325,8 @@ func (e *DaemonTaskExecutor) provisionWorkspace(
 		return "", "", fmt.Errorf("create workspace store: %w", err)
 	}
 
-	wtRunner, err := workspace.NewGitWorktreeRunner(ctx, job.RepoPath, e.logger)
+	wtRunner, err := workspace.NewGitWorktreeRunner(ctx, job.RepoPath, e.logger,
+		workspace.WithWorktreePathTemplate(cfg.Worktree.PathTemplate))
 	if err != nil {
 		return "", "", fmt.Errorf("create worktree runner: %w", err)
 	}
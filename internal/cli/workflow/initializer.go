@@ -36,6 +36,10 @@ type WorkspaceOptions struct {
 	NoInteractive bool
 	OutputFormat  string
 	ErrorHandler  func(wsName string, err error) error
+
+	// WorktreePathTemplate places the new worktree (config worktree.path_template).
+	// Empty uses the default sibling location.
+	WorktreePathTemplate string
 }
 
 // CreateWorkspace creates a new workspace or uses an existing one (upsert behavior).
@@ -52,7 +56,8 @@ func (i *Initializer) CreateWorkspace(ctx context.Context, opts WorkspaceOptions
 	}
 
 	// Create worktree runner
-	wtRunner, err := workspace.NewGitWorktreeRunner(ctx, opts.RepoPath, i.logger)
+	wtRunner, err := workspace.NewGitWorktreeRunner(ctx, opts.RepoPath, i.logger,
+		workspace.WithWorktreePathTemplate(opts.WorktreePathTemplate))
 	if err != nil {
 		return nil, opts.ErrorHandler(opts.Name, fmt.Errorf("failed to create worktree runner: %w", err))
 	}
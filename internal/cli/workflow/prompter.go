@@ -21,7 +21,8 @@ import (
 
 // Prompter handles interactive user prompts.
 type Prompter struct {
-	out tui.Output
+	out             tui.Output
+	defaultTemplate string
 }
 
 // NewPrompter creates a new Prompter.
@@ -29,7 +30,15 @@ func NewPrompter(out tui.Output) *Prompter {
 	return &Prompter{out: out}
 }
 
+// SetDefaultTemplate sets the template used when no template is specified
+// and interactive selection isn't possible (non-interactive or JSON mode).
+func (p *Prompter) SetDefaultTemplate(name string) {
+	p.defaultTemplate = name
+}
+
 // SelectTemplate handles template selection based on flags and interactivity mode.
+// Without a template flag in non-interactive mode, the configured default
+// template is used if one is set.
 func (p *Prompter) SelectTemplate(ctx context.Context, registry *template.Registry, templateName string, noInteractive bool, outputFormat string) (*domain.Template, error) {
 	// Check context cancellation
 	select {
@@ -47,8 +56,16 @@ func (p *Prompter) SelectTemplate(ctx context.Context, registry *template.Regist
 		return tmpl, nil
 	}
 
-	// Non-interactive mode or JSON output requires template flag
+	// Non-interactive mode or JSON output requires template flag or configured default
 	if noInteractive || outputFormat == "json" || !term.IsTerminal(int(os.Stdin.Fd())) { //nolint:gosec // G115: uintptr->int for term.IsTerminal, file descriptors fit in int on all supported platforms
+		if p.defaultTemplate != "" {
+			tmpl, err := registry.Get(p.defaultTemplate)
+			if err != nil {
+				return nil, atlaserrors.NewExitCode2Error(
+					fmt.Errorf("default_template '%s' from config not found: %w", p.defaultTemplate, atlaserrors.ErrTemplateNotFound))
+			}
+			return tmpl, nil
+		}
 		return nil, atlaserrors.NewExitCode2Error(
 			fmt.Errorf("use --template to specify template: %w", atlaserrors.ErrTemplateRequired))
 	}
@@ -92,21 +109,24 @@ func (p *Prompter) ResolveWorkspaceConflict(ctx context.Context, mgr *workspace.
 }
 
 // selectTemplateInteractive displays an interactive template selection menu.
+// Typing in the filter field narrows the list to templates whose name,
+// description, or defaults match.
 func (p *Prompter) selectTemplateInteractive(registry *template.Registry) (*domain.Template, error) {
 	templates := registry.List()
-	options := make([]huh.Option[string], 0, len(templates))
-	for _, t := range templates {
-		label := fmt.Sprintf("%s - %s", t.Name, t.Description)
-		options = append(options, huh.NewOption(label, t.Name))
-	}
 
-	var selected string
+	var query, selected string
 	form := huh.NewForm(
 		huh.NewGroup(
+			huh.NewInput().
+				Title("Filter templates").
+				Description("Type to narrow the list, leave empty to show all").
+				Value(&query),
 			huh.NewSelect[string]().
 				Title("Select a template").
 				Description("Choose the workflow template for this task").
-				Options(options...).
+				OptionsFunc(func() []huh.Option[string] {
+					return templatePickerOptions(templates, query)
+				}, &query).
 				Value(&selected),
 		),
 	).WithTheme(tui.AtlasTheme())
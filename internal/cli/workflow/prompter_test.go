@@ -230,6 +230,51 @@ func TestPrompter_SelectTemplate_WithBadTemplateName(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestPrompter_SelectTemplate_DefaultTemplate verifies the configured default
+// template is used when no template flag is given in non-interactive mode.
+func TestPrompter_SelectTemplate_DefaultTemplate(t *testing.T) {
+	reg, err := template.NewRegistryWithConfig("", nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("uses default in non-interactive mode", func(t *testing.T) {
+		p := NewPrompter(tui.NewOutput(io.Discard, "text"))
+		p.SetDefaultTemplate("bug")
+
+		tmpl, err := p.SelectTemplate(ctx, reg, "", true, "text")
+		require.NoError(t, err)
+		assert.Equal(t, "bug", tmpl.Name)
+	})
+
+	t.Run("uses default with JSON output", func(t *testing.T) {
+		p := NewPrompter(tui.NewOutput(io.Discard, "json"))
+		p.SetDefaultTemplate("bug")
+
+		tmpl, err := p.SelectTemplate(ctx, reg, "", false, "json")
+		require.NoError(t, err)
+		assert.Equal(t, "bug", tmpl.Name)
+	})
+
+	t.Run("explicit flag overrides default", func(t *testing.T) {
+		p := NewPrompter(tui.NewOutput(io.Discard, "text"))
+		p.SetDefaultTemplate("bug")
+
+		tmpl, err := p.SelectTemplate(ctx, reg, "task", true, "text")
+		require.NoError(t, err)
+		assert.Equal(t, "task", tmpl.Name)
+	})
+
+	t.Run("unknown default returns error", func(t *testing.T) {
+		p := NewPrompter(tui.NewOutput(io.Discard, "text"))
+		p.SetDefaultTemplate("no-such-template-xyz")
+
+		_, err := p.SelectTemplate(ctx, reg, "", true, "text")
+		require.Error(t, err)
+		require.ErrorIs(t, err, atlaserrors.ErrTemplateNotFound)
+		assert.Contains(t, err.Error(), "default_template")
+	})
+}
+
 // TestSelectTemplate_Standalone_WithNamedTemplate exercises the standalone wrapper
 // function (which previously had 0% coverage).
 func TestSelectTemplate_Standalone_WithNamedTemplate(t *testing.T) {
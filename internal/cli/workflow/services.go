@@ -5,15 +5,12 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/rs/zerolog"
 
 	"github.com/mrz1836/atlas/internal/ai"
 	"github.com/mrz1836/atlas/internal/config"
-	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	"github.com/mrz1836/atlas/internal/git"
 	"github.com/mrz1836/atlas/internal/hook"
@@ -21,6 +18,7 @@ import (
 	"github.com/mrz1836/atlas/internal/template/steps"
 	"github.com/mrz1836/atlas/internal/tui"
 	"github.com/mrz1836/atlas/internal/validation"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // GitServices holds all git-related services created for task execution.
@@ -150,6 +148,7 @@ func (f *ServiceFactory) CreateAIRunnerWithActivity(cfg *config.Config, activity
 		runnerRegistry.Register(domain.AgentGemini, ai.NewGeminiRunner(&cfg.AI, nil, ai.WithGeminiLogger(f.logger)))
 		runnerRegistry.Register(domain.AgentCodex, ai.NewCodexRunner(&cfg.AI, nil))
 	}
+	runnerRegistry.Register(domain.AgentMock, ai.NewEchoRunner())
 
 	return ai.NewMultiRunner(runnerRegistry)
 }
@@ -206,6 +205,10 @@ func (f *ServiceFactory) CreateExecutorRegistry(deps RegistryDeps) *steps.Execut
 	if deps.Notifier != nil {
 		notifier = deps.Notifier
 	}
+	var loopStore steps.LoopArtifactStore
+	if deps.TaskStore != nil {
+		loopStore = deps.TaskStore
+	}
 	return steps.NewDefaultRegistry(steps.ExecutorDeps{
 		WorkDir:                    deps.WorkDir,
 		ArtifactSaver:              deps.TaskStore,
@@ -228,6 +231,7 @@ func (f *ServiceFactory) CreateExecutorRegistry(deps RegistryDeps) *steps.Execut
 		ProgressCallback:           deps.ProgressCallback,
 		ValidationProgressCallback: deps.ValidationProgressCallback,
 		ValidationLiveOutput:       deps.ValidationLiveOutput,
+		LoopStore:                  loopStore,
 	})
 }
 
@@ -250,6 +254,9 @@ func (f *ServiceFactory) CreateEngine(deps EngineDeps, cfg *config.Config) *task
 	opts := []task.EngineOption{
 		task.WithNotifier(deps.StateNotifier),
 		task.WithOperationsConfig(&cfg.Operations),
+		task.WithWebhooks(cfg.Notifications.Webhooks, nil),
+		// --verify on a template without a verify step falls back to AI self-review
+		task.WithSelfReviewFallback(true),
 	}
 	if deps.ValidationRetryHandler != nil {
 		opts = append(opts, task.WithValidationRetryHandler(deps.ValidationRetryHandler))
@@ -296,14 +303,12 @@ func (f *ServiceFactory) CreateValidationRetryHandler(aiRunner ai.Runner, cfg *c
 // CreateHookManager creates the hook manager for crash recovery and checkpointing.
 // Returns nil if creation fails (hooks are optional, non-blocking).
 func (f *ServiceFactory) CreateHookManager(cfg *config.Config, logger zerolog.Logger) task.HookManager {
-	homeDir, err := os.UserHomeDir()
+	basePath, err := workspace.StateDir()
 	if err != nil {
-		f.logger.Warn().Err(err).Msg("failed to get home directory, hooks disabled")
+		f.logger.Warn().Err(err).Msg("failed to get state directory, hooks disabled")
 		return nil
 	}
 
-	basePath := filepath.Join(homeDir, constants.AtlasHome)
-
 	// Create markdown generator for HOOK.md
 	mdGen := hook.NewMarkdownGenerator()
 
@@ -12,7 +12,10 @@ import (
 
 	"github.com/mrz1836/atlas/internal/ai"
 	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
 	"github.com/mrz1836/atlas/internal/task"
+	"github.com/mrz1836/atlas/internal/template/steps"
 )
 
 func TestCreateHookManager(t *testing.T) {
@@ -474,3 +477,48 @@ func TestCreateEngine_WithProgressCallback(t *testing.T) {
 		_ = progressCalled
 	})
 }
+
+func TestCreateAIRunner_MockAgentRunsTask(t *testing.T) {
+	t.Run("mock agent completes a task offline", func(t *testing.T) {
+		logger := zerolog.Nop()
+		factory := NewServiceFactory(logger)
+		cfg := config.DefaultConfig()
+		cfg.AI.Agent = string(domain.AgentMock)
+		cfg.AI.Model = domain.MockModel
+
+		taskStore, err := task.NewFileStore(t.TempDir())
+		require.NoError(t, err)
+
+		worktree := t.TempDir()
+		registry := steps.NewDefaultRegistry(steps.ExecutorDeps{
+			AIRunner:      factory.CreateAIRunner(cfg),
+			WorkDir:       worktree,
+			ArtifactSaver: taskStore,
+			Logger:        logger,
+		})
+		engine := factory.CreateEngine(EngineDeps{
+			TaskStore:    taskStore,
+			ExecRegistry: registry,
+			Logger:       logger,
+		}, cfg)
+
+		tmpl := &domain.Template{
+			Name:         "mock",
+			DefaultAgent: domain.AgentMock,
+			DefaultModel: domain.MockModel,
+			Steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			},
+		}
+
+		tsk, err := engine.Start(context.Background(), "mock-ws", "feat/mock", worktree, tmpl, "Add a greeting", "")
+		require.NoError(t, err)
+		assert.Equal(t, constants.TaskStatusAwaitingApproval, tsk.Status)
+		require.Len(t, tsk.StepResults, 1)
+		assert.Equal(t, constants.StepStatusSuccess, tsk.StepResults[0].Status)
+
+		data, err := os.ReadFile(filepath.Join(worktree, ai.EchoFileName))
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "Add a greeting")
+	})
+}
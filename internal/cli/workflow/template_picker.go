@@ -0,0 +1,77 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// templatePickerLabel formats a template for the interactive picker, showing
+// its description and any default agent and model alongside the name.
+func templatePickerLabel(t *domain.Template) string {
+	label := t.Name
+	if t.Description != "" {
+		label += " - " + t.Description
+	}
+
+	var defaults []string
+	if t.DefaultAgent != "" {
+		defaults = append(defaults, string(t.DefaultAgent))
+	}
+	if t.DefaultModel != "" {
+		defaults = append(defaults, t.DefaultModel)
+	}
+	if len(defaults) > 0 {
+		label += fmt.Sprintf(" [%s]", strings.Join(defaults, "/"))
+	}
+	return label
+}
+
+// filterTemplates returns the templates matching query, preserving order.
+// The query is split into whitespace-separated terms and a template matches
+// when every term appears, case-insensitively, in its name, description,
+// default agent, or default model. An empty query matches every template.
+func filterTemplates(templates []*domain.Template, query string) []*domain.Template {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return templates
+	}
+
+	matched := make([]*domain.Template, 0, len(templates))
+	for _, t := range templates {
+		haystack := strings.ToLower(strings.Join([]string{
+			t.Name, t.Description, string(t.DefaultAgent), t.DefaultModel,
+		}, " "))
+
+		ok := true
+		for _, term := range terms {
+			if !strings.Contains(haystack, term) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, t)
+		}
+	}
+	return matched
+}
+
+// templatePickerOptions builds the picker options for the templates matching
+// query. When nothing matches, every template is offered so the selection
+// never becomes empty.
+func templatePickerOptions(templates []*domain.Template, query string) []huh.Option[string] {
+	matched := filterTemplates(templates, query)
+	if len(matched) == 0 {
+		matched = templates
+	}
+
+	options := make([]huh.Option[string], 0, len(matched))
+	for _, t := range matched {
+		options = append(options, huh.NewOption(templatePickerLabel(t), t.Name))
+	}
+	return options
+}
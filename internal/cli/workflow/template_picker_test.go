@@ -0,0 +1,75 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+func pickerTemplates() []*domain.Template {
+	return []*domain.Template{
+		{Name: "bugfix", Description: "Fix a reported bug", DefaultAgent: domain.AgentClaude, DefaultModel: "sonnet"},
+		{Name: "feature", Description: "Implement a new feature with speckit", DefaultModel: "opus"},
+		{Name: "commit", Description: "Smart commits for local changes"},
+		{Name: "docs-refresh", Description: "Update documentation", DefaultAgent: domain.AgentGemini},
+	}
+}
+
+func templateNames(templates []*domain.Template) []string {
+	names := make([]string, 0, len(templates))
+	for _, t := range templates {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+func TestFilterTemplates(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "empty query matches all", query: "", want: []string{"bugfix", "feature", "commit", "docs-refresh"}},
+		{name: "whitespace query matches all", query: "   ", want: []string{"bugfix", "feature", "commit", "docs-refresh"}},
+		{name: "matches name", query: "feat", want: []string{"feature"}},
+		{name: "matches description case-insensitively", query: "BUG", want: []string{"bugfix"}},
+		{name: "matches default model", query: "opus", want: []string{"feature"}},
+		{name: "matches default agent", query: "gemini", want: []string{"docs-refresh"}},
+		{name: "all terms must match", query: "fix sonnet", want: []string{"bugfix"}},
+		{name: "terms spanning fields", query: "commit local", want: []string{"commit"}},
+		{name: "shared term keeps order", query: "e", want: []string{"bugfix", "feature", "commit", "docs-refresh"}},
+		{name: "no match", query: "deploy", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, templateNames(filterTemplates(pickerTemplates(), tt.query)))
+		})
+	}
+}
+
+func TestTemplatePickerLabel(t *testing.T) {
+	templates := pickerTemplates()
+
+	assert.Equal(t, "bugfix - Fix a reported bug [claude/sonnet]", templatePickerLabel(templates[0]))
+	assert.Equal(t, "feature - Implement a new feature with speckit [opus]", templatePickerLabel(templates[1]))
+	assert.Equal(t, "commit - Smart commits for local changes", templatePickerLabel(templates[2]))
+	assert.Equal(t, "docs-refresh - Update documentation [gemini]", templatePickerLabel(templates[3]))
+	assert.Equal(t, "bare", templatePickerLabel(&domain.Template{Name: "bare"}))
+}
+
+func TestTemplatePickerOptions(t *testing.T) {
+	t.Run("filters options by query", func(t *testing.T) {
+		options := templatePickerOptions(pickerTemplates(), "docs")
+		assert.Len(t, options, 1)
+		assert.Equal(t, "docs-refresh", options[0].Value)
+		assert.Equal(t, "docs-refresh - Update documentation [gemini]", options[0].Key)
+	})
+
+	t.Run("falls back to all templates when nothing matches", func(t *testing.T) {
+		options := templatePickerOptions(pickerTemplates(), "deploy")
+		assert.Len(t, options, 4)
+	})
+}
@@ -11,7 +11,7 @@ func newWorkspaceCmd() *cobra.Command {
 		Use:   "workspace",
 		Short: "Manage ATLAS workspaces",
 		Long: `Commands for managing ATLAS workspaces including listing,
-destroying, and closing workspaces.
+destroying, closing, and trashing and restoring workspaces.
 
 A workspace represents an isolated development environment with its own
 git worktree and task history.`,
@@ -26,6 +26,7 @@ git worktree and task history.`,
 	addWorkspaceDestroyCmd(cmd)
 	addWorkspaceCloseCmd(cmd)
 	addWorkspaceLogsCmd(cmd)
+	addWorkspaceTrashCmds(cmd)
 
 	return cmd
 }
@@ -37,7 +37,8 @@ for reference. The closed workspace will still appear in 'workspace list'.
 Examples:
   atlas workspace close auth          # Confirm and close
   atlas workspace close auth --force  # Close without confirmation`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := runWorkspaceClose(cmd.Context(), cmd, os.Stdout, args[0], force, "")
 			// If JSON error was already output, silence cobra's error printing
@@ -364,7 +365,7 @@ func outputCloseErrorJSON(w io.Writer, name, errMsg string) error {
 	result := closeResult{
 		Status:    "error",
 		Workspace: name,
-		Error:     errMsg,
+		Error:     errors.RedactString(errMsg),
 	}
 
 	encoder := json.NewEncoder(w)
@@ -9,9 +9,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"charm.land/lipgloss/v2"
-	"github.com/charmbracelet/huh"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
@@ -25,7 +25,10 @@ import (
 
 // addWorkspaceDestroyCmd adds the destroy subcommand to the workspace command.
 func addWorkspaceDestroyCmd(parent *cobra.Command) {
-	var force bool
+	var (
+		force bool
+		yes   bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "destroy <name>",
@@ -33,14 +36,17 @@ func addWorkspaceDestroyCmd(parent *cobra.Command) {
 		Long: `Completely remove a workspace including its git worktree,
 branch, and all associated state files.
 
-This operation cannot be undone. Use --force to skip confirmation.
+This operation cannot be undone. You will be asked to type the workspace
+name to confirm. Use --yes (or --force) to skip confirmation; one of them
+is required in non-interactive mode.
 
 Examples:
   atlas workspace destroy payment           # Confirm and destroy
-  atlas workspace destroy payment --force   # Destroy without confirmation`,
-		Args: cobra.ExactArgs(1),
+  atlas workspace destroy payment --yes     # Destroy without confirmation`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := runWorkspaceDestroy(cmd.Context(), cmd, os.Stdout, args[0], force, "")
+			err := runWorkspaceDestroy(cmd.Context(), cmd, os.Stdout, args[0], force || yes, "")
 			// If JSON error was already output, silence cobra's error printing
 			// but still return error for non-zero exit code
 			if stderrors.Is(err, errors.ErrJSONErrorOutput) {
@@ -51,6 +57,7 @@ Examples:
 	}
 
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation prompt")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Confirm destruction without prompting (required in non-interactive mode)")
 
 	parent.AddCommand(cmd)
 }
@@ -87,7 +94,8 @@ func runWorkspaceDestroyWithOutput(ctx context.Context, w io.Writer, name string
 	}
 
 	// Handle confirmation if needed
-	if err := handleConfirmation(name, force, output, w); err != nil {
+	confirmed, err := handleConfirmation(name, force, output, w)
+	if err != nil || !confirmed {
 		return err
 	}
 
@@ -137,35 +145,29 @@ func handleWorkspaceNotFound(name, output string, w io.Writer) error {
 }
 
 // handleConfirmation handles the user confirmation flow.
-// Returns nil if confirmed or force is true, error otherwise.
-func handleConfirmation(name string, force bool, output string, w io.Writer) error {
+// Returns true if the destroy should proceed: force is set or the user typed
+// the workspace name. Returns false with a nil error if the user declined.
+func handleConfirmation(name string, force bool, output string, w io.Writer) (bool, error) {
 	if force {
-		return nil
-	}
-
-	if !terminalCheck() {
-		if output == OutputJSON {
-			_ = outputDestroyErrorJSON(w, name, "cannot destroy workspace: use --force in non-interactive mode")
-			return errors.ErrJSONErrorOutput
-		}
-		return fmt.Errorf("cannot destroy workspace '%s': %w", name, errors.ErrNonInteractiveMode)
+		return true, nil
 	}
 
-	confirmed, err := confirmDestroy(name)
+	confirmed, err := tui.ConfirmDestructive(tui.NewOutput(w, output),
+		fmt.Sprintf("Destroy workspace '%s'? This cannot be undone.", name), name)
 	if err != nil {
 		if output == OutputJSON {
-			_ = outputDestroyErrorJSON(w, name, fmt.Sprintf("failed to get confirmation: %v", err))
-			return errors.ErrJSONErrorOutput
+			_ = outputDestroyErrorJSON(w, name, fmt.Sprintf("cannot destroy workspace: %v", err))
+			return false, errors.ErrJSONErrorOutput
 		}
-		return fmt.Errorf("failed to get confirmation: %w", err)
+		return false, fmt.Errorf("cannot destroy workspace '%s': %w", name, err)
 	}
 
 	if !confirmed {
 		_, _ = fmt.Fprintln(w, "Operation canceled.")
-		return nil
+		return false, nil
 	}
 
-	return nil
+	return true, nil
 }
 
 // executeDestroy performs the actual destroy operation.
@@ -251,28 +253,6 @@ func showManualCleanupWarning(w io.Writer, output, worktreePath, branch string,
 	_, _ = fmt.Fprintf(w, "\n")
 }
 
-// confirmDestroy prompts the user for confirmation before destroying a workspace.
-func confirmDestroy(name string) (bool, error) {
-	var confirm bool
-
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewConfirm().
-				Title(fmt.Sprintf("Delete workspace '%s'?", name)).
-				Description("This cannot be undone.").
-				Affirmative("Yes, delete").
-				Negative("No, cancel").
-				Value(&confirm),
-		),
-	)
-
-	if err := form.Run(); err != nil {
-		return false, err
-	}
-
-	return confirm, nil
-}
-
 // terminalCheck is a variable for the terminal check function, allowing tests to override it.
 //
 //nolint:gochecknoglobals // Required for test injection of terminal detection
@@ -284,6 +264,7 @@ func isTerminal() bool {
 }
 
 // detectRepoPath finds the git repository root from the current working directory.
+// Inside a linked worktree it returns the main repository, where workspaces are stored.
 func detectRepoPath() (string, error) {
 	// Try current working directory
 	cwd, err := os.Getwd()
@@ -296,7 +277,12 @@ func detectRepoPath() (string, error) {
 	dir := cwd
 	for {
 		gitPath := filepath.Join(dir, ".git")
-		if _, err := os.Stat(gitPath); err == nil {
+		if info, err := os.Stat(gitPath); err == nil {
+			if !info.IsDir() {
+				if root, ok := mainRepoFromGitFile(dir, gitPath); ok {
+					return root, nil
+				}
+			}
 			return dir, nil
 		}
 
@@ -311,6 +297,29 @@ func detectRepoPath() (string, error) {
 	return "", errors.ErrNotGitRepo
 }
 
+// mainRepoFromGitFile returns the main repository root for a linked worktree,
+// whose .git file reads "gitdir: <main>/.git/worktrees/<name>".
+// Returns false for other .git files, such as submodules.
+func mainRepoFromGitFile(dir, gitPath string) (string, bool) {
+	data, err := os.ReadFile(gitPath) //#nosec G304 -- .git file found while walking up from the cwd
+	if err != nil {
+		return "", false
+	}
+	gitDir, found := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !found {
+		return "", false
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(dir, gitDir)
+	}
+	worktreesDir := filepath.Dir(filepath.Clean(gitDir))
+	if filepath.Base(worktreesDir) != "worktrees" {
+		return "", false
+	}
+	return filepath.Dir(filepath.Dir(worktreesDir)), true
+}
+
 // newWorkspaceStore creates a workspace store, using repo-scoped storage when storeBaseDir is empty.
 // When storeBaseDir is provided (typically in tests), it is used directly.
 func newWorkspaceStore(storeBaseDir string) (*workspace.FileStore, error) {
@@ -366,7 +375,7 @@ func outputDestroyErrorJSON(w io.Writer, name, errMsg string) error {
 	result := destroyResult{
 		Status:    "error",
 		Workspace: name,
-		Error:     errMsg,
+		Error:     errors.RedactString(errMsg),
 	}
 
 	encoder := json.NewEncoder(w)
@@ -361,9 +361,9 @@ func TestRunWorkspaceDestroy_NonInteractiveWithoutForce(t *testing.T) {
 	// Execute destroy WITHOUT --force in non-interactive mode
 	err = runWorkspaceDestroyWithOutput(context.Background(), &buf, "test-ws", false, tmpDir, "text")
 
-	// Should return ErrNonInteractiveMode
-	require.ErrorIs(t, err, errors.ErrNonInteractiveMode)
-	assert.Contains(t, err.Error(), "use --force in non-interactive mode")
+	// Should require --yes
+	require.ErrorIs(t, err, errors.ErrConfirmationRequired)
+	assert.Contains(t, err.Error(), "use --yes in non-interactive mode")
 
 	// Workspace should still exist (not destroyed)
 	exists, err := store.Exists(context.Background(), "test-ws")
@@ -411,7 +411,7 @@ func TestRunWorkspaceDestroy_NonInteractiveWithoutForce_JSON(t *testing.T) {
 	require.NoError(t, unmarshalErr)
 
 	assert.Equal(t, "error", result["status"])
-	assert.Contains(t, result["error"], "use --force in non-interactive mode")
+	assert.Contains(t, result["error"], "use --yes in non-interactive mode")
 }
 
 func TestIsTerminal(t *testing.T) {
@@ -585,9 +585,10 @@ func TestCheckWorkspaceExists_ExistsCheckError_JSON(t *testing.T) {
 func TestHandleConfirmation_ForceFlag(t *testing.T) {
 	var buf bytes.Buffer
 
-	// With force flag, should return nil immediately
-	err := handleConfirmation("test-ws", true, "text", &buf)
+	// With force flag, should proceed immediately
+	confirmed, err := handleConfirmation("test-ws", true, "text", &buf)
 	require.NoError(t, err)
+	assert.True(t, confirmed)
 	assert.Empty(t, buf.String())
 }
 
@@ -714,8 +715,9 @@ func TestHandleConfirmation_UserCancels(t *testing.T) {
 
 	// We can't easily test the actual confirmation dialog without user input
 	// This test just verifies the force flag path works
-	err := handleConfirmation("test-ws", true, "text", &buf)
+	confirmed, err := handleConfirmation("test-ws", true, "text", &buf)
 	require.NoError(t, err)
+	assert.True(t, confirmed)
 }
 
 func TestExecuteDestroy_WithLinkedDiscoveries(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"charm.land/lipgloss/v2"
 	"github.com/spf13/cobra"
@@ -37,30 +38,45 @@ func newTableStyles() *tableStyles {
 			Foreground(tui.AdaptiveColor{Light: lipgloss.Color("#666666"), Dark: lipgloss.Color("#888888")}),
 		// Semantic colors for workspace statuses (UX-6)
 		statusColors: map[constants.WorkspaceStatus]tui.AdaptiveColor{
-			constants.WorkspaceStatusActive: {Light: lipgloss.Color("#0087AF"), Dark: lipgloss.Color("#00D7FF")}, // Blue
-			constants.WorkspaceStatusPaused: {Light: lipgloss.Color("#585858"), Dark: lipgloss.Color("#6C6C6C")}, // Gray
-			constants.WorkspaceStatusClosed: {Light: lipgloss.Color("#585858"), Dark: lipgloss.Color("#6C6C6C")}, // Dim
+			constants.WorkspaceStatusActive:  {Light: lipgloss.Color("#0087AF"), Dark: lipgloss.Color("#00D7FF")}, // Blue
+			constants.WorkspaceStatusPaused:  {Light: lipgloss.Color("#585858"), Dark: lipgloss.Color("#6C6C6C")}, // Gray
+			constants.WorkspaceStatusClosed:  {Light: lipgloss.Color("#585858"), Dark: lipgloss.Color("#6C6C6C")}, // Dim
+			constants.WorkspaceStatusTrashed: {Light: lipgloss.Color("#585858"), Dark: lipgloss.Color("#6C6C6C")}, // Dim
 		},
 	}
 }
 
 // addWorkspaceListCmd adds the list subcommand to the workspace command.
 func addWorkspaceListCmd(parent *cobra.Command) {
+	var (
+		since, until string
+		refresh      bool
+	)
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all workspaces",
 		Long: `Display a table of all ATLAS workspaces with their status,
 branch, creation time, and task count.
 
+The table is read from the workspace index, which is kept up to date as
+workspaces change. Use --refresh to rebuild the index from the individual
+workspace files, e.g. after editing workspace state by hand. JSON output
+always reads the full workspace files.
+
 Examples:
   atlas workspace list              # Display as styled table
   atlas workspace list --output json # Display as JSON array
-  atlas workspace ls                 # Alias for list`,
+  atlas workspace ls                 # Alias for list
+  atlas workspace list --since 7d    # Workspaces created in the last week
+  atlas workspace list --refresh     # Rebuild the index before listing`,
 		Aliases: []string{"ls"},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return runWorkspaceList(cmd.Context(), cmd, os.Stdout)
 		},
 	}
+	addTimeBoundFlags(cmd, &since, &until)
+	cmd.Flags().BoolVar(&refresh, "refresh", false, "Rebuild the workspace index from disk before listing")
 	parent.AddCommand(cmd)
 }
 
@@ -78,6 +94,11 @@ func runWorkspaceList(ctx context.Context, cmd *cobra.Command, w io.Writer) erro
 	// Get output format from global flags
 	output := cmd.Flag("output").Value.String()
 
+	query, err := timeBoundsFromFlags(cmd, time.Now())
+	if err != nil {
+		return err
+	}
+
 	// Respect NO_COLOR environment variable (UX-7)
 	tui.CheckNoColor()
 
@@ -94,15 +115,12 @@ func runWorkspaceList(ctx context.Context, cmd *cobra.Command, w io.Writer) erro
 		return fmt.Errorf("failed to create workspace store: %w", err)
 	}
 
-	// Create manager (nil worktreeRunner OK for List operation)
-	mgr := workspace.NewManager(store, nil, logger)
-
-	// Get all workspaces
-	workspaces, err := mgr.List(ctx)
+	workspaces, err := listWorkspacesForOutput(ctx, store, output, getBoolFlagValue(cmd, "refresh"))
 	if err != nil {
 		logger.Debug().Err(err).Msg("failed to list workspaces")
 		return fmt.Errorf("failed to list workspaces: %w", err)
 	}
+	workspaces = filterWorkspacesByCreation(workspaces, query)
 
 	// Handle empty case
 	if len(workspaces) == 0 {
@@ -122,6 +140,48 @@ func runWorkspaceList(ctx context.Context, cmd *cobra.Command, w io.Writer) erro
 	return outputWorkspacesTable(w, workspaces)
 }
 
+// listWorkspacesForOutput returns the workspaces to list. JSON output reads
+// the full workspace files; the table is served from the workspace index,
+// rebuilt first when refresh is set.
+func listWorkspacesForOutput(ctx context.Context, store *workspace.FileStore, output string, refresh bool) ([]*domain.Workspace, error) {
+	if output == OutputJSON {
+		return store.List(ctx)
+	}
+
+	var (
+		entries []workspace.IndexEntry
+		err     error
+	)
+	if refresh {
+		entries, err = store.RebuildIndex(ctx)
+	} else {
+		entries, err = store.ListIndex(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*domain.Workspace, len(entries))
+	for i, entry := range entries {
+		workspaces[i] = entry.Workspace()
+	}
+	return workspaces, nil
+}
+
+// filterWorkspacesByCreation returns the workspaces created within the query's bounds.
+func filterWorkspacesByCreation(workspaces []*domain.Workspace, query task.Query) []*domain.Workspace {
+	if query.IsZero() {
+		return workspaces
+	}
+	matched := make([]*domain.Workspace, 0, len(workspaces))
+	for _, ws := range workspaces {
+		if query.InRange(ws.CreatedAt) {
+			matched = append(matched, ws)
+		}
+	}
+	return matched
+}
+
 // outputWorkspacesJSON outputs workspaces as JSON array.
 func outputWorkspacesJSON(w io.Writer, workspaces []*domain.Workspace) error {
 	encoder := json.NewEncoder(w)
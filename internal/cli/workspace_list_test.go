@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/task"
 	"github.com/mrz1836/atlas/internal/tui"
 	"github.com/mrz1836/atlas/internal/workspace"
 )
@@ -453,3 +456,58 @@ func TestCountActiveTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterWorkspacesByCreation(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	workspaces := []*domain.Workspace{
+		{Name: "new", CreatedAt: now.Add(-time.Hour)},
+		{Name: "old", CreatedAt: now.Add(-10 * 24 * time.Hour)},
+	}
+
+	query, err := parseTimeBounds("7d", "", now)
+	require.NoError(t, err)
+
+	filtered := filterWorkspacesByCreation(workspaces, query)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "new", filtered[0].Name)
+
+	assert.Len(t, filterWorkspacesByCreation(workspaces, task.Query{}), 2)
+}
+
+func TestListWorkspacesForOutput_IndexAndRefresh(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	store, err := workspace.NewFileStore(tmpDir)
+	require.NoError(t, err)
+
+	for _, name := range []string{"kept", "removed"} {
+		require.NoError(t, store.Create(ctx, &domain.Workspace{
+			Name:         name,
+			WorktreePath: "/tmp/" + name,
+			Branch:       "feat/" + name,
+			Status:       constants.WorkspaceStatusActive,
+		}))
+	}
+
+	table, err := listWorkspacesForOutput(ctx, store, OutputText, false)
+	require.NoError(t, err)
+	require.Len(t, table, 2)
+	assert.Equal(t, "feat/kept", table[0].Branch)
+
+	// Remove a workspace without going through the store
+	require.NoError(t, os.RemoveAll(filepath.Join(tmpDir, constants.WorkspacesDir, "removed")))
+
+	stale, err := listWorkspacesForOutput(ctx, store, OutputText, false)
+	require.NoError(t, err)
+	assert.Len(t, stale, 2, "table should be served from the index")
+
+	refreshed, err := listWorkspacesForOutput(ctx, store, OutputText, true)
+	require.NoError(t, err)
+	require.Len(t, refreshed, 1)
+	assert.Equal(t, "kept", refreshed[0].Name)
+
+	full, err := listWorkspacesForOutput(ctx, store, OutputJSON, false)
+	require.NoError(t, err)
+	require.Len(t, full, 1)
+	assert.Equal(t, "/tmp/kept", full[0].WorktreePath, "JSON should read full workspace files")
+}
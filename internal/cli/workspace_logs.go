@@ -113,7 +113,8 @@ Examples:
   atlas workspace logs auth --step validate  # Filter by step
   atlas workspace logs auth --task task-550e8400-e29b-41d4-a716-446655440000  # Specific task
   atlas workspace logs auth --tail 50  # Last 50 lines only`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := runWorkspaceLogs(cmd.Context(), cmd, os.Stdout, args[0], logsOptions{
 				follow:   follow,
@@ -541,7 +542,7 @@ func outputLogsErrorJSON(w io.Writer, name, taskID, errMsg string) error {
 		Status:    "error",
 		Workspace: name,
 		TaskID:    taskID,
-		Error:     errMsg,
+		Error:     errors.RedactString(errMsg),
 	}
 
 	encoder := json.NewEncoder(w)
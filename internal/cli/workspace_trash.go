@@ -0,0 +1,262 @@
+// Package cli provides the command-line interface for atlas.
+package cli
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/tui"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// workspaceTrasher soft-deletes, restores, and purges workspaces.
+type workspaceTrasher interface {
+	Trash(ctx context.Context, name string) error
+	Restore(ctx context.Context, name string) (*domain.Workspace, error)
+	PurgeTrash(ctx context.Context) ([]string, error)
+}
+
+// trashResponse is the JSON output of the trash, restore, and purge-trash commands.
+type trashResponse struct {
+	Status       string   `json:"status"`
+	Workspace    string   `json:"workspace,omitempty"`
+	WorktreePath string   `json:"worktree_path,omitempty"`
+	Purged       []string `json:"purged,omitempty"`
+	Error        string   `json:"error,omitempty"`
+}
+
+// addWorkspaceTrashCmds adds the trash, restore, and purge-trash subcommands
+// to the workspace command.
+func addWorkspaceTrashCmds(parent *cobra.Command) {
+	parent.AddCommand(newWorkspaceTrashCmd(), newWorkspaceRestoreCmd(), newWorkspacePurgeTrashCmd())
+}
+
+// newWorkspaceTrashCmd creates the workspace trash command.
+func newWorkspaceTrashCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "trash <name>",
+		Short: "Move a workspace to the trash so it can be restored later",
+		Long: `Remove a workspace's git worktree and mark it trashed, keeping its record,
+task history, and branch so 'atlas workspace restore' can bring it back
+within the retention window (7 days). Workspaces trashed longer ago than
+that are purged at the same time.
+
+Only committed work survives: a worktree with uncommitted changes is
+refused. Commit or stash them first.
+
+Examples:
+  atlas workspace trash auth`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrashCommand(cmd, func(ctx context.Context, w io.Writer, output string, mgr workspaceTrasher) error {
+				return runWorkspaceTrash(ctx, w, args[0], output, mgr)
+			})
+		},
+	}
+}
+
+// newWorkspaceRestoreCmd creates the workspace restore command.
+func newWorkspaceRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <name>",
+		Short: "Restore a trashed workspace",
+		Long: `Return a trashed workspace to the status it had before it was trashed,
+recreating its git worktree on the workspace's branch.
+
+Examples:
+  atlas workspace restore auth`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeWorkspaceNames,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrashCommand(cmd, func(ctx context.Context, w io.Writer, output string, mgr workspaceTrasher) error {
+				return runWorkspaceRestore(ctx, w, args[0], output, mgr)
+			})
+		},
+	}
+}
+
+// newWorkspacePurgeTrashCmd creates the workspace purge-trash command.
+func newWorkspacePurgeTrashCmd() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "purge-trash",
+		Short: "Permanently destroy trashed workspaces past the retention window",
+		Long: `Permanently destroy every trashed workspace that was trashed more than
+the retention window (7 days) ago, deleting its record, task history,
+and branch. 'atlas workspace trash' also purges them each time it runs.
+
+Examples:
+  atlas workspace purge-trash          # Confirm and purge
+  atlas workspace purge-trash --force  # Purge without confirmation`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runTrashCommand(cmd, func(ctx context.Context, w io.Writer, output string, mgr workspaceTrasher) error {
+				return runWorkspacePurgeTrash(ctx, w, force, output, mgr)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// runTrashCommand builds the workspace manager for the current repository
+// and runs fn with it, silencing cobra's error output once JSON was written.
+func runTrashCommand(cmd *cobra.Command, fn func(context.Context, io.Writer, string, workspaceTrasher) error) error {
+	output := cmd.Flag("output").Value.String()
+	tui.CheckNoColor()
+
+	mgr, err := newTrashManager(cmd.Context())
+	if err == nil {
+		err = fn(cmd.Context(), os.Stdout, output, mgr)
+	}
+	if stderrors.Is(err, errors.ErrJSONErrorOutput) {
+		cmd.SilenceErrors = true
+	}
+	return err
+}
+
+// newTrashManager creates a workspace manager for the current repository.
+func newTrashManager(ctx context.Context) (workspaceTrasher, error) {
+	logger := Logger()
+
+	repoPath, err := detectRepoPath()
+	if err != nil {
+		return nil, fmt.Errorf("not in a git repository: %w", err)
+	}
+	wsStore, err := newWorkspaceStore("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace store: %w", err)
+	}
+	wtRunner, err := workspace.NewGitWorktreeRunner(ctx, repoPath, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worktree runner: %w", err)
+	}
+	return workspace.NewManager(wsStore, wtRunner, logger), nil
+}
+
+// runWorkspaceTrash moves workspace name to the trash.
+func runWorkspaceTrash(ctx context.Context, w io.Writer, name, output string, mgr workspaceTrasher) error {
+	if err := mgr.Trash(ctx, name); err != nil {
+		return trashCommandError(w, output, name, err)
+	}
+
+	if output == OutputJSON {
+		return encodeJSONIndented(w, trashResponse{Status: "trashed", Workspace: name})
+	}
+	out := tui.NewOutput(w, output)
+	out.Success(fmt.Sprintf("Workspace '%s' moved to the trash.", name))
+	out.Info(fmt.Sprintf("Run 'atlas workspace restore %s' to bring it back.", name))
+	return nil
+}
+
+// runWorkspaceRestore restores the trashed workspace name.
+func runWorkspaceRestore(ctx context.Context, w io.Writer, name, output string, mgr workspaceTrasher) error {
+	ws, err := mgr.Restore(ctx, name)
+	if err != nil {
+		return trashCommandError(w, output, name, err)
+	}
+
+	if output == OutputJSON {
+		return encodeJSONIndented(w, trashResponse{Status: "restored", Workspace: name, WorktreePath: ws.WorktreePath})
+	}
+	out := tui.NewOutput(w, output)
+	out.Success(fmt.Sprintf("Workspace '%s' restored.", name))
+	if ws.WorktreePath != "" {
+		out.Info("Worktree: " + ws.WorktreePath)
+	}
+	return nil
+}
+
+// runWorkspacePurgeTrash destroys trashed workspaces past the retention
+// window after confirmation, or right away with force.
+func runWorkspacePurgeTrash(ctx context.Context, w io.Writer, force bool, output string, mgr workspaceTrasher) error {
+	if !force {
+		if !terminalCheck() {
+			return trashCommandError(w, output, "",
+				fmt.Errorf("cannot purge trash: %w", errors.ErrNonInteractiveMode))
+		}
+		confirmed, err := confirmPurgeTrash()
+		if err != nil {
+			return trashCommandError(w, output, "", fmt.Errorf("failed to get confirmation: %w", err))
+		}
+		if !confirmed {
+			_, _ = fmt.Fprintln(w, "Operation canceled.")
+			return nil
+		}
+	}
+
+	purged, err := mgr.PurgeTrash(ctx)
+
+	if output == OutputJSON {
+		resp := trashResponse{Status: "purged", Purged: purged}
+		if err != nil {
+			resp.Status = "error"
+			resp.Error = errors.RedactString(err.Error())
+		}
+		if encErr := encodeJSONIndented(w, resp); encErr != nil {
+			return encErr
+		}
+		if err != nil {
+			return errors.ErrJSONErrorOutput
+		}
+		return nil
+	}
+
+	out := tui.NewOutput(w, output)
+	for _, name := range purged {
+		out.Info(fmt.Sprintf("Purged workspace '%s'", name))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to purge trash: %w", err)
+	}
+	if len(purged) == 0 {
+		out.Success("No trashed workspaces past the retention window.")
+		return nil
+	}
+	out.Success(fmt.Sprintf("Purged %d trashed workspace(s).", len(purged)))
+	return nil
+}
+
+// trashCommandError reports err as JSON when requested, returning
+// ErrJSONErrorOutput, or returns it unchanged.
+func trashCommandError(w io.Writer, output, name string, err error) error {
+	if output != OutputJSON {
+		return err
+	}
+	_ = encodeJSONIndented(w, trashResponse{Status: "error", Workspace: name, Error: errors.RedactString(err.Error())})
+	return errors.ErrJSONErrorOutput
+}
+
+// confirmPurgeTrash prompts the user before purging trashed workspaces.
+func confirmPurgeTrash() (bool, error) {
+	var confirm bool
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Permanently destroy trashed workspaces past the retention window?").
+				Description("Their records, task history, and branches are deleted and cannot be restored.").
+				Affirmative("Yes, purge").
+				Negative("No, cancel").
+				Value(&confirm),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return false, err
+	}
+
+	return confirm, nil
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/errors"
+)
+
+// fakeTrasher records trash operations for the trash command tests.
+type fakeTrasher struct {
+	trashErr    error
+	restored    *domain.Workspace
+	purged      []string
+	purgeErr    error
+	trashed     []string
+	purgeCalled bool
+}
+
+func (f *fakeTrasher) Trash(_ context.Context, name string) error {
+	f.trashed = append(f.trashed, name)
+	return f.trashErr
+}
+
+func (f *fakeTrasher) Restore(_ context.Context, name string) (*domain.Workspace, error) {
+	if f.restored == nil {
+		return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, errors.ErrWorkspaceNotTrashed)
+	}
+	return f.restored, nil
+}
+
+func (f *fakeTrasher) PurgeTrash(_ context.Context) ([]string, error) {
+	f.purgeCalled = true
+	return f.purged, f.purgeErr
+}
+
+func TestWorkspaceTrashCommands_Registered(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "atlas"}
+	AddGlobalFlags(rootCmd, &GlobalFlags{})
+	AddWorkspaceCommand(rootCmd)
+
+	for _, name := range []string{"trash", "restore", "purge-trash"} {
+		cmd, _, err := rootCmd.Find([]string{"workspace", name})
+		require.NoError(t, err)
+		assert.Equal(t, name, cmd.Name())
+	}
+	purge, _, err := rootCmd.Find([]string{"workspace", "purge-trash"})
+	require.NoError(t, err)
+	assert.NotNil(t, purge.Flag("force"))
+}
+
+func TestRunWorkspaceTrash(t *testing.T) {
+	t.Run("trashes the workspace", func(t *testing.T) {
+		mgr := &fakeTrasher{}
+		var buf bytes.Buffer
+
+		require.NoError(t, runWorkspaceTrash(context.Background(), &buf, "auth", OutputText, mgr))
+		assert.Equal(t, []string{"auth"}, mgr.trashed)
+		assert.Contains(t, buf.String(), "atlas workspace restore auth")
+	})
+
+	t.Run("dirty worktree is reported as JSON", func(t *testing.T) {
+		mgr := &fakeTrasher{trashErr: fmt.Errorf("failed to trash workspace 'auth': %w", errors.ErrWorktreeDirty)}
+		var buf bytes.Buffer
+
+		err := runWorkspaceTrash(context.Background(), &buf, "auth", OutputJSON, mgr)
+		require.ErrorIs(t, err, errors.ErrJSONErrorOutput)
+
+		var resp trashResponse
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+		assert.Equal(t, "error", resp.Status)
+		assert.Contains(t, resp.Error, "uncommitted changes")
+	})
+}
+
+func TestRunWorkspaceRestore(t *testing.T) {
+	mgr := &fakeTrasher{restored: &domain.Workspace{Name: "auth", WorktreePath: "/tmp/repo-auth"}}
+	var buf bytes.Buffer
+
+	require.NoError(t, runWorkspaceRestore(context.Background(), &buf, "auth", OutputJSON, mgr))
+
+	var resp trashResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, trashResponse{Status: "restored", Workspace: "auth", WorktreePath: "/tmp/repo-auth"}, resp)
+
+	err := runWorkspaceRestore(context.Background(), &buf, "auth", OutputText, &fakeTrasher{})
+	require.ErrorIs(t, err, errors.ErrWorkspaceNotTrashed)
+}
+
+func TestRunWorkspacePurgeTrash(t *testing.T) {
+	t.Run("requires force in non-interactive mode", func(t *testing.T) {
+		oldCheck := terminalCheck
+		terminalCheck = func() bool { return false }
+		t.Cleanup(func() { terminalCheck = oldCheck })
+
+		mgr := &fakeTrasher{}
+		var buf bytes.Buffer
+
+		err := runWorkspacePurgeTrash(context.Background(), &buf, false, OutputText, mgr)
+		require.ErrorIs(t, err, errors.ErrNonInteractiveMode)
+		assert.False(t, mgr.purgeCalled)
+	})
+
+	t.Run("force purges and lists the workspaces", func(t *testing.T) {
+		mgr := &fakeTrasher{purged: []string{"old", "older"}}
+		var buf bytes.Buffer
+
+		require.NoError(t, runWorkspacePurgeTrash(context.Background(), &buf, true, OutputText, mgr))
+		assert.True(t, mgr.purgeCalled)
+		assert.Contains(t, buf.String(), "Purged workspace 'old'")
+		assert.Contains(t, buf.String(), "Purged 2 trashed workspace(s).")
+	})
+}
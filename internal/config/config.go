@@ -48,6 +48,9 @@ type Config struct {
 	// Approval contains settings for approval operations (approve + merge + close).
 	Approval ApprovalConfig `yaml:"approval" mapstructure:"approval"`
 
+	// Recovery contains settings for the error recovery menu.
+	Recovery RecoveryConfig `yaml:"recovery" mapstructure:"recovery"`
+
 	// Hooks contains settings for the hook system (crash recovery & context persistence).
 	Hooks HookConfig `yaml:"hooks" mapstructure:"hooks"`
 
@@ -63,6 +66,18 @@ type Config struct {
 
 	// Queue contains settings for the daemon task queue.
 	Queue QueueConfig `yaml:"queue" mapstructure:"queue"`
+
+	// Logging contains settings for log output.
+	Logging LoggingConfig `yaml:"logging,omitempty" mapstructure:"logging"`
+}
+
+// LoggingConfig contains settings for log output.
+type LoggingConfig struct {
+	// Level is the log level of long-running commands (start, resume, and the
+	// daemon): "debug", "info", "warn", or "error". It is reloaded while they
+	// run. An explicit --log-level flag takes precedence.
+	// Default: "" (level from --verbose and --quiet)
+	Level string `yaml:"level,omitempty" mapstructure:"level"`
 }
 
 // AIConfig contains settings for AI/LLM operations.
@@ -216,6 +231,12 @@ type WorktreeConfig struct {
 	// NamingSuffix is appended to worktree directory names.
 	// Useful for identifying ATLAS-managed worktrees.
 	NamingSuffix string `yaml:"naming_suffix" mapstructure:"naming_suffix"`
+
+	// PathTemplate is where new worktrees are created. Placeholders:
+	// {repo} (repository root path), {name} (workspace name), {home} (home directory).
+	// The resolved path must be absolute.
+	// Default: "{repo}-{name}" (a sibling of the repository)
+	PathTemplate string `yaml:"path_template,omitempty" mapstructure:"path_template"`
 }
 
 // CIConfig contains settings for CI/CD integration.
@@ -305,6 +326,30 @@ type NotificationsConfig struct {
 	// Events is the list of event types that trigger notifications.
 	// Supported events: "awaiting_approval", "validation_failed", "ci_failed", "github_failed"
 	Events []string `yaml:"events" mapstructure:"events"`
+
+	// Webhooks lists endpoints that receive a JSON POST when any task
+	// completes or fails. Templates can add their own webhooks.
+	Webhooks []WebhookConfig `yaml:"webhooks,omitempty" mapstructure:"webhooks"`
+}
+
+// RecoveryConfig contains settings for recovering tasks from error states.
+type RecoveryConfig struct {
+	// DisabledActions lists recovery actions that are never offered, either
+	// in the interactive menu or through resume --action (e.g. "abandon",
+	// "rebase_retry").
+	// Default: [] (all actions offered)
+	DisabledActions []string `yaml:"disabled_actions,omitempty" mapstructure:"disabled_actions"`
+}
+
+// WebhookConfig describes an HTTP endpoint notified of task events.
+type WebhookConfig struct {
+	// URL is the http or https endpoint to POST to. It often embeds a
+	// token, so it is redacted when the config is displayed.
+	URL string `yaml:"url" mapstructure:"url" sensitive:"true"`
+
+	// Events limits delivery to these events: "task.completed", "task.failed".
+	// Default: [] (all events)
+	Events []string `yaml:"events,omitempty" mapstructure:"events"`
 }
 
 // SmartCommitConfig contains settings for smart commit message generation.
@@ -549,7 +594,7 @@ type RedisConfig struct {
 
 	// Password is the Redis AUTH password (empty for no auth).
 	// Default: ""
-	Password string `yaml:"password" mapstructure:"password"`
+	Password string `yaml:"password" mapstructure:"password" sensitive:"true"`
 
 	// KeyPrefix is the namespace prefix for all Atlas keys.
 	// Default: atlas:
@@ -295,6 +295,9 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("notifications.bell", true)
 	v.SetDefault("notifications.events", []string{"awaiting_approval", "validation_failed"})
 
+	// Logging defaults
+	v.SetDefault("logging.level", "")
+
 	// SmartCommit defaults
 	v.SetDefault("smart_commit.timeout", "30s")
 	v.SetDefault("smart_commit.max_retries", 2)
@@ -364,6 +367,9 @@ func applyOverrides(cfg, overrides *Config) {
 	if overrides.Worktree.NamingSuffix != "" {
 		cfg.Worktree.NamingSuffix = overrides.Worktree.NamingSuffix
 	}
+	if overrides.Worktree.PathTemplate != "" {
+		cfg.Worktree.PathTemplate = overrides.Worktree.PathTemplate
+	}
 
 	// CI overrides
 	if overrides.CI.Timeout != 0 {
@@ -0,0 +1,46 @@
+package config
+
+import "reflect"
+
+// RedactedValue replaces sensitive values in redacted output.
+const RedactedValue = "****"
+
+// Redacted returns a copy of cfg with every non-empty string field tagged
+// `sensitive:"true"` replaced by RedactedValue. The original is not modified.
+func Redacted(cfg *Config) *Config {
+	if cfg == nil {
+		return nil
+	}
+	out := *cfg
+	redactStruct(reflect.ValueOf(&out).Elem())
+	return &out
+}
+
+// redactStruct walks nested struct values and masks sensitive string fields.
+// Slices of structs are copied before their elements are masked, so the
+// backing arrays shared with the original config are never written to.
+// Maps and pointers are not followed.
+func redactStruct(v reflect.Value) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := v.Field(i)
+		switch {
+		case field.Kind() == reflect.Struct:
+			redactStruct(field)
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			if field.Len() == 0 || !field.CanSet() {
+				continue
+			}
+			elems := reflect.MakeSlice(field.Type(), field.Len(), field.Len())
+			reflect.Copy(elems, field)
+			for j := range elems.Len() {
+				redactStruct(elems.Index(j))
+			}
+			field.Set(elems)
+		case field.Kind() == reflect.String && t.Field(i).Tag.Get("sensitive") == "true":
+			if field.String() != "" && field.CanSet() {
+				field.SetString(RedactedValue)
+			}
+		}
+	}
+}
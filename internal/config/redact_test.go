@@ -0,0 +1,57 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRedacted_MasksSensitiveFields tests sensitive fields are masked in the copy only.
+func TestRedacted_MasksSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.Redis.Password = "hunter2"
+
+	redacted := Redacted(cfg)
+
+	require.NotNil(t, redacted)
+	assert.Equal(t, RedactedValue, redacted.Redis.Password)
+	assert.Equal(t, cfg.Redis.Addr, redacted.Redis.Addr)
+	assert.Equal(t, "hunter2", cfg.Redis.Password, "original must not be modified")
+}
+
+// TestRedacted_LeavesEmptySecrets tests unset secrets stay empty so users can
+// tell they are not configured.
+func TestRedacted_LeavesEmptySecrets(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.Redis.Password = ""
+
+	assert.Empty(t, Redacted(cfg).Redis.Password)
+}
+
+// TestRedacted_Nil tests a nil config is returned as nil.
+func TestRedacted_Nil(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, Redacted(nil))
+}
+
+// TestRedacted_MasksWebhookURLs tests sensitive fields inside slices are masked
+// without modifying the original slice.
+func TestRedacted_MasksWebhookURLs(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.Notifications.Webhooks = []WebhookConfig{{URL: "https://hooks.example.com/secret", Events: []string{"task.failed"}}}
+
+	redacted := Redacted(cfg)
+
+	require.Len(t, redacted.Notifications.Webhooks, 1)
+	assert.Equal(t, RedactedValue, redacted.Notifications.Webhooks[0].URL)
+	assert.Equal(t, []string{"task.failed"}, redacted.Notifications.Webhooks[0].Events)
+	assert.Equal(t, "https://hooks.example.com/secret", cfg.Notifications.Webhooks[0].URL, "original must not be modified")
+}
@@ -451,6 +451,12 @@ func parseGitVersion(output string) string {
 	return extractVersionWithRegex(output, gitVersionRe)
 }
 
+// ParseGitVersion extracts the version from `git --version` output.
+// Returns an empty string if no version is found.
+func ParseGitVersion(output string) string {
+	return parseGitVersion(output)
+}
+
 // parseGHVersion parses "gh version 2.62.0 (2024-11-06)" → "2.62.0"
 func parseGHVersion(output string) string {
 	return extractVersionWithRegex(output, ghVersionRe)
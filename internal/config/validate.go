@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"net/url"
+	"slices"
 	"time"
 
 	"github.com/mrz1836/atlas/internal/errors"
@@ -25,6 +27,8 @@ const (
 //   - CI poll interval must be between 1 second and 10 minutes
 //   - Git base branch must not be empty
 //   - Validation timeout must be positive
+//   - Webhook URLs must be absolute http or https URLs
+//   - Logging level must be empty, debug, info, warn, or error
 func Validate(cfg *Config) error {
 	if cfg == nil {
 		return errors.ErrConfigNil
@@ -50,6 +54,16 @@ func Validate(cfg *Config) error {
 		return fmt.Errorf("validate validation config: %w", err)
 	}
 
+	// Validate Notifications config
+	if err := validateNotificationsConfig(&cfg.Notifications); err != nil {
+		return fmt.Errorf("validate notifications config: %w", err)
+	}
+
+	// Validate Logging config
+	if err := validateLoggingConfig(&cfg.Logging); err != nil {
+		return fmt.Errorf("validate logging config: %w", err)
+	}
+
 	return nil
 }
 
@@ -115,3 +129,26 @@ func validateValidationConfig(cfg *ValidationConfig) error {
 
 	return nil
 }
+
+// validateNotificationsConfig checks Notifications-specific configuration values.
+func validateNotificationsConfig(cfg *NotificationsConfig) error {
+	for i, hook := range cfg.Webhooks {
+		u, err := url.Parse(hook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Wrapf(errors.ErrConfigInvalidNotifications,
+				"notifications.webhooks[%d].url must be an http or https URL, got %q", i, hook.URL)
+		}
+	}
+
+	return nil
+}
+
+// validateLoggingConfig checks Logging-specific configuration values.
+func validateLoggingConfig(cfg *LoggingConfig) error {
+	if cfg.Level != "" && !slices.Contains([]string{"debug", "info", "warn", "error"}, cfg.Level) {
+		return errors.Wrapf(errors.ErrConfigInvalidLogging,
+			"logging.level must be one of debug, info, warn, error, got %q", cfg.Level)
+	}
+
+	return nil
+}
@@ -655,3 +655,54 @@ func TestValidate_MultipleErrors(t *testing.T) {
 	require.ErrorIs(t, err, atlaserrors.ErrConfigInvalidAI)
 	assert.Contains(t, err.Error(), "ai.timeout must be positive")
 }
+
+// TestValidateNotificationsConfig_Webhooks tests webhook URLs must be http or https
+func TestValidateNotificationsConfig_Webhooks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "https", url: "https://hooks.example.com/atlas"},
+		{name: "http with port", url: "http://localhost:9000/hook"},
+		{name: "empty", url: "", wantErr: true},
+		{name: "no scheme", url: "hooks.example.com/atlas", wantErr: true},
+		{name: "other scheme", url: "file:///tmp/hook", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultConfig()
+			cfg.Notifications.Webhooks = []WebhookConfig{{URL: tt.url}}
+
+			err := Validate(cfg)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, atlaserrors.ErrConfigInvalidNotifications)
+			assert.Contains(t, err.Error(), "notifications.webhooks[0].url")
+		})
+	}
+}
+
+// TestValidateLoggingConfig_Level tests logging.level must be a known level
+func TestValidateLoggingConfig_Level(t *testing.T) {
+	t.Parallel()
+
+	for _, level := range []string{"", "debug", "info", "warn", "error"} {
+		cfg := DefaultConfig()
+		cfg.Logging.Level = level
+		require.NoError(t, Validate(cfg), "level %q", level)
+	}
+
+	cfg := DefaultConfig()
+	cfg.Logging.Level = "verbose"
+	err := Validate(cfg)
+	require.ErrorIs(t, err, atlaserrors.ErrConfigInvalidLogging)
+	assert.Contains(t, err.Error(), "logging.level")
+}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// DefaultWatchInterval is how often a Watcher checks the config file for changes.
+const DefaultWatchInterval = 2 * time.Second
+
+// Watcher keeps a live Config for long-running processes. It polls the
+// config file's modification time and, when it changes, reloads the file and
+// applies the settings that are safe to change at runtime:
+//   - notifications
+//   - logging.level
+//   - ai.agent, ai.model, and ai.activity_verbosity
+//
+// A reloaded config that fails validation is ignored, as are changes to any
+// other (structural) field, which take effect only after a restart.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	load     func(ctx context.Context) (*Config, error)
+
+	mu        sync.RWMutex
+	current   *Config
+	modTime   time.Time
+	listeners []func(*Config)
+}
+
+// WatcherOption is a functional option for Watcher configuration.
+type WatcherOption func(*Watcher)
+
+// WithWatchInterval sets how often the config file is checked.
+// Non-positive intervals are ignored.
+func WithWatchInterval(interval time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if interval > 0 {
+			w.interval = interval
+		}
+	}
+}
+
+// WithWatchLoader sets the function used to reload configuration when the
+// watched file changes. Defaults to Load, so the reloaded configuration keeps
+// the same global, project, and environment layering as at startup.
+func WithWatchLoader(load func(ctx context.Context) (*Config, error)) WatcherOption {
+	return func(w *Watcher) {
+		if load != nil {
+			w.load = load
+		}
+	}
+}
+
+// NewWatcher creates a Watcher for the config file at path, starting from
+// initial (the configuration the process started with).
+func NewWatcher(path string, initial *Config, opts ...WatcherOption) *Watcher {
+	w := &Watcher{
+		path:     path,
+		interval: DefaultWatchInterval,
+		current:  initial,
+		load:     Load,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if info, err := os.Stat(path); err == nil {
+		w.modTime = info.ModTime()
+	}
+	return w
+}
+
+// Config returns the live configuration. Callers must not modify it; each
+// reload replaces it with a new value.
+func (w *Watcher) Config() *Config {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.current
+}
+
+// OnReload registers fn to be called with the new configuration after each
+// reload that changed a runtime setting.
+func (w *Watcher) OnReload(fn func(*Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.listeners = append(w.listeners, fn)
+}
+
+// Run checks the config file every interval until ctx is canceled.
+// Reload failures are logged and the previous configuration is kept.
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := w.Check(ctx); err != nil {
+				zerolog.Ctx(ctx).Warn().Err(err).Str("path", w.path).Msg("config reload failed, keeping previous settings")
+			}
+		}
+	}
+}
+
+// Check reloads the config file if its modification time changed since the
+// last check and applies the runtime settings. It returns true if the live
+// configuration changed. An invalid file returns an error and leaves the
+// live configuration untouched.
+func (w *Watcher) Check(ctx context.Context) (bool, error) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, atlaserrors.Wrapf(err, "failed to stat config file: %s", w.path)
+	}
+
+	// Record the new mtime before loading so an invalid file is reported once, not every tick
+	w.mu.Lock()
+	unchanged := info.ModTime().Equal(w.modTime)
+	w.modTime = info.ModTime()
+	w.mu.Unlock()
+	if unchanged {
+		return false, nil
+	}
+
+	loaded, err := w.load(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	w.mu.Lock()
+	next := *w.current
+	applyReloadable(&next, loaded)
+	if structuralChanged(w.current, loaded) {
+		zerolog.Ctx(ctx).Warn().Str("path", w.path).
+			Msg("config changes outside notifications, logging, and AI model defaults require a restart")
+	}
+	changed := !reflect.DeepEqual(&next, w.current)
+	if changed {
+		w.current = &next
+	}
+	listeners := w.listeners
+	w.mu.Unlock()
+
+	if changed {
+		zerolog.Ctx(ctx).Info().Str("path", w.path).Msg("config reloaded")
+		for _, fn := range listeners {
+			fn(&next)
+		}
+	}
+	return changed, nil
+}
+
+// applyReloadable copies the settings that are safe to change at runtime
+// from src into dst.
+func applyReloadable(dst, src *Config) {
+	dst.Notifications = src.Notifications
+	dst.Logging = src.Logging
+	dst.AI.Agent = src.AI.Agent
+	dst.AI.Model = src.AI.Model
+	dst.AI.ActivityVerbosity = src.AI.ActivityVerbosity
+}
+
+// structuralChanged reports whether next differs from current in any setting
+// that is not reloadable.
+func structuralChanged(current, next *Config) bool {
+	a, b := *current, *next
+	applyReloadable(&a, &Config{})
+	applyReloadable(&b, &Config{})
+	return !reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,212 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeWatchedConfig writes content to path and bumps its mtime so the
+// watcher sees a change even on filesystems with coarse timestamps.
+func writeWatchedConfig(t *testing.T, path, content string, offset time.Duration) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	stamp := time.Now().Add(offset)
+	require.NoError(t, os.Chtimes(path, stamp, stamp))
+}
+
+func newTestWatcher(t *testing.T, content string) (*Watcher, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeWatchedConfig(t, path, content, 0)
+
+	initial, err := LoadFromPaths(context.Background(), path, "")
+	require.NoError(t, err)
+
+	return NewWatcher(path, initial, WithWatchLoader(func(ctx context.Context) (*Config, error) {
+		return LoadFromPaths(ctx, path, "")
+	})), path
+}
+
+// TestWatcher_Check_ReloadsModelDefault tests that a changed model default is applied
+// while structural fields keep their startup values.
+func TestWatcher_Check_ReloadsModelDefault(t *testing.T) {
+	t.Parallel()
+
+	w, path := newTestWatcher(t, "ai:\n  model: sonnet\ngit:\n  base_branch: main\n")
+
+	var notified atomic.Int32
+	w.OnReload(func(cfg *Config) {
+		notified.Add(1)
+		assert.Equal(t, "opus", cfg.AI.Model)
+	})
+
+	writeWatchedConfig(t, path, "ai:\n  model: opus\ngit:\n  base_branch: develop\n", time.Minute)
+
+	changed, err := w.Check(context.Background())
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "opus", w.Config().AI.Model)
+	assert.Equal(t, "main", w.Config().Git.BaseBranch)
+	assert.Equal(t, int32(1), notified.Load())
+}
+
+// TestWatcher_Check_ReloadsLogLevel tests that a changed logging.level is applied.
+func TestWatcher_Check_ReloadsLogLevel(t *testing.T) {
+	t.Parallel()
+
+	w, path := newTestWatcher(t, "logging:\n  level: info\n")
+
+	writeWatchedConfig(t, path, "logging:\n  level: debug\n", time.Minute)
+
+	changed, err := w.Check(context.Background())
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "debug", w.Config().Logging.Level)
+}
+
+// TestWatcher_Check_DefaultLoaderKeepsLayers tests that the default loader
+// reloads through Load, so project settings layered over the watched global
+// file are not reported as structural changes.
+func TestWatcher_Check_DefaultLoaderKeepsLayers(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+	t.Setenv("HOME", root)
+
+	globalPath := filepath.Join(root, ".atlas", "config.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(globalPath), 0o750))
+	writeWatchedConfig(t, globalPath, "ai:\n  model: sonnet\n", 0)
+
+	projectDir := filepath.Join(root, "project")
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, ".atlas"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, ".atlas", "config.yaml"),
+		[]byte("git:\n  base_branch: develop\n"), 0o600))
+	t.Chdir(projectDir)
+
+	initial, err := Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "develop", initial.Git.BaseBranch)
+
+	w := NewWatcher(globalPath, initial)
+	writeWatchedConfig(t, globalPath, "ai:\n  model: opus\n", time.Minute)
+
+	var logs bytes.Buffer
+	ctx := zerolog.New(&logs).WithContext(context.Background())
+	changed, err := w.Check(ctx)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "opus", w.Config().AI.Model)
+	assert.Equal(t, "develop", w.Config().Git.BaseBranch)
+	assert.NotContains(t, logs.String(), "require a restart")
+}
+
+// TestWatcher_Check_UnchangedFile tests that an untouched file is not reloaded.
+func TestWatcher_Check_UnchangedFile(t *testing.T) {
+	t.Parallel()
+
+	w, _ := newTestWatcher(t, "ai:\n  model: sonnet\n")
+
+	changed, err := w.Check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+// TestWatcher_Check_StructuralOnlyChange tests that a change to structural fields alone
+// leaves the live configuration untouched.
+func TestWatcher_Check_StructuralOnlyChange(t *testing.T) {
+	t.Parallel()
+
+	w, path := newTestWatcher(t, "ai:\n  model: sonnet\ngit:\n  base_branch: main\n")
+	before := w.Config()
+
+	writeWatchedConfig(t, path, "ai:\n  model: sonnet\ngit:\n  base_branch: develop\n", time.Minute)
+
+	changed, err := w.Check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Same(t, before, w.Config())
+}
+
+// TestWatcher_Check_InvalidConfigKeepsCurrent tests that a file failing validation
+// is rejected and the previous settings stay live.
+func TestWatcher_Check_InvalidConfigKeepsCurrent(t *testing.T) {
+	t.Parallel()
+
+	w, path := newTestWatcher(t, "ai:\n  model: sonnet\n")
+
+	writeWatchedConfig(t, path, "ai:\n  model: opus\n  max_turns: 500\n", time.Minute)
+
+	changed, err := w.Check(context.Background())
+	require.Error(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, "sonnet", w.Config().AI.Model)
+
+	// The same invalid file is not reported again on the next check
+	changed, err = w.Check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+// TestWatcher_Check_MissingFile tests that a removed config file is ignored.
+func TestWatcher_Check_MissingFile(t *testing.T) {
+	t.Parallel()
+
+	w, path := newTestWatcher(t, "ai:\n  model: sonnet\n")
+	require.NoError(t, os.Remove(path))
+
+	changed, err := w.Check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, "sonnet", w.Config().AI.Model)
+}
+
+// TestWatcher_Run_StopsOnCancel tests that Run picks up changes and returns when the context is canceled.
+func TestWatcher_Run_StopsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeWatchedConfig(t, path, "ai:\n  model: sonnet\n", 0)
+	initial, err := LoadFromPaths(context.Background(), path, "")
+	require.NoError(t, err)
+
+	w := NewWatcher(path, initial, WithWatchInterval(10*time.Millisecond),
+		WithWatchLoader(func(ctx context.Context) (*Config, error) {
+			return LoadFromPaths(ctx, path, "")
+		}))
+	reloaded := make(chan struct{}, 1)
+	w.OnReload(func(*Config) {
+		select {
+		case reloaded <- struct{}{}:
+		default:
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx) }()
+
+	writeWatchedConfig(t, path, "ai:\n  model: opus\n", time.Minute)
+
+	select {
+	case <-reloaded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher did not reload the config")
+	}
+	assert.Equal(t, "opus", w.Config().AI.Model)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+}
@@ -20,6 +20,15 @@ const (
 	// This directory is created in the user's home directory.
 	AtlasHome = ".atlas"
 
+	// AtlasHomeEnvVar is the environment variable that overrides where ATLAS
+	// stores workspace, task, and hook state and its logs (default ~/.atlas).
+	AtlasHomeEnvVar = "ATLAS_HOME"
+
+	// StateDirEnvVar is the environment variable that overrides the same
+	// directory as AtlasHomeEnvVar and takes precedence over it. --base-dir
+	// takes precedence over both.
+	StateDirEnvVar = "ATLAS_STATE_DIR"
+
 	// ReposDir is the directory name where per-repository data is stored.
 	// Each repository gets a subdirectory named by a hash of its path.
 	ReposDir = "repos"
@@ -140,12 +149,24 @@ const (
 	// WorkspaceFilePerm is the permission mode for workspace metadata files.
 	// Owner has read/write only.
 	WorkspaceFilePerm = 0o600
+
+	// SharedStateDirPerm is the workspace directory mode used with --shared-state.
+	// Owner and group have read/write/execute.
+	SharedStateDirPerm = 0o770
+
+	// SharedStateFilePerm is the workspace file mode used with --shared-state.
+	// Owner and group have read/write.
+	SharedStateFilePerm = 0o660
 )
 
 // Workspace name validation constants.
 const (
 	// MaxWorkspaceNameLength is the maximum allowed length for workspace names.
 	MaxWorkspaceNameLength = 255
+
+	// DefaultWorktreePathTemplate places a worktree next to its repository,
+	// e.g. /path/to/myrepo-auth for workspace "auth".
+	DefaultWorktreePathTemplate = "{repo}-{name}"
 )
 
 // Artifact filename constants for git operation results.
@@ -223,6 +244,11 @@ const (
 	// Example: "2024-01-15 14:30:00"
 	TimeFormatISO = "2006-01-02 15:04:05"
 
+	// TimeFormatDisplay is TimeFormatISO with an explicit UTC offset, used when
+	// showing stored timestamps to users in their display zone.
+	// Example: "2024-01-15 16:30:00 +02:00"
+	TimeFormatDisplay = "2006-01-02 15:04:05 -07:00"
+
 	// TimeFormatCompact is a compact format suitable for filenames and identifiers.
 	// Example: "20240115-143000"
 	TimeFormatCompact = "20060102-150405"
@@ -84,6 +84,11 @@ const (
 	// and is no longer in active use. The worktree is removed but
 	// git branch and history are preserved.
 	WorkspaceStatusClosed WorkspaceStatus = "closed"
+
+	// WorkspaceStatusTrashed indicates the workspace has been soft-deleted.
+	// The worktree is removed but the record and branch are kept so the
+	// workspace can be restored until its retention window expires.
+	WorkspaceStatusTrashed WorkspaceStatus = "trashed"
 )
 
 // String returns the string representation of the WorkspaceStatus.
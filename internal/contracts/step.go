@@ -0,0 +1,23 @@
+// Package contracts provides shared interfaces and utilities to avoid circular dependencies.
+package contracts
+
+import "context"
+
+// stepNameContextKey is the context key for the name of the executing step.
+type stepNameContextKey struct{}
+
+// WithStepName returns a new context carrying the name of the step being executed.
+// The task engine sets it before calling an executor so the executor can
+// scope its artifacts to the step.
+func WithStepName(ctx context.Context, stepName string) context.Context {
+	return context.WithValue(ctx, stepNameContextKey{}, stepName)
+}
+
+// StepNameFromContext returns the executing step's name, or "" if none is set.
+func StepNameFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	name, _ := ctx.Value(stepNameContextKey{}).(string)
+	return name
+}
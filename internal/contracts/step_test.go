@@ -0,0 +1,15 @@
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepNameFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, StepNameFromContext(context.Background()))
+	assert.Equal(t, "validate", StepNameFromContext(WithStepName(context.Background(), "validate")))
+}
@@ -15,8 +15,15 @@ const (
 
 	// AgentCodex uses the Codex CLI from OpenAI.
 	AgentCodex Agent = "codex"
+
+	// AgentMock is a built-in offline agent that makes deterministic edits
+	// instead of calling a provider. Useful for trying atlas without provider access.
+	AgentMock Agent = "mock"
 )
 
+// MockModel is the only model accepted by AgentMock.
+const MockModel = "echo"
+
 // agentConfig holds all configuration for an agent.
 // Adding a new agent only requires adding a single entry to agentConfigs.
 type agentConfig struct {
@@ -25,6 +32,7 @@ type agentConfig struct {
 	hint      string   // CLI installation hint
 	tool      string   // CLI command name
 	aliases   []string // valid short model aliases
+	builtin   bool     // runs in-process; no CLI or API key needed
 	// resolution maps short aliases to full model names.
 	// Model names change frequently. Check current models at:
 	// - Claude: https://platform.claude.com/docs/en/about-claude/models/overview
@@ -71,6 +79,12 @@ var agentConfigs = map[Agent]agentConfig{ //nolint:gochecknoglobals // Central c
 			"mini":  "gpt-5.1-codex-mini",
 		},
 	},
+	AgentMock: {
+		model:   MockModel,
+		hint:    "Built into atlas; no installation needed",
+		aliases: []string{MockModel},
+		builtin: true,
+	},
 }
 
 // String returns the string representation of the Agent.
@@ -129,6 +143,13 @@ func (a Agent) InstallHint() string {
 	return "Unknown agent"
 }
 
+// IsBuiltin returns true if the agent runs inside atlas and needs no
+// external CLI or API key.
+func (a Agent) IsBuiltin() bool {
+	cfg, ok := a.config()
+	return ok && cfg.builtin
+}
+
 // ToolName returns the CLI command name for this agent.
 func (a Agent) ToolName() string {
 	if cfg, ok := a.config(); ok {
@@ -34,6 +34,7 @@ func TestAgent_IsValid(t *testing.T) {
 		{"claude is valid", AgentClaude, true},
 		{"gemini is valid", AgentGemini, true},
 		{"codex is valid", AgentCodex, true},
+		{"mock is valid", AgentMock, true},
 		{"empty is invalid", Agent(""), false},
 		{"unknown is invalid", Agent("unknown"), false},
 		{"gpt is invalid", Agent("gpt"), false},
@@ -185,6 +186,7 @@ func TestAgent_ToolName(t *testing.T) {
 		{"claude tool name", AgentClaude, "claude"},
 		{"gemini tool name", AgentGemini, "gemini"},
 		{"codex tool name", AgentCodex, "codex"},
+		{"mock has no tool name", AgentMock, ""},
 		{"empty agent has no tool name", Agent(""), ""},
 		{"unknown agent has no tool name", Agent("unknown"), ""},
 	}
@@ -196,3 +198,10 @@ func TestAgent_ToolName(t *testing.T) {
 		})
 	}
 }
+
+func TestAgent_IsBuiltin(t *testing.T) {
+	assert.True(t, AgentMock.IsBuiltin())
+	assert.False(t, AgentClaude.IsBuiltin())
+	assert.False(t, Agent("unknown").IsBuiltin())
+	assert.Equal(t, MockModel, AgentMock.DefaultModel())
+}
@@ -816,6 +816,7 @@ func TestStepDefinition_Clone(t *testing.T) {
 		Required:    true,
 		Timeout:     10 * time.Minute,
 		RetryCount:  2,
+		Retry:       &RetryPolicy{Max: 3, Delay: 2 * time.Second},
 		Config: map[string]any{
 			"option1": "value1",
 			"option2": 123,
@@ -842,6 +843,11 @@ func TestStepDefinition_Clone(t *testing.T) {
 	// Modify original config - cloned should not be affected
 	original.Config["option1"] = "modified"
 	assert.Equal(t, "value1", cloned.Config["option1"])
+
+	// Verify deep copy of Retry
+	require.NotNil(t, cloned.Retry)
+	original.Retry.Max = 7
+	assert.Equal(t, 3, cloned.Retry.Max)
 }
 
 // TestStepDefinition_Clone_NilConfig verifies Clone handles nil Config correctly.
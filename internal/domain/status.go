@@ -71,4 +71,7 @@ const (
 
 	// WorkspaceStatusClosed indicates the workspace has been closed.
 	WorkspaceStatusClosed = constants.WorkspaceStatusClosed
+
+	// WorkspaceStatusTrashed indicates the workspace has been soft-deleted.
+	WorkspaceStatusTrashed = constants.WorkspaceStatusTrashed
 )
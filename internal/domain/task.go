@@ -47,6 +47,22 @@ type Task struct {
 	// Description is a human-readable summary of what the task does.
 	Description string `json:"description"`
 
+	// Actor identifies who or what initiated the task.
+	// Set from ATLAS_ACTOR or the engine config, defaulting to the OS username.
+	Actor string `json:"actor,omitempty"`
+
+	// BaseBranch is the branch the task's work merges into (PR base, diff base).
+	// Recorded at start so later steps don't need to re-detect it.
+	BaseBranch string `json:"base_branch,omitempty"`
+
+	// TargetBranch is the existing branch the task was started on (--target),
+	// if any. Empty when the task created its own branch.
+	TargetBranch string `json:"target_branch,omitempty"`
+
+	// Priority orders tasks within a workspace: higher values are resumed
+	// first, ties by newest first. Defaults to 0.
+	Priority int `json:"priority,omitempty"`
+
 	// Status represents the current state in the task lifecycle.
 	// Uses constants.TaskStatus values (pending, running, completed, etc.).
 	Status constants.TaskStatus `json:"status"`
@@ -60,6 +76,14 @@ type Task struct {
 	// StepResults stores the outcome of each completed step.
 	StepResults []StepResult `json:"step_results,omitempty"`
 
+	// BeforeAllResult is the outcome of the template's before_all step, if any.
+	// It is kept apart from StepResults since before_all is not a numbered step.
+	BeforeAllResult *StepResult `json:"before_all_result,omitempty"`
+
+	// AfterAllResult is the outcome of the template's after_all step, if any.
+	// It is cleared whenever before_all runs again.
+	AfterAllResult *StepResult `json:"after_all_result,omitempty"`
+
 	// Transitions records the history of status changes for audit trail.
 	Transitions []Transition `json:"transitions,omitempty"`
 
@@ -109,6 +133,11 @@ type TaskConfig struct {
 
 	// Variables are template-specific variables for this task.
 	Variables map[string]string `json:"variables,omitempty"`
+
+	// Webhooks are the template's webhooks for the current run. They are
+	// never persisted, since URLs often embed credentials; Start and Resume
+	// take them from the template.
+	Webhooks []Webhook `json:"-"`
 }
 
 // Step represents a single execution step within a task.
@@ -248,6 +277,10 @@ type Transition struct {
 
 	// Reason optionally describes why the transition happened.
 	Reason string `json:"reason,omitempty"`
+
+	// Actor identifies who or what triggered the transition
+	// (e.g., a username for manual resumes, a bot name in CI).
+	Actor string `json:"actor,omitempty"`
 }
 
 // LoopState tracks the current state of a loop step execution.
@@ -270,7 +303,8 @@ type LoopState struct {
 
 	// ExitReason explains why the loop terminated.
 	// Values: "max_iterations_reached", "exit_signal", "condition_met",
-	// "circuit_breaker_stagnation", "circuit_breaker_errors", "context_canceled".
+	// "circuit_breaker_stagnation", "circuit_breaker_errors", "no_ops_reached",
+	// "repeated_error", "context_canceled".
 	ExitReason string `json:"exit_reason,omitempty"`
 
 	// ScratchpadPath is the full path to the scratchpad file.
@@ -282,6 +316,19 @@ type LoopState struct {
 	// ConsecutiveErrors tracks consecutive iteration failures.
 	ConsecutiveErrors int `json:"consecutive_errors"`
 
+	// NoOpCount tracks consecutive successful iterations whose output
+	// contained the configured no-op signal.
+	NoOpCount int `json:"no_op_count"`
+
+	// RecentErrorFingerprints holds hashes of the errors from the most recent
+	// consecutive failed iterations, used by break_on_repeated_error.
+	RecentErrorFingerprints []string `json:"recent_error_fingerprints,omitempty"`
+
+	// CountersResetIteration is the last iteration whose inner step reset the
+	// stagnation and consecutive-error counters via
+	// Metadata["reset_loop_counters"]. Zero if no reset happened.
+	CountersResetIteration int `json:"counters_reset_iteration,omitempty"`
+
 	// ConsecutiveCheckpointErrors tracks consecutive checkpoint save failures.
 	// If this exceeds a threshold, the loop should fail to prevent data loss.
 	ConsecutiveCheckpointErrors int `json:"consecutive_checkpoint_errors"`
@@ -307,6 +354,18 @@ type IterationResult struct {
 	// ExitSignal indicates if AI signaled completion.
 	ExitSignal bool `json:"exit_signal"`
 
+	// Aborted indicates an inner step requested the loop stop with a failure
+	// by setting Metadata["abort_loop"] = true.
+	Aborted bool `json:"aborted,omitempty"`
+
+	// ResetCounters indicates an inner step asked the loop to zero its
+	// stagnation and consecutive-error counters by setting
+	// Metadata["reset_loop_counters"] = true.
+	ResetCounters bool `json:"reset_counters,omitempty"`
+
+	// NoOp indicates an inner step reported the configured no-op signal.
+	NoOp bool `json:"no_op,omitempty"`
+
 	// Duration is how long the iteration took.
 	Duration time.Duration `json:"duration"`
 
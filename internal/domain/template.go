@@ -1,7 +1,10 @@
 // Package domain provides shared domain types for the ATLAS task orchestration system.
 package domain
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // StepType categorizes the kind of execution a step performs.
 // This determines which executor handles the step.
@@ -74,6 +77,14 @@ type Template struct {
 	// Steps defines the ordered sequence of step definitions.
 	Steps []StepDefinition `json:"steps"`
 
+	// BeforeAll runs once before the first step, for shared setup.
+	// If it fails, no steps run and the task moves to an error state.
+	BeforeAll *StepDefinition `json:"before_all,omitempty"`
+
+	// AfterAll runs once after the steps finish, whether the task completed
+	// or failed, for cleanup. Its failure is logged but never fails the task.
+	AfterAll *StepDefinition `json:"after_all,omitempty"`
+
 	// ValidationCommands are run during the validation step.
 	ValidationCommands []string `json:"validation_commands,omitempty"`
 
@@ -87,6 +98,39 @@ type Template struct {
 	// VerifyModel specifies which AI model to use for verification.
 	// If empty, uses a different model family from the implementation model.
 	VerifyModel string `json:"verify_model,omitempty"`
+
+	// Requires lists executables (e.g. "gh") that must be on PATH.
+	// The engine checks them before starting a task.
+	Requires []string `json:"requires,omitempty"`
+
+	// Webhooks lists endpoints notified when a task from this template
+	// completes or fails.
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+}
+
+// Webhook event names posted to webhook endpoints.
+const (
+	// WebhookEventTaskCompleted fires when all steps finish and the task
+	// awaits approval.
+	WebhookEventTaskCompleted = "task.completed"
+
+	// WebhookEventTaskFailed fires when a step failure moves the task into
+	// an error state.
+	WebhookEventTaskFailed = "task.failed"
+)
+
+// Webhook is an HTTP endpoint that receives a JSON POST on task events.
+type Webhook struct {
+	// URL is the endpoint to POST to.
+	URL string `json:"url"`
+
+	// Events limits delivery to these event names. Empty means all events.
+	Events []string `json:"events,omitempty"`
+}
+
+// Wants reports whether the webhook subscribes to event.
+func (w Webhook) Wants(event string) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, event)
 }
 
 // StepDefinition describes a step within a template.
@@ -121,10 +165,49 @@ type StepDefinition struct {
 	// RetryCount is how many times to retry on failure.
 	RetryCount int `json:"retry_count,omitempty"`
 
+	// Retry overrides the engine's default retry policy for this step.
+	// Nil means the engine default applies.
+	Retry *RetryPolicy `json:"retry,omitempty"`
+
+	// OnFailureGoto names a step to jump back to when this step fails,
+	// instead of moving the task to an error state.
+	OnFailureGoto string `json:"on_failure_goto,omitempty"`
+
+	// OnSuccessGoto names a step to jump to when this step succeeds,
+	// instead of advancing to the next step.
+	OnSuccessGoto string `json:"on_success_goto,omitempty"`
+
+	// ContinueOnError lets the task move on to the next step when this
+	// step fails. The failure is recorded on the step and as a task warning.
+	ContinueOnError bool `json:"continue_on_error,omitempty"`
+
+	// ParallelGroup runs this step concurrently with the adjacent steps that
+	// share the same non-empty group id. The next step waits for the group.
+	ParallelGroup string `json:"parallel_group,omitempty"`
+
+	// Checkpoint marks this step as a safe point to resume from. When a task
+	// stops at a step that is not a checkpoint, resume rewinds to the nearest
+	// checkpoint before it. Nil means true.
+	Checkpoint *bool `json:"checkpoint,omitempty"`
+
 	// Config contains step-specific configuration.
 	Config map[string]any `json:"config,omitempty"`
 }
 
+// RetryPolicy controls how a failed step is re-executed.
+//
+// Example JSON representation:
+//
+//	{"max": 3, "delay": 2000000000}
+type RetryPolicy struct {
+	// Max is the number of retries after the first failed attempt.
+	// Zero disables retries.
+	Max int `json:"max"`
+
+	// Delay is the pause between attempts.
+	Delay time.Duration `json:"delay,omitempty"`
+}
+
 // TemplateVariable defines a variable that can be used in templates.
 type TemplateVariable struct {
 	// Description explains what this variable is used for.
@@ -145,6 +228,11 @@ type LoopConfig struct {
 	// When set, the loop will stop after this many iterations.
 	MaxIterations int `json:"max_iterations,omitempty"`
 
+	// AutoMaxIterations is set when max_iterations is "auto". The cap is then
+	// taken from a before step's suggested_max_iterations result metadata,
+	// clamped to a safety ceiling, with a default when none is suggested.
+	AutoMaxIterations bool `json:"auto_max_iterations,omitempty"`
+
 	// Until is a condition name that must evaluate to true to exit.
 	// Built-in conditions: "all_tests_pass", "validation_passed", "no_changes".
 	Until string `json:"until,omitempty"`
@@ -161,16 +249,66 @@ type LoopConfig struct {
 	// CircuitBreaker contains safety settings to prevent infinite loops.
 	CircuitBreaker CircuitBreakerConfig `json:"circuit_breaker,omitempty"`
 
-	// FreshContext spawns a new AI context for each iteration.
-	// Prevents context bloat over long loops.
+	// FreshContext runs each iteration without the previous iteration's output.
+	// When false, the output is carried forward in task metadata and added to
+	// AI prompts. Prevents context bloat over long loops.
 	FreshContext bool `json:"fresh_context,omitempty"`
 
 	// ScratchpadFile is the filename for cross-iteration memory (JSON format).
 	// Stored in the task artifacts directory.
 	ScratchpadFile string `json:"scratchpad_file,omitempty"`
 
+	// ScratchpadBackend selects where the scratchpad is stored:
+	// "file" (default) or "store" (task artifacts via the task store).
+	ScratchpadBackend string `json:"scratchpad_backend,omitempty"`
+
+	// SummaryFile is the path for a markdown summary written when the loop exits.
+	// It must be relative and is resolved within the worktree.
+	SummaryFile string `json:"summary_file,omitempty"`
+
+	// CommitEachIteration commits each iteration's file changes separately,
+	// so progress in long loops is recoverable and reviewable.
+	CommitEachIteration bool `json:"commit_each_iteration,omitempty"`
+
+	// CommitMessageTemplate is the commit message used with CommitEachIteration.
+	// Supports {iteration} and {summary} placeholders.
+	CommitMessageTemplate string `json:"commit_message_template,omitempty"`
+
+	// IterationDelay is the pause before each iteration after the first.
+	// It doubles after each consecutive failed iteration (capped at 32x).
+	IterationDelay time.Duration `json:"iteration_delay,omitempty"`
+
+	// IterationJitter randomizes each pause by up to ±this fraction (0-1)
+	// so concurrent loops do not hit a rate-limited provider in lockstep.
+	IterationJitter float64 `json:"iteration_jitter,omitempty"`
+
+	// NoOpSignal is a token inner steps print to report there was nothing to do.
+	// Iterations are counted as no-ops even if they touched files.
+	NoOpSignal string `json:"no_op_signal,omitempty"`
+
+	// MaxNoOps exits the loop after this many consecutive no-op iterations.
+	// Requires NoOpSignal.
+	MaxNoOps int `json:"max_noops,omitempty"`
+
+	// BreakOnRepeatedError exits the loop once this many consecutive iterations
+	// failed with an identical error, even below the consecutive-errors threshold.
+	// Zero disables the check.
+	BreakOnRepeatedError int `json:"break_on_repeated_error,omitempty"`
+
+	// BeforeSteps run once before the first iteration, e.g. to assess how
+	// much work the loop has. They are not repeated when a loop resumes.
+	BeforeSteps []StepDefinition `json:"before_steps,omitempty"`
+
 	// Steps are the inner steps to execute each iteration.
 	Steps []StepDefinition `json:"steps,omitempty"`
+
+	// AllowEmpty permits a loop without inner steps. An empty loop does
+	// nothing useful, so it is rejected unless this is set.
+	AllowEmpty bool `json:"allow_empty,omitempty"`
+
+	// Fresh ignores checkpointed loop state and starts from iteration 1,
+	// e.g. after the inner steps changed significantly.
+	Fresh bool `json:"fresh,omitempty"`
 }
 
 // CircuitBreakerConfig defines safety thresholds for loop termination.
@@ -195,6 +333,12 @@ func (t *Template) Clone() *Template {
 		copy(clone.ValidationCommands, t.ValidationCommands)
 	}
 
+	// Deep copy Requires slice
+	if t.Requires != nil {
+		clone.Requires = make([]string, len(t.Requires))
+		copy(clone.Requires, t.Requires)
+	}
+
 	// Deep copy Steps slice with nested Config maps
 	if t.Steps != nil {
 		clone.Steps = make([]StepDefinition, len(t.Steps))
@@ -214,9 +358,23 @@ func (t *Template) Clone() *Template {
 	return &clone
 }
 
+// IsCheckpoint reports whether resume may start at this step. Steps are
+// checkpoints unless Checkpoint is explicitly false.
+func (s StepDefinition) IsCheckpoint() bool {
+	return s.Checkpoint == nil || *s.Checkpoint
+}
+
 // Clone creates a deep copy of the step definition.
 func (s StepDefinition) Clone() StepDefinition {
 	clone := s
+	if s.Retry != nil {
+		retry := *s.Retry
+		clone.Retry = &retry
+	}
+	if s.Checkpoint != nil {
+		checkpoint := *s.Checkpoint
+		clone.Checkpoint = &checkpoint
+	}
 	if s.Config != nil {
 		clone.Config = make(map[string]any, len(s.Config))
 		for k, v := range s.Config {
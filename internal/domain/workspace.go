@@ -40,9 +40,13 @@ type Workspace struct {
 	Branch string `json:"branch"`
 
 	// Status is the current state of the workspace.
-	// Uses constants.WorkspaceStatus values (active, paused, closed).
+	// Uses constants.WorkspaceStatus values (active, paused, closed, trashed).
 	Status constants.WorkspaceStatus `json:"status"`
 
+	// Trash records when and from which status the workspace was trashed.
+	// Nil unless Status is trashed.
+	Trash *WorkspaceTrash `json:"trash,omitempty"`
+
 	// Tasks is the list of tasks associated with this workspace.
 	Tasks []TaskRef `json:"tasks"`
 
@@ -63,6 +67,15 @@ type Workspace struct {
 	SchemaVersion int `json:"schema_version"`
 }
 
+// WorkspaceTrash describes a soft-deleted workspace.
+type WorkspaceTrash struct {
+	// TrashedAt is when the workspace was moved to the trash.
+	TrashedAt time.Time `json:"trashed_at"`
+
+	// PreviousStatus is the status the workspace returns to on restore.
+	PreviousStatus constants.WorkspaceStatus `json:"previous_status"`
+}
+
 // TaskRef is a lightweight reference to a task within a workspace.
 // This allows workspaces to track task history without embedding
 // full task objects.
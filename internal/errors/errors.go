@@ -63,6 +63,10 @@ var (
 	// (lint, test, build) failed during task execution.
 	ErrValidationFailed = errors.New("validation failed")
 
+	// ErrMalformedValidationArtifact indicates a saved validation artifact
+	// does not have the validation result shape.
+	ErrMalformedValidationArtifact = errors.New("malformed validation artifact")
+
 	// ErrCIFailed indicates that the CI workflow completed but one or more
 	// checks did not pass.
 	ErrCIFailed = errors.New("ci workflow failed")
@@ -97,6 +101,10 @@ var (
 	// ErrBranchNotFound indicates the specified branch does not exist locally or remotely.
 	ErrBranchNotFound = errors.New("branch not found")
 
+	// ErrUnrelatedHistories indicates two branches share no common ancestor,
+	// so how far one is ahead or behind the other is undefined.
+	ErrUnrelatedHistories = errors.New("branches have unrelated histories")
+
 	// ErrNotGitRepo indicates the path is not a git repository.
 	ErrNotGitRepo = errors.New("not a git repository")
 
@@ -157,12 +165,20 @@ var (
 	// was attempted in non-interactive mode without the force flag.
 	ErrNonInteractiveMode = errors.New("use --force in non-interactive mode")
 
+	// ErrConfirmationRequired indicates that a destructive operation was attempted
+	// in non-interactive mode without the --yes flag.
+	ErrConfirmationRequired = errors.New("confirmation required: use --yes in non-interactive mode")
+
 	// ErrNoMenuOptions indicates that no options were provided to a menu.
 	ErrNoMenuOptions = errors.New("no menu options provided")
 
 	// ErrMenuCanceled indicates that the user canceled a menu operation.
 	ErrMenuCanceled = errors.New("menu canceled by user")
 
+	// ErrInvalidRecoveryAction indicates that a recovery action is unknown or
+	// not applicable to the task's current state.
+	ErrInvalidRecoveryAction = errors.New("invalid recovery action")
+
 	// ========== Configuration Errors ==========
 
 	// ErrConfigNil indicates that a nil config was passed to validation.
@@ -183,6 +199,12 @@ var (
 	// ErrConfigInvalidValidation indicates an invalid Validation configuration value.
 	ErrConfigInvalidValidation = errors.New("invalid Validation configuration")
 
+	// ErrConfigInvalidNotifications indicates an invalid Notifications configuration value.
+	ErrConfigInvalidNotifications = errors.New("invalid Notifications configuration")
+
+	// ErrConfigInvalidLogging indicates an invalid Logging configuration value.
+	ErrConfigInvalidLogging = errors.New("invalid Logging configuration")
+
 	// ErrInsecurePermissions indicates that a file has insecure permissions.
 	ErrInsecurePermissions = errors.New("insecure file permissions")
 
@@ -201,12 +223,19 @@ var (
 	// ErrInvalidDuration indicates that a duration format is invalid.
 	ErrInvalidDuration = errors.New("invalid duration format")
 
+	// ErrInvalidTimeExpression indicates a --since/--until value is neither
+	// an RFC3339 timestamp nor a relative duration.
+	ErrInvalidTimeExpression = errors.New("invalid time expression")
+
 	// ErrValueOutOfRange indicates that a value is outside the allowed range.
 	ErrValueOutOfRange = errors.New("value out of range")
 
 	// ErrInvalidModel indicates that an AI model name is invalid.
 	ErrInvalidModel = errors.New("invalid model")
 
+	// ErrStateDirNotWritable indicates the state directory (--base-dir or ATLAS_STATE_DIR) cannot be written.
+	ErrStateDirNotWritable = errors.New("state directory is not writable")
+
 	// ========== Tool Detection Errors ==========
 
 	// ErrUnknownTool indicates that an unknown tool name was specified.
@@ -218,9 +247,15 @@ var (
 	// ErrMissingRequiredTools indicates that required tools are missing or outdated.
 	ErrMissingRequiredTools = errors.New("required tools are missing or outdated")
 
+	// ErrDoctorChecksFailed indicates that one or more critical environment checks failed.
+	ErrDoctorChecksFailed = errors.New("critical environment checks failed")
+
 	// ErrNotInProjectDir indicates that --project flag was used but not in a project directory.
 	ErrNotInProjectDir = errors.New("not in a project directory")
 
+	// ErrScaffoldFileExists indicates atlas init would overwrite an existing file without --force.
+	ErrScaffoldFileExists = errors.New("scaffold file already exists")
+
 	// ========== Workspace & Worktree Errors ==========
 
 	// ErrWorkspaceExists indicates an attempt to create a workspace that already exists.
@@ -235,9 +270,22 @@ var (
 	// ErrWorkspaceHasRunningTasks indicates the workspace has tasks still running.
 	ErrWorkspaceHasRunningTasks = errors.New("workspace has running tasks")
 
+	// ErrInvalidWorkspaceArchive indicates a workspace archive is malformed or unsafe to import.
+	ErrInvalidWorkspaceArchive = errors.New("invalid workspace archive")
+
+	// ErrWorkspaceNotTrashed indicates a restore was attempted on a workspace that is not in the trash.
+	ErrWorkspaceNotTrashed = errors.New("workspace is not trashed")
+
+	// ErrWorkspaceTrashExpired indicates a trashed workspace is past its retention window and can no longer be restored.
+	ErrWorkspaceTrashExpired = errors.New("trashed workspace retention window expired")
+
 	// ErrWorktreeExists indicates the worktree path already exists.
 	ErrWorktreeExists = errors.New("worktree already exists")
 
+	// ErrWorktreePathOccupied indicates the computed worktree path already exists
+	// and is neither empty nor a prunable worktree.
+	ErrWorktreePathOccupied = errors.New("worktree path is occupied")
+
 	// ErrWorktreeNotFound indicates the requested worktree does not exist.
 	ErrWorktreeNotFound = errors.New("worktree not found")
 
@@ -247,12 +295,28 @@ var (
 	// ErrWorktreeDirty indicates the worktree has uncommitted changes.
 	ErrWorktreeDirty = errors.New("worktree has uncommitted changes")
 
+	// ErrBranchNotMerged indicates a branch has commits not merged into its
+	// upstream or HEAD, so a safe delete refused it.
+	ErrBranchNotMerged = errors.New("branch is not fully merged")
+
+	// ErrInvalidWorktreePath indicates a worktree path template resolved to a
+	// path that is not absolute or cannot be written.
+	ErrInvalidWorktreePath = errors.New("invalid worktree path")
+
 	// ErrWorktreeRunnerNotAvailable indicates the worktree runner is not configured.
 	ErrWorktreeRunnerNotAvailable = errors.New("worktree runner not available")
 
 	// ErrLockTimeout indicates a file lock could not be acquired within the timeout period.
 	ErrLockTimeout = errors.New("lock acquisition timeout")
 
+	// ErrLocked indicates another process holds the lock, e.g. a workspace
+	// that is being modified by a concurrent atlas invocation.
+	ErrLocked = errors.New("locked by another process")
+
+	// ErrReadOnly indicates a mutating operation was attempted through a
+	// read-only workspace manager.
+	ErrReadOnly = errors.New("workspace manager is read-only")
+
 	// ========== Task Errors ==========
 
 	// ErrNoTasksFound indicates that no tasks exist for a workspace.
@@ -264,16 +328,31 @@ var (
 	// ErrTaskExists indicates an attempt to create a task that already exists.
 	ErrTaskExists = errors.New("task already exists")
 
+	// ErrWorkspaceStoreNotConfigured indicates a coordinated task and workspace
+	// update was requested on a task store without a workspace store.
+	ErrWorkspaceStoreNotConfigured = errors.New("workspace store not configured")
+
 	// ErrTaskInterrupted indicates the task was interrupted by the user (Ctrl+C).
 	// The task state is saved and can be resumed with `atlas resume`.
 	ErrTaskInterrupted = errors.New("task interrupted by user")
 
+	// ErrRunTimeout indicates a start or resume run exceeded its --timeout.
+	// The task state is saved as interrupted and can be resumed with `atlas resume`.
+	ErrRunTimeout = errors.New("run exceeded timeout")
+
 	// ErrTaskAbandoned indicates the task was abandoned.
 	ErrTaskAbandoned = errors.New("task abandoned")
 
 	// ErrTaskFailed indicates the task failed during execution.
 	ErrTaskFailed = errors.New("task failed")
 
+	// ErrWebhookRejected indicates a webhook endpoint answered with a non-2xx status.
+	ErrWebhookRejected = errors.New("webhook rejected")
+
+	// ErrResumeFailureLimit indicates a task failed at the same step on too many
+	// consecutive resumes and needs manual intervention before running it again.
+	ErrResumeFailureLimit = errors.New("task keeps failing at the same step after resume")
+
 	// ErrInvalidTransition indicates an attempt to make an invalid state transition.
 	ErrInvalidTransition = errors.New("invalid state transition")
 
@@ -286,6 +365,9 @@ var (
 	// ErrExecutorNotFound indicates no executor is registered for the given step type.
 	ErrExecutorNotFound = errors.New("executor not found for step type")
 
+	// ErrStepInputTemplate indicates a step config references earlier step outputs that cannot be rendered.
+	ErrStepInputTemplate = errors.New("invalid step input template")
+
 	// ErrResumeNotImplemented indicates the resume feature is not yet implemented.
 	ErrResumeNotImplemented = errors.New("resume not yet implemented")
 
@@ -318,9 +400,16 @@ var (
 	// ErrTemplateParseError indicates the template file has invalid YAML/JSON syntax.
 	ErrTemplateParseError = errors.New("template parse error")
 
+	// ErrTemplateStepMismatch indicates a task's recorded steps no longer match
+	// its template's steps, so resuming could run the wrong step.
+	ErrTemplateStepMismatch = errors.New("template steps do not match task")
+
 	// ErrVariableRequired indicates a required template variable was not provided.
 	ErrVariableRequired = errors.New("required variable not provided")
 
+	// ErrMissingDependency indicates an executable required by a template is not on PATH.
+	ErrMissingDependency = errors.New("required executable not found")
+
 	// ========== Artifact & File Errors ==========
 
 	// ErrPathTraversal indicates an attempt to use path traversal in a filename.
@@ -349,6 +438,9 @@ var (
 	// ErrCommandTimeout indicates a command exceeded its timeout duration.
 	ErrCommandTimeout = errors.New("command timeout exceeded")
 
+	// ErrSchemaViolation indicates a JSON document does not match its schema.
+	ErrSchemaViolation = errors.New("document does not match schema")
+
 	// ErrInvalidArgument indicates that an invalid argument was provided.
 	ErrInvalidArgument = errors.New("invalid argument")
 
@@ -539,3 +631,45 @@ func IsExitCode2Error(err error) bool {
 	var e *ExitCode2Error
 	return errors.As(err, &e)
 }
+
+// TransientError wraps an error to mark the failure as temporary, so
+// retrying the same operation later may succeed.
+type TransientError struct {
+	Err error
+}
+
+// NewTransientError wraps an error to mark it as transient.
+func NewTransientError(err error) *TransientError {
+	return &TransientError{Err: err}
+}
+
+// Error implements the error interface.
+func (e *TransientError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *TransientError) Unwrap() error {
+	return e.Err
+}
+
+// IsTransient checks if an error is a temporary failure worth retrying:
+// anything wrapped with NewTransientError, a GitHub rate limit, a lock
+// timeout, or a CI polling timeout.
+func IsTransient(err error) bool {
+	var e *TransientError
+	if errors.As(err, &e) {
+		return true
+	}
+	return errors.Is(err, ErrGHRateLimited) ||
+		errors.Is(err, ErrLockTimeout) ||
+		errors.Is(err, ErrCITimeout)
+}
+
+// IsUserAbort checks if an error means the user deliberately stopped the
+// operation by abandoning the task or canceling a prompt.
+func IsUserAbort(err error) bool {
+	return errors.Is(err, ErrUserAbandoned) ||
+		errors.Is(err, ErrOperationCanceled) ||
+		errors.Is(err, ErrMenuCanceled)
+}
@@ -330,6 +330,44 @@ func TestIsExitCode2Error_Nil(t *testing.T) {
 	assert.False(t, atlaserrors.IsExitCode2Error(nil))
 }
 
+func TestTransientError_Unwrap(t *testing.T) {
+	baseErr := atlaserrors.ErrGitHubOperation
+	transientErr := atlaserrors.NewTransientError(baseErr)
+
+	assert.Equal(t, baseErr.Error(), transientErr.Error())
+	assert.Equal(t, baseErr, transientErr.Unwrap())
+	require.ErrorIs(t, transientErr, baseErr)
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"wrapper", atlaserrors.NewTransientError(atlaserrors.ErrGitOperation), true},
+		{"wrapped wrapper", atlaserrors.Wrap(atlaserrors.NewTransientError(atlaserrors.ErrGitOperation), "push"), true},
+		{"rate limited", atlaserrors.ErrGHRateLimited, true},
+		{"lock timeout", atlaserrors.Wrap(atlaserrors.ErrLockTimeout, "workspace"), true},
+		{"ci timeout", atlaserrors.ErrCITimeout, true},
+		{"other", atlaserrors.ErrValidationFailed, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, atlaserrors.IsTransient(tt.err))
+		})
+	}
+}
+
+func TestIsUserAbort(t *testing.T) {
+	assert.True(t, atlaserrors.IsUserAbort(atlaserrors.ErrUserAbandoned))
+	assert.True(t, atlaserrors.IsUserAbort(atlaserrors.Wrap(atlaserrors.ErrOperationCanceled, "prompt")))
+	assert.True(t, atlaserrors.IsUserAbort(atlaserrors.ErrMenuCanceled))
+	assert.False(t, atlaserrors.IsUserAbort(atlaserrors.ErrTaskInterrupted))
+	assert.False(t, atlaserrors.IsUserAbort(nil))
+}
+
 // TestUserMessage_NewErrorMappings tests the newly added error message mappings.
 func TestUserMessage_NewErrorMappings(t *testing.T) {
 	tests := []struct {
@@ -351,6 +389,7 @@ func TestUserMessage_NewErrorMappings(t *testing.T) {
 		{"ErrBranchExists", atlaserrors.ErrBranchExists, "already exists"},
 		{"ErrBranchNotFound", atlaserrors.ErrBranchNotFound, "does not exist"},
 		{"ErrWorktreeExists", atlaserrors.ErrWorktreeExists, "worktree already exists"},
+		{"ErrWorktreePathOccupied", atlaserrors.ErrWorktreePathOccupied, "already occupied"},
 		{"ErrWorktreeDirty", atlaserrors.ErrWorktreeDirty, "uncommitted changes"},
 		{"ErrPushAuthFailed", atlaserrors.ErrPushAuthFailed, "authentication"},
 		{"ErrPushNetworkFailed", atlaserrors.ErrPushNetworkFailed, "network"},
@@ -365,6 +404,7 @@ func TestUserMessage_NewErrorMappings(t *testing.T) {
 		{"ErrWorkspaceExists", atlaserrors.ErrWorkspaceExists, "already exists"},
 		{"ErrWorkspaceNotFound", atlaserrors.ErrWorkspaceNotFound, "not found"},
 		{"ErrTaskNotFound", atlaserrors.ErrTaskNotFound, "not found"},
+		{"ErrResumeFailureLimit", atlaserrors.ErrResumeFailureLimit, "keeps failing"},
 		{"ErrInvalidTransition", atlaserrors.ErrInvalidTransition, "Cannot transition"},
 		{"ErrTaskInterrupted", atlaserrors.ErrTaskInterrupted, "interrupted"},
 
@@ -373,6 +413,7 @@ func TestUserMessage_NewErrorMappings(t *testing.T) {
 		{"ErrConfigInvalidAI", atlaserrors.ErrConfigInvalidAI, "Invalid AI"},
 		{"ErrInvalidModel", atlaserrors.ErrInvalidModel, "Invalid AI model"},
 		{"ErrEmptyValue", atlaserrors.ErrEmptyValue, "required value"},
+		{"ErrScaffoldFileExists", atlaserrors.ErrScaffoldFileExists, "already exists"},
 
 		// Template Errors
 		{"ErrTemplateNotFound", atlaserrors.ErrTemplateNotFound, "does not exist"},
@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultiError collects several errors into one, for operations such as
+// validation or bulk cleanup that should report every failure rather than
+// stopping at the first.
+//
+// Like the standard library's errors.Join, it exposes Unwrap() []error, so
+// errors.Is and errors.As match any contained error:
+//
+//	var merr errors.MultiError
+//	for _, ws := range workspaces {
+//	    merr.Append(cleanup(ws))
+//	}
+//	if err := merr.ErrorOrNil(); err != nil {
+//	    return err
+//	}
+type MultiError struct {
+	errs []error
+}
+
+// NewMultiError returns a MultiError holding the non-nil errs,
+// or nil if there are none.
+func NewMultiError(errs ...error) error {
+	var m MultiError
+	for _, err := range errs {
+		m.Append(err)
+	}
+	return m.ErrorOrNil()
+}
+
+// Append adds err to the collection. Nil errors are ignored and a nested
+// MultiError is flattened into its contained errors.
+func (m *MultiError) Append(err error) {
+	if err == nil {
+		return
+	}
+	// Only flatten a direct MultiError; one wrapped with context keeps its message
+	if nested, ok := err.(*MultiError); ok { //nolint:errorlint // intentional direct type check
+		m.errs = append(m.errs, nested.errs...)
+		return
+	}
+	m.errs = append(m.errs, err)
+}
+
+// ErrorOrNil returns m as an error, or nil when it holds no errors.
+func (m *MultiError) ErrorOrNil() error {
+	if m == nil || len(m.errs) == 0 {
+		return nil
+	}
+	return m
+}
+
+// Len returns the number of contained errors.
+func (m *MultiError) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.errs)
+}
+
+// Errors returns a copy of the contained errors in the order they were added.
+func (m *MultiError) Errors() []error {
+	if m == nil {
+		return nil
+	}
+	return append([]error(nil), m.errs...)
+}
+
+// Error implements the error interface. A single error is returned as-is;
+// several are listed one per line under a count.
+func (m *MultiError) Error() string {
+	switch m.Len() {
+	case 0:
+		return "no errors"
+	case 1:
+		return m.errs[0].Error()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d errors occurred:", len(m.errs))
+	for _, err := range m.errs {
+		b.WriteString("\n  - ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the contained errors for errors.Is/As support.
+func (m *MultiError) Unwrap() []error {
+	return m.Errors()
+}
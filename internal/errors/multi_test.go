@@ -0,0 +1,92 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func TestMultiError_IsFindsContainedSentinel(t *testing.T) {
+	err := atlaserrors.NewMultiError(
+		fmt.Errorf("template bug: %w", atlaserrors.ErrTemplateInvalid),
+		fmt.Errorf("workspace ws-1: %w", atlaserrors.ErrWorkspaceNotFound),
+	)
+
+	require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+	assert.NotErrorIs(t, err, atlaserrors.ErrTaskNotFound)
+
+	// Still matched after being wrapped with more context
+	wrapped := fmt.Errorf("cleanup: %w", err)
+	assert.ErrorIs(t, wrapped, atlaserrors.ErrWorkspaceNotFound)
+}
+
+func TestMultiError_AsFindsContainedType(t *testing.T) {
+	loopErr := atlaserrors.NewLoopError("stagnation", 3, 0, 2, atlaserrors.ErrLoopStagnation)
+	err := atlaserrors.NewMultiError(atlaserrors.ErrEmptyValue, loopErr)
+
+	var target *atlaserrors.LoopError
+	require.ErrorAs(t, err, &target)
+	assert.Equal(t, 3, target.Iteration)
+
+	var merr *atlaserrors.MultiError
+	require.ErrorAs(t, fmt.Errorf("wrapped: %w", err), &merr)
+	assert.Equal(t, 2, merr.Len())
+}
+
+func TestMultiError_ErrorListsAll(t *testing.T) {
+	err := atlaserrors.NewMultiError(
+		errors.New("first problem"),  //nolint:err113 // test-only error
+		errors.New("second problem"), //nolint:err113 // test-only error
+		errors.New("third problem"),  //nolint:err113 // test-only error
+	)
+
+	assert.Equal(t, "3 errors occurred:\n  - first problem\n  - second problem\n  - third problem", err.Error())
+}
+
+func TestMultiError_SingleError(t *testing.T) {
+	err := atlaserrors.NewMultiError(nil, atlaserrors.ErrEmptyValue, nil)
+
+	assert.Equal(t, atlaserrors.ErrEmptyValue.Error(), err.Error())
+	assert.ErrorIs(t, err, atlaserrors.ErrEmptyValue)
+}
+
+func TestMultiError_Empty(t *testing.T) {
+	assert.NoError(t, atlaserrors.NewMultiError())
+	assert.NoError(t, atlaserrors.NewMultiError(nil, nil))
+
+	var merr atlaserrors.MultiError
+	assert.NoError(t, merr.ErrorOrNil())
+	assert.Zero(t, merr.Len())
+	assert.Empty(t, merr.Errors())
+}
+
+func TestMultiError_AppendAndErrors(t *testing.T) {
+	var merr atlaserrors.MultiError
+	merr.Append(atlaserrors.ErrEmptyValue)
+	merr.Append(nil)
+	merr.Append(atlaserrors.NewMultiError(atlaserrors.ErrTaskNotFound, atlaserrors.ErrWorkspaceNotFound))
+
+	errs := merr.Errors()
+	require.Len(t, errs, 3, "nested multi-errors are flattened")
+	assert.Equal(t, []error{atlaserrors.ErrEmptyValue, atlaserrors.ErrTaskNotFound, atlaserrors.ErrWorkspaceNotFound}, errs)
+
+	// Errors returns a copy
+	errs[0] = nil
+	assert.Equal(t, atlaserrors.ErrEmptyValue, merr.Errors()[0])
+
+	assert.Len(t, merr.Unwrap(), 3)
+}
+
+func TestMultiError_CompatibleWithJoin(t *testing.T) {
+	joined := errors.Join(atlaserrors.ErrEmptyValue, atlaserrors.ErrTaskNotFound)
+	err := atlaserrors.NewMultiError(joined, atlaserrors.ErrWorkspaceNotFound)
+
+	require.ErrorIs(t, err, atlaserrors.ErrTaskNotFound)
+	require.ErrorIs(t, errors.Join(err, atlaserrors.ErrLockTimeout), atlaserrors.ErrWorkspaceNotFound)
+}
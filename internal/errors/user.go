@@ -173,6 +173,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Check the branch name with 'git branch -a' or create it first.",
 		},
 	},
+	{
+		err: ErrUnrelatedHistories,
+		info: ErrorInfo{
+			Message: "The branch and its base share no common history.",
+			Action:  "Check the workspace's base branch, or rebase the branch onto it manually.",
+		},
+	},
 	{
 		err: ErrWorktreeExists,
 		info: ErrorInfo{
@@ -180,6 +187,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Remove the existing worktree with 'git worktree remove <path>'.",
 		},
 	},
+	{
+		err: ErrWorktreePathOccupied,
+		info: ErrorInfo{
+			Message: "The worktree location is already occupied by another directory.",
+			Action:  "Remove the directory manually, then try again.",
+		},
+	},
 	{
 		err: ErrWorktreeNotFound,
 		info: ErrorInfo{
@@ -194,6 +208,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Ensure the path points to a valid git worktree directory.",
 		},
 	},
+	{
+		err: ErrInvalidWorktreePath,
+		info: ErrorInfo{
+			Message: "The configured worktree location is not usable.",
+			Action:  "Set worktree.path_template to an absolute, writable location, e.g. '{home}/.atlas/worktrees/{name}'.",
+		},
+	},
 	{
 		err: ErrWorktreeDirty,
 		info: ErrorInfo{
@@ -328,6 +349,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Use 'atlas resume' to continue the existing task.",
 		},
 	},
+	{
+		err: ErrResumeFailureLimit,
+		info: ErrorInfo{
+			Message: "The task keeps failing at the same step after being resumed.",
+			Action:  "Fix the failing step manually, or abandon the task with 'atlas abandon'.",
+		},
+	},
 	{
 		err: ErrInvalidTransition,
 		info: ErrorInfo{
@@ -377,6 +405,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Wait and try again, or check for stuck processes.",
 		},
 	},
+	{
+		err: ErrLocked,
+		info: ErrorInfo{
+			Message: "Another atlas command is working on this workspace.",
+			Action:  "Wait for the other command to finish, then try again.",
+		},
+	},
 
 	// ===================
 	// Configuration
@@ -437,6 +472,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Use formats like '30s', '5m', '1h' for durations.",
 		},
 	},
+	{
+		err: ErrInvalidTimeExpression,
+		info: ErrorInfo{
+			Message: "Invalid time expression.",
+			Action:  "Use an RFC3339 timestamp (2024-01-15T14:30:00Z) or a relative duration like '24h' or '7d'.",
+		},
+	},
 	{
 		err: ErrValueOutOfRange,
 		info: ErrorInfo{
@@ -476,6 +518,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Check the template file for syntax errors.",
 		},
 	},
+	{
+		err: ErrMissingDependency,
+		info: ErrorInfo{
+			Message: "The template requires a tool that is not installed.",
+			Action:  "Install the tool named in the error or add it to your PATH.",
+		},
+	},
 	{
 		err: ErrTemplateFileMissing,
 		info: ErrorInfo{
@@ -490,6 +539,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Check the template file for YAML syntax errors.",
 		},
 	},
+	{
+		err: ErrTemplateStepMismatch,
+		info: ErrorInfo{
+			Message: "The template's steps changed since this task started.",
+			Action:  "Restore the original template or start a new task with the updated one.",
+		},
+	},
 	{
 		err: ErrVariableRequired,
 		info: ErrorInfo{
@@ -621,6 +677,13 @@ var errorInfoEntries = []errorEntry{
 			Action:  "Run 'atlas doctor' to check and install required tools.",
 		},
 	},
+	{
+		err: ErrScaffoldFileExists,
+		info: ErrorInfo{
+			Message: "A file atlas init would write already exists.",
+			Action:  "Move the file aside, or re-run with --force to overwrite it.",
+		},
+	},
 }
 
 // errorInfoMap provides O(1) lookup for direct sentinel error matches.
@@ -0,0 +1,68 @@
+package flock
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// Permissions for the lock file and its parent directory.
+const (
+	lockDirPerm  = 0o750
+	lockFilePerm = 0o600
+)
+
+// DirLock is a cross-process lock keyed on a directory path.
+// The lock file lives next to the directory ("<dir>.lock") so the directory
+// itself can be created or removed while the lock is held.
+type DirLock struct {
+	f *os.File
+}
+
+// LockDir acquires an exclusive lock on dir without waiting.
+// Returns ErrLocked if another process holds the lock in any mode.
+func LockDir(dir string) (*DirLock, error) {
+	return lockDir(dir, Exclusive)
+}
+
+// RLockDir acquires a shared lock on dir without waiting.
+// Any number of readers may hold it at once.
+// Returns ErrLocked if another process holds the exclusive lock.
+func RLockDir(dir string) (*DirLock, error) {
+	return lockDir(dir, Shared)
+}
+
+// lockDir opens the lock file for dir and applies lock to it.
+func lockDir(dir string, lock func(fd uintptr) error) (*DirLock, error) {
+	path := filepath.Clean(dir) + ".lock"
+	if err := os.MkdirAll(filepath.Dir(path), lockDirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, lockFilePerm) //#nosec G304 -- path is derived from a caller-controlled state directory
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	if err := lock(f.Fd()); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("%s: %w", dir, atlaserrors.ErrLocked)
+	}
+	return &DirLock{f: f}, nil
+}
+
+// Unlock releases the lock. Calling Unlock on a nil lock is a no-op.
+func (l *DirLock) Unlock() error {
+	if l == nil || l.f == nil {
+		return nil
+	}
+	f := l.f
+	l.f = nil
+	if err := Unlock(f.Fd()); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return f.Close()
+}
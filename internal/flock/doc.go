@@ -1,8 +1,10 @@
 // Package flock provides cross-platform file locking utilities.
 //
 // This package consolidates file locking logic that was previously duplicated
-// across the workspace and task packages. It provides exclusive, non-blocking
-// file locks that work on both Unix and Windows systems.
+// across the workspace and task packages. It provides exclusive and shared
+// non-blocking file locks that work on both Unix and Windows systems.
+// Readers take a shared lock so they never observe a writer's in-flight changes,
+// while writers take an exclusive lock.
 //
 // Usage:
 //
@@ -3,10 +3,12 @@
 package flock_test
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/flock"
 )
 
@@ -121,3 +123,159 @@ func TestExclusiveLock(t *testing.T) {
 		}
 	})
 }
+
+func TestSharedLock(t *testing.T) {
+	t.Parallel()
+
+	openLockFile := func(t *testing.T, path string) *os.File {
+		t.Helper()
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600) // #nosec G304 -- test code using safe temp dir
+		if err != nil {
+			t.Fatalf("failed to open lock file: %v", err)
+		}
+		t.Cleanup(func() {
+			if closeErr := f.Close(); closeErr != nil {
+				t.Errorf("failed to close file: %v", closeErr)
+			}
+		})
+		return f
+	}
+
+	t.Run("multiple shared locks can be held together", func(t *testing.T) {
+		t.Parallel()
+		lockFile := filepath.Join(t.TempDir(), "test.lock")
+		f1 := openLockFile(t, lockFile)
+		f2 := openLockFile(t, lockFile)
+
+		if err := flock.Shared(f1.Fd()); err != nil {
+			t.Fatalf("first shared lock failed: %v", err)
+		}
+		if err := flock.Shared(f2.Fd()); err != nil {
+			t.Errorf("second shared lock failed: %v", err)
+		}
+
+		if err := flock.Unlock(f1.Fd()); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+		if err := flock.Unlock(f2.Fd()); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+	})
+
+	t.Run("shared lock fails while exclusive lock is held", func(t *testing.T) {
+		t.Parallel()
+		lockFile := filepath.Join(t.TempDir(), "test.lock")
+		writer := openLockFile(t, lockFile)
+		reader := openLockFile(t, lockFile)
+
+		if err := flock.Exclusive(writer.Fd()); err != nil {
+			t.Fatalf("exclusive lock failed: %v", err)
+		}
+
+		if err := flock.Shared(reader.Fd()); err == nil {
+			t.Error("expected shared lock acquisition to fail, but it succeeded")
+		}
+
+		if err := flock.Unlock(writer.Fd()); err != nil {
+			t.Fatalf("failed to unlock: %v", err)
+		}
+
+		if err := flock.Shared(reader.Fd()); err != nil {
+			t.Errorf("expected shared lock after exclusive release, got error: %v", err)
+		}
+		if err := flock.Unlock(reader.Fd()); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+	})
+
+	t.Run("exclusive lock fails while shared lock is held", func(t *testing.T) {
+		t.Parallel()
+		lockFile := filepath.Join(t.TempDir(), "test.lock")
+		reader := openLockFile(t, lockFile)
+		writer := openLockFile(t, lockFile)
+
+		if err := flock.Shared(reader.Fd()); err != nil {
+			t.Fatalf("shared lock failed: %v", err)
+		}
+		defer func() {
+			if err := flock.Unlock(reader.Fd()); err != nil {
+				t.Errorf("failed to unlock: %v", err)
+			}
+		}()
+
+		if err := flock.Exclusive(writer.Fd()); err == nil {
+			t.Error("expected exclusive lock acquisition to fail, but it succeeded")
+		}
+	})
+}
+
+func TestLockDir(t *testing.T) {
+	t.Parallel()
+
+	t.Run("second exclusive lock fails with ErrLocked", func(t *testing.T) {
+		t.Parallel()
+		dir := filepath.Join(t.TempDir(), "ws")
+
+		l1, err := flock.LockDir(dir)
+		if err != nil {
+			t.Fatalf("expected to acquire lock, got error: %v", err)
+		}
+
+		if _, err := flock.LockDir(dir); !errors.Is(err, atlaserrors.ErrLocked) {
+			t.Errorf("expected ErrLocked, got: %v", err)
+		}
+		if _, err := flock.RLockDir(dir); !errors.Is(err, atlaserrors.ErrLocked) {
+			t.Errorf("expected ErrLocked for shared lock, got: %v", err)
+		}
+
+		if err := l1.Unlock(); err != nil {
+			t.Fatalf("failed to unlock: %v", err)
+		}
+
+		l2, err := flock.LockDir(dir)
+		if err != nil {
+			t.Fatalf("expected lock after release, got error: %v", err)
+		}
+		if err := l2.Unlock(); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+	})
+
+	t.Run("shared locks coexist", func(t *testing.T) {
+		t.Parallel()
+		dir := filepath.Join(t.TempDir(), "ws")
+
+		r1, err := flock.RLockDir(dir)
+		if err != nil {
+			t.Fatalf("first shared lock failed: %v", err)
+		}
+		r2, err := flock.RLockDir(dir)
+		if err != nil {
+			t.Fatalf("second shared lock failed: %v", err)
+		}
+		if _, err := flock.LockDir(dir); !errors.Is(err, atlaserrors.ErrLocked) {
+			t.Errorf("expected ErrLocked while readers hold the lock, got: %v", err)
+		}
+
+		if err := r1.Unlock(); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+		if err := r2.Unlock(); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+	})
+
+	t.Run("unlock is idempotent", func(t *testing.T) {
+		t.Parallel()
+		l, err := flock.LockDir(filepath.Join(t.TempDir(), "ws"))
+		if err != nil {
+			t.Fatalf("failed to lock: %v", err)
+		}
+		if err := l.Unlock(); err != nil {
+			t.Errorf("failed to unlock: %v", err)
+		}
+		if err := l.Unlock(); err != nil {
+			t.Errorf("second unlock should be a no-op, got: %v", err)
+		}
+	})
+}
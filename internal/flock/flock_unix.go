@@ -11,6 +11,13 @@ func Exclusive(fd uintptr) error {
 	return syscall.Flock(int(fd), syscall.LOCK_EX|syscall.LOCK_NB) //nolint:gosec // G115: uintptr->int for syscall, file descriptors fit in int on all supported platforms
 }
 
+// Shared acquires a shared non-blocking lock on the file descriptor.
+// Multiple holders may share the lock, but it cannot coexist with an exclusive lock.
+// Returns an error if the lock cannot be acquired immediately.
+func Shared(fd uintptr) error {
+	return syscall.Flock(int(fd), syscall.LOCK_SH|syscall.LOCK_NB) //nolint:gosec // G115: uintptr->int for syscall, file descriptors fit in int on all supported platforms
+}
+
 // Unlock releases the lock on the file descriptor.
 func Unlock(fd uintptr) error {
 	return syscall.Flock(int(fd), syscall.LOCK_UN) //nolint:gosec // G115: uintptr->int for syscall, file descriptors fit in int on all supported platforms
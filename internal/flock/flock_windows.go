@@ -26,6 +26,20 @@ func Exclusive(fd uintptr) error {
 	)
 }
 
+// Shared acquires a shared non-blocking lock on the file descriptor.
+// Multiple holders may share the lock, but it cannot coexist with an exclusive lock.
+// Returns an error if the lock cannot be acquired immediately.
+func Shared(fd uintptr) error {
+	return windows.LockFileEx(
+		windows.Handle(fd),
+		windows.LOCKFILE_FAIL_IMMEDIATELY,
+		lockReserved,
+		lockBytesLow,
+		lockBytesHigh,
+		&windows.Overlapped{},
+	)
+}
+
 // Unlock releases the lock on the file descriptor.
 func Unlock(fd uintptr) error {
 	return windows.UnlockFileEx(
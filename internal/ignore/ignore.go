@@ -0,0 +1,198 @@
+// Package ignore matches worktree paths against gitignore-style patterns
+// read from a .atlasignore file.
+//
+// Supported syntax follows .gitignore: blank lines and lines starting with #
+// are skipped, a leading ! negates a pattern, a trailing / matches directories
+// only, a leading or inner / anchors the pattern to the worktree root, and **
+// matches any number of directories. Later patterns override earlier ones, and
+// a file inside an ignored directory cannot be re-included.
+package ignore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// FileName is the name of the ignore file read from the worktree root.
+const FileName = ".atlasignore"
+
+// pattern is one parsed ignore rule.
+type pattern struct {
+	segments []string // Pattern split on "/"
+	negate   bool     // Pattern started with "!"
+	dirOnly  bool     // Pattern ended with "/"
+	anchored bool     // Pattern only matches from the root
+}
+
+// Matcher reports whether paths are excluded by a set of ignore patterns.
+// The zero value and a nil Matcher match nothing.
+type Matcher struct {
+	patterns []pattern
+}
+
+// New creates a matcher from pattern lines in .gitignore syntax.
+func New(lines []string) *Matcher {
+	m := &Matcher{}
+	for _, line := range lines {
+		if p, ok := parsePattern(line); ok {
+			m.patterns = append(m.patterns, p)
+		}
+	}
+	return m
+}
+
+// Parse reads patterns from r, one per line.
+func Parse(r io.Reader) (*Matcher, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ignore patterns: %w", err)
+	}
+	return New(lines), nil
+}
+
+// Load reads the .atlasignore file in dir. A missing file yields an empty
+// matcher, not an error.
+func Load(dir string) (*Matcher, error) {
+	f, err := os.Open(filepath.Join(dir, FileName)) //#nosec G304 -- dir is the task's worktree
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Matcher{}, nil
+		}
+		return nil, fmt.Errorf("failed to open %s: %w", FileName, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return Parse(f)
+}
+
+// Empty returns true if the matcher has no patterns.
+func (m *Matcher) Empty() bool {
+	return m == nil || len(m.patterns) == 0
+}
+
+// Match returns true if the file at rel, a path relative to the worktree
+// root, is excluded. The path may use the OS path separator.
+func (m *Matcher) Match(rel string) bool {
+	if m.Empty() {
+		return false
+	}
+
+	rel = strings.Trim(path.Clean(filepath.ToSlash(rel)), "/")
+	if rel == "" || rel == "." {
+		return false
+	}
+	parts := strings.Split(rel, "/")
+
+	// An excluded parent directory excludes everything beneath it
+	for i := 1; i < len(parts); i++ {
+		if m.matchPath(parts[:i], true) {
+			return true
+		}
+	}
+	return m.matchPath(parts, false)
+}
+
+// Filter returns the paths that are not excluded, preserving order.
+func (m *Matcher) Filter(paths []string) []string {
+	if m.Empty() {
+		return paths
+	}
+	kept := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if !m.Match(p) {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// matchPath applies every pattern in order; the last one to match decides.
+func (m *Matcher) matchPath(parts []string, isDir bool) bool {
+	excluded := false
+	for _, p := range m.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.matches(parts) {
+			excluded = !p.negate
+		}
+	}
+	return excluded
+}
+
+// parsePattern parses one line. Returns false for blank lines and comments.
+func parsePattern(line string) (pattern, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return pattern{}, false
+	}
+
+	var p pattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		line = line[1:] // escaped leading "!" or "#"
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return pattern{}, false
+	}
+
+	p.segments = strings.Split(line, "/")
+	return p, true
+}
+
+// matches reports whether the pattern matches the path segments.
+func (p pattern) matches(parts []string) bool {
+	if p.anchored {
+		return matchSegments(p.segments, parts)
+	}
+	for i := range parts {
+		if matchSegments(p.segments, parts[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSegments matches glob segments against path segments, where a "**"
+// segment matches zero or more path segments.
+func matchSegments(pat, parts []string) bool {
+	for len(pat) > 0 {
+		if pat[0] == "**" {
+			rest := pat[1:]
+			for i := 0; i <= len(parts); i++ {
+				if matchSegments(rest, parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(parts) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pat[0], parts[0]); err != nil || !ok {
+			return false
+		}
+		pat, parts = pat[1:], parts[1:]
+	}
+	return len(parts) == 0
+}
@@ -0,0 +1,125 @@
+package ignore_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/ignore"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	t.Parallel()
+
+	m := ignore.New([]string{
+		"# generated code",
+		"*.pb.go",
+		"vendor/",
+		"/build",
+		"docs/**/*.png",
+		"*.log",
+		"!keep.log",
+		"",
+	})
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"api/v1/service.pb.go", true},
+		{"service.pb.go", true},
+		{"service.go", false},
+		{"vendor/github.com/pkg/errors/errors.go", true},
+		{"internal/vendor/lib.go", true},
+		{"vendor", false}, // dir-only pattern does not match a file named vendor
+		{"build/out.bin", true},
+		{"cmd/build/main.go", false}, // anchored to the root
+		{"docs/img.png", true},
+		{"docs/a/b/img.png", true},
+		{"img.png", false},
+		{"debug.log", true},
+		{"keep.log", false},
+		{"logs/keep.log", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, m.Match(tc.path))
+		})
+	}
+}
+
+func TestMatcher_NegationCannotReincludeIgnoredDir(t *testing.T) {
+	t.Parallel()
+
+	m := ignore.New([]string{"gen/", "!gen/keep.go"})
+
+	assert.True(t, m.Match("gen/keep.go"))
+}
+
+func TestMatcher_NegationOrder(t *testing.T) {
+	t.Parallel()
+
+	// The last matching pattern wins
+	m := ignore.New([]string{"!important.txt", "*.txt"})
+
+	assert.True(t, m.Match("important.txt"))
+}
+
+func TestMatcher_Filter(t *testing.T) {
+	t.Parallel()
+
+	m := ignore.New([]string{"*.pb.go"})
+
+	got := m.Filter([]string{"a.go", "a.pb.go", "b.go"})
+
+	assert.Equal(t, []string{"a.go", "b.go"}, got)
+}
+
+func TestMatcher_NilMatchesNothing(t *testing.T) {
+	t.Parallel()
+
+	var m *ignore.Matcher
+
+	assert.True(t, m.Empty())
+	assert.False(t, m.Match("anything.go"))
+	assert.Equal(t, []string{"a.go"}, m.Filter([]string{"a.go"}))
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	m, err := ignore.Parse(strings.NewReader("*.tmp\n\\#notes\n"))
+	require.NoError(t, err)
+
+	assert.True(t, m.Match("x.tmp"))
+	assert.True(t, m.Match("#notes"))
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file yields empty matcher", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := ignore.Load(t.TempDir())
+		require.NoError(t, err)
+		assert.True(t, m.Empty())
+	})
+
+	t.Run("reads patterns from the worktree", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ignore.FileName), []byte("*.pb.go\n"), 0o600))
+
+		m, err := ignore.Load(dir)
+		require.NoError(t, err)
+		assert.True(t, m.Match("x.pb.go"))
+	})
+}
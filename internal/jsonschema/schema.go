@@ -0,0 +1,255 @@
+// Package jsonschema generates JSON Schemas (draft 2020-12) from Go types and
+// validates JSON documents against them.
+//
+// Schemas are derived from encoding/json struct tags, so they describe exactly
+// what json.Marshal produces: fields without omitempty are required, fields
+// tagged "-" are left out, embedded structs are inlined, and nil slices, maps
+// and pointers may encode as null. Validate supports the subset of keywords
+// Reflect emits: type, properties, required, additionalProperties, items and
+// format (not checked).
+package jsonschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect Reflect declares.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON Schema type names.
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+	TypeNull    = "null"
+)
+
+// Schema is a JSON Schema document or subschema.
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        Types              `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+
+	// AdditionalProperties is false for structs, which reject unknown
+	// fields, and the value schema for maps. Nil allows anything.
+	AdditionalProperties *Additional `json:"additionalProperties,omitempty"`
+}
+
+// Types is the set of JSON types a value may have. It encodes as a single
+// string when it holds one type.
+type Types []string
+
+// MarshalJSON encodes one type as a string and several as an array.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON accepts a string or an array of strings.
+func (t *Types) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = Types{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// Additional is the value of additionalProperties: either false or a schema.
+type Additional struct {
+	Schema *Schema
+}
+
+// MarshalJSON encodes a nil schema as false.
+func (a *Additional) MarshalJSON() ([]byte, error) {
+	if a.Schema == nil {
+		return []byte("false"), nil
+	}
+	return json.Marshal(a.Schema)
+}
+
+// UnmarshalJSON accepts false or a schema object. True is treated like an
+// absent keyword by leaving an empty schema, which allows anything.
+func (a *Additional) UnmarshalJSON(data []byte) error {
+	var allowed bool
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		if allowed {
+			a.Schema = &Schema{}
+		}
+		return nil
+	}
+	a.Schema = &Schema{}
+	return json.Unmarshal(data, a.Schema)
+}
+
+//nolint:gochecknoglobals // Read-only type lookups used by Reflect
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// Reflect returns the schema of the JSON encoding of v's type.
+func Reflect(v any, title string) *Schema {
+	s := newReflector().reflectType(reflect.TypeOf(v))
+	s.Schema = Draft
+	s.Title = title
+	return s
+}
+
+// reflector tracks the structs being expanded so recursive types terminate.
+type reflector struct {
+	expanding map[reflect.Type]bool
+}
+
+// newReflector returns a reflector with no structs in progress.
+func newReflector() *reflector {
+	return &reflector{expanding: make(map[reflect.Type]bool)}
+}
+
+// reflectType builds the schema for t.
+func (r *reflector) reflectType(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+	if t.Kind() == reflect.Pointer {
+		return nullable(r.reflectType(t.Elem()))
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: Types{TypeString}, Format: "date-time"}
+	case t.Implements(jsonMarshalerType), reflect.PointerTo(t).Implements(jsonMarshalerType):
+		// Custom encodings can produce anything
+		return &Schema{}
+	case t.Implements(textMarshalerType), reflect.PointerTo(t).Implements(textMarshalerType):
+		return &Schema{Type: Types{TypeString}}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: Types{TypeBoolean}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Types{TypeInteger}}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{TypeNumber}}
+	case reflect.String:
+		return &Schema{Type: Types{TypeString}}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// []byte encodes as a base64 string
+			return nullable(&Schema{Type: Types{TypeString}})
+		}
+		return nullable(&Schema{Type: Types{TypeArray}, Items: r.reflectType(t.Elem())})
+	case reflect.Array:
+		return &Schema{Type: Types{TypeArray}, Items: r.reflectType(t.Elem())}
+	case reflect.Map:
+		return nullable(&Schema{Type: Types{TypeObject}, AdditionalProperties: &Additional{Schema: r.reflectType(t.Elem())}})
+	case reflect.Struct:
+		return r.reflectStruct(t)
+	default:
+		// Interfaces and other kinds accept any value
+		return &Schema{}
+	}
+}
+
+// reflectStruct builds an object schema from a struct's exported fields.
+// A struct nested in itself is left unconstrained at the inner level.
+func (r *reflector) reflectStruct(t reflect.Type) *Schema {
+	if r.expanding[t] {
+		return &Schema{}
+	}
+	r.expanding[t] = true
+	defer delete(r.expanding, t)
+
+	s := &Schema{
+		Type:                 Types{TypeObject},
+		Properties:           make(map[string]*Schema),
+		AdditionalProperties: &Additional{},
+	}
+	r.addFields(s, t)
+	return s
+}
+
+// addFields adds t's fields to s, inlining embedded structs like encoding/json.
+func (r *reflector) addFields(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				r.addFields(s, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s.Properties[name] = r.reflectType(f.Type)
+		if !hasOption(opts, "omitempty") && !hasOption(opts, "omitzero") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// hasOption reports whether a comma-separated tag option list contains opt.
+func hasOption(opts, opt string) bool {
+	for o := range strings.SplitSeq(opts, ",") {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// nullable adds null to the types s allows. A schema without a type already
+// allows null.
+func nullable(s *Schema) *Schema {
+	if len(s.Type) > 0 && !containsType(s.Type, TypeNull) {
+		s.Type = append(s.Type, TypeNull)
+	}
+	return s
+}
+
+// containsType reports whether types includes name.
+func containsType(types Types, name string) bool {
+	for _, t := range types {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/jsonschema"
+)
+
+type base struct {
+	ID string `json:"id"`
+}
+
+type sample struct {
+	base
+
+	Name     string            `json:"name"`
+	Count    int               `json:"count"`
+	Ratio    float64           `json:"ratio,omitempty"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Parent   *base             `json:"parent,omitempty"`
+	Created  time.Time         `json:"created"`
+	Extra    any               `json:"extra,omitempty"`
+	Skipped  string            `json:"-"`
+	internal string
+}
+
+type node struct {
+	Value    int     `json:"value"`
+	Children []*node `json:"children,omitempty"`
+}
+
+func TestReflect(t *testing.T) {
+	t.Parallel()
+
+	s := jsonschema.Reflect(sample{}, "sample")
+
+	assert.Equal(t, jsonschema.Draft, s.Schema)
+	assert.Equal(t, "sample", s.Title)
+	assert.Equal(t, jsonschema.Types{jsonschema.TypeObject}, s.Type)
+	assert.Equal(t, []string{"id", "name", "count", "tags", "created"}, s.Required)
+
+	assert.Contains(t, s.Properties, "id", "embedded struct fields are inlined")
+	assert.NotContains(t, s.Properties, "Skipped")
+	assert.NotContains(t, s.Properties, "internal")
+
+	assert.Equal(t, jsonschema.Types{jsonschema.TypeInteger}, s.Properties["count"].Type)
+	assert.Equal(t, jsonschema.Types{jsonschema.TypeNumber}, s.Properties["ratio"].Type)
+	assert.Equal(t, jsonschema.Types{jsonschema.TypeArray, jsonschema.TypeNull}, s.Properties["tags"].Type)
+	assert.Equal(t, jsonschema.Types{jsonschema.TypeObject, jsonschema.TypeNull}, s.Properties["parent"].Type)
+	assert.Equal(t, "date-time", s.Properties["created"].Format)
+	assert.Empty(t, s.Properties["extra"].Type)
+}
+
+func TestReflect_RecursiveType(t *testing.T) {
+	t.Parallel()
+
+	s := jsonschema.Reflect(node{}, "node")
+	require.NotNil(t, s.Properties["children"].Items)
+	assert.Empty(t, s.Properties["children"].Items.Type, "the nested occurrence is unconstrained")
+}
+
+func TestSchema_MarshalRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	s := jsonschema.Reflect(sample{}, "sample")
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"additionalProperties":false`)
+	assert.Contains(t, string(data), `"type":["array","null"]`)
+
+	var decoded jsonschema.Schema
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, s.Required, decoded.Required)
+	require.NoError(t, decoded.Validate([]byte(`{"id":"a","name":"n","count":1,"tags":null,"created":"2026-01-02T03:04:05Z"}`)))
+}
+
+func TestSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	s := jsonschema.Reflect(sample{}, "sample")
+	valid, err := json.Marshal(sample{
+		base:    base{ID: "abc"},
+		Name:    "demo",
+		Count:   3,
+		Tags:    []string{"x"},
+		Labels:  map[string]string{"k": "v"},
+		Parent:  &base{ID: "root"},
+		Created: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Validate(valid))
+
+	tests := []struct {
+		name    string
+		doc     string
+		message string
+	}{
+		{"wrong type", `{"id":"a","name":"n","count":"3","tags":[],"created":""}`, `$.count: expected integer, got string`},
+		{"fractional integer", `{"id":"a","name":"n","count":1.5,"tags":[],"created":""}`, `$.count: expected integer, got number`},
+		{"missing required", `{"id":"a","name":"n","tags":[],"created":""}`, `$: missing required property "count"`},
+		{"unknown property", `{"id":"a","name":"n","count":1,"tags":[],"created":"","bogus":true}`, `$.bogus: unexpected property`},
+		{"bad array item", `{"id":"a","name":"n","count":1,"tags":[1],"created":""}`, `$.tags[0]: expected string, got number`},
+		{"bad map value", `{"id":"a","name":"n","count":1,"tags":[],"created":"","labels":{"k":1}}`, `$.labels.k: expected string, got number`},
+		{"not JSON", `{"id":`, "invalid JSON"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := s.Validate([]byte(tc.doc))
+			require.ErrorIs(t, err, atlaserrors.ErrSchemaViolation)
+			assert.Contains(t, err.Error(), tc.message)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// Validate checks that data is a JSON document matching s. All violations
+// are reported, each wrapping ErrSchemaViolation with the JSON path of the
+// offending value (e.g. "$.task.current_step").
+func (s *Schema) Validate(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("%w: invalid JSON: %w", atlaserrors.ErrSchemaViolation, err)
+	}
+
+	var errs []error
+	s.validate("$", doc, &errs)
+	return errors.Join(errs...)
+}
+
+// validate appends the violations of value at path to errs.
+func (s *Schema) validate(path string, value any, errs *[]error) {
+	if len(s.Type) > 0 && !slices.ContainsFunc(s.Type, func(t string) bool { return matchesType(t, value) }) {
+		*errs = append(*errs, fmt.Errorf("%w: %s: expected %s, got %s",
+			atlaserrors.ErrSchemaViolation, path, typeList(s.Type), jsonType(value)))
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		s.validateObject(path, v, errs)
+	case []any:
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, errs)
+			}
+		}
+	}
+}
+
+// validateObject checks required, properties and additionalProperties.
+func (s *Schema) validateObject(path string, obj map[string]any, errs *[]error) {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			*errs = append(*errs, fmt.Errorf("%w: %s: missing required property %q",
+				atlaserrors.ErrSchemaViolation, path, name))
+		}
+	}
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, name := range keys {
+		child := path + "." + name
+		if prop, ok := s.Properties[name]; ok {
+			prop.validate(child, obj[name], errs)
+			continue
+		}
+		if s.AdditionalProperties == nil {
+			continue
+		}
+		if s.AdditionalProperties.Schema == nil {
+			*errs = append(*errs, fmt.Errorf("%w: %s: unexpected property", atlaserrors.ErrSchemaViolation, child))
+			continue
+		}
+		s.AdditionalProperties.Schema.validate(child, obj[name], errs)
+	}
+}
+
+// matchesType reports whether a decoded JSON value has the named type.
+func matchesType(name string, value any) bool {
+	switch name {
+	case TypeInteger:
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := n.Int64()
+		return err == nil
+	case TypeNumber:
+		_, ok := value.(json.Number)
+		return ok
+	default:
+		return jsonType(value) == name
+	}
+}
+
+// jsonType returns the JSON type name of a decoded value. Numbers report
+// as "number".
+func jsonType(value any) string {
+	switch value.(type) {
+	case nil:
+		return TypeNull
+	case bool:
+		return TypeBoolean
+	case json.Number:
+		return TypeNumber
+	case string:
+		return TypeString
+	case []any:
+		return TypeArray
+	default:
+		return TypeObject
+	}
+}
+
+// typeList renders allowed types for an error message.
+func typeList(types Types) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("one of %v", []string(types))
+}
@@ -0,0 +1,48 @@
+package task
+
+import (
+	"context"
+	"os"
+	"os/user"
+)
+
+// ActorEnvVar is the environment variable that identifies who or what is
+// driving ATLAS (e.g., "ci-bot"). It takes precedence over the OS username.
+const ActorEnvVar = "ATLAS_ACTOR"
+
+// actorContextKey is the context key for the acting identity.
+type actorContextKey struct{}
+
+// ResolveActor determines the identity recorded on tasks and transitions.
+// Precedence: configured value, then ATLAS_ACTOR, then the OS username.
+// Returns an empty string if no identity can be determined.
+func ResolveActor(configured string) string {
+	if configured != "" {
+		return configured
+	}
+
+	if actor := os.Getenv(ActorEnvVar); actor != "" {
+		return actor
+	}
+
+	if currentUser, err := user.Current(); err == nil && currentUser.Username != "" {
+		return currentUser.Username
+	}
+
+	return ""
+}
+
+// WithActor returns a new context carrying the acting identity.
+// Transitions applied with this context record the actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the acting identity from the context, if any.
+func ActorFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	actor, _ := ctx.Value(actorContextKey{}).(string)
+	return actor
+}
@@ -0,0 +1,26 @@
+package task
+
+import (
+	"context"
+
+	"github.com/mrz1836/atlas/internal/clock"
+)
+
+// clockContextKey is the context key for the engine's clock.
+type clockContextKey struct{}
+
+// WithClock returns a new context carrying the clock.
+// Transitions applied with this context are timestamped by it.
+func WithClock(ctx context.Context, c clock.Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, c)
+}
+
+// clockFromContext returns the clock from the context, or the real clock if none.
+func clockFromContext(ctx context.Context) clock.Clock {
+	if ctx != nil {
+		if c, ok := ctx.Value(clockContextKey{}).(clock.Clock); ok && c != nil {
+			return c
+		}
+	}
+	return clock.RealClock{}
+}
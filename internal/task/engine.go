@@ -23,12 +23,19 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 
 	"github.com/mrz1836/atlas/internal/backlog"
+	"github.com/mrz1836/atlas/internal/clock"
 	"github.com/mrz1836/atlas/internal/config"
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/ctxutil"
@@ -85,6 +92,55 @@ type EngineConfig struct {
 	// Default is true (auto-proceed on success).
 	AutoProceedValidation bool
 
+	// AutoProceed controls auto-proceed per step type.
+	// Entries take precedence over AutoProceedGit and AutoProceedValidation.
+	// Step types not listed fall back to those fields (git, validation) or
+	// proceed automatically (all other types).
+	AutoProceed map[domain.StepType]bool
+
+	// StepRetry is the default retry policy for failed steps.
+	// A step's own Retry policy takes precedence. Zero value disables retries.
+	StepRetry domain.RetryPolicy
+
+	// BlockCommitOnVerifyFailure prevents git steps from running after a
+	// verify step fails. Downstream git steps are marked skipped and stay
+	// blocked until the verify step passes. Default is true.
+	BlockCommitOnVerifyFailure bool
+
+	// MaxStepOutputBytes caps the size of StepResult.Output stored on the task.
+	// Longer output is truncated with a "[truncated N bytes]" marker and the
+	// full output is saved as a "<step>/output.log" artifact. Zero disables truncation.
+	MaxStepOutputBytes int
+
+	// SkipUnknownSteps marks steps whose type has no registered executor as
+	// skipped with a warning instead of failing the task, so templates written
+	// for a newer atlas still run. Default is false (fail fast).
+	SkipUnknownSteps bool
+
+	// MaxStepJumps bounds how many on_failure_goto/on_success_goto jumps a
+	// single task may take. Zero uses DefaultMaxStepJumps. Once the limit is
+	// reached, a failing step fails the task and a succeeding step advances.
+	MaxStepJumps int
+
+	// ResyncAppendedSteps lets Resume continue a task whose template gained
+	// new steps at the end since the task started; the new steps are added
+	// as pending. Any other step drift still fails. Default is false.
+	ResyncAppendedSteps bool
+
+	// MaxResumeFailures makes Resume refuse, with ErrResumeFailureLimit, a
+	// task whose last N resumes all failed at the step it is on. Progress
+	// past that step resets the count, and ResumeFrom clears it as an
+	// explicit override. Zero disables the check.
+	MaxResumeFailures int
+
+	// Clock supplies the time for task timestamps and step timings.
+	// If nil, NewEngine uses clock.RealClock.
+	Clock clock.Clock
+
+	// Actor identifies who or what drives this engine (e.g., "ci-bot").
+	// If empty, ResolveActor falls back to ATLAS_ACTOR and the OS username.
+	Actor string
+
 	// ProgressCallback is called before and after each step execution.
 	// If nil, no progress callbacks are made.
 	ProgressCallback StepProgressCallback
@@ -93,8 +149,27 @@ type EngineConfig struct {
 // DefaultEngineConfig returns sensible defaults.
 func DefaultEngineConfig() EngineConfig {
 	return EngineConfig{
-		AutoProceedGit:        true,
-		AutoProceedValidation: true,
+		AutoProceedGit:             true,
+		AutoProceedValidation:      true,
+		BlockCommitOnVerifyFailure: true,
+		Clock:                      clock.RealClock{},
+	}
+}
+
+// ShouldAutoProceed reports whether a successful step of the given type
+// proceeds automatically or pauses for user confirmation.
+func (c EngineConfig) ShouldAutoProceed(stepType domain.StepType) bool {
+	if proceed, ok := c.AutoProceed[stepType]; ok {
+		return proceed
+	}
+
+	switch stepType {
+	case domain.StepTypeGit:
+		return c.AutoProceedGit
+	case domain.StepTypeValidation:
+		return c.AutoProceedValidation
+	default:
+		return true
 	}
 }
 
@@ -169,6 +244,13 @@ type Engine struct {
 	validationRetryHandler ValidationRetryHandler
 	metrics                Metrics
 	hookManager            HookManager
+	idGenerator            func(workspace string) string
+
+	// Webhooks notified on task completion and failure
+	webhooks       []domain.Webhook
+	webhookClient  WebhookClient
+	webhookBackoff time.Duration
+	webhookWG      sync.WaitGroup
 
 	// operationsConfig provides per-operation AI overrides (e.g. analyze → opus).
 	// Used by step logging and progress events so they report the same agent/model
@@ -180,6 +262,13 @@ type Engine struct {
 	lintCommands      []string
 	testCommands      []string
 	preCommitCommands []string
+
+	// selfReviewFallback injects a verify step into verify-enabled
+	// templates that lack one (see WithSelfReviewFallback)
+	selfReviewFallback bool
+
+	// events fans run events out to Events subscribers
+	events eventHub
 }
 
 // EngineOption configures an Engine.
@@ -248,16 +337,35 @@ func WithValidationCommands(format, lint, test, preCommit []string) EngineOption
 	}
 }
 
+// WithIDGenerator sets the function that generates IDs for new tasks,
+// for embedders with their own scheme (UUIDs, ticket IDs). It receives the
+// workspace name. Defaults to GenerateTaskID. Start rejects empty IDs, IDs
+// that are not a single path element, and IDs already used in the workspace.
+func WithIDGenerator(gen func(workspace string) string) EngineOption {
+	return func(e *Engine) {
+		if gen != nil {
+			e.idGenerator = gen
+		}
+	}
+}
+
 // NewEngine creates a new task engine with the given dependencies.
 // The store is used for task persistence, and the registry provides
 // step executors for each step type. Optional EngineOption functions
 // can be passed to configure additional features like CI failure handling.
 func NewEngine(store Store, registry *steps.ExecutorRegistry, cfg EngineConfig, logger zerolog.Logger, opts ...EngineOption) *Engine {
+	if cfg.Clock == nil {
+		cfg.Clock = clock.RealClock{}
+	}
 	e := &Engine{
-		store:    store,
-		registry: registry,
-		config:   cfg,
-		logger:   logger,
+		store:       store,
+		registry:    registry,
+		config:      cfg,
+		logger:      logger,
+		idGenerator: func(string) string { return GenerateTaskID() },
+
+		webhookClient:  &http.Client{Timeout: webhookTimeout},
+		webhookBackoff: defaultWebhookBackoff,
 	}
 	for _, opt := range opts {
 		opt(e)
@@ -265,6 +373,94 @@ func NewEngine(store Store, registry *steps.ExecutorRegistry, cfg EngineConfig,
 	return e
 }
 
+// newTaskID generates an ID for a new task in workspaceName and checks that
+// it is usable as a task directory and not already taken.
+func (e *Engine) newTaskID(ctx context.Context, workspaceName string) (string, error) {
+	taskID := e.idGenerator(workspaceName)
+	if strings.TrimSpace(taskID) == "" {
+		return "", fmt.Errorf("failed to start task: generated task ID %w", atlaserrors.ErrEmptyValue)
+	}
+	if taskID == "." || taskID == ".." || strings.ContainsAny(taskID, `/\`) {
+		return "", fmt.Errorf("%w: generated task ID '%s' is not a single path element", atlaserrors.ErrInvalidArgument, taskID)
+	}
+
+	_, err := e.store.Get(ctx, workspaceName, taskID)
+	switch {
+	case err == nil:
+		return "", fmt.Errorf("failed to start task '%s': %w", taskID, atlaserrors.ErrTaskExists)
+	case !errors.Is(err, atlaserrors.ErrTaskNotFound):
+		return "", fmt.Errorf("failed to check task ID '%s': %w", taskID, err)
+	}
+	return taskID, nil
+}
+
+// now returns the current UTC time from the engine's clock.
+func (e *Engine) now() time.Time {
+	return e.config.Clock.Now().UTC()
+}
+
+// timestampedStore is implemented by stores that take the UpdatedAt stamp
+// from the caller instead of the system clock, such as FileStore.
+type timestampedStore interface {
+	UpdateAt(ctx context.Context, workspaceName string, task *domain.Task, updatedAt time.Time) error
+}
+
+// workspaceTaskUpdater is implemented by stores that can save a task together
+// with its workspace record, such as FileStore.
+type workspaceTaskUpdater interface {
+	UpdateTaskAndWorkspace(ctx context.Context, workspaceName string, task *domain.Task, updatedAt time.Time) error
+}
+
+// saveTask stamps the task with the engine's clock and persists it.
+func (e *Engine) saveTask(ctx context.Context, task *domain.Task) error {
+	task.UpdatedAt = e.now()
+	if stamped, ok := e.store.(timestampedStore); ok {
+		return stamped.UpdateAt(ctx, task.WorkspaceID, task, task.UpdatedAt)
+	}
+	return e.store.Update(ctx, task.WorkspaceID, task)
+}
+
+// saveTaskWithWorkspace is saveTask, but saves the workspace record together
+// with the task when the store supports it.
+func (e *Engine) saveTaskWithWorkspace(ctx context.Context, task *domain.Task) error {
+	coordinated, ok := e.store.(workspaceTaskUpdater)
+	if !ok {
+		return e.saveTask(ctx, task)
+	}
+	task.UpdatedAt = e.now()
+	return coordinated.UpdateTaskAndWorkspace(ctx, task.WorkspaceID, task, task.UpdatedAt)
+}
+
+// StartOption configures a task created by Engine.Start.
+type StartOption func(*domain.Task)
+
+// WithBaseBranch records the branch the task's work merges into.
+// An empty branch is ignored, so a later option can't clear an earlier one.
+func WithBaseBranch(branch string) StartOption {
+	return func(t *domain.Task) {
+		if branch != "" {
+			t.BaseBranch = branch
+		}
+	}
+}
+
+// WithTargetBranch records the existing branch the task was started on.
+// An empty branch is ignored.
+func WithTargetBranch(branch string) StartOption {
+	return func(t *domain.Task) {
+		if branch != "" {
+			t.TargetBranch = branch
+		}
+	}
+}
+
+// WithPriority sets the task's priority within its workspace.
+func WithPriority(priority int) StartOption {
+	return func(t *domain.Task) {
+		t.Priority = priority
+	}
+}
+
 // Start creates and begins execution of a new task.
 // It generates a unique task ID, creates the initial task state,
 // transitions to Running, and begins step execution.
@@ -274,19 +470,41 @@ func NewEngine(store Store, registry *steps.ExecutorRegistry, cfg EngineConfig,
 // The template defines the steps to execute.
 // The description provides a human-readable summary of the task.
 // The fromBacklogID links this task to a backlog discovery (empty if not from backlog).
+// The opts record additional task details such as the base and target branches.
 //
 // Returns the created task and any error that occurred during execution.
 // Even if execution fails partway through, the task is returned so the
 // caller can inspect its state.
-func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath string, template *domain.Template, description, fromBacklogID string) (*domain.Task, error) {
+func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath string, template *domain.Template, description, fromBacklogID string, opts ...StartOption) (*domain.Task, error) {
+	ctx = e.beginRun(ctx)
+	task, err := e.start(ctx, workspaceName, branch, worktreePath, template, description, fromBacklogID, opts...)
+	e.endRun(task, err)
+	return task, err
+}
+
+// start creates and runs a new task for Start.
+func (e *Engine) start(ctx context.Context, workspaceName, branch, worktreePath string, template *domain.Template, description, fromBacklogID string, opts ...StartOption) (*domain.Task, error) {
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return nil, err
 	}
 
+	template = e.withSelfReview(template)
+
+	// Fail before creating anything if the template's tools are missing
+	if err := checkTemplateRequirements(template); err != nil {
+		return nil, err
+	}
+
 	// Generate unique task ID
-	taskID := GenerateTaskID()
+	taskID, err := e.newTaskID(ctx, workspaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := ResolveActor(e.config.Actor)
+	ctx = WithClock(WithActor(ctx, actor), e.config.Clock)
 
-	now := time.Now().UTC()
+	now := e.now()
 
 	// Convert template steps to task steps
 	taskSteps := make([]domain.Step, len(template.Steps))
@@ -304,6 +522,7 @@ func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath
 		WorkspaceID: workspaceName,
 		TemplateID:  template.Name,
 		Description: description,
+		Actor:       actor,
 		Status:      constants.TaskStatusPending,
 		CurrentStep: 0,
 		Steps:       taskSteps,
@@ -312,8 +531,9 @@ func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath
 		CreatedAt:   now,
 		UpdatedAt:   now,
 		Config: domain.TaskConfig{
-			Agent: template.DefaultAgent,
-			Model: template.DefaultModel,
+			Agent:    template.DefaultAgent,
+			Model:    template.DefaultModel,
+			Webhooks: slices.Clone(template.Webhooks),
 		},
 		SchemaVersion: constants.TaskSchemaVersion,
 		Metadata: map[string]any{
@@ -322,6 +542,10 @@ func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath
 		},
 	}
 
+	for _, opt := range opts {
+		opt(task)
+	}
+
 	// Set backlog ID in metadata so updateBacklogStatus can find it
 	if fromBacklogID != "" {
 		task.Metadata["from_backlog_id"] = fromBacklogID
@@ -339,9 +563,10 @@ func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath
 	}
 
 	// Create task in store (initial persistence)
-	if err := e.store.Create(ctx, workspaceName, task); err != nil {
+	if err := e.createTask(ctx, workspaceName, task); err != nil {
 		return nil, fmt.Errorf("failed to save task: %w", err)
 	}
+	taskID = task.ID
 
 	// Create hook for crash recovery (if hook manager is configured)
 	e.initializeHook(ctx, task)
@@ -364,6 +589,43 @@ func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath
 	return task, nil
 }
 
+// checkTemplateRequirements verifies every executable in template.Requires is on PATH.
+func checkTemplateRequirements(template *domain.Template) error {
+	for _, tool := range template.Requires {
+		if _, err := exec.LookPath(tool); err != nil {
+			return fmt.Errorf("%w: template %q requires %q on PATH", atlaserrors.ErrMissingDependency, template.Name, tool)
+		}
+	}
+	return nil
+}
+
+// maxTaskIDAttempts bounds how many IDs createTask tries before giving up.
+const maxTaskIDAttempts = 3
+
+// createTask persists a new task. If the store reports that a task with that
+// ID already exists, such as one started concurrently in the same workspace,
+// it retries with the suffixes -2, -3, ... up to maxTaskIDAttempts IDs.
+func (e *Engine) createTask(ctx context.Context, workspaceName string, task *domain.Task) error {
+	baseID := task.ID
+	var err error
+	for attempt := 1; attempt <= maxTaskIDAttempts; attempt++ {
+		if attempt > 1 {
+			task.ID = fmt.Sprintf("%s-%d", baseID, attempt)
+		}
+		err = e.store.Create(ctx, workspaceName, task)
+		if !errors.Is(err, atlaserrors.ErrTaskExists) {
+			return err
+		}
+
+		e.logger.Warn().
+			Str("task_id", task.ID).
+			Str("workspace_name", workspaceName).
+			Int("attempt", attempt).
+			Msg("task ID already exists")
+	}
+	return fmt.Errorf("failed to start task '%s' after %d attempts: %w", baseID, maxTaskIDAttempts, err)
+}
+
 // Resume continues execution of a paused or failed task.
 // It validates the task is in a resumable state, transitions back to Running
 // if in an error state, and continues from the current step.
@@ -372,6 +634,14 @@ func (e *Engine) Start(ctx context.Context, workspaceName, branch, worktreePath
 //
 // Returns an error if the task is in a terminal state (Completed, Rejected, Abandoned).
 func (e *Engine) Resume(ctx context.Context, task *domain.Task, template *domain.Template) error {
+	ctx = e.beginRun(ctx)
+	err := e.resume(ctx, task, template)
+	e.endRun(task, err)
+	return err
+}
+
+// resume continues a task for Resume.
+func (e *Engine) resume(ctx context.Context, task *domain.Task, template *domain.Template) error {
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return err
 	}
@@ -382,12 +652,32 @@ func (e *Engine) Resume(ctx context.Context, task *domain.Task, template *domain
 		Int("current_step", task.CurrentStep).
 		Msg("resuming task")
 
+	// Attribute resume transitions to whoever is resuming, not the task's creator
+	ctx = WithClock(WithActor(ctx, ResolveActor(e.config.Actor)), e.config.Clock)
+	template = templateForResume(task, template)
+	if template != nil {
+		task.Config.Webhooks = slices.Clone(template.Webhooks)
+	}
+
 	// Validate task is in resumable state
 	if IsTerminalStatus(task.Status) {
 		return fmt.Errorf("%w: cannot resume terminal task with status %s",
 			atlaserrors.ErrInvalidTransition, task.Status)
 	}
 
+	// Refuse to run steps the task never recorded at this position
+	if err := e.checkStepAlignment(task, template); err != nil {
+		return err
+	}
+
+	// Stop retrying a step that keeps failing after resumes
+	if err := e.checkResumeFailures(task); err != nil {
+		return err
+	}
+
+	// Restart a stopped non-checkpoint step from the checkpoint before it
+	e.rewindToCheckpoint(task, template)
+
 	// Check if resuming from step-level approval with a user choice
 	if choice, ok := task.Metadata["step_approval_choice"].(string); ok && choice != "" {
 		e.logger.Debug().
@@ -409,7 +699,7 @@ func (e *Engine) Resume(ctx context.Context, task *domain.Task, template *domain
 		if err := Transition(ctx, task, constants.TaskStatusRunning, "resumed by user"); err != nil {
 			return err
 		}
-		if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+		if err := e.saveTask(ctx, task); err != nil {
 			return fmt.Errorf("failed to save resumed state: %w", err)
 		}
 	}
@@ -418,7 +708,96 @@ func (e *Engine) Resume(ctx context.Context, task *domain.Task, template *domain
 	ctx = e.injectLoggerContext(ctx, task.WorkspaceID, task.ID)
 
 	// Continue from current step
-	return e.runSteps(ctx, task, template)
+	err := e.runSteps(ctx, task, template)
+	e.recordResumeOutcome(ctx, task)
+	return err
+}
+
+// ApproveStep approves the step a task is paused on, so the next Resume
+// continues after it instead of running it again. A human step that returned
+// awaiting_approval is marked successful and the task advances past it; a
+// step that already completed and paused for confirmation needs no change.
+// The task is not saved; Resume persists it.
+//
+// Returns ErrInvalidTransition if the task is not awaiting approval.
+func (e *Engine) ApproveStep(ctx context.Context, task *domain.Task) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	if task.Status != constants.TaskStatusAwaitingApproval {
+		return fmt.Errorf("%w: task status %s is not awaiting approval",
+			atlaserrors.ErrInvalidTransition, task.Status)
+	}
+
+	idx := task.CurrentStep
+	if idx >= len(task.Steps) || task.Steps[idx].Status != constants.StepStatusAwaitingApproval {
+		return nil
+	}
+
+	now := e.now()
+	task.Steps[idx].Status = constants.StepStatusSuccess
+	task.Steps[idx].CompletedAt = &now
+	task.CurrentStep++
+	task.UpdatedAt = now
+
+	e.logger.Info().
+		Str("task_id", task.ID).
+		Str("step_name", task.Steps[idx].Name).
+		Int("step_index", idx).
+		Msg("step approved")
+
+	return nil
+}
+
+// ResumeFrom continues a paused or failed task from an earlier step, for
+// recovery that must redo work already done (e.g. an AI fix that needs the
+// implementation step to run again). Steps from stepIndex onward are reset
+// to pending and execution resumes at stepIndex.
+//
+// Returns an error if the task is in a terminal state or if stepIndex is
+// negative or beyond the task's current step.
+func (e *Engine) ResumeFrom(ctx context.Context, task *domain.Task, template *domain.Template, stepIndex int) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	if IsTerminalStatus(task.Status) {
+		return fmt.Errorf("%w: cannot resume terminal task with status %s",
+			atlaserrors.ErrInvalidTransition, task.Status)
+	}
+	template = templateForResume(task, template)
+	if stepIndex < 0 || stepIndex >= len(template.Steps) || stepIndex > task.CurrentStep {
+		return fmt.Errorf("%w: step index %d (task is at step %d of %d)",
+			atlaserrors.ErrValueOutOfRange, stepIndex, task.CurrentStep, len(template.Steps))
+	}
+
+	// Validate before saving so a rejected rewind leaves the task as it was
+	if err := e.checkStepAlignment(task, template); err != nil {
+		return err
+	}
+
+	from := task.CurrentStep
+	resetStepsFrom(task, stepIndex)
+	task.CurrentStep = stepIndex
+	task.UpdatedAt = e.now()
+
+	// An explicit step overrides any pending step approval choice and
+	// the resume failure breaker
+	delete(task.Metadata, "step_approval_choice")
+	clearResumeFailures(task)
+
+	e.logger.Info().
+		Str("task_id", task.ID).
+		Int("from_step", from).
+		Int("to_step", stepIndex).
+		Msg("rewinding task for resume")
+
+	if err := e.saveTask(ctx, task); err != nil {
+		return fmt.Errorf("failed to save rewound state: %w", err)
+	}
+
+	return e.Resume(ctx, task, template)
 }
 
 // ExecuteStep executes a single step and returns the result.
@@ -434,7 +813,7 @@ func (e *Engine) ExecuteStep(ctx context.Context, task *domain.Task, step *domai
 	}
 
 	// Record start time
-	startTime := time.Now()
+	startTime := e.config.Clock.Now()
 
 	// Update task step status (only for sequential execution)
 	if task.CurrentStep < len(task.Steps) {
@@ -464,6 +843,7 @@ func (e *Engine) HandleStepResult(ctx context.Context, task *domain.Task, result
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return err
 	}
+	ctx = WithClock(ctx, e.config.Clock)
 
 	// Handle nil result - create minimal result for tracking
 	if result == nil {
@@ -475,6 +855,7 @@ func (e *Engine) HandleStepResult(ctx context.Context, task *domain.Task, result
 	}
 
 	// Append result to history
+	e.capStepOutput(ctx, task, result)
 	task.StepResults = append(task.StepResults, *result)
 
 	// Update task step status based on result
@@ -531,6 +912,7 @@ func (e *Engine) Abandon(ctx context.Context, task *domain.Task, reason string,
 	if task == nil {
 		return fmt.Errorf("%w: task is nil", atlaserrors.ErrInvalidTransition)
 	}
+	ctx = WithClock(ctx, e.config.Clock)
 
 	log := e.logger.With().
 		Str("task_id", task.ID).
@@ -556,7 +938,7 @@ func (e *Engine) Abandon(ctx context.Context, task *domain.Task, reason string,
 	// Update hook state to reflect abandonment
 	e.failHookTask(ctx, task, fmt.Errorf("%w: %s", atlaserrors.ErrTaskAbandoned, reason))
 
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		log.Error().Err(err).Msg("failed to save abandoned task")
 		return fmt.Errorf("failed to save task: %w", err)
 	}
@@ -613,6 +995,11 @@ func (e *Engine) terminateTrackedProcesses(task *domain.Task, log zerolog.Logger
 
 // handleSuccessResult processes a successful step result.
 func (e *Engine) handleSuccessResult(ctx context.Context, task *domain.Task, step *domain.StepDefinition, result *domain.StepResult) error {
+	// A passing verify lifts any block from an earlier verify failure
+	if step.Type == domain.StepTypeVerify {
+		e.unblockGitSteps(task)
+	}
+
 	// Check for detect_only validation with no issues - skip fix steps
 	if result.Metadata != nil {
 		detectOnly, hasDetectOnly := result.Metadata["detect_only"].(bool)
@@ -643,7 +1030,34 @@ func (e *Engine) handleSuccessResult(ctx context.Context, task *domain.Task, ste
 		}
 	}
 
-	// Auto-proceed logic handled by caller (runSteps continues)
+	if !e.config.ShouldAutoProceed(step.Type) {
+		return e.pauseForConfirmation(ctx, task, step)
+	}
+
+	return nil
+}
+
+// pauseForConfirmation moves the task to AwaitingApproval after a successful
+// step whose type is configured not to auto-proceed.
+func (e *Engine) pauseForConfirmation(ctx context.Context, task *domain.Task, step *domain.StepDefinition) error {
+	oldStatus := task.Status
+	if task.Status == constants.TaskStatusRunning {
+		if err := Transition(ctx, task, constants.TaskStatusValidating, "auto-proceed disabled"); err != nil {
+			return err
+		}
+	}
+	reason := fmt.Sprintf("step '%s' completed, auto-proceed disabled for %s steps", step.Name, step.Type)
+	if err := Transition(ctx, task, constants.TaskStatusAwaitingApproval, reason); err != nil {
+		return err
+	}
+
+	e.logger.Info().
+		Str("task_id", task.ID).
+		Str("step_name", step.Name).
+		Str("step_type", string(step.Type)).
+		Msg("pausing for confirmation before next step")
+
+	e.notifyStateChange(oldStatus, constants.TaskStatusAwaitingApproval)
 	return nil
 }
 
@@ -696,6 +1110,9 @@ func (e *Engine) handleFailedResult(ctx context.Context, task *domain.Task, step
 	// Store error context for retry (FR25)
 	e.setErrorMetadata(task, step.Name, result.Error)
 
+	// A failed verify must not let broken work reach a commit
+	e.blockGitStepsAfterVerifyFailure(task, step)
+
 	// Check for specialized failure types (ci_failed, gh_failed, ci_timeout)
 	// These have dedicated handlers with user action options
 	if handled, err := e.DispatchFailureByType(ctx, task, result); handled {
@@ -711,7 +1128,7 @@ func (e *Engine) handleFailedResult(ctx context.Context, task *domain.Task, step
 func (e *Engine) processStepResult(ctx context.Context, task *domain.Task, result *domain.StepResult, step *domain.StepDefinition) error {
 	if err := e.HandleStepResult(ctx, task, result, step); err != nil {
 		// Save state before returning error (best-effort, log if fails)
-		if saveErr := e.store.Update(ctx, task.WorkspaceID, task); saveErr != nil {
+		if saveErr := e.saveTask(ctx, task); saveErr != nil {
 			e.logger.Error().
 				Err(saveErr).
 				AnErr("original_error", err).
@@ -744,12 +1161,32 @@ func (e *Engine) handleContextCancellation(ctx context.Context, task *domain.Tas
 	e.interruptHookStep(uncancelledCtx, task, stepName)
 
 	// Try to save current state as checkpoint
-	if saveErr := e.store.Update(uncancelledCtx, task.WorkspaceID, task); saveErr != nil {
+	if saveErr := e.saveTask(uncancelledCtx, task); saveErr != nil {
 		e.logger.Error().Err(saveErr).Msg("failed to save state on cancellation")
 	}
 	return err
 }
 
+// runSteps runs the template's before_all step, the step sequence from the
+// task's current step, and the after_all step once the sequence ends.
+// after_all runs when the task completes and when it fails, but not when it
+// pauses for approval or is interrupted, since the sequence continues on resume.
+func (e *Engine) runSteps(ctx context.Context, task *domain.Task, template *domain.Template) error {
+	if err := e.runBeforeAll(ctx, task, template); err != nil {
+		return err
+	}
+
+	err := e.runStepSequence(ctx, task, template)
+	if IsErrorStatus(task.Status) {
+		e.runAfterAll(ctx, task, template)
+		if saveErr := e.saveTask(ctx, task); saveErr != nil {
+			e.logger.Error().Err(saveErr).Str("task_id", task.ID).Msg("failed to save after_all result")
+		}
+	}
+	return err
+}
+
+// runStepSequence executes the steps from task.CurrentStep onward.
 // It checks for context cancellation between steps and pauses on
 // awaiting approval or error states.
 //
@@ -758,7 +1195,7 @@ func (e *Engine) handleContextCancellation(ctx context.Context, task *domain.Tas
 // - processStepResult: handles the step result
 // - saveAndPause: saves state when pausing
 // - advanceToNextStep: increments step counter and checkpoints
-func (e *Engine) runSteps(ctx context.Context, task *domain.Task, template *domain.Template) error {
+func (e *Engine) runStepSequence(ctx context.Context, task *domain.Task, template *domain.Template) error {
 	totalSteps := len(template.Steps)
 
 	for task.CurrentStep < totalSteps {
@@ -768,6 +1205,15 @@ func (e *Engine) runSteps(ctx context.Context, task *domain.Task, template *doma
 
 		step := &template.Steps[task.CurrentStep]
 
+		// Steps sharing a parallel_group run together; the next step waits for all of them
+		if step.ParallelGroup != "" {
+			stopped, err := e.runParallelGroup(ctx, task, template)
+			if err != nil || stopped {
+				return err
+			}
+			continue
+		}
+
 		// Check if this step should be skipped (e.g., git push/PR when no changes)
 		if e.shouldSkipStep(task, step) {
 			if err := e.handleSkippedStep(ctx, task, step); err != nil {
@@ -783,6 +1229,25 @@ func (e *Engine) runSteps(ctx context.Context, task *domain.Task, template *doma
 		e.transitionHookStep(ctx, task, step.Name, task.CurrentStep)
 
 		result, err := e.executeCurrentStep(ctx, task, template)
+
+		// A failing step with on_failure_goto jumps instead of failing the task
+		jumped, jumpErr := e.tryFailureGoto(ctx, task, template, step, result, err)
+		if jumpErr != nil {
+			return jumpErr
+		}
+		if jumped {
+			continue
+		}
+
+		// A failing continue_on_error step is recorded and the task moves on
+		continued, continueErr := e.tryContinueOnError(ctx, task, template, step, result, err)
+		if continueErr != nil {
+			return continueErr
+		}
+		if continued {
+			continue
+		}
+
 		result, err = e.handleStepExecutionResult(ctx, task, step, result, err, totalSteps)
 		if err != nil {
 			return err
@@ -801,14 +1266,27 @@ func (e *Engine) runSteps(ctx context.Context, task *domain.Task, template *doma
 		e.completeHookStep(ctx, task, step.Name, result.FilesChanged)
 
 		if e.shouldPause(task) {
+			// A completed step paused for confirmation must not re-run on resume
+			if result.Status == constants.StepStatusSuccess && task.Status == constants.TaskStatusAwaitingApproval {
+				task.CurrentStep++
+			}
 			return e.saveAndPause(ctx, task)
 		}
 
+		jumped, jumpErr = e.trySuccessGoto(ctx, task, template, step, result)
+		if jumpErr != nil {
+			return jumpErr
+		}
+		if jumped {
+			continue
+		}
+
 		if err := e.advanceToNextStep(ctx, task); err != nil {
 			return err
 		}
 	}
 
+	e.runAfterAll(ctx, task, template)
 	return e.completeTask(ctx, task)
 }
 
@@ -816,6 +1294,7 @@ func (e *Engine) runSteps(ctx context.Context, task *domain.Task, template *doma
 func (e *Engine) handleExecutionError(ctx context.Context, task *domain.Task, step *domain.StepDefinition, result *domain.StepResult, err error) error {
 	// Save step result first to preserve output (e.g., validation errors)
 	if result != nil {
+		e.capStepOutput(ctx, task, result)
 		task.StepResults = append(task.StepResults, *result)
 	}
 	return e.handleStepError(ctx, task, step, err)
@@ -888,20 +1367,23 @@ func (e *Engine) handleStepError(ctx context.Context, task *domain.Task, step *d
 	if task.CurrentStep < len(task.Steps) {
 		task.Steps[task.CurrentStep].Status = constants.StepStatusFailed
 		task.Steps[task.CurrentStep].Error = err.Error()
-		now := time.Now().UTC()
+		now := e.now()
 		task.Steps[task.CurrentStep].CompletedAt = &now
 	}
 
 	// Store error context for retry (FR25)
 	e.setErrorMetadata(task, step.Name, err.Error())
 
+	// A failed verify must not let broken work reach a commit
+	e.blockGitStepsAfterVerifyFailure(task, step)
+
 	// Transition to error state following valid path
 	if transErr := e.transitionToErrorState(ctx, task, step.Type, err.Error()); transErr != nil {
 		return transErr
 	}
 
 	// Save state before returning
-	if saveErr := e.store.Update(ctx, task.WorkspaceID, task); saveErr != nil {
+	if saveErr := e.saveTask(ctx, task); saveErr != nil {
 		return fmt.Errorf("failed to save error state: %w", saveErr)
 	}
 
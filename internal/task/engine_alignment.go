@@ -0,0 +1,62 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements the template/task step alignment check run on resume.
+// A task stores the steps its template had when it started; if the template
+// changed since, resuming could run a different step than the one recorded
+// at the task's current position.
+package task
+
+import (
+	"fmt"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// checkStepAlignment verifies the task's recorded steps match the template's
+// steps by name and type, position by position. Tasks with no recorded steps
+// are not checked.
+//
+// When the template only appended new steps and EngineConfig.ResyncAppendedSteps
+// is set, the new steps are added to the task as pending instead of failing.
+// Returns ErrTemplateStepMismatch naming the first offending index otherwise.
+func (e *Engine) checkStepAlignment(task *domain.Task, template *domain.Template) error {
+	if len(task.Steps) == 0 {
+		return nil
+	}
+
+	common := min(len(task.Steps), len(template.Steps))
+	for i := 0; i < common; i++ {
+		recorded, def := task.Steps[i], template.Steps[i]
+		if recorded.Name != def.Name || recorded.Type != def.Type {
+			return fmt.Errorf("%w: step %d is %s (%s) in the task but %s (%s) in template %q",
+				atlaserrors.ErrTemplateStepMismatch, i, recorded.Name, recorded.Type, def.Name, def.Type, template.Name)
+		}
+	}
+
+	switch {
+	case len(task.Steps) > len(template.Steps):
+		return fmt.Errorf("%w: step %d (%s) is recorded in the task but missing from template %q",
+			atlaserrors.ErrTemplateStepMismatch, common, task.Steps[common].Name, template.Name)
+	case len(task.Steps) < len(template.Steps):
+		if !e.config.ResyncAppendedSteps {
+			return fmt.Errorf("%w: step %d (%s) is in template %q but not recorded in the task",
+				atlaserrors.ErrTemplateStepMismatch, common, template.Steps[common].Name, template.Name)
+		}
+		for _, def := range template.Steps[common:] {
+			task.Steps = append(task.Steps, domain.Step{
+				Name:   def.Name,
+				Type:   def.Type,
+				Status: constants.StepStatusPending,
+			})
+		}
+		e.logger.Info().
+			Str("task_id", task.ID).
+			Str("template", template.Name).
+			Int("appended_steps", len(template.Steps)-common).
+			Msg("synced steps appended to template since the task started")
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements the template's before_all and after_all steps, which
+// run once around the whole step sequence for shared setup and cleanup.
+// Their results are recorded on the task apart from the numbered steps.
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// runBeforeAll runs the template's before_all step unless its setup is still
+// in place from an earlier run of this task, i.e. it succeeded and after_all
+// has not run since. On failure no steps run: after_all runs for cleanup and
+// the task moves to an error state.
+func (e *Engine) runBeforeAll(ctx context.Context, task *domain.Task, template *domain.Template) error {
+	step := template.BeforeAll
+	if step == nil {
+		return nil
+	}
+	if prev := task.BeforeAllResult; prev != nil && prev.Status == constants.StepStatusSuccess && task.AfterAllResult == nil {
+		return nil
+	}
+
+	result, err := e.runAroundStep(ctx, task, step)
+	task.BeforeAllResult = result
+	task.AfterAllResult = nil
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return e.handleContextCancellation(ctx, task, template, ctx.Err())
+	}
+
+	e.logger.Error().
+		Err(err).
+		Str("task_id", task.ID).
+		Str("step_name", step.Name).
+		Msg("before_all step failed")
+
+	e.setErrorMetadata(task, step.Name, err.Error())
+	e.runAfterAll(ctx, task, template)
+
+	if transErr := e.transitionToErrorState(ctx, task, step.Type, err.Error()); transErr != nil {
+		return transErr
+	}
+	if saveErr := e.saveTask(ctx, task); saveErr != nil {
+		return fmt.Errorf("failed to save error state: %w", saveErr)
+	}
+	return err
+}
+
+// runAfterAll runs the template's after_all step and records its result.
+// A failure is logged and recorded but never changes the task's outcome.
+func (e *Engine) runAfterAll(ctx context.Context, task *domain.Task, template *domain.Template) {
+	step := template.AfterAll
+	if step == nil {
+		return
+	}
+
+	result, err := e.runAroundStep(ctx, task, step)
+	task.AfterAllResult = result
+	if err != nil {
+		e.logger.Warn().
+			Err(err).
+			Str("task_id", task.ID).
+			Str("step_name", step.Name).
+			Msg("after_all step failed")
+	}
+}
+
+// runAroundStep executes a before_all or after_all step and returns a result
+// that is never nil. A failed result without an error is reported as an error.
+func (e *Engine) runAroundStep(ctx context.Context, task *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	startedAt := e.now()
+	result, err := e.executeStepInternal(ctx, task, step)
+	if result == nil {
+		result = &domain.StepResult{
+			StepName:    step.Name,
+			Status:      constants.StepStatusFailed,
+			StartedAt:   startedAt,
+			CompletedAt: e.now(),
+		}
+	}
+	result.StepIndex = -1
+
+	if err == nil && result.Status == constants.StepStatusFailed {
+		err = fmt.Errorf("%w: step '%s' failed: %s", atlaserrors.ErrTaskFailed, step.Name, result.Error)
+	}
+	if err != nil {
+		result.Status = constants.StepStatusFailed
+		if result.Error == "" {
+			result.Error = err.Error()
+		}
+	}
+	e.capStepOutput(ctx, task, result)
+
+	return result, err
+}
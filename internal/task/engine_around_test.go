@@ -0,0 +1,171 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// orderExecutor records the order steps run in and fails the named steps.
+type orderExecutor struct {
+	stepType domain.StepType
+	fail     map[string]bool
+
+	mu    sync.Mutex
+	order []string
+}
+
+func (e *orderExecutor) Execute(_ context.Context, _ *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	e.mu.Lock()
+	e.order = append(e.order, step.Name)
+	e.mu.Unlock()
+
+	status := constants.StepStatusSuccess
+	errMsg := ""
+	if e.fail[step.Name] {
+		status = constants.StepStatusFailed
+		errMsg = step.Name + " broke"
+	}
+	return &domain.StepResult{
+		StepName:    step.Name,
+		Status:      status,
+		Error:       errMsg,
+		StartedAt:   time.Now().UTC(),
+		CompletedAt: time.Now().UTC(),
+	}, nil
+}
+
+func (e *orderExecutor) Type() domain.StepType {
+	return e.stepType
+}
+
+// newAroundEngine returns an engine whose AI steps run on exec, and a
+// template with before_all, two steps, and after_all.
+func newAroundEngine(exec *orderExecutor) (*Engine, *domain.Template) {
+	registry := steps.NewExecutorRegistry()
+	registry.Register(exec)
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name:      "around",
+		BeforeAll: &domain.StepDefinition{Name: "setup", Type: domain.StepTypeAI},
+		AfterAll:  &domain.StepDefinition{Name: "cleanup", Type: domain.StepTypeAI},
+		Steps: []domain.StepDefinition{
+			{Name: "first", Type: domain.StepTypeAI, Required: true},
+			{Name: "second", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+	return engine, template
+}
+
+// TestEngine_BeforeAfterAll_Success tests before_all runs before the first
+// step and after_all after the last, with results recorded apart.
+func TestEngine_BeforeAfterAll_Success(t *testing.T) {
+	t.Parallel()
+
+	exec := &orderExecutor{stepType: domain.StepTypeAI}
+	engine, template := newAroundEngine(exec)
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "around", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"setup", "first", "second", "cleanup"}, exec.order)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+
+	require.NotNil(t, task.BeforeAllResult)
+	assert.Equal(t, "setup", task.BeforeAllResult.StepName)
+	assert.Equal(t, constants.StepStatusSuccess, task.BeforeAllResult.Status)
+	require.NotNil(t, task.AfterAllResult)
+	assert.Equal(t, "cleanup", task.AfterAllResult.StepName)
+
+	// The numbered step history holds only the template's steps
+	require.Len(t, task.StepResults, 2)
+	assert.Equal(t, "first", task.StepResults[0].StepName)
+	assert.Equal(t, "second", task.StepResults[1].StepName)
+}
+
+// TestEngine_AfterAll_RunsOnStepFailure tests after_all still runs when a
+// step fails and the task moves to an error state.
+func TestEngine_AfterAll_RunsOnStepFailure(t *testing.T) {
+	t.Parallel()
+
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"first": true}}
+	engine, template := newAroundEngine(exec)
+
+	// A failed step result pauses the task in an error state without an error
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "around", "")
+	require.NoError(t, err)
+	require.NotNil(t, task)
+
+	assert.Equal(t, []string{"setup", "first", "cleanup"}, exec.order)
+	assert.True(t, IsErrorStatus(task.Status), "task status %s", task.Status)
+	require.NotNil(t, task.AfterAllResult)
+	assert.Equal(t, constants.StepStatusSuccess, task.AfterAllResult.Status)
+}
+
+// TestEngine_BeforeAll_FailureSkipsSteps tests a failing before_all stops
+// the task before any step runs and still runs after_all.
+func TestEngine_BeforeAll_FailureSkipsSteps(t *testing.T) {
+	t.Parallel()
+
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"setup": true}}
+	engine, template := newAroundEngine(exec)
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "around", "")
+	require.Error(t, err)
+	require.NotNil(t, task)
+
+	assert.Equal(t, []string{"setup", "cleanup"}, exec.order)
+	assert.True(t, IsErrorStatus(task.Status), "task status %s", task.Status)
+	require.NotNil(t, task.BeforeAllResult)
+	assert.Equal(t, constants.StepStatusFailed, task.BeforeAllResult.Status)
+	assert.Equal(t, "setup broke", task.BeforeAllResult.Error)
+	assert.Empty(t, task.StepResults)
+	assert.Equal(t, 0, task.CurrentStep)
+}
+
+// TestEngine_AfterAll_FailureDoesNotFailTask tests a failing after_all is
+// recorded without changing the task's outcome.
+func TestEngine_AfterAll_FailureDoesNotFailTask(t *testing.T) {
+	t.Parallel()
+
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"cleanup": true}}
+	engine, template := newAroundEngine(exec)
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "around", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+	require.NotNil(t, task.AfterAllResult)
+	assert.Equal(t, constants.StepStatusFailed, task.AfterAllResult.Status)
+}
+
+// TestEngine_BeforeAll_RerunsAfterTeardown tests that resuming a failed task
+// sets up again, since after_all already cleaned up.
+func TestEngine_BeforeAll_RerunsAfterTeardown(t *testing.T) {
+	t.Parallel()
+
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"first": true}}
+	engine, template := newAroundEngine(exec)
+
+	// A failed step result pauses the task in an error state without an error
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "around", "")
+	require.NoError(t, err)
+
+	exec.mu.Lock()
+	exec.fail = nil
+	exec.order = nil
+	exec.mu.Unlock()
+
+	require.NoError(t, engine.Resume(context.Background(), task, template))
+	assert.Equal(t, []string{"setup", "first", "second", "cleanup"}, exec.order)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+}
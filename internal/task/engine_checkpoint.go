@@ -0,0 +1,65 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements checkpoint boundaries for resume. A step marked
+// checkpoint: false is not safe to resume in the middle of, typically because
+// it depends on state an earlier step produced in the same run. When a task
+// stops at such a step, Resume rewinds to the nearest checkpoint before it and
+// runs the steps from there again.
+package task
+
+import (
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// checkpointIndex returns the index of the nearest checkpoint step at or
+// before idx, or 0 when no earlier step is a checkpoint.
+func checkpointIndex(template *domain.Template, idx int) int {
+	for i := min(idx, len(template.Steps)-1); i >= 0; i-- {
+		if template.Steps[i].IsCheckpoint() {
+			return i
+		}
+	}
+	return 0
+}
+
+// rewindToCheckpoint moves a task that failed or was interrupted at a
+// non-checkpoint step back to the nearest checkpoint before it, resetting the
+// steps in between to pending. Tasks paused for approval or carrying a step
+// approval choice are left alone, since they continue deliberately from
+// their current step. The rewound state is saved by the resume transition.
+func (e *Engine) rewindToCheckpoint(task *domain.Task, template *domain.Template) {
+	if !IsErrorStatus(task.Status) {
+		return
+	}
+	if choice, _ := task.Metadata["step_approval_choice"].(string); choice != "" {
+		return
+	}
+	if task.CurrentStep >= len(template.Steps) || template.Steps[task.CurrentStep].IsCheckpoint() {
+		return
+	}
+
+	from := task.CurrentStep
+	to := checkpointIndex(template, from)
+	resetStepsFrom(task, to)
+	task.CurrentStep = to
+	task.UpdatedAt = e.now()
+
+	e.logger.Info().
+		Str("task_id", task.ID).
+		Int("from_step", from).
+		Int("to_step", to).
+		Str("step_name", template.Steps[from].Name).
+		Msg("rewinding to checkpoint step for resume")
+}
+
+// resetStepsFrom marks the task's steps from idx onward as pending and clears
+// their timing and error.
+func resetStepsFrom(task *domain.Task, idx int) {
+	for i := idx; i < len(task.Steps); i++ {
+		task.Steps[i].Status = constants.StepStatusPending
+		task.Steps[i].StartedAt = nil
+		task.Steps[i].CompletedAt = nil
+		task.Steps[i].Error = ""
+	}
+}
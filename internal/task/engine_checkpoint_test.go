@@ -0,0 +1,97 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// newCheckpointEngine returns an engine running exec and a template whose
+// "commit" and "push" steps are not checkpoints.
+func newCheckpointEngine(exec *orderExecutor) (*Engine, *domain.Template) {
+	registry := steps.NewExecutorRegistry()
+	registry.Register(exec)
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	noCheckpoint := false
+	template := &domain.Template{
+		Name: "checkpoint",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "stage", Type: domain.StepTypeAI, Required: true},
+			{Name: "commit", Type: domain.StepTypeAI, Required: true, Checkpoint: &noCheckpoint},
+			{Name: "push", Type: domain.StepTypeAI, Required: true, Checkpoint: &noCheckpoint},
+		},
+	}
+	return engine, template
+}
+
+// TestEngine_Resume_RewindsToCheckpoint tests that a failure at a
+// non-checkpoint step makes resume restart from the checkpoint before it.
+func TestEngine_Resume_RewindsToCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"push": true}}
+	engine, template := newCheckpointEngine(exec)
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "checkpoint", "")
+	require.NoError(t, err)
+	require.True(t, IsErrorStatus(task.Status))
+	require.Equal(t, 3, task.CurrentStep)
+
+	exec.fail = nil
+	exec.order = nil
+	require.NoError(t, engine.Resume(ctx, task, template))
+
+	assert.Equal(t, []string{"stage", "commit", "push"}, exec.order)
+	assert.False(t, IsErrorStatus(task.Status))
+}
+
+// TestEngine_Resume_CheckpointStepResumesInPlace tests that a failure at a
+// checkpoint step resumes at that step.
+func TestEngine_Resume_CheckpointStepResumesInPlace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"stage": true}}
+	engine, template := newCheckpointEngine(exec)
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "checkpoint", "")
+	require.NoError(t, err)
+	require.Equal(t, 1, task.CurrentStep)
+
+	exec.fail = nil
+	exec.order = nil
+	require.NoError(t, engine.Resume(ctx, task, template))
+
+	assert.Equal(t, []string{"stage", "commit", "push"}, exec.order)
+}
+
+// TestEngine_Resume_NoPriorCheckpointRestartsTask tests that resume restarts
+// from the first step when no earlier step is a checkpoint.
+func TestEngine_Resume_NoPriorCheckpointRestartsTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"push": true}}
+	engine, template := newCheckpointEngine(exec)
+	for i := range template.Steps {
+		noCheckpoint := false
+		template.Steps[i].Checkpoint = &noCheckpoint
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "checkpoint", "")
+	require.NoError(t, err)
+
+	exec.fail = nil
+	exec.order = nil
+	require.NoError(t, engine.Resume(ctx, task, template))
+
+	assert.Equal(t, []string{"implement", "stage", "commit", "push"}, exec.order)
+}
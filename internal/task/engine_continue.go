@@ -0,0 +1,84 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements continue_on_error. A failing step marked
+// continue_on_error records its failure and a task warning, then the task
+// advances to the next step instead of moving to an error state.
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// stepWarningsMetadataKey is the task metadata key listing failures of
+// continue_on_error steps.
+const stepWarningsMetadataKey = "step_warnings"
+
+// tryContinueOnError advances past a failed continue_on_error step.
+// Returns true if the task moved on. Cancellation never continues.
+func (e *Engine) tryContinueOnError(ctx context.Context, task *domain.Task, template *domain.Template, step *domain.StepDefinition, result *domain.StepResult, err error) (bool, error) {
+	if !step.ContinueOnError {
+		return false, nil
+	}
+	failed := err != nil || (result != nil && result.Status == constants.StepStatusFailed)
+	if !failed || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, nil
+	}
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	} else if result != nil {
+		errMsg = result.Error
+	}
+
+	if result != nil {
+		e.notifyStepComplete(task, step, result, len(template.Steps))
+		e.capStepOutput(ctx, task, result)
+		task.StepResults = append(task.StepResults, *result)
+	}
+	if task.CurrentStep < len(task.Steps) {
+		task.Steps[task.CurrentStep].Status = constants.StepStatusFailed
+		task.Steps[task.CurrentStep].Error = errMsg
+		now := e.now()
+		task.Steps[task.CurrentStep].CompletedAt = &now
+	}
+
+	e.addStepWarning(task, fmt.Sprintf("step %q failed and was continued past: %s", step.Name, errMsg))
+
+	e.logger.Warn().
+		Str("task_id", task.ID).
+		Str("step_name", step.Name).
+		Str("error", errMsg).
+		Msg("step failed, continuing because continue_on_error is set")
+
+	return true, e.advanceToNextStep(ctx, task)
+}
+
+// addStepWarning appends a warning to the task's step_warnings metadata.
+func (e *Engine) addStepWarning(task *domain.Task, warning string) {
+	e.setMetadata(task, stepWarningsMetadataKey, append(StepWarnings(task), warning))
+}
+
+// StepWarnings returns the failures recorded for continue_on_error steps.
+// Metadata read back from JSON holds the list as []any.
+func StepWarnings(task *domain.Task) []string {
+	switch v := task.Metadata[stepWarningsMetadataKey].(type) {
+	case []string:
+		return v
+	case []any:
+		warnings := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				warnings = append(warnings, s)
+			}
+		}
+		return warnings
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,240 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements the engine event stream. A server embedding the engine
+// calls Engine.Events to get a channel of step, status, and run completion
+// events it can fan out to its own clients. Unlike ProgressCallback, the
+// stream never blocks the engine: a subscriber that falls behind loses its
+// oldest buffered events instead.
+package task
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// EngineEventBufferSize is the capacity of each channel returned by Events.
+const EngineEventBufferSize = 64
+
+// EngineEventType identifies the kind of EngineEvent.
+type EngineEventType string
+
+// Engine event types.
+const (
+	// EngineEventStepStarted is sent before a step executes.
+	EngineEventStepStarted EngineEventType = "step_started"
+
+	// EngineEventStepCompleted is sent after a step finishes, whatever its status.
+	EngineEventStepCompleted EngineEventType = "step_completed"
+
+	// EngineEventStatusChanged is sent after every task status transition.
+	EngineEventStatusChanged EngineEventType = "status_changed"
+
+	// EngineEventRunCompleted is the last event of a run, sent when Start or
+	// Resume returns.
+	EngineEventRunCompleted EngineEventType = "run_completed"
+)
+
+// EngineEvent is one event on the stream returned by Engine.Events.
+type EngineEvent struct {
+	Type      EngineEventType
+	TaskID    string
+	Workspace string
+	Timestamp time.Time
+
+	// Step events: the step and, once completed, its result status.
+	StepIndex  int
+	StepName   string
+	StepType   domain.StepType
+	StepStatus string
+
+	// Status events: the transition that was applied.
+	FromStatus constants.TaskStatus
+	ToStatus   constants.TaskStatus
+	Reason     string
+
+	// Run completed events: the task's final status and the run's error, if any.
+	Status constants.TaskStatus
+	Error  string
+
+	// Dropped counts events this subscriber missed since the previous event
+	// it was sent, because its buffer was full.
+	Dropped int
+}
+
+// Events returns a channel receiving the events of the engine's current run,
+// or of the next run if none is in progress. A run is a call to Start or
+// Resume; events from runs that overlap it are sent too. The channel is
+// closed after the EngineEventRunCompleted event once no run is in progress.
+//
+// The channel holds EngineEventBufferSize events. When it is full the oldest
+// buffered event is discarded to make room, so the engine never waits on a
+// slow reader and the latest state, including run completion, gets through.
+// Each call returns a new channel.
+func (e *Engine) Events() <-chan EngineEvent {
+	return e.events.subscribe()
+}
+
+// eventSubscriber is one channel returned by Events.
+type eventSubscriber struct {
+	ch      chan EngineEvent
+	dropped int
+}
+
+// send delivers event without blocking, discarding the oldest buffered event
+// when the channel is full. Only the hub sends, under its lock, so the
+// channel cannot fill up again between discarding and sending.
+func (s *eventSubscriber) send(event EngineEvent) {
+	event.Dropped = s.dropped
+	select {
+	case s.ch <- event:
+		s.dropped = 0
+		return
+	default:
+	}
+
+	select {
+	case <-s.ch:
+		s.dropped++
+	default:
+		// The reader drained the channel in the meantime
+	}
+
+	event.Dropped = s.dropped
+	select {
+	case s.ch <- event:
+		s.dropped = 0
+	default:
+		s.dropped++
+	}
+}
+
+// eventHub tracks Events subscribers and the runs in progress.
+// The zero value is ready to use.
+type eventHub struct {
+	mu          sync.Mutex
+	subscribers []*eventSubscriber
+	activeRuns  int
+}
+
+// subscribe adds a subscriber and returns its channel.
+func (h *eventHub) subscribe() <-chan EngineEvent {
+	sub := &eventSubscriber{ch: make(chan EngineEvent, EngineEventBufferSize)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers = append(h.subscribers, sub)
+	return sub.ch
+}
+
+// publish sends event to every subscriber.
+func (h *eventHub) publish(event EngineEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, sub := range h.subscribers {
+		sub.send(event)
+	}
+}
+
+// begin records that a run started.
+func (h *eventHub) begin() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.activeRuns++
+}
+
+// end records that a run finished, publishing event first. When it was the
+// last run in progress, every subscriber's channel is closed and dropped.
+func (h *eventHub) end(event EngineEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, sub := range h.subscribers {
+		sub.send(event)
+	}
+
+	h.activeRuns--
+	if h.activeRuns > 0 {
+		return
+	}
+	for _, sub := range h.subscribers {
+		close(sub.ch)
+	}
+	h.subscribers = nil
+}
+
+// beginRun marks the start of a Start or Resume run and returns a context
+// that reports the run's status transitions to Events subscribers.
+func (e *Engine) beginRun(ctx context.Context) context.Context {
+	e.events.begin()
+	return withTransitionHook(ctx, e.publishTransition)
+}
+
+// endRun sends the run completed event and closes the Events channels when
+// no other run is in progress. task may be nil if the run failed before
+// creating it.
+func (e *Engine) endRun(task *domain.Task, runErr error) {
+	event := EngineEvent{
+		Type:      EngineEventRunCompleted,
+		Timestamp: e.now(),
+	}
+	if task != nil {
+		event.TaskID = task.ID
+		event.Workspace = task.WorkspaceID
+		event.Status = task.Status
+	}
+	if runErr != nil {
+		event.Error = runErr.Error()
+	}
+	e.events.end(event)
+}
+
+// publishStepEvent sends a step started or completed event.
+func (e *Engine) publishStepEvent(eventType EngineEventType, task *domain.Task, step *domain.StepDefinition, status string) {
+	e.events.publish(EngineEvent{
+		Type:       eventType,
+		TaskID:     task.ID,
+		Workspace:  task.WorkspaceID,
+		Timestamp:  e.now(),
+		StepIndex:  task.CurrentStep,
+		StepName:   step.Name,
+		StepType:   step.Type,
+		StepStatus: status,
+	})
+}
+
+// publishTransition sends a status changed event for an applied transition.
+func (e *Engine) publishTransition(task *domain.Task, transition domain.Transition) {
+	e.events.publish(EngineEvent{
+		Type:       EngineEventStatusChanged,
+		TaskID:     task.ID,
+		Workspace:  task.WorkspaceID,
+		Timestamp:  transition.Timestamp,
+		StepIndex:  task.CurrentStep,
+		FromStatus: transition.FromStatus,
+		ToStatus:   transition.ToStatus,
+		Reason:     transition.Reason,
+	})
+}
+
+// transitionHook is called by Transition after a transition is applied.
+type transitionHook func(task *domain.Task, transition domain.Transition)
+
+// transitionHookContextKey is the context key for the transition hook.
+type transitionHookContextKey struct{}
+
+// withTransitionHook returns a new context carrying hook.
+func withTransitionHook(ctx context.Context, hook transitionHook) context.Context {
+	return context.WithValue(ctx, transitionHookContextKey{}, hook)
+}
+
+// transitionHookFromContext returns the transition hook from the context, or nil.
+func transitionHookFromContext(ctx context.Context) transitionHook {
+	if ctx == nil {
+		return nil
+	}
+	hook, _ := ctx.Value(transitionHookContextKey{}).(transitionHook)
+	return hook
+}
@@ -0,0 +1,146 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// newEventsEngine returns an engine running exec and a template with the
+// given number of AI steps.
+func newEventsEngine(exec *orderExecutor, stepCount int) (*Engine, *domain.Template) {
+	registry := steps.NewExecutorRegistry()
+	registry.Register(exec)
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{Name: "events"}
+	for i := range stepCount {
+		template.Steps = append(template.Steps, domain.StepDefinition{
+			Name:     fmt.Sprintf("step-%d", i),
+			Type:     domain.StepTypeAI,
+			Required: true,
+		})
+	}
+	return engine, template
+}
+
+// drainEvents reads events until the channel is closed.
+func drainEvents(ch <-chan EngineEvent) []EngineEvent {
+	var events []EngineEvent
+	for event := range ch {
+		events = append(events, event)
+	}
+	return events
+}
+
+// TestEngine_Events_MultiStepRun tests the event sequence of a run that
+// fails at its second step, and that the channel closes when the run ends.
+func TestEngine_Events_MultiStepRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"step-1": true}}
+	engine, template := newEventsEngine(exec, 3)
+
+	events := engine.Events()
+	done := make(chan []EngineEvent)
+	go func() { done <- drainEvents(events) }()
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "events", "")
+	require.NoError(t, err)
+	require.Equal(t, constants.TaskStatusValidationFailed, task.Status)
+	got := <-done
+
+	type summary struct {
+		Type   EngineEventType
+		Step   string
+		Status string
+	}
+	summaries := make([]summary, len(got))
+	for i, event := range got {
+		s := summary{Type: event.Type, Step: event.StepName, Status: event.StepStatus}
+		if event.Type == EngineEventStatusChanged {
+			s.Status = string(event.FromStatus) + "->" + string(event.ToStatus)
+		}
+		if event.Type == EngineEventRunCompleted {
+			s.Status = string(event.Status)
+		}
+		summaries[i] = s
+		assert.Equal(t, task.ID, event.TaskID)
+		assert.Equal(t, "test-workspace", event.Workspace)
+		assert.Zero(t, event.Dropped)
+	}
+
+	assert.Equal(t, []summary{
+		{Type: EngineEventStatusChanged, Status: "pending->running"},
+		{Type: EngineEventStepStarted, Step: "step-0"},
+		{Type: EngineEventStepCompleted, Step: "step-0", Status: constants.StepStatusSuccess},
+		{Type: EngineEventStepStarted, Step: "step-1"},
+		{Type: EngineEventStepCompleted, Step: "step-1", Status: constants.StepStatusFailed},
+		{Type: EngineEventStatusChanged, Status: "running->validating"},
+		{Type: EngineEventStatusChanged, Status: "validating->validation_failed"},
+		{Type: EngineEventRunCompleted, Status: "validation_failed"},
+	}, summaries)
+}
+
+// TestEngine_Events_ClosedPerRun tests that a channel only covers one run
+// and a new subscription is needed for the next.
+func TestEngine_Events_ClosedPerRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"step-1": true}}
+	engine, template := newEventsEngine(exec, 2)
+
+	first := engine.Events()
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "events", "")
+	require.NoError(t, err)
+	firstEvents := drainEvents(first)
+	require.NotEmpty(t, firstEvents)
+	assert.Equal(t, EngineEventRunCompleted, firstEvents[len(firstEvents)-1].Type)
+
+	exec.fail = nil
+	second := engine.Events()
+	require.NoError(t, engine.Resume(ctx, task, template))
+	secondEvents := drainEvents(second)
+
+	require.NotEmpty(t, secondEvents)
+	assert.Equal(t, EngineEventStatusChanged, secondEvents[0].Type)
+	assert.Equal(t, constants.TaskStatusRunning, secondEvents[0].ToStatus)
+	assert.Equal(t, EngineEventRunCompleted, secondEvents[len(secondEvents)-1].Type)
+	assert.Empty(t, secondEvents[len(secondEvents)-1].Error)
+}
+
+// TestEngine_Events_SlowReaderDoesNotBlock tests that a subscriber that
+// never reads loses its oldest events instead of stalling the run, and still
+// receives the run completed event.
+func TestEngine_Events_SlowReaderDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI}
+	engine, template := newEventsEngine(exec, EngineEventBufferSize)
+
+	events := engine.Events()
+	_, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "events", "")
+	require.NoError(t, err)
+	assert.Len(t, exec.order, EngineEventBufferSize, "every step ran")
+
+	got := drainEvents(events)
+	require.Len(t, got, EngineEventBufferSize)
+	last := got[len(got)-1]
+	assert.Equal(t, EngineEventRunCompleted, last.Type)
+
+	dropped := 0
+	for _, event := range got {
+		dropped += event.Dropped
+	}
+	assert.Positive(t, dropped)
+}
@@ -85,7 +85,7 @@ func (e *Engine) handleCIFailure(ctx context.Context, task *domain.Task, result
 	task.Metadata["last_error"] = result.Error
 
 	// Save task state
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		return fmt.Errorf("failed to save task state: %w", err)
 	}
 
@@ -131,7 +131,7 @@ func (e *Engine) handleGHFailure(ctx context.Context, task *domain.Task, result
 	}
 
 	// Save task state
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		return fmt.Errorf("failed to save task state: %w", err)
 	}
 
@@ -172,7 +172,7 @@ func (e *Engine) handleCITimeout(ctx context.Context, task *domain.Task, result
 	task.Metadata["last_error"] = result.Error
 
 	// Save task state
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		return fmt.Errorf("failed to save task state: %w", err)
 	}
 
@@ -219,7 +219,7 @@ func (e *Engine) handleCIFetchErrorFailure(ctx context.Context, task *domain.Tas
 	}
 
 	// Save task state
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		return fmt.Errorf("failed to save task state: %w", err)
 	}
 
@@ -231,6 +231,7 @@ func (e *Engine) ProcessCIFailureAction(ctx context.Context, task *domain.Task,
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return fmt.Errorf("processing CI failure action canceled: %w", err)
 	}
+	ctx = WithClock(ctx, e.config.Clock)
 
 	if e.ciFailureHandler == nil {
 		return fmt.Errorf("CI failure handler not configured: %w", atlaserrors.ErrExecutorNotFound)
@@ -295,19 +296,19 @@ func (e *Engine) processCIFailureResult(ctx context.Context, task *domain.Task,
 		if err := Transition(ctx, task, constants.TaskStatusRunning, "retry from implement"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case CIFailureFixManually:
 		// Store instructions; task remains in CIFailed
 		task.Metadata["manual_fix_instructions"] = result.Message
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case CIFailureAbandon:
 		// Transition to abandoned
 		if err := Transition(ctx, task, constants.TaskStatusAbandoned, "user abandoned after CI failure"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 	}
 
 	return nil
@@ -318,6 +319,7 @@ func (e *Engine) ProcessGHFailureAction(ctx context.Context, task *domain.Task,
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return fmt.Errorf("processing GitHub failure action canceled: %w", err)
 	}
+	ctx = WithClock(ctx, e.config.Clock)
 
 	e.logger.Info().
 		Str("task_id", task.ID).
@@ -332,19 +334,19 @@ func (e *Engine) ProcessGHFailureAction(ctx context.Context, task *domain.Task,
 		if err := Transition(ctx, task, constants.TaskStatusRunning, "retry GitHub operation"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case GHFailureFixAndRetry:
 		// Store instructions; task remains in GHFailed for manual fix
 		task.Metadata["awaiting_manual_fix"] = true
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case GHFailureAbandon:
 		// Transition to abandoned
 		if err := Transition(ctx, task, constants.TaskStatusAbandoned, "user abandoned after GitHub failure"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 	}
 
 	return nil
@@ -355,6 +357,7 @@ func (e *Engine) ProcessCITimeoutAction(ctx context.Context, task *domain.Task,
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return fmt.Errorf("processing CI timeout action canceled: %w", err)
 	}
+	ctx = WithClock(ctx, e.config.Clock)
 
 	e.logger.Info().
 		Str("task_id", task.ID).
@@ -370,7 +373,7 @@ func (e *Engine) ProcessCITimeoutAction(ctx context.Context, task *domain.Task,
 		if err := Transition(ctx, task, constants.TaskStatusRunning, "continue waiting for CI"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case CITimeoutRetry:
 		// Transition back to running and restart from implement
@@ -379,19 +382,19 @@ func (e *Engine) ProcessCITimeoutAction(ctx context.Context, task *domain.Task,
 		if err := Transition(ctx, task, constants.TaskStatusRunning, "retry from implement after timeout"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case CITimeoutFixManually:
 		// Store instructions; task remains in CITimeout for manual fix
 		task.Metadata["awaiting_manual_fix"] = true
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 
 	case CITimeoutAbandon:
 		// Transition to abandoned
 		if err := Transition(ctx, task, constants.TaskStatusAbandoned, "user abandoned after CI timeout"); err != nil {
 			return err
 		}
-		return e.store.Update(ctx, task.WorkspaceID, task)
+		return e.saveTask(ctx, task)
 	}
 
 	return nil
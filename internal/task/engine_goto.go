@@ -0,0 +1,166 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements step branching. A step's on_failure_goto and
+// on_success_goto send execution to a named step instead of failing the task
+// or advancing linearly. Jumps are counted per task and bounded so a step that
+// never passes cannot loop forever.
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// DefaultMaxStepJumps is the per-task goto limit used when
+// EngineConfig.MaxStepJumps is zero.
+const DefaultMaxStepJumps = 10
+
+// stepJumpsMetadataKey is the task metadata key counting goto jumps taken.
+const stepJumpsMetadataKey = "step_jumps"
+
+// maxStepJumps returns the configured goto limit.
+func (e *Engine) maxStepJumps() int {
+	if e.config.MaxStepJumps > 0 {
+		return e.config.MaxStepJumps
+	}
+	return DefaultMaxStepJumps
+}
+
+// stepJumpCount returns how many goto jumps the task has taken.
+// Metadata read back from JSON holds numbers as float64.
+func stepJumpCount(task *domain.Task) int {
+	switch v := task.Metadata[stepJumpsMetadataKey].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
+
+// resolveGotoTarget returns the index of the named step if the task may
+// still jump. It returns false if the name is unknown or the limit is reached.
+func (e *Engine) resolveGotoTarget(task *domain.Task, template *domain.Template, name string) (int, bool) {
+	target := -1
+	for i := range template.Steps {
+		if template.Steps[i].Name == name {
+			target = i
+			break
+		}
+	}
+	if target < 0 {
+		e.logger.Warn().
+			Str("task_id", task.ID).
+			Str("goto", name).
+			Msg("goto references unknown step, ignoring")
+		return 0, false
+	}
+
+	if jumps := stepJumpCount(task); jumps >= e.maxStepJumps() {
+		e.logger.Warn().
+			Str("task_id", task.ID).
+			Str("goto", name).
+			Int("step_jumps", jumps).
+			Msg("step jump limit reached, not jumping")
+		return 0, false
+	}
+	return target, true
+}
+
+// tryFailureGoto jumps to the step's on_failure_goto target when the step
+// failed, recording the failure instead of moving the task to an error state.
+// Returns true if the task jumped. Cancellation never triggers a jump.
+func (e *Engine) tryFailureGoto(ctx context.Context, task *domain.Task, template *domain.Template, step *domain.StepDefinition, result *domain.StepResult, err error) (bool, error) {
+	if step.OnFailureGoto == "" {
+		return false, nil
+	}
+	failed := err != nil || (result != nil && result.Status == constants.StepStatusFailed)
+	if !failed || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, nil
+	}
+
+	target, ok := e.resolveGotoTarget(task, template, step.OnFailureGoto)
+	if !ok {
+		return false, nil
+	}
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	} else if result != nil {
+		errMsg = result.Error
+	}
+
+	if result != nil {
+		e.notifyStepComplete(task, step, result, len(template.Steps))
+		e.capStepOutput(ctx, task, result)
+		task.StepResults = append(task.StepResults, *result)
+	}
+	if task.CurrentStep < len(task.Steps) {
+		task.Steps[task.CurrentStep].Status = constants.StepStatusFailed
+		task.Steps[task.CurrentStep].Error = errMsg
+		now := e.now()
+		task.Steps[task.CurrentStep].CompletedAt = &now
+	}
+
+	// Keep the failure available to the step we jump to, as a retry would (FR25)
+	e.setErrorMetadata(task, step.Name, errMsg)
+
+	return true, e.jumpToStep(ctx, task, step, target, "on_failure_goto")
+}
+
+// trySuccessGoto jumps to the step's on_success_goto target after a
+// successful step. Returns true if the task jumped.
+func (e *Engine) trySuccessGoto(ctx context.Context, task *domain.Task, template *domain.Template, step *domain.StepDefinition, result *domain.StepResult) (bool, error) {
+	if step.OnSuccessGoto == "" || result == nil || result.Status != constants.StepStatusSuccess {
+		return false, nil
+	}
+
+	target, ok := e.resolveGotoTarget(task, template, step.OnSuccessGoto)
+	if !ok {
+		return false, nil
+	}
+	return true, e.jumpToStep(ctx, task, step, target, "on_success_goto")
+}
+
+// jumpToStep moves the task to the target step and checkpoints.
+// Steps that will run again are reset to pending; steps jumped over are
+// marked skipped.
+func (e *Engine) jumpToStep(ctx context.Context, task *domain.Task, step *domain.StepDefinition, target int, trigger string) error {
+	from := task.CurrentStep
+	jumps := stepJumpCount(task) + 1
+	e.setMetadata(task, stepJumpsMetadataKey, jumps)
+
+	if target <= from {
+		for i := target; i <= from && i < len(task.Steps); i++ {
+			task.Steps[i].Status = constants.StepStatusPending
+			task.Steps[i].CompletedAt = nil
+		}
+	} else {
+		for i := from + 1; i < target && i < len(task.Steps); i++ {
+			task.Steps[i].Status = constants.StepStatusSkipped
+		}
+	}
+
+	task.CurrentStep = target
+	task.UpdatedAt = e.now()
+
+	e.logger.Info().
+		Str("task_id", task.ID).
+		Str("step_name", step.Name).
+		Str("trigger", trigger).
+		Int("from_step", from).
+		Int("to_step", target).
+		Int("step_jumps", jumps).
+		Msg("jumping to step")
+
+	if err := e.saveTask(ctx, task); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return nil
+}
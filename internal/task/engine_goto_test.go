@@ -0,0 +1,123 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// fixThenValidateTemplate returns a template whose validate step jumps back
+// to the fix step on failure.
+func fixThenValidateTemplate() *domain.Template {
+	return &domain.Template{
+		Name: "goto-template",
+		Steps: []domain.StepDefinition{
+			{Name: "fix", Type: domain.StepTypeAI, Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true, OnFailureGoto: "fix"},
+		},
+	}
+}
+
+// TestEngine_OnFailureGoto_JumpsBackUntilSuccess tests a failing validate
+// step sends execution back to the fix step until validation passes.
+func TestEngine_OnFailureGoto_JumpsBackUntilSuccess(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	fixCalls := 0
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeAI,
+		onExecute: func(_ *domain.StepDefinition) { fixCalls++ },
+	})
+	validate := &flakyExecutor{stepType: domain.StepTypeValidation, failures: 2}
+	registry.Register(validate)
+
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", fixThenValidateTemplate(), "goto", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, fixCalls)
+	assert.Equal(t, 3, validate.calls)
+	assert.Equal(t, 2, stepJumpCount(task))
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+	assert.Equal(t, constants.StepStatusSuccess, task.Steps[1].Status)
+	assert.Contains(t, task.Metadata["retry_context"], "validate")
+}
+
+// TestEngine_OnFailureGoto_StopsAtJumpLimit tests the task fails normally
+// once MaxStepJumps is exhausted.
+func TestEngine_OnFailureGoto_StopsAtJumpLimit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	fixCalls := 0
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeAI,
+		onExecute: func(_ *domain.StepDefinition) { fixCalls++ },
+	})
+	validate := &flakyExecutor{stepType: domain.StepTypeValidation, failures: 100}
+	registry.Register(validate)
+
+	config := DefaultEngineConfig()
+	config.MaxStepJumps = 2
+	engine := NewEngine(newMockStore(), registry, config, testLogger())
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", fixThenValidateTemplate(), "goto", "")
+
+	require.ErrorIs(t, err, atlaserrors.ErrCIFailed)
+	require.NotNil(t, task)
+	assert.Equal(t, 3, fixCalls)
+	assert.Equal(t, 3, validate.calls)
+	assert.Equal(t, 2, stepJumpCount(task))
+	assert.Equal(t, constants.TaskStatusValidationFailed, task.Status)
+}
+
+// TestEngine_OnSuccessGoto_SkipsIntermediateSteps tests a succeeding step
+// jumps forward and the steps in between are marked skipped.
+func TestEngine_OnSuccessGoto_SkipsIntermediateSteps(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var executed []string
+	record := func(step *domain.StepDefinition) { executed = append(executed, step.Name) }
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{stepType: domain.StepTypeAI, onExecute: record})
+	registry.Register(&trackingExecutor{stepType: domain.StepTypeVerify, onExecute: record})
+
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name: "goto-template",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true, OnSuccessGoto: "finish"},
+			{Name: "verify", Type: domain.StepTypeVerify, Required: true},
+			{Name: "finish", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "goto", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"implement", "finish"}, executed)
+	assert.Equal(t, constants.StepStatusSkipped, task.Steps[1].Status)
+	assert.Equal(t, 1, stepJumpCount(task))
+}
+
+// TestStepJumpCount tests the jump counter survives a JSON round trip.
+func TestStepJumpCount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, stepJumpCount(&domain.Task{}))
+	assert.Equal(t, 3, stepJumpCount(&domain.Task{Metadata: map[string]any{stepJumpsMetadataKey: 3}}))
+	assert.Equal(t, 4, stepJumpCount(&domain.Task{Metadata: map[string]any{stepJumpsMetadataKey: float64(4)}}))
+}
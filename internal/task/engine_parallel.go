@@ -0,0 +1,217 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements declarative parallel groups. Adjacent template steps
+// that share a parallel_group id run concurrently through
+// executeParallelSteps; the step after the group starts once every member
+// has finished. Results are recorded in template order so task history reads
+// the same as a sequential run.
+//
+// Executors in a group each receive their own copy of the task. Changes they
+// make to task.Metadata are merged back into the task in template order once
+// the group finishes; any other mutation of the task is discarded, so
+// executors must report everything else through their StepResult.
+package task
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// parallelGroupSteps returns the indices of the steps in the parallel group
+// starting at task.CurrentStep that still need to run, and the index of the
+// group's last step. Members that already succeeded, for example before a
+// sibling failed and the task was resumed, are not run again.
+func parallelGroupSteps(task *domain.Task, template *domain.Template) ([]int, int) {
+	start := task.CurrentStep
+	group := template.Steps[start].ParallelGroup
+
+	pending := []int{}
+	last := start
+	for i := start; i < len(template.Steps) && template.Steps[i].ParallelGroup == group; i++ {
+		last = i
+		if i < len(task.Steps) && task.Steps[i].Status == constants.StepStatusSuccess {
+			continue
+		}
+		pending = append(pending, i)
+	}
+	return pending, last
+}
+
+// runParallelGroup runs the parallel group at task.CurrentStep and advances
+// past it. Returns true if the task stopped inside the group, either paused
+// for approval or moved to an error state by a failing member.
+func (e *Engine) runParallelGroup(ctx context.Context, task *domain.Task, template *domain.Template) (bool, error) {
+	totalSteps := len(template.Steps)
+	members, last := parallelGroupSteps(task, template)
+
+	run := make([]int, 0, len(members))
+	for _, idx := range members {
+		step := &template.Steps[idx]
+		task.CurrentStep = idx
+		if e.shouldSkipStep(task, step) {
+			e.recordSkippedStep(task, step)
+			continue
+		}
+		e.notifyStepStart(task, step, totalSteps)
+		e.markStepRunning(task, idx)
+		run = append(run, idx)
+	}
+
+	if len(run) == 0 {
+		task.CurrentStep = last
+		return false, e.advanceToNextStep(ctx, task)
+	}
+
+	e.transitionHookStep(ctx, task, template.Steps[run[0]].Name, run[0])
+
+	results, errs, groupErr := e.executeParallelSteps(ctx, task, template, run)
+	if err := ctx.Err(); err != nil {
+		resetUnfinishedSteps(task, run, last, results, errs)
+		return true, e.handleContextCancellation(ctx, task, template, err)
+	}
+
+	failed := -1
+	if groupErr != nil {
+		failed = firstParallelFailure(errs)
+	}
+
+	for i, idx := range run {
+		if i == failed {
+			continue
+		}
+		if errs[i] != nil {
+			// Canceled because a sibling failed; runs again on resume
+			resetStep(task, idx)
+			continue
+		}
+
+		step := &template.Steps[idx]
+		task.CurrentStep = idx
+		e.notifyStepComplete(task, step, results[i], totalSteps)
+		if err := e.processStepResult(ctx, task, results[i], step); err != nil {
+			e.failHookStep(ctx, task, step.Name, err)
+			return true, err
+		}
+		if e.shouldPause(task) {
+			// A completed step paused for confirmation must not re-run on resume
+			if results[i].Status == constants.StepStatusSuccess && task.Status == constants.TaskStatusAwaitingApproval {
+				task.CurrentStep++
+			}
+			return true, e.saveAndPause(ctx, task)
+		}
+	}
+
+	if failed >= 0 {
+		idx := run[failed]
+		step := &template.Steps[idx]
+		task.CurrentStep = idx
+		if results[failed] != nil {
+			e.notifyStepComplete(task, step, results[failed], totalSteps)
+		}
+		e.failHookStep(ctx, task, step.Name, errs[failed])
+		return true, e.handleExecutionError(ctx, task, step, results[failed], errs[failed])
+	}
+
+	e.completeHookStep(ctx, task, template.Steps[last].Name, nil)
+	task.CurrentStep = last
+	return false, e.advanceToNextStep(ctx, task)
+}
+
+// firstParallelFailure returns the position of the step that failed the
+// group: the first error that is not a cancellation caused by a sibling.
+func firstParallelFailure(errs []error) int {
+	first := -1
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, context.Canceled) {
+			return i
+		}
+		if first < 0 {
+			first = i
+		}
+	}
+	return first
+}
+
+// markStepRunning records the start of a step attempt, as ExecuteStep does
+// for sequential steps.
+func (e *Engine) markStepRunning(task *domain.Task, idx int) {
+	if idx >= len(task.Steps) {
+		return
+	}
+	now := e.now()
+	task.Steps[idx].Status = constants.StepStatusRunning
+	task.Steps[idx].StartedAt = &now
+	task.Steps[idx].Attempts++
+}
+
+// resetStep returns a step to pending so it runs again.
+func resetStep(task *domain.Task, idx int) {
+	if idx >= len(task.Steps) {
+		return
+	}
+	task.Steps[idx].Status = constants.StepStatusPending
+	task.Steps[idx].StartedAt = nil
+}
+
+// resetUnfinishedSteps handles cancellation of a parallel group. Members that
+// succeeded are recorded; the rest are reset to pending and the task points
+// at the first of them so resume reruns only what did not finish.
+func resetUnfinishedSteps(task *domain.Task, run []int, last int, results []*domain.StepResult, errs []error) {
+	resumeAt := last + 1
+	for i, idx := range run {
+		if errs[i] == nil && results[i] != nil && results[i].Status == constants.StepStatusSuccess {
+			task.StepResults = append(task.StepResults, *results[i])
+			if idx < len(task.Steps) {
+				task.Steps[idx].Status = constants.StepStatusSuccess
+				completed := results[i].CompletedAt
+				task.Steps[idx].CompletedAt = &completed
+			}
+			continue
+		}
+		resetStep(task, idx)
+		resumeAt = min(resumeAt, idx)
+	}
+	task.CurrentStep = resumeAt
+}
+
+// isolateTask returns a copy of task that a parallel step can use without
+// racing its siblings. Metadata is cloned one level deep and StepResults is
+// clipped so appends reallocate instead of sharing a backing array.
+func isolateTask(task *domain.Task) *domain.Task {
+	view := *task
+	view.Metadata = maps.Clone(task.Metadata)
+	view.StepResults = slices.Clip(task.StepResults)
+	return &view
+}
+
+// mergeParallelMetadata applies the top-level metadata keys each view added,
+// changed, or removed to task. Views are merged in order, so when siblings
+// write the same key the later template step wins, as in a sequential run.
+func mergeParallelMetadata(task *domain.Task, views []*domain.Task) {
+	base := maps.Clone(task.Metadata)
+	for _, view := range views {
+		for key, value := range view.Metadata {
+			if old, ok := base[key]; ok && reflect.DeepEqual(old, value) {
+				continue
+			}
+			if task.Metadata == nil {
+				task.Metadata = make(map[string]any)
+			}
+			task.Metadata[key] = value
+		}
+		for key := range base {
+			if _, ok := view.Metadata[key]; !ok {
+				delete(task.Metadata, key)
+			}
+		}
+	}
+}
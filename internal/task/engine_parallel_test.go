@@ -0,0 +1,279 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// eventLog records step events from concurrent executors.
+type eventLog struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (l *eventLog) add(event string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, event)
+}
+
+func (l *eventLog) snapshot() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.events...)
+}
+
+// barrierExecutor only lets a step finish once every step sharing the
+// barrier has started, so it fails if the steps run one at a time.
+type barrierExecutor struct {
+	stepType domain.StepType
+	barrier  *sync.WaitGroup
+	log      *eventLog
+}
+
+func (e *barrierExecutor) Execute(ctx context.Context, _ *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	e.log.add(step.Name + ":start")
+	e.barrier.Done()
+
+	arrived := make(chan struct{})
+	go func() {
+		e.barrier.Wait()
+		close(arrived)
+	}()
+	select {
+	case <-arrived:
+	case <-time.After(5 * time.Second):
+		return nil, atlaserrors.ErrCITimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	e.log.add(step.Name + ":done")
+	return &domain.StepResult{
+		StepName:    step.Name,
+		Status:      constants.StepStatusSuccess,
+		StartedAt:   time.Now().UTC(),
+		CompletedAt: time.Now().UTC(),
+	}, nil
+}
+
+func (e *barrierExecutor) Type() domain.StepType {
+	return e.stepType
+}
+
+// parallelGroupTemplate returns a template whose two check steps form a
+// parallel group followed by a sequential step.
+func parallelGroupTemplate() *domain.Template {
+	return &domain.Template{
+		Name: "parallel-template",
+		Steps: []domain.StepDefinition{
+			{Name: "lint", Type: domain.StepTypeAI, Required: true, ParallelGroup: "checks"},
+			{Name: "test", Type: domain.StepTypeAI, Required: true, ParallelGroup: "checks"},
+			{Name: "commit", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+}
+
+// TestEngine_ParallelGroup_RunsConcurrently tests that grouped steps run
+// concurrently and the following step waits for the whole group.
+func TestEngine_ParallelGroup_RunsConcurrently(t *testing.T) {
+	t.Parallel()
+
+	log := &eventLog{}
+	barrier := &sync.WaitGroup{}
+	barrier.Add(2)
+
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&barrierExecutor{stepType: domain.StepTypeAI, barrier: barrier, log: log})
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeValidation,
+		onExecute: func(step *domain.StepDefinition) { log.add(step.Name + ":start") },
+	})
+
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", parallelGroupTemplate(), "parallel", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+
+	events := log.snapshot()
+	require.Len(t, events, 5)
+	assert.ElementsMatch(t, []string{"lint:start", "test:start"}, events[:2])
+	assert.ElementsMatch(t, []string{"lint:done", "test:done"}, events[2:4])
+	assert.Equal(t, "commit:start", events[4])
+
+	for i := range task.Steps {
+		assert.Equal(t, constants.StepStatusSuccess, task.Steps[i].Status, task.Steps[i].Name)
+	}
+	require.Len(t, task.StepResults, 3)
+	assert.Equal(t, "lint", task.StepResults[0].StepName)
+	assert.Equal(t, "test", task.StepResults[1].StepName)
+	assert.Equal(t, "commit", task.StepResults[2].StepName)
+}
+
+// TestEngine_ParallelGroup_FailureStopsTask tests that a failing group member
+// moves the task to an error state at that step and later steps do not run.
+func TestEngine_ParallelGroup_FailureStopsTask(t *testing.T) {
+	t.Parallel()
+
+	var executed []string
+	var mu sync.Mutex
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{
+		stepType: domain.StepTypeAI,
+		onExecute: func(step *domain.StepDefinition) {
+			mu.Lock()
+			defer mu.Unlock()
+			executed = append(executed, step.Name)
+		},
+	})
+	registry.Register(&failingExecutor{stepType: domain.StepTypeValidation, err: atlaserrors.ErrValidationFailed})
+
+	tmpl := &domain.Template{
+		Name: "parallel-template",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true, ParallelGroup: "work"},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true, ParallelGroup: "work"},
+			{Name: "summarize", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", tmpl, "parallel", "")
+
+	require.ErrorIs(t, err, atlaserrors.ErrValidationFailed)
+	assert.Equal(t, constants.TaskStatusValidationFailed, task.Status)
+	assert.Equal(t, 1, task.CurrentStep)
+	assert.NotContains(t, executed, "summarize")
+	assert.Equal(t, constants.StepStatusPending, task.Steps[2].Status)
+}
+
+// TestParallelGroupSteps tests group membership and skipping of members
+// that already succeeded.
+func TestParallelGroupSteps(t *testing.T) {
+	t.Parallel()
+
+	tmpl := &domain.Template{
+		Steps: []domain.StepDefinition{
+			{Name: "a", ParallelGroup: "g"},
+			{Name: "b", ParallelGroup: "g"},
+			{Name: "c", ParallelGroup: "g"},
+			{Name: "d"},
+		},
+	}
+	task := &domain.Task{
+		Steps: []domain.Step{
+			{Name: "a", Status: constants.StepStatusFailed},
+			{Name: "b", Status: constants.StepStatusSuccess},
+			{Name: "c", Status: constants.StepStatusPending},
+			{Name: "d", Status: constants.StepStatusPending},
+		},
+	}
+
+	pending, last := parallelGroupSteps(task, tmpl)
+
+	assert.Equal(t, []int{0, 2}, pending)
+	assert.Equal(t, 2, last)
+}
+
+// TestFirstParallelFailure tests that a sibling cancellation is not reported
+// as the group's failure.
+func TestFirstParallelFailure(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, -1, firstParallelFailure([]error{nil, nil}))
+	assert.Equal(t, 1, firstParallelFailure([]error{context.Canceled, atlaserrors.ErrValidationFailed}))
+	assert.Equal(t, 0, firstParallelFailure([]error{context.Canceled, nil}))
+}
+
+// metadataWriterExecutor writes task metadata the way real executors do,
+// including lazily creating the map and removing a key.
+type metadataWriterExecutor struct {
+	stepType domain.StepType
+}
+
+func (e *metadataWriterExecutor) Execute(_ context.Context, task *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	if task.Metadata == nil {
+		task.Metadata = make(map[string]any)
+	}
+	for i := range 50 {
+		task.Metadata[step.Name] = i
+		_ = task.Metadata["seed"]
+	}
+	task.Metadata["last_writer"] = step.Name
+	if step.Name == "step-07" {
+		delete(task.Metadata, "stale")
+	}
+	task.StepResults = append(task.StepResults, domain.StepResult{StepName: step.Name})
+
+	return &domain.StepResult{StepName: step.Name, Status: constants.StepStatusSuccess}, nil
+}
+
+func (e *metadataWriterExecutor) Type() domain.StepType {
+	return e.stepType
+}
+
+// TestEngine_ParallelGroup_MetadataWritesMerged tests that many parallel
+// executors writing task metadata neither race (run with -race) nor lose
+// updates, and that conflicting keys resolve in template order.
+func TestEngine_ParallelGroup_MetadataWritesMerged(t *testing.T) {
+	t.Parallel()
+
+	const count = 32
+
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&metadataWriterExecutor{stepType: domain.StepTypeAI})
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{Name: "metadata-writers"}
+	task := &domain.Task{
+		ID:       "task-metadata",
+		Status:   constants.TaskStatusRunning,
+		Metadata: map[string]any{"seed": "kept", "stale": true},
+	}
+	indices := make([]int, count)
+	for i := range count {
+		name := fmt.Sprintf("step-%02d", i)
+		template.Steps = append(template.Steps, domain.StepDefinition{Name: name, Type: domain.StepTypeAI, ParallelGroup: "writers"})
+		task.Steps = append(task.Steps, domain.Step{Name: name, Type: domain.StepTypeAI})
+		indices[i] = i
+	}
+
+	results, err := engine.executeParallelGroup(context.Background(), task, template, indices)
+
+	require.NoError(t, err)
+	require.Len(t, results, count)
+	for i := range count {
+		assert.Equal(t, 49, task.Metadata[fmt.Sprintf("step-%02d", i)])
+	}
+	assert.Equal(t, "kept", task.Metadata["seed"])
+	assert.Equal(t, fmt.Sprintf("step-%02d", count-1), task.Metadata["last_writer"])
+	assert.NotContains(t, task.Metadata, "stale")
+	assert.Empty(t, task.StepResults, "executor appends to StepResults are not merged")
+}
+
+// TestMergeParallelMetadata_NilBase tests merging when the task had no metadata.
+func TestMergeParallelMetadata_NilBase(t *testing.T) {
+	t.Parallel()
+
+	task := &domain.Task{}
+	first, second := isolateTask(task), isolateTask(task)
+	second.Metadata = map[string]any{"pr_number": 42}
+
+	mergeParallelMetadata(task, []*domain.Task{first, second})
+
+	assert.Equal(t, map[string]any{"pr_number": 42}, task.Metadata)
+}
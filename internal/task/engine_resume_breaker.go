@@ -0,0 +1,101 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements the resume circuit breaker. A task that is resumed and
+// fails at the same step over and over spends AI budget without progress.
+// Resume counts consecutive resumes that end in failure at the same step and,
+// once EngineConfig.MaxResumeFailures is reached, refuses to run that step
+// again until someone intervenes.
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+const (
+	// resumeFailureCountKey is the task metadata key counting consecutive
+	// resumes that failed at the same step.
+	resumeFailureCountKey = "resume_failure_count"
+
+	// resumeFailureStepKey is the task metadata key holding the index of the
+	// step those resumes failed at.
+	resumeFailureStepKey = "resume_failure_step"
+)
+
+// resumeFailures returns the consecutive resume failure count and the step
+// index it applies to. Metadata read back from JSON holds numbers as float64.
+func resumeFailures(task *domain.Task) (int, int) {
+	return metadataInt(task, resumeFailureCountKey), metadataInt(task, resumeFailureStepKey)
+}
+
+// metadataInt reads an integer from task metadata, or zero if absent.
+func metadataInt(task *domain.Task, key string) int {
+	switch v := task.Metadata[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
+
+// checkResumeFailures refuses a resume once the task has failed at its
+// current step on MaxResumeFailures consecutive resumes.
+func (e *Engine) checkResumeFailures(task *domain.Task) error {
+	if e.config.MaxResumeFailures <= 0 {
+		return nil
+	}
+
+	count, step := resumeFailures(task)
+	if count < e.config.MaxResumeFailures || step != task.CurrentStep {
+		return nil
+	}
+
+	name := ""
+	if step < len(task.Steps) {
+		name = task.Steps[step].Name
+	}
+	return fmt.Errorf("%w: step %d (%s) failed on %d consecutive resumes; fix it manually before resuming again",
+		atlaserrors.ErrResumeFailureLimit, step, name, count)
+}
+
+// recordResumeOutcome updates the resume failure count after a resume run.
+// A failure at the step of the previous failure increments it, a failure
+// elsewhere starts a new count, and progress past that step clears it.
+// Interruptions are not failures and leave the count unchanged.
+func (e *Engine) recordResumeOutcome(ctx context.Context, task *domain.Task) {
+	count, step := resumeFailures(task)
+
+	switch {
+	case IsErrorStatus(task.Status) && task.Status != constants.TaskStatusInterrupted:
+		if step != task.CurrentStep {
+			count = 0
+		}
+		task.Metadata = e.ensureMetadata(task.Metadata)
+		task.Metadata[resumeFailureCountKey] = count + 1
+		task.Metadata[resumeFailureStepKey] = task.CurrentStep
+	case count > 0 && task.CurrentStep > step:
+		clearResumeFailures(task)
+	default:
+		return
+	}
+
+	// Save with a fresh context so the count survives a canceled run
+	if err := e.saveTask(context.WithoutCancel(ctx), task); err != nil {
+		e.logger.Warn().
+			Err(err).
+			Str("task_id", task.ID).
+			Msg("failed to save resume failure count")
+	}
+}
+
+// clearResumeFailures removes the resume failure count from the task.
+func clearResumeFailures(task *domain.Task) {
+	delete(task.Metadata, resumeFailureCountKey)
+	delete(task.Metadata, resumeFailureStepKey)
+}
@@ -0,0 +1,121 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// newBreakerEngine returns an engine that allows maxFailures failed resumes
+// at one step, and a template with three AI steps run by exec.
+func newBreakerEngine(exec *orderExecutor, maxFailures int) (*Engine, *domain.Template) {
+	registry := steps.NewExecutorRegistry()
+	registry.Register(exec)
+	cfg := DefaultEngineConfig()
+	cfg.MaxResumeFailures = maxFailures
+	engine := NewEngine(newMockStore(), registry, cfg, testLogger())
+
+	template := &domain.Template{
+		Name: "breaker",
+		Steps: []domain.StepDefinition{
+			{Name: "first", Type: domain.StepTypeAI, Required: true},
+			{Name: "second", Type: domain.StepTypeAI, Required: true},
+			{Name: "third", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+	return engine, template
+}
+
+// TestEngine_Resume_FailureLimit tests that resumes failing at the same step
+// are refused once the limit is reached, without running the step again.
+func TestEngine_Resume_FailureLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"second": true}}
+	engine, template := newBreakerEngine(exec, 2)
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "breaker", "")
+	require.NoError(t, err)
+	require.True(t, IsErrorStatus(task.Status))
+	require.Equal(t, 1, task.CurrentStep)
+
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, engine.Resume(ctx, task, template))
+		count, step := resumeFailures(task)
+		assert.Equal(t, i, count)
+		assert.Equal(t, 1, step)
+	}
+
+	runs := len(exec.order)
+	err = engine.Resume(ctx, task, template)
+	require.ErrorIs(t, err, atlaserrors.ErrResumeFailureLimit)
+	assert.Contains(t, err.Error(), "step 1 (second) failed on 2 consecutive resumes")
+	assert.Len(t, exec.order, runs, "refused resume must not run the step")
+
+	// An explicit rewind overrides the breaker
+	exec.fail = nil
+	require.NoError(t, engine.ResumeFrom(ctx, task, template, 1))
+	assert.False(t, IsErrorStatus(task.Status))
+	assert.NotContains(t, task.Metadata, resumeFailureCountKey)
+}
+
+// TestEngine_Resume_FailureCountResetsOnProgress tests that getting past the
+// failing step clears the count and a failure at a later step starts anew.
+func TestEngine_Resume_FailureCountResetsOnProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	exec := &orderExecutor{stepType: domain.StepTypeAI, fail: map[string]bool{"second": true}}
+	engine, template := newBreakerEngine(exec, 2)
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "breaker", "")
+	require.NoError(t, err)
+	require.NoError(t, engine.Resume(ctx, task, template))
+	count, _ := resumeFailures(task)
+	require.Equal(t, 1, count)
+
+	// Second passes now, but third fails: a new count at the new step
+	exec.fail = map[string]bool{"third": true}
+	require.NoError(t, engine.Resume(ctx, task, template))
+	count, step := resumeFailures(task)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 2, step)
+
+	// Third passes: the task completes and the count is cleared
+	exec.fail = nil
+	require.NoError(t, engine.Resume(ctx, task, template))
+	assert.False(t, IsErrorStatus(task.Status))
+	assert.NotContains(t, task.Metadata, resumeFailureCountKey)
+	assert.NotContains(t, task.Metadata, resumeFailureStepKey)
+}
+
+// TestEngine_CheckResumeFailures tests the breaker against metadata read
+// back from JSON and with the check disabled.
+func TestEngine_CheckResumeFailures(t *testing.T) {
+	t.Parallel()
+
+	task := &domain.Task{
+		CurrentStep: 1,
+		Steps:       []domain.Step{{Name: "first"}, {Name: "second"}},
+		Metadata: map[string]any{
+			resumeFailureCountKey: float64(3),
+			resumeFailureStepKey:  float64(1),
+		},
+	}
+
+	limited := &Engine{config: EngineConfig{MaxResumeFailures: 3}}
+	require.ErrorIs(t, limited.checkResumeFailures(task), atlaserrors.ErrResumeFailureLimit)
+
+	disabled := &Engine{config: EngineConfig{}}
+	require.NoError(t, disabled.checkResumeFailures(task))
+
+	task.CurrentStep = 0
+	require.NoError(t, limited.checkResumeFailures(task), "a different step is not blocked")
+}
@@ -0,0 +1,102 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements the AI self-review fallback. With verify enabled, a
+// template that has no verify step would silently skip verification; the
+// fallback injects a verify step after the last AI step so the implementation
+// still gets an independent review with the template's verify model.
+package task
+
+import (
+	"slices"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// SelfReviewStepName is the name of the verify step injected by the
+// self-review fallback.
+const SelfReviewStepName = "self_review"
+
+// selfReviewTimeout bounds the injected self-review step, matching the
+// verify steps of the built-in templates.
+const selfReviewTimeout = 5 * time.Minute
+
+// WithSelfReviewFallback makes Start inject an AI self-review verify step
+// when the template has Verify enabled but defines no verify step. The step
+// runs after the last AI step and uses the template's VerifyModel.
+func WithSelfReviewFallback(enabled bool) EngineOption {
+	return func(e *Engine) {
+		e.selfReviewFallback = enabled
+	}
+}
+
+// withSelfReview returns the template to start a task with: a clone with
+// the self-review step injected when the fallback applies, otherwise the
+// template itself.
+func (e *Engine) withSelfReview(template *domain.Template) *domain.Template {
+	if !e.selfReviewFallback || template == nil || !template.Verify {
+		return template
+	}
+	return injectSelfReview(template)
+}
+
+// templateForResume re-applies the self-review step to the template of a
+// task that was started with it, so the steps line up with what the task
+// recorded regardless of how this engine is configured.
+func templateForResume(task *domain.Task, template *domain.Template) *domain.Template {
+	if template == nil {
+		return template
+	}
+	for _, step := range task.Steps {
+		if step.Name == SelfReviewStepName && step.Type == domain.StepTypeVerify {
+			return injectSelfReview(template)
+		}
+	}
+	return template
+}
+
+// injectSelfReview returns a clone of template with the self-review step
+// inserted after the last AI step. The template is returned unchanged if it
+// already has a verify step, has no AI step to review, or already uses the
+// step name.
+func injectSelfReview(template *domain.Template) *domain.Template {
+	insertAt := -1
+	for i, step := range template.Steps {
+		if step.Type == domain.StepTypeVerify || step.Name == SelfReviewStepName {
+			return template
+		}
+		if step.Type == domain.StepTypeAI {
+			insertAt = i + 1
+		}
+	}
+	if insertAt < 0 {
+		return template
+	}
+
+	// Keep a parallel group contiguous when the last AI step belongs to one
+	if group := template.Steps[insertAt-1].ParallelGroup; group != "" {
+		for insertAt < len(template.Steps) && template.Steps[insertAt].ParallelGroup == group {
+			insertAt++
+		}
+	}
+
+	out := template.Clone()
+	out.Steps = slices.Insert(out.Steps, insertAt, selfReviewStep(template.VerifyModel))
+	return out
+}
+
+// selfReviewStep builds the injected verify step.
+func selfReviewStep(verifyModel string) domain.StepDefinition {
+	cfg := map[string]any{}
+	if verifyModel != "" {
+		cfg["model"] = verifyModel
+	}
+	return domain.StepDefinition{
+		Name:        SelfReviewStepName,
+		Type:        domain.StepTypeVerify,
+		Description: "AI self-review of the implementation",
+		Required:    true,
+		Timeout:     selfReviewTimeout,
+		Config:      cfg,
+	}
+}
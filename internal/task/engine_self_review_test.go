@@ -0,0 +1,184 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// newSelfReviewEngine returns an engine that records the AI and verify
+// steps it runs, and a verify-enabled template without a verify step.
+func newSelfReviewEngine(ai, verify *orderExecutor, opts ...EngineOption) (*Engine, *domain.Template) {
+	registry := steps.NewExecutorRegistry()
+	registry.Register(ai)
+	registry.Register(verify)
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger(), opts...)
+
+	template := &domain.Template{
+		Name:        "custom",
+		Verify:      true,
+		VerifyModel: "opus",
+		Steps: []domain.StepDefinition{
+			{Name: "analyze", Type: domain.StepTypeAI, Required: true},
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "wrap_up", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+	return engine, template
+}
+
+// TestEngine_Start_SelfReviewFallbackInjectsVerifyStep tests that a
+// verify-enabled template without a verify step gains a self-review step
+// after its last AI step when the fallback is enabled.
+func TestEngine_Start_SelfReviewFallbackInjectsVerifyStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ai := &orderExecutor{stepType: domain.StepTypeAI}
+	verify := &orderExecutor{stepType: domain.StepTypeVerify}
+	engine, template := newSelfReviewEngine(ai, verify, WithSelfReviewFallback(true))
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "self review", "")
+	require.NoError(t, err)
+	assert.False(t, IsErrorStatus(task.Status))
+
+	names := make([]string, len(task.Steps))
+	for i, step := range task.Steps {
+		names[i] = step.Name
+	}
+	assert.Equal(t, []string{"analyze", "implement", "wrap_up", SelfReviewStepName}, names)
+	assert.Equal(t, domain.StepTypeVerify, task.Steps[3].Type)
+	assert.Equal(t, []string{SelfReviewStepName}, verify.order)
+
+	// The caller's template is left untouched
+	assert.Len(t, template.Steps, 3)
+}
+
+// TestEngine_Start_SelfReviewFallbackDisabled tests that templates run as
+// written without the option or with verify disabled.
+func TestEngine_Start_SelfReviewFallbackDisabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		opts   []EngineOption
+		verify bool
+	}{
+		{name: "option not set", verify: true},
+		{name: "option disabled", opts: []EngineOption{WithSelfReviewFallback(false)}, verify: true},
+		{name: "verify off", opts: []EngineOption{WithSelfReviewFallback(true)}, verify: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ai := &orderExecutor{stepType: domain.StepTypeAI}
+			verify := &orderExecutor{stepType: domain.StepTypeVerify}
+			engine, template := newSelfReviewEngine(ai, verify, tt.opts...)
+			template.Verify = tt.verify
+
+			task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "self review", "")
+			require.NoError(t, err)
+			assert.Len(t, task.Steps, 3)
+			assert.Empty(t, verify.order)
+		})
+	}
+}
+
+// TestEngine_Resume_KeepsInjectedSelfReview tests that a task started with
+// the self-review step resumes against the same step list, even on an
+// engine without the fallback.
+func TestEngine_Resume_KeepsInjectedSelfReview(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ai := &orderExecutor{stepType: domain.StepTypeAI}
+	verify := &orderExecutor{stepType: domain.StepTypeVerify, fail: map[string]bool{SelfReviewStepName: true}}
+	engine, template := newSelfReviewEngine(ai, verify, WithSelfReviewFallback(true))
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "self review", "")
+	require.NoError(t, err)
+	require.True(t, IsErrorStatus(task.Status))
+	require.Equal(t, 3, task.CurrentStep)
+
+	verify.fail = nil
+	verify.order = nil
+	resumer := NewEngine(engine.store, engine.registry, DefaultEngineConfig(), testLogger())
+	require.NoError(t, resumer.Resume(ctx, task, template))
+
+	assert.Equal(t, []string{SelfReviewStepName}, verify.order)
+	assert.False(t, IsErrorStatus(task.Status))
+}
+
+func TestInjectSelfReview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inserts after last AI step with verify model", func(t *testing.T) {
+		t.Parallel()
+
+		template := &domain.Template{
+			VerifyModel: "opus",
+			Steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeAI},
+				{Name: "validate", Type: domain.StepTypeValidation},
+				{Name: "commit", Type: domain.StepTypeGit},
+			},
+		}
+
+		out := injectSelfReview(template)
+
+		require.Len(t, out.Steps, 4)
+		step := out.Steps[1]
+		assert.Equal(t, SelfReviewStepName, step.Name)
+		assert.Equal(t, domain.StepTypeVerify, step.Type)
+		assert.True(t, step.Required)
+		assert.Equal(t, "opus", step.Config["model"])
+		assert.Equal(t, "validate", out.Steps[2].Name)
+	})
+
+	t.Run("keeps parallel group together", func(t *testing.T) {
+		t.Parallel()
+
+		template := &domain.Template{
+			Steps: []domain.StepDefinition{
+				{Name: "lint", Type: domain.StepTypeValidation, ParallelGroup: "checks"},
+				{Name: "review", Type: domain.StepTypeAI, ParallelGroup: "checks"},
+				{Name: "test", Type: domain.StepTypeValidation, ParallelGroup: "checks"},
+				{Name: "commit", Type: domain.StepTypeGit},
+			},
+		}
+
+		out := injectSelfReview(template)
+
+		require.Len(t, out.Steps, 5)
+		assert.Equal(t, SelfReviewStepName, out.Steps[3].Name)
+		assert.NotContains(t, out.Steps[3].Config, "model")
+	})
+
+	t.Run("leaves templates with a verify step alone", func(t *testing.T) {
+		t.Parallel()
+
+		template := &domain.Template{
+			Steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeAI},
+				{Name: "verify", Type: domain.StepTypeVerify},
+			},
+		}
+		assert.Same(t, template, injectSelfReview(template))
+	})
+
+	t.Run("leaves templates without an AI step alone", func(t *testing.T) {
+		t.Parallel()
+
+		template := &domain.Template{
+			Steps: []domain.StepDefinition{{Name: "validate", Type: domain.StepTypeValidation}},
+		}
+		assert.Same(t, template, injectSelfReview(template))
+	})
+}
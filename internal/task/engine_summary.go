@@ -0,0 +1,110 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements the run summary, a machine-readable artifact written
+// when a task finishes or stops in an error state so tooling can inspect the
+// whole run without parsing task.json.
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// RunSummaryArtifact is the artifact filename of the run summary.
+const RunSummaryArtifact = "run-summary.json"
+
+// RunSummary describes a finished task run.
+type RunSummary struct {
+	TaskID      string               `json:"task_id"`
+	WorkspaceID string               `json:"workspace_id"`
+	TemplateID  string               `json:"template_id"`
+	Status      constants.TaskStatus `json:"status"`
+	StartedAt   time.Time            `json:"started_at"`
+	FinishedAt  time.Time            `json:"finished_at"`
+	DurationMs  int64                `json:"duration_ms"`
+	Steps       []RunSummaryStep     `json:"steps"`
+	Totals      RunSummaryTotals     `json:"totals"`
+	LastError   string               `json:"last_error,omitempty"`
+}
+
+// RunSummaryStep describes one template step in a run summary.
+type RunSummaryStep struct {
+	Name       string          `json:"name"`
+	Type       domain.StepType `json:"type"`
+	Status     string          `json:"status"`
+	Attempts   int             `json:"attempts"`
+	DurationMs int64           `json:"duration_ms"` // Summed across attempts
+	Error      string          `json:"error,omitempty"`
+}
+
+// RunSummaryTotals aggregates step results across the run.
+type RunSummaryTotals struct {
+	StepResults  int   `json:"step_results"`
+	Failed       int   `json:"failed"`
+	FilesChanged int   `json:"files_changed"` // Distinct files
+	AITurns      int   `json:"ai_turns"`
+	StepTimeMs   int64 `json:"step_time_ms"`
+}
+
+// BuildRunSummary builds the run summary for a task as of finishedAt.
+func BuildRunSummary(task *domain.Task, finishedAt time.Time) RunSummary {
+	summary := RunSummary{
+		TaskID:      task.ID,
+		WorkspaceID: task.WorkspaceID,
+		TemplateID:  task.TemplateID,
+		Status:      task.Status,
+		StartedAt:   task.CreatedAt,
+		FinishedAt:  finishedAt,
+		DurationMs:  finishedAt.Sub(task.CreatedAt).Milliseconds(),
+		Steps:       make([]RunSummaryStep, 0, len(task.Steps)),
+	}
+	if lastErr, ok := task.Metadata["last_error"].(string); ok {
+		summary.LastError = lastErr
+	}
+
+	stepTime := make(map[string]int64, len(task.Steps))
+	files := make(map[string]struct{})
+	for _, result := range task.StepResults {
+		stepTime[result.StepName] += result.DurationMs
+		summary.Totals.StepResults++
+		summary.Totals.StepTimeMs += result.DurationMs
+		summary.Totals.AITurns += result.NumTurns
+		if result.Status == constants.StepStatusFailed {
+			summary.Totals.Failed++
+		}
+		for _, f := range result.FilesChanged {
+			files[f] = struct{}{}
+		}
+	}
+	summary.Totals.FilesChanged = len(files)
+
+	for _, step := range task.Steps {
+		summary.Steps = append(summary.Steps, RunSummaryStep{
+			Name:       step.Name,
+			Type:       step.Type,
+			Status:     step.Status,
+			Attempts:   step.Attempts,
+			DurationMs: stepTime[step.Name],
+			Error:      step.Error,
+		})
+	}
+	return summary
+}
+
+// writeRunSummary saves the run summary artifact, replacing any earlier one
+// so a resumed run that finishes again leaves a single current summary.
+// Failures are logged and never fail the task.
+func (e *Engine) writeRunSummary(ctx context.Context, task *domain.Task) {
+	data, err := json.MarshalIndent(BuildRunSummary(task, e.now()), "", "  ")
+	if err != nil {
+		e.logger.Warn().Err(err).Str("task_id", task.ID).Msg("failed to encode run summary")
+		return
+	}
+	if err := e.store.SaveArtifact(ctx, task.WorkspaceID, task.ID, RunSummaryArtifact, data); err != nil {
+		e.logger.Warn().Err(err).Str("task_id", task.ID).Msg("failed to save run summary")
+	}
+}
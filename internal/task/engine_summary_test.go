@@ -0,0 +1,120 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// readRunSummary decodes the run summary artifact saved to the mock store.
+func readRunSummary(t *testing.T, store *mockStore) RunSummary {
+	t.Helper()
+	store.mu.Lock()
+	data, ok := store.artifacts[RunSummaryArtifact]
+	store.mu.Unlock()
+	require.True(t, ok, "run summary artifact not saved")
+
+	var summary RunSummary
+	require.NoError(t, json.Unmarshal(data, &summary))
+	return summary
+}
+
+// TestEngine_RunSummary_Success tests a completed run writes a summary with
+// its final status and every step.
+func TestEngine_RunSummary_Success(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{stepType: domain.StepTypeAI})
+	registry.Register(&trackingExecutor{stepType: domain.StepTypeValidation})
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name: "summary",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "summary", "")
+	require.NoError(t, err)
+
+	summary := readRunSummary(t, store)
+	assert.Equal(t, task.ID, summary.TaskID)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, summary.Status)
+	require.Len(t, summary.Steps, 2)
+	assert.Equal(t, "implement", summary.Steps[0].Name)
+	assert.Equal(t, constants.StepStatusSuccess, summary.Steps[1].Status)
+	assert.Equal(t, 2, summary.Totals.StepResults)
+	assert.Zero(t, summary.Totals.Failed)
+	assert.Empty(t, summary.LastError)
+}
+
+// TestEngine_RunSummary_Failure tests a run stopping in an error state writes
+// a summary with that status and the last error.
+func TestEngine_RunSummary_Failure(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{stepType: domain.StepTypeAI})
+	registry.Register(&flakyExecutor{stepType: domain.StepTypeValidation, failures: 1})
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name: "summary",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "summary", "")
+	require.ErrorIs(t, err, atlaserrors.ErrCIFailed)
+	require.NotNil(t, task)
+
+	summary := readRunSummary(t, store)
+	assert.Equal(t, task.Status, summary.Status)
+	assert.Equal(t, constants.TaskStatusValidationFailed, summary.Status)
+	require.Len(t, summary.Steps, 2)
+	assert.Equal(t, constants.StepStatusFailed, summary.Steps[1].Status)
+	assert.Contains(t, summary.LastError, atlaserrors.ErrCIFailed.Error())
+}
+
+// TestBuildRunSummary_Totals tests step durations are summed across attempts
+// and files are counted once.
+func TestBuildRunSummary_Totals(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	task := &domain.Task{
+		ID:        "task-1",
+		Status:    constants.TaskStatusAwaitingApproval,
+		CreatedAt: created,
+		Steps: []domain.Step{
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess, Attempts: 2},
+		},
+		StepResults: []domain.StepResult{
+			{StepName: "implement", Status: constants.StepStatusFailed, DurationMs: 100, NumTurns: 3, FilesChanged: []string{"a.go"}},
+			{StepName: "implement", Status: constants.StepStatusSuccess, DurationMs: 200, NumTurns: 2, FilesChanged: []string{"a.go", "b.go"}},
+		},
+	}
+
+	summary := BuildRunSummary(task, created.Add(time.Minute))
+
+	assert.Equal(t, int64(60000), summary.DurationMs)
+	assert.Equal(t, int64(300), summary.Steps[0].DurationMs)
+	assert.Equal(t, 2, summary.Steps[0].Attempts)
+	assert.Equal(t, RunSummaryTotals{StepResults: 2, Failed: 1, FilesChanged: 2, AITurns: 5, StepTimeMs: 300}, summary.Totals)
+}
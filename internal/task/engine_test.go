@@ -8,12 +8,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mrz1836/atlas/internal/clock"
 	"github.com/mrz1836/atlas/internal/config"
 	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/contracts"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/template/steps"
@@ -21,13 +24,16 @@ import (
 
 // mockStore implements Store interface for testing.
 type mockStore struct {
-	mu          sync.Mutex
-	tasks       map[string]*domain.Task
-	createErr   error
-	updateErr   error
+	mu        sync.Mutex
+	tasks     map[string]*domain.Task
+	createErr error
+	updateErr error
+	// collisions makes the next N Create calls fail with ErrTaskExists.
+	collisions  int
 	getErr      error
 	createCalls int
 	updateCalls int
+	artifacts   map[string][]byte
 }
 
 func newMockStore() *mockStore {
@@ -43,6 +49,10 @@ func (m *mockStore) Create(_ context.Context, _ string, task *domain.Task) error
 	if m.createErr != nil {
 		return m.createErr
 	}
+	if m.collisions > 0 {
+		m.collisions--
+		return atlaserrors.ErrTaskExists
+	}
 	// Deep copy to avoid external modifications
 	m.tasks[task.ID] = task
 	return nil
@@ -97,7 +107,17 @@ func (m *mockStore) ReadLog(_ context.Context, _, _ string) ([]byte, error) {
 	return nil, nil
 }
 
-func (m *mockStore) SaveArtifact(_ context.Context, _, _, _ string, _ []byte) error {
+func (m *mockStore) SaveArtifact(_ context.Context, _, _, filename string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.artifacts == nil {
+		m.artifacts = make(map[string][]byte)
+	}
+	m.artifacts[filename] = data
+	return nil
+}
+
+func (m *mockStore) SaveStepArtifact(_ context.Context, _, _, _, _ string, _ []byte) error {
 	return nil
 }
 
@@ -249,6 +269,133 @@ func TestEngine_Start_TaskIDFormat(t *testing.T) {
 	assert.Regexp(t, `^task-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, task.ID)
 }
 
+// TestEngine_Start_RetriesTaskIDCollision tests Start retries a colliding
+// task ID with a -2 suffix when the store already has a task with that ID.
+func TestEngine_Start_RetriesTaskIDCollision(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	store.collisions = 1
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeAI,
+		result:   &domain.StepResult{Status: "success"},
+	})
+
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeAI},
+		},
+	}
+
+	first, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "first", "")
+	require.NoError(t, err)
+	second, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "second", "")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Regexp(t, `^task-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}-2$`, first.ID)
+	assert.Regexp(t, `^task-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, second.ID)
+	assert.Equal(t, 3, store.createCalls)
+	assert.Contains(t, store.tasks, first.ID)
+	assert.Contains(t, store.tasks, second.ID)
+}
+
+// TestEngine_Start_TaskIDCollisionGivesUp tests Start stops retrying after
+// maxTaskIDAttempts collisions.
+func TestEngine_Start_TaskIDCollisionGivesUp(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	store.collisions = maxTaskIDAttempts
+	registry := steps.NewExecutorRegistry()
+
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeAI},
+		},
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+
+	require.Error(t, err)
+	assert.Nil(t, task)
+	require.ErrorIs(t, err, atlaserrors.ErrTaskExists)
+	assert.Equal(t, maxTaskIDAttempts, store.createCalls)
+}
+
+// TestEngine_Start_MissingRequiredExecutable tests Start fails before creating
+// the task when a template requires a tool that is not on PATH.
+func TestEngine_Start_MissingRequiredExecutable(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	executor := &mockExecutor{
+		stepType: domain.StepTypeAI,
+		result:   &domain.StepResult{Status: "success"},
+	}
+	registry.Register(executor)
+
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name:     "test-template",
+		Requires: []string{"atlas-test-nonexistent-tool"},
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeAI},
+		},
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+
+	require.Error(t, err)
+	assert.Nil(t, task)
+	require.ErrorIs(t, err, atlaserrors.ErrMissingDependency)
+	assert.Contains(t, err.Error(), "atlas-test-nonexistent-tool")
+	assert.Equal(t, 0, store.createCalls)
+}
+
+// TestEngine_Start_RequiredExecutablesPresent tests Start proceeds when every
+// required tool is on PATH.
+func TestEngine_Start_RequiredExecutablesPresent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeAI,
+		result:   &domain.StepResult{Status: "success"},
+	})
+
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name:     "test-template",
+		Requires: []string{"go"},
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeAI},
+		},
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, 1, store.createCalls)
+	assert.Len(t, task.StepResults, 1)
+}
+
 // TestEngine_Start_SetsFromBacklogIDInMetadata tests that fromBacklogID is set in metadata.
 func TestEngine_Start_SetsFromBacklogIDInMetadata(t *testing.T) {
 	t.Parallel()
@@ -418,107 +565,374 @@ func TestEngine_Resume_FromErrorState(t *testing.T) {
 	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
 }
 
-// TestEngine_Resume_TerminalState tests that resume rejects terminal states.
-func TestEngine_Resume_TerminalState(t *testing.T) {
+// TestEngine_Start_RecordsActor tests the actor is recorded on creation and
+// propagated to transitions.
+// TestEngine_Start_RecordsBranches tests the base and target branches are recorded on the task.
+func TestEngine_Start_RecordsBranches(t *testing.T) {
 	t.Parallel()
-	ctx := context.Background()
 
-	store := newMockStore()
-	registry := steps.NewExecutorRegistry()
-	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+	tests := []struct {
+		name       string
+		opts       []StartOption
+		wantBase   string
+		wantTarget string
+	}{
+		{
+			name:     "normal start records computed base",
+			opts:     []StartOption{WithBaseBranch("main"), WithBaseBranch(""), WithTargetBranch("")},
+			wantBase: "main",
+		},
+		{
+			name:     "explicit base overrides computed base",
+			opts:     []StartOption{WithBaseBranch("main"), WithBaseBranch("develop")},
+			wantBase: "develop",
+		},
+		{
+			name:       "target branch is recorded",
+			opts:       []StartOption{WithBaseBranch("main"), WithTargetBranch("hotfix/login")},
+			wantBase:   "main",
+			wantTarget: "hotfix/login",
+		},
+		{
+			name: "no options leaves branches empty",
+		},
+	}
 
-	template := &domain.Template{
-		Name:  "test",
-		Steps: []domain.StepDefinition{},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMockStore()
+			registry := steps.NewExecutorRegistry()
+			registry.Register(&mockExecutor{
+				stepType: domain.StepTypeAI,
+				result:   &domain.StepResult{Status: "success"},
+			})
+			engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+			template := &domain.Template{
+				Name:  "test-template",
+				Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI}},
+			}
+
+			task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "", tt.opts...)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBase, task.BaseBranch)
+			assert.Equal(t, tt.wantTarget, task.TargetBranch)
+			assert.Equal(t, tt.wantBase, store.tasks[task.ID].BaseBranch, "branches are persisted")
+		})
 	}
+}
 
-	terminalStates := []constants.TaskStatus{
-		constants.TaskStatusCompleted,
-		constants.TaskStatusRejected,
-		constants.TaskStatusAbandoned,
+// TestEngine_Start_RecordsPriority tests the priority is recorded on the task.
+func TestEngine_Start_RecordsPriority(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		opts         []StartOption
+		wantPriority int
+	}{
+		{name: "default priority is zero"},
+		{name: "priority is recorded", opts: []StartOption{WithPriority(7)}, wantPriority: 7},
+		{name: "negative priority is recorded", opts: []StartOption{WithPriority(-2)}, wantPriority: -2},
 	}
 
-	for _, status := range terminalStates {
-		task := &domain.Task{
-			ID:          "task-123",
-			WorkspaceID: "test",
-			Status:      status,
-		}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-		err := engine.Resume(ctx, task, template)
+			store := newMockStore()
+			registry := steps.NewExecutorRegistry()
+			registry.Register(&mockExecutor{
+				stepType: domain.StepTypeAI,
+				result:   &domain.StepResult{Status: "success"},
+			})
+			engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
 
-		assert.ErrorIs(t, err, atlaserrors.ErrInvalidTransition)
+			template := &domain.Template{
+				Name:  "test-template",
+				Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI}},
+			}
+
+			task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "", tt.opts...)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPriority, task.Priority)
+			assert.Equal(t, tt.wantPriority, store.tasks[task.ID].Priority, "priority is persisted")
+		})
 	}
 }
 
-// TestEngine_Resume_ContextCancellation tests context cancellation during resume.
-func TestEngine_Resume_ContextCancellation(t *testing.T) {
+func TestEngine_Start_RecordsActor(t *testing.T) {
 	t.Parallel()
-	ctx, cancel := context.WithCancel(context.Background())
-	cancel() // Cancel immediately
+	ctx := context.Background()
 
 	store := newMockStore()
 	registry := steps.NewExecutorRegistry()
-	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeAI,
+		result:   &domain.StepResult{Status: "success"},
+	})
 
-	task := &domain.Task{
-		ID:          "task-123",
-		WorkspaceID: "test",
-		Status:      constants.TaskStatusRunning,
+	config := DefaultEngineConfig()
+	config.Actor = "ci-bot"
+	engine := NewEngine(store, registry, config, testLogger())
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeAI},
+		},
 	}
-	template := &domain.Template{Name: "test"}
 
-	err := engine.Resume(ctx, task, template)
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
 
-	assert.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, err)
+	assert.Equal(t, "ci-bot", task.Actor)
+	require.NotEmpty(t, task.Transitions)
+	for _, tr := range task.Transitions {
+		assert.Equal(t, "ci-bot", tr.Actor, "transition %s -> %s", tr.FromStatus, tr.ToStatus)
+	}
 }
 
-// TestEngine_ExecuteStep_ContextCancellation tests context check at step entry.
-func TestEngine_ExecuteStep_ContextCancellation(t *testing.T) {
+// fakeClock is a Clock frozen at a fixed instant.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c fakeClock) Now() time.Time { return c.now }
+
+// TestEngine_Start_UsesConfiguredClock tests task and transition timestamps
+// come from the engine's clock, and that tasks started in the same instant
+// still get distinct IDs.
+func TestEngine_Start_UsesConfiguredClock(t *testing.T) {
 	t.Parallel()
-	ctx, cancel := context.WithCancel(context.Background())
-	cancel() // Cancel immediately
+	ctx := context.Background()
 
 	store := newMockStore()
 	registry := steps.NewExecutorRegistry()
-	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeAI,
+		result:   &domain.StepResult{Status: "success"},
+	})
 
-	task := &domain.Task{ID: "task-123", WorkspaceID: "test"}
-	step := &domain.StepDefinition{Name: "step1", Type: domain.StepTypeAI}
+	fixed := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	config := DefaultEngineConfig()
+	config.Clock = fakeClock{now: fixed}
+	engine := NewEngine(store, registry, config, testLogger())
 
-	result, err := engine.ExecuteStep(ctx, task, step)
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeAI},
+		},
+	}
 
-	assert.Nil(t, result)
-	assert.ErrorIs(t, err, context.Canceled)
+	first, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "first", "")
+	require.NoError(t, err)
+	second, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "second", "")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Regexp(t, `^task-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, first.ID)
+
+	assert.Equal(t, fixed, first.CreatedAt)
+	assert.Equal(t, fixed, first.UpdatedAt)
+	require.NotEmpty(t, first.Transitions)
+	for _, tr := range first.Transitions {
+		assert.Equal(t, fixed, tr.Timestamp, "transition %s -> %s", tr.FromStatus, tr.ToStatus)
+	}
 }
 
-// TestEngine_ExecuteStep_ExecutorNotFound tests error when executor not registered.
-func TestEngine_ExecuteStep_ExecutorNotFound(t *testing.T) {
+// TestEngine_Start_PersistsClockTimestamps tests the timestamps saved by a
+// FileStore come from the engine's clock rather than the system clock.
+func TestEngine_Start_PersistsClockTimestamps(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
-	store := newMockStore()
-	registry := steps.NewExecutorRegistry() // Empty registry
-	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeAI,
+		result:   &domain.StepResult{Status: "success"},
+	})
 
-	task := &domain.Task{ID: "task-123", WorkspaceID: "test", Steps: []domain.Step{{Name: "step1"}}}
-	step := &domain.StepDefinition{Name: "step1", Type: domain.StepTypeAI}
+	fixed := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	config := DefaultEngineConfig()
+	config.Clock = fakeClock{now: fixed}
+	engine := NewEngine(store, registry, config, testLogger())
 
-	result, err := engine.ExecuteStep(ctx, task, step)
+	template := &domain.Template{
+		Name:  "test-template",
+		Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI}},
+	}
 
-	assert.Nil(t, result)
-	assert.ErrorIs(t, err, atlaserrors.ErrExecutorNotFound)
+	started, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "clocked", "")
+	require.NoError(t, err)
+
+	saved, err := store.Get(ctx, "test-workspace", started.ID)
+	require.NoError(t, err)
+	assert.True(t, saved.CreatedAt.Equal(fixed))
+	assert.True(t, saved.UpdatedAt.Equal(fixed), "persisted UpdatedAt %s", saved.UpdatedAt)
 }
 
-// TestEngine_HandleStepResult_AutoProceed tests auto-proceed for validation.
-func TestEngine_HandleStepResult_AutoProceed(t *testing.T) {
+// TestClockFromContext tests the context clock falls back to the real clock.
+func TestClockFromContext(t *testing.T) {
+	t.Parallel()
+
+	fixed := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, fixed, clockFromContext(WithClock(context.Background(), fakeClock{now: fixed})).Now())
+	assert.Equal(t, clock.RealClock{}, clockFromContext(context.Background()))
+}
+
+// TestEngine_Resume_RecordsResumingActor tests resume transitions are
+// attributed to the resuming actor rather than the task's creator.
+func TestEngine_Resume_RecordsResumingActor(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
 
 	store := newMockStore()
 	registry := steps.NewExecutorRegistry()
-	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
-
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeValidation,
+		result:   &domain.StepResult{Status: "success"},
+	})
+
+	task := &domain.Task{
+		ID:          "task-550e8400-e29b-41d4-a716-446655440000",
+		WorkspaceID: "test-workspace",
+		Actor:       "alice",
+		Status:      constants.TaskStatusValidationFailed,
+		CurrentStep: 0,
+		Steps: []domain.Step{
+			{Name: "step1", Type: domain.StepTypeValidation, Status: "failed"},
+		},
+		Transitions: []domain.Transition{},
+	}
+	store.tasks[task.ID] = task
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "step1", Type: domain.StepTypeValidation},
+		},
+	}
+
+	config := DefaultEngineConfig()
+	config.Actor = "ci-bot"
+	engine := NewEngine(store, registry, config, testLogger())
+
+	require.NoError(t, engine.Resume(ctx, task, template))
+
+	assert.Equal(t, "alice", task.Actor)
+	require.NotEmpty(t, task.Transitions)
+	assert.Equal(t, "ci-bot", task.Transitions[0].Actor)
+}
+
+// TestEngine_Resume_TerminalState tests that resume rejects terminal states.
+func TestEngine_Resume_TerminalState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name:  "test",
+		Steps: []domain.StepDefinition{},
+	}
+
+	terminalStates := []constants.TaskStatus{
+		constants.TaskStatusCompleted,
+		constants.TaskStatusRejected,
+		constants.TaskStatusAbandoned,
+	}
+
+	for _, status := range terminalStates {
+		task := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test",
+			Status:      status,
+		}
+
+		err := engine.Resume(ctx, task, template)
+
+		assert.ErrorIs(t, err, atlaserrors.ErrInvalidTransition)
+	}
+}
+
+// TestEngine_Resume_ContextCancellation tests context cancellation during resume.
+func TestEngine_Resume_ContextCancellation(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Cancel immediately
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	task := &domain.Task{
+		ID:          "task-123",
+		WorkspaceID: "test",
+		Status:      constants.TaskStatusRunning,
+	}
+	template := &domain.Template{Name: "test"}
+
+	err := engine.Resume(ctx, task, template)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// TestEngine_ExecuteStep_ContextCancellation tests context check at step entry.
+func TestEngine_ExecuteStep_ContextCancellation(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Cancel immediately
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	task := &domain.Task{ID: "task-123", WorkspaceID: "test"}
+	step := &domain.StepDefinition{Name: "step1", Type: domain.StepTypeAI}
+
+	result, err := engine.ExecuteStep(ctx, task, step)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// TestEngine_ExecuteStep_ExecutorNotFound tests error when executor not registered.
+func TestEngine_ExecuteStep_ExecutorNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry() // Empty registry
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	task := &domain.Task{ID: "task-123", WorkspaceID: "test", Steps: []domain.Step{{Name: "step1"}}}
+	step := &domain.StepDefinition{Name: "step1", Type: domain.StepTypeAI}
+
+	result, err := engine.ExecuteStep(ctx, task, step)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, atlaserrors.ErrExecutorNotFound)
+}
+
+// TestEngine_HandleStepResult_AutoProceed tests auto-proceed for validation.
+func TestEngine_HandleStepResult_AutoProceed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
 	task := &domain.Task{
 		ID:          "task-123",
 		WorkspaceID: "test",
@@ -575,6 +989,117 @@ func TestEngine_HandleStepResult_PausesForHuman(t *testing.T) {
 	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
 }
 
+// TestEngine_HandleStepResult_AutoProceedMap tests per-step-type auto-proceed
+// with a mixed map: git pauses while validation proceeds.
+func TestEngine_HandleStepResult_AutoProceedMap(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultEngineConfig()
+	cfg.AutoProceed = map[domain.StepType]bool{
+		domain.StepTypeGit:        false,
+		domain.StepTypeValidation: true,
+	}
+
+	tests := []struct {
+		name           string
+		stepType       domain.StepType
+		expectedStatus constants.TaskStatus
+	}{
+		{name: "git step pauses", stepType: domain.StepTypeGit, expectedStatus: constants.TaskStatusAwaitingApproval},
+		{name: "validation step proceeds", stepType: domain.StepTypeValidation, expectedStatus: constants.TaskStatusRunning},
+		{name: "unlisted step type proceeds", stepType: domain.StepTypeAI, expectedStatus: constants.TaskStatusRunning},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			engine := NewEngine(newMockStore(), steps.NewExecutorRegistry(), cfg, testLogger())
+
+			task := &domain.Task{
+				ID:          "task-123",
+				WorkspaceID: "test",
+				Status:      constants.TaskStatusRunning,
+				Steps:       []domain.Step{{Name: "step", Type: tc.stepType, Status: "running"}},
+			}
+			result := &domain.StepResult{
+				StepName:    "step",
+				Status:      "success",
+				CompletedAt: time.Now().UTC(),
+			}
+			step := &domain.StepDefinition{Name: "step", Type: tc.stepType}
+
+			err := engine.HandleStepResult(ctx, task, result, step)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, task.Status)
+		})
+	}
+}
+
+// TestEngineConfig_ShouldAutoProceed tests map entries override the legacy
+// fields and unlisted step types fall back to them.
+func TestEngineConfig_ShouldAutoProceed(t *testing.T) {
+	t.Parallel()
+
+	cfg := EngineConfig{
+		AutoProceedGit:        false,
+		AutoProceedValidation: true,
+		AutoProceed: map[domain.StepType]bool{
+			domain.StepTypeValidation: false,
+		},
+	}
+
+	assert.False(t, cfg.ShouldAutoProceed(domain.StepTypeGit))
+	assert.False(t, cfg.ShouldAutoProceed(domain.StepTypeValidation))
+	assert.True(t, cfg.ShouldAutoProceed(domain.StepTypeAI))
+	assert.True(t, DefaultEngineConfig().ShouldAutoProceed(domain.StepTypeGit))
+}
+
+// TestEngine_Start_PausesAfterGitStep tests that a paused git step is not
+// re-executed when the task resumes.
+func TestEngine_Start_PausesAfterGitStep(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	gitCalls := 0
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeGit,
+		onExecute: func(_ *domain.StepDefinition) { gitCalls++ },
+	})
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeValidation,
+		result:   &domain.StepResult{Status: "success"},
+	})
+
+	cfg := DefaultEngineConfig()
+	cfg.AutoProceed = map[domain.StepType]bool{domain.StepTypeGit: false}
+	engine := NewEngine(store, registry, cfg, testLogger())
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+			{Name: "commit", Type: domain.StepTypeGit, Required: true},
+			{Name: "verify", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+	assert.Equal(t, 2, task.CurrentStep)
+	assert.Equal(t, 1, gitCalls)
+
+	err = engine.Resume(ctx, task, template)
+	require.NoError(t, err)
+	assert.Equal(t, 1, gitCalls)
+	assert.Len(t, task.StepResults, 3)
+}
+
 // TestEngine_HandleStepResult_ErrorState tests transitioning to error state on failure.
 func TestEngine_HandleStepResult_ErrorState(t *testing.T) {
 	t.Parallel()
@@ -1592,65 +2117,211 @@ func TestEngine_executeCurrentStep(t *testing.T) {
 	})
 }
 
-// TestEngine_processStepResult tests the processStepResult helper.
-func TestEngine_processStepResult(t *testing.T) {
-	t.Parallel()
-	t.Run("success_result_returns_nil", func(t *testing.T) {
-		ctx := context.Background()
+// flakyExecutor fails a fixed number of times before succeeding.
+type flakyExecutor struct {
+	stepType domain.StepType
+	failures int
+	calls    int
+}
 
-		store := newMockStore()
-		registry := steps.NewExecutorRegistry()
-		engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+func (e *flakyExecutor) Execute(_ context.Context, _ *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	e.calls++
+	if e.calls <= e.failures {
+		return nil, atlaserrors.ErrCIFailed
+	}
+	return &domain.StepResult{StepName: step.Name, Status: "success"}, nil
+}
 
-		task := &domain.Task{
+func (e *flakyExecutor) Type() domain.StepType {
+	return e.stepType
+}
+
+// TestEngine_executeCurrentStep_RetryPolicy tests step-level retry policies.
+func TestEngine_executeCurrentStep_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	newTask := func() *domain.Task {
+		return &domain.Task{
 			ID:          "task-123",
 			WorkspaceID: "test",
-			Status:      constants.TaskStatusRunning,
-			CurrentStep: 0,
-			Steps:       []domain.Step{{Name: "step0", Type: domain.StepTypeAI, Status: "running"}},
-			StepResults: []domain.StepResult{},
+			Steps:       []domain.Step{{Name: "ci_wait", Type: domain.StepTypeCI}},
 		}
+	}
 
-		result := &domain.StepResult{
-			StepName:    "step0",
-			Status:      "success",
-			CompletedAt: time.Now().UTC(),
+	t.Run("step_with_policy_recovers_after_two_failures", func(t *testing.T) {
+		t.Parallel()
+		executor := &flakyExecutor{stepType: domain.StepTypeCI, failures: 2}
+		registry := steps.NewExecutorRegistry()
+		registry.Register(executor)
+		engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+		template := &domain.Template{
+			Name: "test",
+			Steps: []domain.StepDefinition{{
+				Name:  "ci_wait",
+				Type:  domain.StepTypeCI,
+				Retry: &domain.RetryPolicy{Max: 3, Delay: time.Millisecond},
+			}},
 		}
-		step := &domain.StepDefinition{Name: "step0", Type: domain.StepTypeAI}
+		task := newTask()
 
-		err := engine.processStepResult(ctx, task, result, step)
+		result, err := engine.executeCurrentStep(context.Background(), task, template)
 
 		require.NoError(t, err)
-		assert.Len(t, task.StepResults, 1)
+		require.NotNil(t, result)
+		assert.Equal(t, "success", result.Status)
+		assert.Equal(t, 3, executor.calls)
+		assert.Equal(t, 3, task.Steps[0].Attempts)
 	})
 
-	t.Run("failed_result_transitions_to_error_state", func(t *testing.T) {
-		ctx := context.Background()
-
-		store := newMockStore()
+	t.Run("step_without_policy_fails_immediately", func(t *testing.T) {
+		t.Parallel()
+		executor := &flakyExecutor{stepType: domain.StepTypeCI, failures: 2}
 		registry := steps.NewExecutorRegistry()
-		engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+		registry.Register(executor)
+		engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
 
-		task := &domain.Task{
-			ID:          "task-123",
-			WorkspaceID: "test",
-			Status:      constants.TaskStatusRunning,
-			CurrentStep: 0,
-			Steps:       []domain.Step{{Name: "step0", Type: domain.StepTypeGit, Status: "running"}},
-			StepResults: []domain.StepResult{},
-			Transitions: []domain.Transition{},
+		template := &domain.Template{
+			Name:  "test",
+			Steps: []domain.StepDefinition{{Name: "ci_wait", Type: domain.StepTypeCI}},
 		}
-		store.tasks[task.ID] = task
 
-		result := &domain.StepResult{
-			StepName:    "step0",
-			Status:      "failed",
-			Error:       "git push failed",
-			CompletedAt: time.Now().UTC(),
-		}
-		step := &domain.StepDefinition{Name: "step0", Type: domain.StepTypeGit}
+		_, err := engine.executeCurrentStep(context.Background(), newTask(), template)
 
-		err := engine.processStepResult(ctx, task, result, step)
+		require.ErrorIs(t, err, atlaserrors.ErrCIFailed)
+		assert.Equal(t, 1, executor.calls)
+	})
+
+	t.Run("step_policy_overrides_engine_default", func(t *testing.T) {
+		t.Parallel()
+		executor := &flakyExecutor{stepType: domain.StepTypeCI, failures: 2}
+		registry := steps.NewExecutorRegistry()
+		registry.Register(executor)
+		cfg := DefaultEngineConfig()
+		cfg.StepRetry = domain.RetryPolicy{Max: 5}
+		engine := NewEngine(newMockStore(), registry, cfg, testLogger())
+
+		template := &domain.Template{
+			Name: "test",
+			Steps: []domain.StepDefinition{{
+				Name:  "ci_wait",
+				Type:  domain.StepTypeCI,
+				Retry: &domain.RetryPolicy{Max: 0},
+			}},
+		}
+
+		_, err := engine.executeCurrentStep(context.Background(), newTask(), template)
+
+		require.ErrorIs(t, err, atlaserrors.ErrCIFailed)
+		assert.Equal(t, 1, executor.calls)
+	})
+
+	t.Run("engine_default_applies_without_step_policy", func(t *testing.T) {
+		t.Parallel()
+		executor := &flakyExecutor{stepType: domain.StepTypeCI, failures: 1}
+		registry := steps.NewExecutorRegistry()
+		registry.Register(executor)
+		cfg := DefaultEngineConfig()
+		cfg.StepRetry = domain.RetryPolicy{Max: 1}
+		engine := NewEngine(newMockStore(), registry, cfg, testLogger())
+
+		template := &domain.Template{
+			Name:  "test",
+			Steps: []domain.StepDefinition{{Name: "ci_wait", Type: domain.StepTypeCI}},
+		}
+
+		result, err := engine.executeCurrentStep(context.Background(), newTask(), template)
+
+		require.NoError(t, err)
+		assert.Equal(t, "success", result.Status)
+		assert.Equal(t, 2, executor.calls)
+	})
+
+	t.Run("cancellation_during_delay_stops_retrying", func(t *testing.T) {
+		t.Parallel()
+		executor := &flakyExecutor{stepType: domain.StepTypeCI, failures: 5}
+		registry := steps.NewExecutorRegistry()
+		registry.Register(executor)
+		engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+		template := &domain.Template{
+			Name: "test",
+			Steps: []domain.StepDefinition{{
+				Name:  "ci_wait",
+				Type:  domain.StepTypeCI,
+				Retry: &domain.RetryPolicy{Max: 3, Delay: time.Hour},
+			}},
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := engine.executeCurrentStep(ctx, newTask(), template)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, executor.calls)
+	})
+}
+
+// TestEngine_processStepResult tests the processStepResult helper.
+func TestEngine_processStepResult(t *testing.T) {
+	t.Parallel()
+	t.Run("success_result_returns_nil", func(t *testing.T) {
+		ctx := context.Background()
+
+		store := newMockStore()
+		registry := steps.NewExecutorRegistry()
+		engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+		task := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test",
+			Status:      constants.TaskStatusRunning,
+			CurrentStep: 0,
+			Steps:       []domain.Step{{Name: "step0", Type: domain.StepTypeAI, Status: "running"}},
+			StepResults: []domain.StepResult{},
+		}
+
+		result := &domain.StepResult{
+			StepName:    "step0",
+			Status:      "success",
+			CompletedAt: time.Now().UTC(),
+		}
+		step := &domain.StepDefinition{Name: "step0", Type: domain.StepTypeAI}
+
+		err := engine.processStepResult(ctx, task, result, step)
+
+		require.NoError(t, err)
+		assert.Len(t, task.StepResults, 1)
+	})
+
+	t.Run("failed_result_transitions_to_error_state", func(t *testing.T) {
+		ctx := context.Background()
+
+		store := newMockStore()
+		registry := steps.NewExecutorRegistry()
+		engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+		task := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test",
+			Status:      constants.TaskStatusRunning,
+			CurrentStep: 0,
+			Steps:       []domain.Step{{Name: "step0", Type: domain.StepTypeGit, Status: "running"}},
+			StepResults: []domain.StepResult{},
+			Transitions: []domain.Transition{},
+		}
+		store.tasks[task.ID] = task
+
+		result := &domain.StepResult{
+			StepName:    "step0",
+			Status:      "failed",
+			Error:       "git push failed",
+			CompletedAt: time.Now().UTC(),
+		}
+		step := &domain.StepDefinition{Name: "step0", Type: domain.StepTypeGit}
+
+		err := engine.processStepResult(ctx, task, result, step)
 
 		// HandleStepResult returns nil for failed status (successful transition)
 		require.NoError(t, err)
@@ -5050,3 +5721,804 @@ func TestEngine_HandleStepResult_ApprovalContextInTransition(t *testing.T) {
 		assert.Contains(t, approvalTransition.Reason, "awaiting user approval")
 	})
 }
+
+// verifyThenGitTemplate returns a template with a verify step followed by git commit and push.
+func verifyThenGitTemplate() *domain.Template {
+	return &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "verify", Type: domain.StepTypeVerify, Required: true},
+			{Name: "git_commit", Type: domain.StepTypeGit, Required: true, Config: map[string]any{"operation": "commit"}},
+			{Name: "git_push", Type: domain.StepTypeGit, Required: true, Config: map[string]any{"operation": "push"}},
+		},
+	}
+}
+
+// TestEngine_BlockCommitOnVerifyFailure tests a failed verify step skips downstream git steps.
+func TestEngine_BlockCommitOnVerifyFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		verify   *mockExecutor
+		wantErr  bool
+		block    bool
+		wantGit  string
+		wantMeta bool
+	}{
+		{
+			name:     "failed result blocks git steps",
+			verify:   &mockExecutor{stepType: domain.StepTypeVerify, result: &domain.StepResult{Status: constants.StepStatusFailed, Error: "tests missing"}},
+			block:    true,
+			wantGit:  constants.StepStatusSkipped,
+			wantMeta: true,
+		},
+		{
+			name:     "execution error blocks git steps",
+			verify:   &mockExecutor{stepType: domain.StepTypeVerify, err: atlaserrors.ErrClaudeInvocation},
+			wantErr:  true,
+			block:    true,
+			wantGit:  constants.StepStatusSkipped,
+			wantMeta: true,
+		},
+		{
+			name:    "disabled leaves git steps pending",
+			verify:  &mockExecutor{stepType: domain.StepTypeVerify, result: &domain.StepResult{Status: constants.StepStatusFailed, Error: "tests missing"}},
+			block:   false,
+			wantGit: constants.StepStatusPending,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gitCalls := 0
+			registry := steps.NewExecutorRegistry()
+			registry.Register(tc.verify)
+			registry.Register(&trackingExecutor{
+				stepType:  domain.StepTypeGit,
+				onExecute: func(_ *domain.StepDefinition) { gitCalls++ },
+			})
+
+			config := DefaultEngineConfig()
+			config.BlockCommitOnVerifyFailure = tc.block
+			engine := NewEngine(newMockStore(), registry, config, testLogger())
+
+			task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", verifyThenGitTemplate(), "test description", "")
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotNil(t, task)
+			assert.Equal(t, constants.TaskStatusValidationFailed, task.Status)
+			assert.Equal(t, 0, gitCalls, "git steps must not run after a failed verify")
+			assert.Equal(t, constants.StepStatusFailed, task.Steps[0].Status)
+			assert.Equal(t, tc.wantGit, task.Steps[1].Status)
+			assert.Equal(t, tc.wantGit, task.Steps[2].Status)
+			blocked, _ := task.Metadata["verify_blocked_git"].(bool)
+			assert.Equal(t, tc.wantMeta, blocked)
+		})
+	}
+}
+
+// TestEngine_BlockCommitOnVerifyFailure_UnblockedWhenVerifyPasses tests a
+// passing verify on resume lets the git steps run.
+func TestEngine_BlockCommitOnVerifyFailure_UnblockedWhenVerifyPasses(t *testing.T) {
+	t.Parallel()
+
+	gitCalls := 0
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{stepType: domain.StepTypeVerify, result: &domain.StepResult{Status: constants.StepStatusSuccess}})
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeGit,
+		onExecute: func(_ *domain.StepDefinition) { gitCalls++ },
+	})
+
+	store := newMockStore()
+	task := &domain.Task{
+		ID:          "task-550e8400-e29b-41d4-a716-446655440000",
+		WorkspaceID: "test-workspace",
+		Status:      constants.TaskStatusValidationFailed,
+		CurrentStep: 0,
+		Steps: []domain.Step{
+			{Name: "verify", Type: domain.StepTypeVerify, Status: constants.StepStatusFailed},
+			{Name: "git_commit", Type: domain.StepTypeGit, Status: constants.StepStatusSkipped},
+			{Name: "git_push", Type: domain.StepTypeGit, Status: constants.StepStatusSkipped},
+		},
+		Metadata: map[string]any{"verify_blocked_git": true},
+	}
+	store.tasks[task.ID] = task
+
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+	require.NoError(t, engine.Resume(context.Background(), task, verifyThenGitTemplate()))
+
+	assert.Equal(t, 2, gitCalls)
+	assert.NotContains(t, task.Metadata, "verify_blocked_git")
+	assert.Equal(t, constants.StepStatusSuccess, task.Steps[1].Status)
+	assert.Equal(t, constants.StepStatusSuccess, task.Steps[2].Status)
+}
+
+// TestEngine_MaxStepOutputBytes tests large step output is truncated on the
+// task and the full output is preserved as an artifact.
+func TestEngine_MaxStepOutputBytes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	fullOutput := strings.Repeat("x", 100)
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{
+		stepType: domain.StepTypeValidation,
+		result:   &domain.StepResult{StepName: "validate", Status: constants.StepStatusSuccess, Output: fullOutput},
+	})
+
+	config := DefaultEngineConfig()
+	config.MaxStepOutputBytes = 40
+	engine := NewEngine(store, registry, config, testLogger())
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	task, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+	require.NoError(t, err)
+
+	stored, err := store.Get(ctx, "test-workspace", task.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.StepResults, 1)
+
+	result := stored.StepResults[0]
+	assert.Equal(t, strings.Repeat("x", 40)+"\n[truncated 60 bytes]", result.Output)
+	assert.Equal(t, "validate/output.log", result.ArtifactPath)
+
+	artifact, err := store.GetArtifact(ctx, "test-workspace", task.ID, result.ArtifactPath)
+	require.NoError(t, err)
+	assert.Equal(t, fullOutput, string(artifact))
+}
+
+// TestEngine_ExecutorReceivesStepName tests the engine passes the current step
+// name to executors through the context.
+func TestEngine_ExecutorReceivesStepName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var seen []string
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&callbackExecutor{
+		stepType: domain.StepTypeAI,
+		callback: func(ctx context.Context) (*domain.StepResult, error) {
+			seen = append(seen, contracts.StepNameFromContext(ctx))
+			return &domain.StepResult{Status: constants.StepStatusSuccess}, nil
+		},
+	})
+
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "plan", Type: domain.StepTypeAI, Required: true},
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+
+	_, err := engine.Start(ctx, "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plan", "implement"}, seen)
+}
+
+// TestEngine_MaxStepOutputBytes_UnderLimit tests output within the limit is stored as-is.
+func TestEngine_MaxStepOutputBytes_UnderLimit(t *testing.T) {
+	t.Parallel()
+
+	engine := NewEngine(newMockStore(), steps.NewExecutorRegistry(), EngineConfig{MaxStepOutputBytes: 40}, testLogger())
+	task := &domain.Task{ID: "task-1", WorkspaceID: "test-workspace"}
+	result := &domain.StepResult{StepName: "validate", Output: "short output"}
+
+	engine.capStepOutput(context.Background(), task, result)
+
+	assert.Equal(t, "short output", result.Output)
+	assert.Empty(t, result.ArtifactPath)
+}
+
+// TestEngine_SkipUnknownSteps tests steps with an unregistered type are
+// skipped when SkipUnknownSteps is enabled and fail the task otherwise.
+func TestEngine_SkipUnknownSteps(t *testing.T) {
+	t.Parallel()
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "future", Type: domain.StepType("quantum"), Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	newEngine := func(skipUnknown bool) *Engine {
+		registry := steps.NewExecutorRegistry()
+		registry.Register(&mockExecutor{
+			stepType: domain.StepTypeValidation,
+			result:   &domain.StepResult{StepName: "validate", Status: constants.StepStatusSuccess},
+		})
+		config := DefaultEngineConfig()
+		config.SkipUnknownSteps = skipUnknown
+		return NewEngine(newMockStore(), registry, config, testLogger())
+	}
+
+	t.Run("enabled skips and continues", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := newEngine(true).Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+		assert.Equal(t, constants.StepStatusSkipped, task.Steps[0].Status)
+		require.Len(t, task.StepResults, 2)
+		assert.Equal(t, constants.StepStatusSkipped, task.StepResults[0].Status)
+		assert.Contains(t, task.StepResults[0].Output, `no executor registered for step type "quantum"`)
+		assert.Equal(t, constants.StepStatusSuccess, task.StepResults[1].Status)
+	})
+
+	t.Run("disabled fails fast", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := newEngine(false).Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "test description", "")
+		require.ErrorIs(t, err, atlaserrors.ErrExecutorNotFound)
+		require.NotNil(t, task)
+		assert.NotEqual(t, constants.TaskStatusCompleted, task.Status)
+		assert.Equal(t, 0, task.CurrentStep)
+	})
+}
+
+// TestEngine_ResumeFrom_ReExecutesEarlierSteps tests resuming from step 0 of a
+// task that had reached step 2 runs the earlier steps again.
+func TestEngine_ResumeFrom_ReExecutesEarlierSteps(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var executed []string
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeAI,
+		onExecute: func(step *domain.StepDefinition) { executed = append(executed, step.Name) },
+	})
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeValidation,
+		onExecute: func(step *domain.StepDefinition) { executed = append(executed, step.Name) },
+	})
+
+	store := newMockStore()
+	task := &domain.Task{
+		ID:          "task-resume-from",
+		WorkspaceID: "test-workspace",
+		Status:      constants.TaskStatusValidationFailed,
+		CurrentStep: 2,
+		Steps: []domain.Step{
+			{Name: "plan", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess},
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess},
+			{Name: "validate", Type: domain.StepTypeValidation, Status: constants.StepStatusFailed, Error: "lint failed"},
+		},
+		Metadata: map[string]any{"step_approval_choice": "retry"},
+	}
+	store.tasks[task.ID] = task
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "plan", Type: domain.StepTypeAI, Required: true},
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	err := engine.ResumeFrom(ctx, task, template, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plan", "implement", "validate"}, executed)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+	assert.NotContains(t, task.Metadata, "step_approval_choice")
+	for _, step := range task.Steps {
+		assert.Empty(t, step.Error, step.Name)
+	}
+}
+
+// TestEngine_ResumeFrom_InvalidIndex tests step indexes outside the task's progress are rejected.
+func TestEngine_ResumeFrom_InvalidIndex(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "plan", Type: domain.StepTypeAI},
+			{Name: "implement", Type: domain.StepTypeAI},
+			{Name: "validate", Type: domain.StepTypeValidation},
+		},
+	}
+
+	for _, index := range []int{-1, 2, 3} {
+		store := newMockStore()
+		task := &domain.Task{
+			ID:          "task-123",
+			WorkspaceID: "test",
+			Status:      constants.TaskStatusValidationFailed,
+			CurrentStep: 1,
+			Steps:       make([]domain.Step, 3),
+		}
+		engine := NewEngine(store, steps.NewExecutorRegistry(), DefaultEngineConfig(), testLogger())
+
+		err := engine.ResumeFrom(ctx, task, template, index)
+
+		require.ErrorIs(t, err, atlaserrors.ErrValueOutOfRange, "index %d", index)
+		assert.Equal(t, 1, task.CurrentStep)
+	}
+}
+
+// TestEngine_ResumeFrom_TerminalState tests terminal tasks cannot be rewound.
+func TestEngine_ResumeFrom_TerminalState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	engine := NewEngine(newMockStore(), steps.NewExecutorRegistry(), DefaultEngineConfig(), testLogger())
+	template := &domain.Template{
+		Name:  "test",
+		Steps: []domain.StepDefinition{{Name: "plan", Type: domain.StepTypeAI}},
+	}
+
+	for _, status := range []constants.TaskStatus{
+		constants.TaskStatusCompleted,
+		constants.TaskStatusRejected,
+		constants.TaskStatusAbandoned,
+	} {
+		task := &domain.Task{ID: "task-123", WorkspaceID: "test", Status: status, CurrentStep: 0}
+
+		err := engine.ResumeFrom(ctx, task, template, 0)
+
+		assert.ErrorIs(t, err, atlaserrors.ErrInvalidTransition)
+	}
+}
+
+// TestEngine_ResumeFrom_MisalignedTemplate tests a rejected rewind saves nothing.
+func TestEngine_ResumeFrom_MisalignedTemplate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := newMockStore()
+	engine := NewEngine(store, steps.NewExecutorRegistry(), DefaultEngineConfig(), testLogger())
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "plan", Type: domain.StepTypeAI},
+			{Name: "review", Type: domain.StepTypeAI},
+		},
+	}
+	task := &domain.Task{
+		ID:          "task-123",
+		WorkspaceID: "test",
+		Status:      constants.TaskStatusValidationFailed,
+		CurrentStep: 1,
+		Steps: []domain.Step{
+			{Name: "plan", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess},
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusFailed},
+		},
+	}
+
+	err := engine.ResumeFrom(ctx, task, template, 0)
+
+	require.ErrorIs(t, err, atlaserrors.ErrTemplateStepMismatch)
+	assert.Equal(t, 1, task.CurrentStep)
+	assert.Equal(t, constants.StepStatusSuccess, task.Steps[0].Status)
+	assert.Zero(t, store.updateCalls, "rewound state must not be saved")
+}
+
+func alignmentTask() *domain.Task {
+	return &domain.Task{
+		ID:          "task-alignment",
+		WorkspaceID: "test-workspace",
+		Status:      constants.TaskStatusValidationFailed,
+		CurrentStep: 1,
+		Steps: []domain.Step{
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess},
+			{Name: "validate", Type: domain.StepTypeValidation, Status: constants.StepStatusFailed},
+		},
+	}
+}
+
+func alignmentRegistry() *steps.ExecutorRegistry {
+	registry := steps.NewExecutorRegistry()
+	for _, stepType := range []domain.StepType{domain.StepTypeAI, domain.StepTypeValidation} {
+		registry.Register(&mockExecutor{
+			stepType: stepType,
+			result:   &domain.StepResult{Status: constants.StepStatusSuccess},
+		})
+	}
+	return registry
+}
+
+func TestEngine_Resume_AlignedTemplate(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	task := alignmentTask()
+	store.tasks[task.ID] = task
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+		},
+	}
+
+	engine := NewEngine(store, alignmentRegistry(), DefaultEngineConfig(), testLogger())
+	err := engine.Resume(context.Background(), task, template)
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+}
+
+func TestEngine_Resume_MisalignedTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		steps   []domain.StepDefinition
+		wantMsg string
+	}{
+		{
+			name: "renamed step",
+			steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeAI},
+				{Name: "verify", Type: domain.StepTypeValidation},
+			},
+			wantMsg: "step 1 is validate (validation) in the task but verify (validation)",
+		},
+		{
+			name: "changed type",
+			steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeValidation},
+				{Name: "validate", Type: domain.StepTypeValidation},
+			},
+			wantMsg: "step 0 is implement (ai) in the task but implement (validation)",
+		},
+		{
+			name: "removed step",
+			steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeAI},
+			},
+			wantMsg: "step 1 (validate) is recorded in the task but missing",
+		},
+		{
+			name: "appended step without resync",
+			steps: []domain.StepDefinition{
+				{Name: "implement", Type: domain.StepTypeAI},
+				{Name: "validate", Type: domain.StepTypeValidation},
+				{Name: "commit", Type: domain.StepTypeGit},
+			},
+			wantMsg: "step 2 (commit) is in template",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMockStore()
+			task := alignmentTask()
+			store.tasks[task.ID] = task
+			template := &domain.Template{Name: "test-template", Steps: tc.steps}
+
+			engine := NewEngine(store, alignmentRegistry(), DefaultEngineConfig(), testLogger())
+			err := engine.Resume(context.Background(), task, template)
+
+			require.ErrorIs(t, err, atlaserrors.ErrTemplateStepMismatch)
+			assert.Contains(t, err.Error(), tc.wantMsg)
+			assert.Equal(t, constants.TaskStatusValidationFailed, task.Status, "task should be left untouched")
+		})
+	}
+}
+
+func TestEngine_Resume_ResyncAppendedSteps(t *testing.T) {
+	t.Parallel()
+
+	var executed []string
+	registry := steps.NewExecutorRegistry()
+	for _, stepType := range []domain.StepType{domain.StepTypeAI, domain.StepTypeValidation, domain.StepTypeGit} {
+		registry.Register(&trackingExecutor{
+			stepType:  stepType,
+			onExecute: func(step *domain.StepDefinition) { executed = append(executed, step.Name) },
+		})
+	}
+
+	store := newMockStore()
+	task := alignmentTask()
+	store.tasks[task.ID] = task
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "validate", Type: domain.StepTypeValidation, Required: true},
+			{Name: "commit", Type: domain.StepTypeGit, Required: true},
+		},
+	}
+
+	config := DefaultEngineConfig()
+	config.AutoProceedGit = true
+	config.ResyncAppendedSteps = true
+	engine := NewEngine(store, registry, config, testLogger())
+
+	err := engine.Resume(context.Background(), task, template)
+
+	require.NoError(t, err)
+	require.Len(t, task.Steps, 3)
+	assert.Equal(t, "commit", task.Steps[2].Name)
+	assert.Equal(t, []string{"validate", "commit"}, executed)
+}
+
+func continueOnErrorTemplate(continueOnError bool) *domain.Template {
+	return &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+			{Name: "lint_fix", Type: domain.StepTypeValidation, Required: true, ContinueOnError: continueOnError},
+			{Name: "summarize", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+}
+
+func continueOnErrorRegistry(executed *[]string) *steps.ExecutorRegistry {
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeAI,
+		onExecute: func(step *domain.StepDefinition) { *executed = append(*executed, step.Name) },
+	})
+	registry.Register(&failingExecutor{stepType: domain.StepTypeValidation, err: atlaserrors.ErrValidationFailed})
+	return registry
+}
+
+func TestEngine_ContinueOnError_FailedStepDoesNotHaltTask(t *testing.T) {
+	t.Parallel()
+
+	var executed []string
+	store := newMockStore()
+	engine := NewEngine(store, continueOnErrorRegistry(&executed), DefaultEngineConfig(), testLogger())
+
+	task, err := engine.Start(context.Background(), "test-workspace", "feat/test", "", continueOnErrorTemplate(true), "continue past lint", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, task.Status)
+	assert.Equal(t, []string{"implement", "summarize"}, executed)
+	assert.Equal(t, constants.StepStatusFailed, task.Steps[1].Status)
+	assert.Contains(t, task.Steps[1].Error, atlaserrors.ErrValidationFailed.Error())
+	require.Len(t, StepWarnings(task), 1)
+	assert.Contains(t, StepWarnings(task)[0], `step "lint_fix" failed`)
+}
+
+func TestEngine_ContinueOnError_UnsetStillHalts(t *testing.T) {
+	t.Parallel()
+
+	var executed []string
+	store := newMockStore()
+	engine := NewEngine(store, continueOnErrorRegistry(&executed), DefaultEngineConfig(), testLogger())
+
+	task, err := engine.Start(context.Background(), "test-workspace", "feat/test", "", continueOnErrorTemplate(false), "halt on lint", "")
+
+	require.ErrorIs(t, err, atlaserrors.ErrValidationFailed)
+	assert.Equal(t, constants.TaskStatusValidationFailed, task.Status)
+	assert.Equal(t, []string{"implement"}, executed)
+	assert.Empty(t, StepWarnings(task))
+}
+
+func TestStepWarnings_FromJSONMetadata(t *testing.T) {
+	t.Parallel()
+
+	task := &domain.Task{Metadata: map[string]any{stepWarningsMetadataKey: []any{"one", 2, "two"}}}
+
+	assert.Equal(t, []string{"one", "two"}, StepWarnings(task))
+}
+
+// TestEngine_Start_CustomIDGenerator tests that WithIDGenerator supplies the task ID.
+func TestEngine_Start_CustomIDGenerator(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{stepType: domain.StepTypeAI, result: &domain.StepResult{Status: "success"}})
+
+	var gotWorkspace string
+	customID := "job-" + uuid.NewString()
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger(), WithIDGenerator(func(workspace string) string {
+		gotWorkspace = workspace
+		return customID
+	}))
+
+	template := &domain.Template{
+		Name:  "test-template",
+		Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI, Required: true}},
+	}
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "custom id", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, customID, task.ID)
+	assert.Equal(t, "test-workspace", gotWorkspace)
+	assert.Contains(t, store.tasks, customID)
+}
+
+// TestEngine_Start_CustomIDGeneratorRetry tests that colliding generated IDs
+// are disambiguated with -2, -3 suffixes rather than by regenerating.
+func TestEngine_Start_CustomIDGeneratorRetry(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	store.collisions = 2
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{stepType: domain.StepTypeAI, result: &domain.StepResult{Status: "success"}})
+
+	var calls int
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger(), WithIDGenerator(func(string) string {
+		calls++
+		return fmt.Sprintf("job-%d", calls)
+	}))
+
+	template := &domain.Template{
+		Name:  "test-template",
+		Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI}},
+	}
+
+	task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "custom id", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "job-1-3", task.ID)
+	assert.Contains(t, store.tasks, "job-1-3")
+	assert.Equal(t, 1, calls, "the generator is not asked again")
+	assert.Equal(t, 3, store.createCalls)
+}
+
+// TestEngine_Start_InvalidGeneratedID tests that empty, unsafe, and colliding
+// generated IDs are rejected before anything is saved.
+func TestEngine_Start_InvalidGeneratedID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr error
+	}{
+		{name: "empty", id: "  ", wantErr: atlaserrors.ErrEmptyValue},
+		{name: "path", id: "../escape", wantErr: atlaserrors.ErrInvalidArgument},
+		{name: "collision", id: "task-fixed", wantErr: atlaserrors.ErrTaskExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMockStore()
+			store.tasks["task-fixed"] = &domain.Task{ID: "task-fixed", WorkspaceID: "test-workspace"}
+			registry := steps.NewExecutorRegistry()
+			registry.Register(&mockExecutor{stepType: domain.StepTypeAI, result: &domain.StepResult{Status: "success"}})
+			engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger(),
+				WithIDGenerator(func(string) string { return tt.id }))
+
+			template := &domain.Template{
+				Name:  "test-template",
+				Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI}},
+			}
+
+			task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "bad id", "")
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, task)
+			assert.Len(t, store.tasks, 1, "nothing is saved")
+		})
+	}
+}
+
+// statusRecordingExecutor records the task status seen by each step.
+type statusRecordingExecutor struct {
+	stepType domain.StepType
+	seen     []constants.TaskStatus
+}
+
+func (e *statusRecordingExecutor) Execute(_ context.Context, task *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	e.seen = append(e.seen, task.Status)
+	return &domain.StepResult{StepName: step.Name, Status: constants.StepStatusSuccess}, nil
+}
+
+func (e *statusRecordingExecutor) Type() domain.StepType {
+	return e.stepType
+}
+
+// humanPausedTask returns a task paused on a human review step before an implement step.
+func humanPausedTask() (*domain.Task, *domain.Template) {
+	task := &domain.Task{
+		ID:          "task-continue",
+		WorkspaceID: "test-workspace",
+		Status:      constants.TaskStatusAwaitingApproval,
+		CurrentStep: 1,
+		Steps: []domain.Step{
+			{Name: "spec", Type: domain.StepTypeAI, Status: constants.StepStatusSuccess},
+			{Name: "review_spec", Type: domain.StepTypeHuman, Status: constants.StepStatusAwaitingApproval},
+			{Name: "implement", Type: domain.StepTypeAI, Status: constants.StepStatusPending},
+		},
+	}
+	template := &domain.Template{
+		Name: "test-template",
+		Steps: []domain.StepDefinition{
+			{Name: "spec", Type: domain.StepTypeAI, Required: true},
+			{Name: "review_spec", Type: domain.StepTypeHuman, Required: true},
+			{Name: "implement", Type: domain.StepTypeAI, Required: true},
+		},
+	}
+	return task, template
+}
+
+// TestEngine_ApproveStep_ContinuesPastHumanStep tests that an approved human
+// step is not run again and the task resumes running at the next step.
+func TestEngine_ApproveStep_ContinuesPastHumanStep(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	humanRuns := 0
+	recorder := &statusRecordingExecutor{stepType: domain.StepTypeAI}
+	registry := steps.NewExecutorRegistry()
+	registry.Register(recorder)
+	registry.Register(&trackingExecutor{
+		stepType:  domain.StepTypeHuman,
+		onExecute: func(*domain.StepDefinition) { humanRuns++ },
+	})
+
+	store := newMockStore()
+	task, template := humanPausedTask()
+	store.tasks[task.ID] = task
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	require.NoError(t, engine.ApproveStep(ctx, task))
+	assert.Equal(t, 2, task.CurrentStep)
+	assert.Equal(t, constants.StepStatusSuccess, task.Steps[1].Status)
+	require.NotNil(t, task.Steps[1].CompletedAt)
+
+	require.NoError(t, engine.Resume(ctx, task, template))
+
+	assert.Zero(t, humanRuns)
+	assert.Equal(t, []constants.TaskStatus{constants.TaskStatusRunning}, recorder.seen)
+	assert.Equal(t, constants.StepStatusSuccess, task.Steps[2].Status)
+}
+
+// TestEngine_ApproveStep_RejectsRunningTask tests that only tasks awaiting approval can be approved.
+func TestEngine_ApproveStep_RejectsRunningTask(t *testing.T) {
+	t.Parallel()
+
+	engine := NewEngine(newMockStore(), steps.NewExecutorRegistry(), DefaultEngineConfig(), testLogger())
+	task, _ := humanPausedTask()
+	task.Status = constants.TaskStatusRunning
+
+	err := engine.ApproveStep(context.Background(), task)
+
+	require.ErrorIs(t, err, atlaserrors.ErrInvalidTransition)
+	assert.Equal(t, 1, task.CurrentStep)
+	assert.Equal(t, constants.StepStatusAwaitingApproval, task.Steps[1].Status)
+}
+
+// TestEngine_ApproveStep_ConfirmationPauseUnchanged tests that a step which
+// completed and paused for confirmation is left as is.
+func TestEngine_ApproveStep_ConfirmationPauseUnchanged(t *testing.T) {
+	t.Parallel()
+
+	engine := NewEngine(newMockStore(), steps.NewExecutorRegistry(), DefaultEngineConfig(), testLogger())
+	task, _ := humanPausedTask()
+	task.Steps[1].Status = constants.StepStatusSuccess
+	task.CurrentStep = 2
+
+	require.NoError(t, engine.ApproveStep(context.Background(), task))
+	assert.Equal(t, 2, task.CurrentStep)
+}
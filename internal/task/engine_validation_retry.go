@@ -282,7 +282,7 @@ func (e *Engine) convertRetryResultToStepResult(
 	step *domain.StepDefinition,
 	retryResult *validation.RetryResult,
 ) *domain.StepResult {
-	now := time.Now()
+	now := e.config.Clock.Now()
 	startTime := now.Add(-time.Duration(retryResult.PipelineResult.DurationMs) * time.Millisecond)
 
 	filesChanged := 0
@@ -0,0 +1,220 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements webhook delivery. When a task completes or a step
+// failure moves it into an error state, the engine POSTs a JSON payload to
+// every configured webhook subscribed to the event. Delivery runs in the
+// background within a bounded deadline, is retried a few times, and failures
+// are only logged; a webhook never fails or delays a task.
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/config"
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// Webhook delivery limits.
+const (
+	// webhookAttempts is how many times a webhook delivery is tried.
+	webhookAttempts = 3
+
+	// webhookTimeout bounds a single delivery attempt.
+	webhookTimeout = 5 * time.Second
+
+	// WebhookDeadline bounds delivering one event to all of its webhooks,
+	// retries included.
+	WebhookDeadline = 15 * time.Second
+
+	// defaultWebhookBackoff is the wait before the first retry; it doubles
+	// after each failed attempt.
+	defaultWebhookBackoff = 500 * time.Millisecond
+)
+
+// WebhookClient sends webhook requests. *http.Client satisfies it.
+type WebhookClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// WebhookPayload is the JSON body posted to webhooks.
+type WebhookPayload struct {
+	Event       string               `json:"event"`
+	TaskID      string               `json:"task_id"`
+	Workspace   string               `json:"workspace"`
+	TemplateID  string               `json:"template_id"`
+	Status      constants.TaskStatus `json:"status"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description,omitempty"`
+	Error       string               `json:"error,omitempty"`
+	Timestamp   time.Time            `json:"timestamp"`
+}
+
+// WithWebhooks sets the globally configured webhooks, delivered for every
+// task in addition to the webhooks declared by the task's template. A non-nil
+// client replaces the default http.Client used for delivery.
+func WithWebhooks(hooks []config.WebhookConfig, client WebhookClient) EngineOption {
+	return func(e *Engine) {
+		e.webhooks = make([]domain.Webhook, 0, len(hooks))
+		for _, h := range hooks {
+			e.webhooks = append(e.webhooks, domain.Webhook{URL: h.URL, Events: h.Events})
+		}
+		if client != nil {
+			e.webhookClient = client
+		}
+	}
+}
+
+// fireWebhooks delivers event for task to every subscribed webhook in the
+// background. reason is the failure reason for task.failed and empty
+// otherwise. Use WaitForWebhooks before exiting to let deliveries finish.
+func (e *Engine) fireWebhooks(ctx context.Context, task *domain.Task, event, reason string) {
+	var hooks []domain.Webhook
+	for _, h := range slices.Concat(e.webhooks, task.Config.Webhooks) {
+		if h.Wants(event) {
+			hooks = append(hooks, h)
+		}
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(e.buildWebhookPayload(task, event, reason))
+	if err != nil {
+		e.logger.Warn().Err(err).Str("task_id", task.ID).Msg("failed to encode webhook payload")
+		return
+	}
+
+	// Deliver even when the run itself was canceled, but never for longer
+	// than WebhookDeadline
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), WebhookDeadline)
+	taskID := task.ID
+	e.webhookWG.Add(1)
+	go func() {
+		defer e.webhookWG.Done()
+		defer cancel()
+		for _, h := range hooks {
+			if err := e.deliverWebhook(ctx, h.URL, body); err != nil {
+				e.logger.Warn().Err(err).
+					Str("task_id", taskID).
+					Str("event", event).
+					Str("url", redactWebhookURL(h.URL)).
+					Msg("failed to deliver webhook")
+			}
+		}
+	}()
+}
+
+// WaitForWebhooks blocks until background webhook deliveries finish or ctx
+// is done, so a CLI run does not exit before its webhooks are sent.
+func (e *Engine) WaitForWebhooks(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		e.webhookWG.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// redactWebhookURL reduces a webhook URL to its scheme and host for logging,
+// since paths and query strings often carry tokens.
+func redactWebhookURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "[invalid url]"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// buildWebhookPayload describes task for a webhook event.
+func (e *Engine) buildWebhookPayload(task *domain.Task, event, reason string) WebhookPayload {
+	summary := fmt.Sprintf("task %s in workspace %s is %s", task.ID, task.WorkspaceID, task.Status)
+	if event == domain.WebhookEventTaskFailed && task.CurrentStep >= 0 && task.CurrentStep < len(task.Steps) {
+		summary = fmt.Sprintf("task %s in workspace %s failed at step %s", task.ID, task.WorkspaceID, task.Steps[task.CurrentStep].Name)
+	}
+
+	return WebhookPayload{
+		Event:       event,
+		TaskID:      task.ID,
+		Workspace:   task.WorkspaceID,
+		TemplateID:  task.TemplateID,
+		Status:      task.Status,
+		Summary:     summary,
+		Description: task.Description,
+		Error:       reason,
+		Timestamp:   e.now(),
+	}
+}
+
+// deliverWebhook POSTs body to endpoint, retrying with backoff on transport
+// errors and non-2xx responses until ctx is done.
+func (e *Engine) deliverWebhook(ctx context.Context, endpoint string, body []byte) error {
+	backoff := e.webhookBackoff
+	var lastErr error
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		if lastErr = e.postWebhook(ctx, endpoint, body); lastErr == nil {
+			return nil
+		}
+		if attempt == webhookAttempts {
+			break
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("after %d attempts: %w", attempt, lastErr)
+		}
+		backoff *= 2
+	}
+	return fmt.Errorf("after %d attempts: %w", webhookAttempts, lastErr)
+}
+
+// postWebhook makes a single delivery attempt. Errors never include the
+// endpoint, which may embed credentials.
+func (e *Engine) postWebhook(ctx context.Context, endpoint string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return withoutURL(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "atlas-webhook")
+
+	resp, err := e.webhookClient.Do(req)
+	if err != nil {
+		return withoutURL(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: status %d", atlaserrors.ErrWebhookRejected, resp.StatusCode)
+	}
+	return nil
+}
+
+// withoutURL unwraps the *url.Error net/http returns, whose message
+// includes the full request URL.
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
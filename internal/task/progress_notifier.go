@@ -23,6 +23,8 @@ func (e *Engine) notifyStateChange(oldStatus, newStatus constants.TaskStatus) {
 
 // notifyStepStart calls the progress callback with a "start" event if configured.
 func (e *Engine) notifyStepStart(task *domain.Task, step *domain.StepDefinition, totalSteps int) {
+	e.publishStepEvent(EngineEventStepStarted, task, step, "")
+
 	if e.config.ProgressCallback == nil {
 		return
 	}
@@ -49,6 +51,8 @@ func (e *Engine) notifyStepStart(task *domain.Task, step *domain.StepDefinition,
 
 // notifyStepComplete calls the progress callback with a "complete" event if configured.
 func (e *Engine) notifyStepComplete(task *domain.Task, step *domain.StepDefinition, result *domain.StepResult, totalSteps int) {
+	e.publishStepEvent(EngineEventStepCompleted, task, step, result.Status)
+
 	if e.config.ProgressCallback == nil {
 		return
 	}
@@ -0,0 +1,49 @@
+package task
+
+import (
+	"time"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// Query specifies criteria for listing tasks.
+// Zero-value bounds are unset; both bounds are inclusive.
+type Query struct {
+	CreatedAfter  time.Time // zero = no lower bound
+	CreatedBefore time.Time // zero = no upper bound
+}
+
+// IsZero returns true if the query has no criteria.
+func (q Query) IsZero() bool {
+	return q.CreatedAfter.IsZero() && q.CreatedBefore.IsZero()
+}
+
+// InRange returns true if t falls within the query's time bounds.
+func (q Query) InRange(t time.Time) bool {
+	if !q.CreatedAfter.IsZero() && t.Before(q.CreatedAfter) {
+		return false
+	}
+	if !q.CreatedBefore.IsZero() && t.After(q.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
+// Match returns true if the task matches the query criteria.
+func (q Query) Match(t *domain.Task) bool {
+	return t != nil && q.InRange(t.CreatedAt)
+}
+
+// Filter returns the tasks matching the query, preserving order.
+func (q Query) Filter(tasks []*domain.Task) []*domain.Task {
+	if q.IsZero() {
+		return tasks
+	}
+	matched := make([]*domain.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if q.Match(t) {
+			matched = append(matched, t)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,60 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// TestQuery_Filter tests created-at bounds include and exclude tasks.
+func TestQuery_Filter(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	tasks := []*domain.Task{
+		{ID: "task-old", CreatedAt: base.Add(-48 * time.Hour)},
+		{ID: "task-mid", CreatedAt: base},
+		{ID: "task-new", CreatedAt: base.Add(48 * time.Hour)},
+	}
+
+	ids := func(tasks []*domain.Task) []string {
+		out := make([]string, 0, len(tasks))
+		for _, t := range tasks {
+			out = append(out, t.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected []string
+	}{
+		{"no bounds", Query{}, []string{"task-old", "task-mid", "task-new"}},
+		{"after", Query{CreatedAfter: base.Add(-time.Hour)}, []string{"task-mid", "task-new"}},
+		{"before", Query{CreatedBefore: base.Add(time.Hour)}, []string{"task-old", "task-mid"}},
+		{"both", Query{CreatedAfter: base.Add(-time.Hour), CreatedBefore: base.Add(time.Hour)}, []string{"task-mid"}},
+		{"bounds are inclusive", Query{CreatedAfter: base, CreatedBefore: base}, []string{"task-mid"}},
+		{"nothing in range", Query{CreatedAfter: base.Add(72 * time.Hour)}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, ids(tt.query.Filter(tasks)))
+		})
+	}
+}
+
+// TestQuery_IsZero tests detection of an empty query.
+func TestQuery_IsZero(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Query{}.IsZero())
+	assert.False(t, Query{CreatedAfter: time.Now()}.IsZero())
+	assert.False(t, Query{CreatedBefore: time.Now()}.IsZero())
+	assert.False(t, Query{}.Match(nil))
+}
@@ -11,7 +11,6 @@ package task
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/ctxutil"
@@ -207,6 +206,8 @@ func GetValidTargetStatuses(from constants.TaskStatus) []constants.TaskStatus {
 
 // Transition validates and applies a state transition to the task.
 // It records the transition in the task's history and updates timestamps.
+// The transition's actor comes from the context (see WithActor), falling
+// back to the task's actor.
 // The caller is responsible for persisting the updated task.
 //
 // Parameters:
@@ -237,7 +238,12 @@ func Transition(ctx context.Context, task *domain.Task, to constants.TaskStatus,
 			atlaserrors.ErrInvalidTransition, from, to)
 	}
 
-	now := time.Now().UTC()
+	now := clockFromContext(ctx).Now().UTC()
+
+	actor := ActorFromContext(ctx)
+	if actor == "" {
+		actor = task.Actor
+	}
 
 	// Record transition in history
 	transition := domain.Transition{
@@ -245,6 +251,7 @@ func Transition(ctx context.Context, task *domain.Task, to constants.TaskStatus,
 		ToStatus:   to,
 		Timestamp:  now,
 		Reason:     reason,
+		Actor:      actor,
 	}
 	task.Transitions = append(task.Transitions, transition)
 
@@ -257,5 +264,9 @@ func Transition(ctx context.Context, task *domain.Task, to constants.TaskStatus,
 		task.CompletedAt = &now
 	}
 
+	if hook := transitionHookFromContext(ctx); hook != nil {
+		hook(task, transition)
+	}
+
 	return nil
 }
@@ -9,7 +9,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
@@ -19,10 +18,9 @@ import (
 // advanceToNextStep increments the step counter, updates timestamp, and saves a checkpoint.
 func (e *Engine) advanceToNextStep(ctx context.Context, task *domain.Task) error {
 	task.CurrentStep++
-	task.UpdatedAt = time.Now().UTC()
 
 	// Save checkpoint
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		return fmt.Errorf("failed to save checkpoint: %w", err)
 	}
 	return nil
@@ -34,7 +32,7 @@ func (e *Engine) saveAndPause(ctx context.Context, task *domain.Task) error {
 	// Cleanup step resources before pausing (e.g., remove stale git lock files)
 	e.cleanupOnPause(ctx, task)
 
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	if err := e.saveTask(ctx, task); err != nil {
 		return fmt.Errorf("failed to save checkpoint: %w", err)
 	}
 	e.logger.Info().
@@ -165,6 +163,9 @@ func (e *Engine) transitionToErrorState(ctx context.Context, task *domain.Task,
 	}
 	e.failHookStep(ctx, task, stepName, fmt.Errorf("%w: %s", atlaserrors.ErrTaskFailed, reason))
 
+	e.writeRunSummary(ctx, task)
+	e.fireWebhooks(ctx, task, domain.WebhookEventTaskFailed, reason)
+
 	return nil
 }
 
@@ -190,16 +191,19 @@ func (e *Engine) completeTask(ctx context.Context, task *domain.Task) error {
 	// Notify on transition to attention state
 	e.notifyStateChange(oldStatus, constants.TaskStatusAwaitingApproval)
 
-	// Save final state
-	if err := e.store.Update(ctx, task.WorkspaceID, task); err != nil {
+	// Save final state together with the workspace's record of the task
+	if err := e.saveTaskWithWorkspace(ctx, task); err != nil {
 		return fmt.Errorf("failed to save completed state: %w", err)
 	}
 
 	// Finalize hook on task completion
 	e.completeHookTask(ctx, task)
 
+	e.writeRunSummary(ctx, task)
+	e.fireWebhooks(ctx, task, domain.WebhookEventTaskCompleted, "")
+
 	// Record task completion for metrics
-	e.recordTaskCompleted(task.ID, time.Since(task.CreatedAt), string(task.Status))
+	e.recordTaskCompleted(task.ID, e.config.Clock.Now().Sub(task.CreatedAt), string(task.Status))
 
 	e.logger.Info().
 		Str("task_id", task.ID).
@@ -2,6 +2,7 @@ package task
 
 import (
 	"context"
+	"os/user"
 	"testing"
 	"time"
 
@@ -684,6 +685,36 @@ func TestTransition_EmptyReason(t *testing.T) {
 	assert.Empty(t, task.Transitions[0].Reason)
 }
 
+// TestTransition_RecordsActor tests that the transition actor comes from the
+// context and falls back to the task's actor.
+func TestTransition_RecordsActor(t *testing.T) {
+	t.Parallel()
+	task := &domain.Task{
+		ID:     "task-00000000-0000-4000-8000-000000000000",
+		Status: constants.TaskStatusPending,
+		Actor:  "alice",
+	}
+
+	require.NoError(t, Transition(context.Background(), task, constants.TaskStatusRunning, "started"))
+	require.NoError(t, Transition(WithActor(context.Background(), "ci-bot"), task, constants.TaskStatusValidating, "validating"))
+
+	require.Len(t, task.Transitions, 2)
+	assert.Equal(t, "alice", task.Transitions[0].Actor)
+	assert.Equal(t, "ci-bot", task.Transitions[1].Actor)
+}
+
+// TestResolveActor tests actor precedence: configured value, env var, OS username.
+func TestResolveActor(t *testing.T) {
+	t.Setenv(ActorEnvVar, "ci-bot")
+	assert.Equal(t, "configured", ResolveActor("configured"))
+	assert.Equal(t, "ci-bot", ResolveActor(""))
+
+	t.Setenv(ActorEnvVar, "")
+	if currentUser, err := user.Current(); err == nil {
+		assert.Equal(t, currentUser.Username, ResolveActor(""))
+	}
+}
+
 // TestTransition_MultipleSequentialTransitions tests that multiple transitions
 // are appended to the history.
 func TestTransition_MultipleSequentialTransitions(t *testing.T) {
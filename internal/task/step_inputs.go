@@ -0,0 +1,127 @@
+// Package task provides task lifecycle management for ATLAS.
+//
+// This file implements step input templating. String values in a step's
+// config may reference earlier steps with Go template syntax, for example
+// {{.Steps.detect.Output}}, and are rendered from the task's recorded step
+// results just before the step runs. A reference to a step that has not run,
+// or to an unknown field, fails the step instead of rendering empty.
+package task
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// StepOutput is what a step input template can read about an earlier step.
+type StepOutput struct {
+	Output       string
+	Status       string
+	Error        string
+	FilesChanged []string
+}
+
+// stepInputData is the root value step input templates are rendered with.
+type stepInputData struct {
+	Steps map[string]StepOutput
+}
+
+// isStepInputTemplate reports whether s references earlier step outputs.
+// Other {{...}} text, such as unexpanded template variables, is left alone.
+func isStepInputTemplate(s string) bool {
+	return strings.Contains(s, "{{") && strings.Contains(s, ".Steps")
+}
+
+// expandStepInputs returns step with config strings referencing earlier step
+// outputs rendered from task's step results. The template's step definition
+// is never modified; step itself is returned when nothing references a step.
+func expandStepInputs(task *domain.Task, step *domain.StepDefinition) (*domain.StepDefinition, error) {
+	if !configHasStepInputs(step.Config) {
+		return step, nil
+	}
+
+	data := stepInputData{Steps: make(map[string]StepOutput, len(task.StepResults))}
+	for _, result := range task.StepResults {
+		// Later results, such as retries and loop-backs, replace earlier ones
+		data.Steps[result.StepName] = StepOutput{
+			Output:       result.Output,
+			Status:       result.Status,
+			Error:        result.Error,
+			FilesChanged: result.FilesChanged,
+		}
+	}
+
+	cfg, err := renderStepInputs(step.Config, data, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: step '%s': %w", atlaserrors.ErrStepInputTemplate, step.Name, err)
+	}
+
+	expanded := *step
+	expanded.Config = cfg
+	return &expanded, nil
+}
+
+// configHasStepInputs reports whether any string in cfg, including nested
+// maps, is a step input template.
+func configHasStepInputs(cfg map[string]any) bool {
+	for _, v := range cfg {
+		switch val := v.(type) {
+		case string:
+			if isStepInputTemplate(val) {
+				return true
+			}
+		case map[string]any:
+			if configHasStepInputs(val) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// renderStepInputs renders the step input templates in cfg, recursing into
+// nested maps. prefix is the dotted key path used in error messages.
+func renderStepInputs(cfg map[string]any, data stepInputData, prefix string) (map[string]any, error) {
+	result := make(map[string]any, len(cfg))
+	for k, v := range cfg {
+		key := prefix + k
+		switch val := v.(type) {
+		case string:
+			if !isStepInputTemplate(val) {
+				result[k] = val
+				continue
+			}
+			rendered, err := renderStepInput(key, val, data)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = rendered
+		case map[string]any:
+			nested, err := renderStepInputs(val, data, key+".")
+			if err != nil {
+				return nil, err
+			}
+			result[k] = nested
+		default:
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
+// renderStepInput renders a single config value.
+func renderStepInput(key, text string, data stepInputData) (string, error) {
+	tmpl, err := template.New(key).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("config '%s': %w", key, err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("config '%s': %w", key, err)
+	}
+	return b.String(), nil
+}
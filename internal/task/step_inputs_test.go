@@ -0,0 +1,145 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template/steps"
+)
+
+// outputExecutor succeeds with a fixed output.
+type outputExecutor struct {
+	stepType domain.StepType
+	output   string
+}
+
+func (e *outputExecutor) Execute(_ context.Context, _ *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	return &domain.StepResult{
+		StepName:     step.Name,
+		Status:       constants.StepStatusSuccess,
+		Output:       e.output,
+		FilesChanged: []string{"parse.go"},
+		StartedAt:    time.Now().UTC(),
+		CompletedAt:  time.Now().UTC(),
+	}, nil
+}
+
+func (e *outputExecutor) Type() domain.StepType {
+	return e.stepType
+}
+
+// TestEngine_StepInputs_ReceivesPriorOutput tests a fix step's config is
+// rendered with the detect step's output before it runs.
+func TestEngine_StepInputs_ReceivesPriorOutput(t *testing.T) {
+	t.Parallel()
+
+	var fixConfig map[string]any
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&outputExecutor{stepType: domain.StepTypeValidation, output: "3 lint errors in parse.go"})
+	registry.Register(&trackingExecutor{stepType: domain.StepTypeAI, onExecute: func(step *domain.StepDefinition) {
+		fixConfig = step.Config
+	}})
+	engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{
+		Name: "inputs",
+		Steps: []domain.StepDefinition{
+			{Name: "detect", Type: domain.StepTypeValidation, Required: true},
+			{Name: "fix", Type: domain.StepTypeAI, Required: true, Config: map[string]any{
+				"prompt":     "Fix these issues:\n{{.Steps.detect.Output}}",
+				"model":      "sonnet",
+				"unexpanded": "{{ticket_id}}",
+				"context": map[string]any{
+					"files":  "{{range .Steps.detect.FilesChanged}}{{.}} {{end}}",
+					"status": "{{.Steps.detect.Status}}",
+				},
+			}},
+		},
+	}
+
+	_, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "inputs", "")
+	require.NoError(t, err)
+
+	require.NotNil(t, fixConfig)
+	assert.Equal(t, "Fix these issues:\n3 lint errors in parse.go", fixConfig["prompt"])
+	assert.Equal(t, "sonnet", fixConfig["model"])
+	assert.Equal(t, "{{ticket_id}}", fixConfig["unexpanded"], "non-step placeholders are left alone")
+	assert.Equal(t, map[string]any{"files": "parse.go ", "status": constants.StepStatusSuccess}, fixConfig["context"])
+
+	// The template's own definition keeps the reference for later runs
+	assert.Equal(t, "Fix these issues:\n{{.Steps.detect.Output}}", template.Steps[1].Config["prompt"])
+}
+
+// TestEngine_StepInputs_BadReferenceFails tests references to unknown steps
+// or fields fail the step with a clear error instead of rendering empty.
+func TestEngine_StepInputs_BadReferenceFails(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		prompt  string
+		wantMsg string
+	}{
+		{name: "step not run", prompt: "Fix {{.Steps.detetc.Output}}", wantMsg: `"detetc"`},
+		{name: "unknown field", prompt: "Fix {{.Steps.detect.Stdout}}", wantMsg: "Stdout"},
+		{name: "parse error", prompt: "Fix {{.Steps.detect.Output", wantMsg: "config 'prompt'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			executed := false
+			registry := steps.NewExecutorRegistry()
+			registry.Register(&outputExecutor{stepType: domain.StepTypeValidation, output: "errors"})
+			registry.Register(&trackingExecutor{stepType: domain.StepTypeAI, onExecute: func(*domain.StepDefinition) {
+				executed = true
+			}})
+			engine := NewEngine(newMockStore(), registry, DefaultEngineConfig(), testLogger())
+
+			template := &domain.Template{
+				Name: "inputs",
+				Steps: []domain.StepDefinition{
+					{Name: "detect", Type: domain.StepTypeValidation, Required: true},
+					{Name: "fix", Type: domain.StepTypeAI, Required: true, Config: map[string]any{"prompt": tt.prompt}},
+				},
+			}
+
+			task, err := engine.Start(context.Background(), "test-workspace", "test-branch", "/tmp/test-worktree", template, "inputs", "")
+			require.ErrorIs(t, err, atlaserrors.ErrStepInputTemplate)
+			assert.Contains(t, err.Error(), "step 'fix'")
+			assert.Contains(t, err.Error(), tt.wantMsg)
+			assert.False(t, executed, "step must not run with an unrendered input")
+			require.NotNil(t, task)
+			assert.Equal(t, constants.StepStatusFailed, task.Steps[1].Status)
+		})
+	}
+}
+
+// TestExpandStepInputs_LatestResultWins tests a step that ran more than once
+// is referenced by its most recent result.
+func TestExpandStepInputs_LatestResultWins(t *testing.T) {
+	t.Parallel()
+
+	task := &domain.Task{StepResults: []domain.StepResult{
+		{StepName: "detect", Output: "first"},
+		{StepName: "detect", Output: "second"},
+	}}
+	step := &domain.StepDefinition{Name: "fix", Config: map[string]any{"prompt": "{{.Steps.detect.Output}}"}}
+
+	expanded, err := expandStepInputs(task, step)
+	require.NoError(t, err)
+	assert.Equal(t, "second", expanded.Config["prompt"])
+
+	plain := &domain.StepDefinition{Name: "fix", Config: map[string]any{"prompt": "no references"}}
+	same, err := expandStepInputs(task, plain)
+	require.NoError(t, err)
+	assert.Same(t, plain, same)
+}
@@ -7,17 +7,22 @@ package task
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/mrz1836/atlas/internal/config"
 	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/contracts"
 	"github.com/mrz1836/atlas/internal/ctxutil"
 	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 )
 
 // executeStepInternal executes a step without modifying task state.
@@ -32,11 +37,16 @@ func (e *Engine) executeStepInternal(ctx context.Context, task *domain.Task, ste
 		return nil, err
 	}
 
+	step, err = expandStepInputs(task, step)
+	if err != nil {
+		return nil, err
+	}
+
 	e.buildStepLogEvent(task, step, zerolog.InfoLevel, 0).Msg("executing step")
 
-	startTime := time.Now()
-	result, err := executor.Execute(ctx, task, step)
-	duration := time.Since(startTime)
+	startTime := e.config.Clock.Now()
+	result, err := executor.Execute(contracts.WithStepName(ctx, step.Name), task, step)
+	duration := e.config.Clock.Now().Sub(startTime)
 
 	if err != nil {
 		e.buildStepLogEvent(task, step, zerolog.ErrorLevel, duration.Milliseconds()).
@@ -124,7 +134,62 @@ func applyAgentModelOverride(agent domain.Agent, model, overrideAgent, overrideM
 // It does not modify task state beyond what ExecuteStep does (step status, attempts, timing).
 func (e *Engine) executeCurrentStep(ctx context.Context, task *domain.Task, template *domain.Template) (*domain.StepResult, error) {
 	step := &template.Steps[task.CurrentStep]
-	return e.ExecuteStep(ctx, task, step)
+	policy := e.resolveRetryPolicy(step)
+
+	result, err := e.ExecuteStep(ctx, task, step)
+	for attempt := 1; attempt <= policy.Max && shouldRetryStep(ctx, result, err); attempt++ {
+		e.buildStepLogEvent(task, step, zerolog.WarnLevel, 0).
+			Err(err).
+			Int("retry_attempt", attempt).
+			Int("max_retries", policy.Max).
+			Dur("delay", policy.Delay).
+			Msg("retrying failed step")
+
+		if waitErr := waitRetryDelay(ctx, policy.Delay); waitErr != nil {
+			return result, waitErr
+		}
+		result, err = e.ExecuteStep(ctx, task, step)
+	}
+
+	return result, err
+}
+
+// resolveRetryPolicy returns the step's retry policy, falling back to the engine default.
+func (e *Engine) resolveRetryPolicy(step *domain.StepDefinition) domain.RetryPolicy {
+	if step.Retry != nil {
+		return *step.Retry
+	}
+	return e.config.StepRetry
+}
+
+// shouldRetryStep reports whether a step attempt failed in a way worth retrying.
+// Cancellation, missing executors, and bad step input templates are never retried.
+func shouldRetryStep(ctx context.Context, result *domain.StepResult, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) &&
+			!errors.Is(err, context.DeadlineExceeded) &&
+			!errors.Is(err, atlaserrors.ErrExecutorNotFound) &&
+			!errors.Is(err, atlaserrors.ErrStepInputTemplate)
+	}
+	return result != nil && result.Status == constants.StepStatusFailed
+}
+
+// waitRetryDelay sleeps for delay, returning early if ctx is canceled.
+func waitRetryDelay(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 // executeParallelGroup runs multiple steps concurrently.
@@ -133,6 +198,14 @@ func (e *Engine) executeCurrentStep(ctx context.Context, task *domain.Task, temp
 //
 // stepIndices are the indices into template.Steps for the parallel group.
 func (e *Engine) executeParallelGroup(ctx context.Context, task *domain.Task, template *domain.Template, stepIndices []int) ([]*domain.StepResult, error) {
+	results, _, err := e.executeParallelSteps(ctx, task, template, stepIndices)
+	return results, err
+}
+
+// executeParallelSteps runs multiple steps concurrently like
+// executeParallelGroup, also returning each step's own error so callers can
+// tell the failing step from siblings canceled because of it.
+func (e *Engine) executeParallelSteps(ctx context.Context, task *domain.Task, template *domain.Template, stepIndices []int) ([]*domain.StepResult, []error, error) {
 	e.logger.Info().
 		Str("task_id", task.ID).
 		Int("parallel_count", len(stepIndices)).
@@ -140,18 +213,28 @@ func (e *Engine) executeParallelGroup(ctx context.Context, task *domain.Task, te
 
 	g, gctx := errgroup.WithContext(ctx)
 	results := make([]*domain.StepResult, len(stepIndices))
+	errs := make([]error, len(stepIndices))
 	var mu sync.Mutex
 
+	// Each step gets its own view of the task so executors writing metadata
+	// do not race; their changes are merged back once the group finishes
+	views := make([]*domain.Task, len(stepIndices))
+	for i := range stepIndices {
+		views[i] = isolateTask(task)
+	}
+	defer mergeParallelMetadata(task, views)
+
 	for i, idx := range stepIndices {
 		step := &template.Steps[idx]
 
 		g.Go(func() error {
 			// Use internal method to avoid race on task.Steps
-			result, err := e.executeStepInternal(gctx, task, step)
+			result, err := e.executeStepInternal(gctx, views[i], step)
 
 			// Always save result first - it may contain useful output even on error
 			mu.Lock()
 			results[i] = result
+			errs[i] = err
 			mu.Unlock()
 
 			if err != nil {
@@ -162,22 +245,36 @@ func (e *Engine) executeParallelGroup(ctx context.Context, task *domain.Task, te
 	}
 
 	if err := g.Wait(); err != nil {
-		return results, err
+		return results, errs, err
 	}
 
-	return results, nil
+	return results, errs, nil
 }
 
 // handleSkippedStep marks a step as skipped and advances to the next step.
 func (e *Engine) handleSkippedStep(ctx context.Context, task *domain.Task, step *domain.StepDefinition) error {
+	e.recordSkippedStep(task, step)
+	return e.advanceToNextStep(ctx, task)
+}
+
+// recordSkippedStep marks the current step as skipped and records a skipped result.
+func (e *Engine) recordSkippedStep(task *domain.Task, step *domain.StepDefinition) {
 	// Determine skip reason for logging and output
 	reason := e.getSkipReason(task, step)
 
-	e.logger.Info().
-		Str("task_id", task.ID).
-		Str("step_name", step.Name).
-		Str("reason", reason).
-		Msg("skipping step")
+	if e.isUnknownStepType(step) {
+		e.logger.Warn().
+			Str("task_id", task.ID).
+			Str("step_name", step.Name).
+			Str("step_type", string(step.Type)).
+			Msg("skipping step with unregistered type")
+	} else {
+		e.logger.Info().
+			Str("task_id", task.ID).
+			Str("step_name", step.Name).
+			Str("reason", reason).
+			Msg("skipping step")
+	}
 
 	// Mark step as skipped
 	if task.CurrentStep < len(task.Steps) {
@@ -190,15 +287,17 @@ func (e *Engine) handleSkippedStep(ctx context.Context, task *domain.Task, step
 		StepName:    step.Name,
 		Status:      constants.StepStatusSkipped,
 		Output:      "Skipped - " + reason,
-		StartedAt:   time.Now().UTC(),
-		CompletedAt: time.Now().UTC(),
+		StartedAt:   e.now(),
+		CompletedAt: e.now(),
 	})
-
-	return e.advanceToNextStep(ctx, task)
 }
 
 // getSkipReason determines the reason a step is being skipped.
 func (e *Engine) getSkipReason(task *domain.Task, step *domain.StepDefinition) string {
+	if e.isUnknownStepType(step) {
+		return fmt.Sprintf("no executor registered for step type %q", step.Type)
+	}
+
 	if !step.Required {
 		return "optional step not enabled"
 	}
@@ -212,6 +311,10 @@ func (e *Engine) getSkipReason(task *domain.Task, step *domain.StepDefinition) s
 		}
 	}
 
+	if e.isGitBlockedByVerify(task, step) {
+		return "verify step failed"
+	}
+
 	return "no changes to push/PR"
 }
 
@@ -220,6 +323,7 @@ func (e *Engine) getSkipReason(task *domain.Task, step *domain.StepDefinition) s
 // - git push and PR steps when "skip_git_steps" flag is set (no changes to commit)
 // - AI and validation steps when "no_issues_detected" flag is set (detect_only found no issues)
 // - steps with skip_condition that evaluates to true
+// - steps with an unregistered type when SkipUnknownSteps is enabled
 func (e *Engine) shouldSkipStep(task *domain.Task, step *domain.StepDefinition) bool {
 	// Check skip_condition first (for smart conditional steps)
 	if skipCond, ok := step.Config["skip_condition"].(string); ok && skipCond != "" {
@@ -228,13 +332,23 @@ func (e *Engine) shouldSkipStep(task *domain.Task, step *domain.StepDefinition)
 		}
 	}
 
+	if e.isUnknownStepType(step) {
+		return true
+	}
+
 	if !step.Required {
 		return true
 	}
 	if task.Metadata == nil {
 		return false
 	}
-	return e.shouldSkipForNoIssues(task, step) || e.shouldSkipGitSteps(task, step)
+	return e.shouldSkipForNoIssues(task, step) || e.shouldSkipGitSteps(task, step) || e.isGitBlockedByVerify(task, step)
+}
+
+// isUnknownStepType reports whether the step should be skipped because no
+// executor is registered for its type and SkipUnknownSteps is enabled.
+func (e *Engine) isUnknownStepType(step *domain.StepDefinition) bool {
+	return e.config.SkipUnknownSteps && e.registry != nil && !e.registry.Has(step.Type)
 }
 
 // shouldSkipForNoIssues checks if step should be skipped when no issues were detected.
@@ -269,6 +383,84 @@ func (e *Engine) shouldSkipGitSteps(task *domain.Task, step *domain.StepDefiniti
 	return e.isSkippableGitOperation(step)
 }
 
+// isGitBlockedByVerify returns true if the step is a git step blocked by a failed verify step.
+func (e *Engine) isGitBlockedByVerify(task *domain.Task, step *domain.StepDefinition) bool {
+	blocked, _ := task.Metadata["verify_blocked_git"].(bool)
+	return blocked && step.Type == domain.StepTypeGit
+}
+
+// blockGitStepsAfterVerifyFailure marks pending git steps after a failed verify
+// step as skipped and records the block so they are skipped if execution
+// continues past the verify step. No-op for other step types or when
+// BlockCommitOnVerifyFailure is disabled.
+func (e *Engine) blockGitStepsAfterVerifyFailure(task *domain.Task, step *domain.StepDefinition) {
+	if step.Type != domain.StepTypeVerify || !e.config.BlockCommitOnVerifyFailure {
+		return
+	}
+
+	e.setMetadata(task, "verify_blocked_git", true)
+
+	for i := task.CurrentStep + 1; i < len(task.Steps); i++ {
+		if task.Steps[i].Type == domain.StepTypeGit && task.Steps[i].Status == constants.StepStatusPending {
+			task.Steps[i].Status = constants.StepStatusSkipped
+		}
+	}
+
+	e.logger.Warn().
+		Str("task_id", task.ID).
+		Str("step_name", step.Name).
+		Msg("verify step failed, blocking downstream git steps")
+}
+
+// unblockGitSteps clears a verify failure block and restores the blocked git steps to pending.
+func (e *Engine) unblockGitSteps(task *domain.Task) {
+	if blocked, _ := task.Metadata["verify_blocked_git"].(bool); !blocked {
+		return
+	}
+
+	delete(task.Metadata, "verify_blocked_git")
+
+	for i := task.CurrentStep + 1; i < len(task.Steps); i++ {
+		if task.Steps[i].Type == domain.StepTypeGit && task.Steps[i].Status == constants.StepStatusSkipped {
+			task.Steps[i].Status = constants.StepStatusPending
+		}
+	}
+}
+
+// stepOutputArtifact is the artifact filename holding a step's full output
+// when the stored output is truncated.
+const stepOutputArtifact = "output.log"
+
+// capStepOutput truncates result.Output to MaxStepOutputBytes before it is
+// stored on the task. The full output is saved as a "<step>/output.log"
+// artifact first; if that fails, the output is left intact so nothing is lost.
+func (e *Engine) capStepOutput(ctx context.Context, task *domain.Task, result *domain.StepResult) {
+	limit := e.config.MaxStepOutputBytes
+	if limit <= 0 || len(result.Output) <= limit {
+		return
+	}
+
+	artifactPath := StepArtifactPath(result.StepName, stepOutputArtifact)
+	if err := e.store.SaveStepArtifact(ctx, task.WorkspaceID, task.ID, result.StepName, stepOutputArtifact, []byte(result.Output)); err != nil {
+		e.logger.Warn().Err(err).
+			Str("task_id", task.ID).
+			Str("step_name", result.StepName).
+			Msg("failed to save full step output, keeping it untruncated")
+		return
+	}
+
+	// Back off to a rune boundary so the stored output stays valid UTF-8
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(result.Output[cut]) {
+		cut--
+	}
+
+	result.Output = fmt.Sprintf("%s\n[truncated %d bytes]", result.Output[:cut], len(result.Output)-cut)
+	if result.ArtifactPath == "" {
+		result.ArtifactPath = artifactPath
+	}
+}
+
 // isSkippableGitOperation returns true if the step is a push or create_pr operation.
 func (e *Engine) isSkippableGitOperation(step *domain.StepDefinition) bool {
 	op, ok := step.Config["operation"].(string)
@@ -4,6 +4,7 @@
 package task
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -11,7 +12,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -24,6 +24,7 @@ import (
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 	"github.com/mrz1836/atlas/internal/flock"
+	"github.com/mrz1836/atlas/internal/workspace"
 )
 
 // LockTimeout is the maximum duration to wait for acquiring a file lock.
@@ -35,10 +36,6 @@ const (
 	filePerm = 0o600 // Secure file permissions
 )
 
-// validTaskIDRegex matches valid task IDs (task-{uuid}).
-// Format: task-[8 hex]-[4 hex]-[4 hex]-[4 hex]-[12 hex]
-var validTaskIDRegex = regexp.MustCompile(`^task-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
-
 // Store defines the interface for task persistence operations.
 type Store interface {
 	// Create creates a new task in the workspace.
@@ -68,6 +65,11 @@ type Store interface {
 	// SaveArtifact saves an artifact file for the task.
 	SaveArtifact(ctx context.Context, workspaceName, taskID, filename string, data []byte) error
 
+	// SaveStepArtifact saves an artifact under the step's namespace
+	// (<stepName>/<filename>) so same-named files from different steps don't collide.
+	// Retrieve it with GetArtifact and StepArtifactPath.
+	SaveStepArtifact(ctx context.Context, workspaceName, taskID, stepName, filename string, data []byte) error
+
 	// SaveVersionedArtifact saves an artifact with version suffix (e.g., validation.1.json).
 	// Returns the actual filename used.
 	SaveVersionedArtifact(ctx context.Context, workspaceName, taskID, baseName string, data []byte) (string, error)
@@ -81,23 +83,26 @@ type Store interface {
 
 // FileStore implements Store using the local filesystem.
 type FileStore struct {
-	atlasHome string         // Usually ~/.atlas
-	logger    zerolog.Logger // Logger for debug output
+	atlasHome string           // Usually ~/.atlas
+	logger    zerolog.Logger   // Logger for debug output
+	wsUpdater WorkspaceUpdater // Optional, for coordinated task and workspace updates
 }
 
 // NewFileStore creates a new FileStore with the given atlas home directory.
-// If atlasHome is empty, uses the default ~/.atlas directory.
+// If atlasHome is empty, uses workspace.StateDir (--base-dir, ATLAS_STATE_DIR, ATLAS_HOME or ~/.atlas).
+// The workspace store under the same directory is attached so coordinated
+// updates can be recovered by every store.
 func NewFileStore(atlasHome string) (*FileStore, error) {
 	if atlasHome == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		var err error
+		if atlasHome, err = workspace.StateDir(); err != nil {
+			return nil, err
 		}
-		atlasHome = filepath.Join(home, constants.AtlasHome)
 	}
 	return &FileStore{
 		atlasHome: atlasHome,
 		logger:    zerolog.Nop(), // Default to no-op logger
+		wsUpdater: defaultWorkspaceStore(atlasHome),
 	}, nil
 }
 
@@ -115,22 +120,24 @@ func repoHash(repoPath string) (string, error) {
 // NewRepoScopedFileStore creates a FileStore scoped to a specific repository.
 // Storage path: ~/.atlas/repos/{repo-hash}/
 // This prevents workspace name collisions across different repositories.
+// The repository's workspace store is attached, as in NewFileStore.
 func NewRepoScopedFileStore(repoPath string) (*FileStore, error) {
 	if repoPath == "" {
 		return nil, fmt.Errorf("repo path cannot be empty: %w", atlaserrors.ErrEmptyValue)
 	}
-	home, err := os.UserHomeDir()
+	stateDir, err := workspace.StateDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		return nil, err
 	}
 	hash, err := repoHash(repoPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compute repo hash: %w", err)
 	}
-	atlasHome := filepath.Join(home, constants.AtlasHome, constants.ReposDir, hash)
+	atlasHome := filepath.Join(stateDir, constants.ReposDir, hash)
 	return &FileStore{
 		atlasHome: atlasHome,
 		logger:    zerolog.Nop(),
+		wsUpdater: defaultWorkspaceStore(atlasHome),
 	}, nil
 }
 
@@ -219,8 +226,11 @@ func (s *FileStore) Get(ctx context.Context, workspaceName, taskID string) (*dom
 		return nil, fmt.Errorf("failed to get task '%s': %w", taskID, atlaserrors.ErrTaskNotFound)
 	}
 
-	// Acquire lock for read operation
-	lockFile, err := s.acquireLock(ctx, workspaceName, taskID)
+	// Finish any coordinated update that was interrupted part way
+	s.recoverPendingUpdate(ctx, workspaceName, taskID)
+
+	// Acquire shared lock for read operation so concurrent writes are never observed mid-flight
+	lockFile, err := s.acquireSharedLock(ctx, workspaceName, taskID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task '%s': %w", taskID, err)
 	}
@@ -239,14 +249,29 @@ func (s *FileStore) Get(ctx context.Context, workspaceName, taskID string) (*dom
 	// Parse JSON
 	var task domain.Task
 	if err := json.Unmarshal(data, &task); err != nil {
-		return nil, fmt.Errorf("failed to parse task '%s': corrupted state file: %w", taskID, err)
+		recovered, backupErr := loadTaskBackup(taskFile)
+		if backupErr != nil {
+			return nil, fmt.Errorf("failed to parse task '%s': corrupted state file: %w", taskID, err)
+		}
+		s.logger.Warn().Err(err).
+			Str("task_id", taskID).
+			Str("workspace_name", workspaceName).
+			Msg("task file corrupted, recovered last good state from backup")
+		return recovered, nil
 	}
 
 	return &task, nil
 }
 
-// Update saves the current task state (atomic write).
+// Update saves the current task state (atomic write), stamping UpdatedAt
+// with the current time.
 func (s *FileStore) Update(ctx context.Context, workspaceName string, task *domain.Task) error {
+	return s.UpdateAt(ctx, workspaceName, task, time.Now())
+}
+
+// UpdateAt is Update with the UpdatedAt timestamp given by the caller, e.g.
+// from the engine's clock.
+func (s *FileStore) UpdateAt(ctx context.Context, workspaceName string, task *domain.Task, updatedAt time.Time) error {
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return err
 	}
@@ -274,7 +299,10 @@ func (s *FileStore) Update(ctx context.Context, workspaceName string, task *doma
 	defer func() { _ = s.releaseLock(lockFile) }()
 
 	// Update timestamp
-	task.UpdatedAt = time.Now().UTC()
+	task.UpdatedAt = updatedAt.UTC()
+
+	// This write replaces any journaled task record, so a later load must not replay it
+	s.supersedePendingUpdate(ctx, workspaceName, task.ID)
 
 	// Marshal task to JSON
 	data, err := json.MarshalIndent(task, "", "  ")
@@ -284,7 +312,7 @@ func (s *FileStore) Update(ctx context.Context, workspaceName string, task *doma
 
 	// Write task file atomically
 	taskFile := s.taskFilePath(workspaceName, task.ID)
-	if err := atomicWrite(taskFile, data); err != nil {
+	if err := s.writeTaskFile(taskFile, data); err != nil {
 		return fmt.Errorf("failed to update task '%s': %w", task.ID, err)
 	}
 
@@ -323,8 +351,12 @@ func (s *FileStore) List(ctx context.Context, workspaceName string) ([]*domain.T
 			continue
 		}
 
-		// Skip invalid task IDs
-		if !validTaskIDRegex.MatchString(entry.Name()) {
+		// Skip hidden entries and directories that hold no task, whatever
+		// their ID scheme (see WithIDGenerator)
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if _, err := os.Stat(s.taskFilePath(workspaceName, entry.Name())); err != nil {
 			continue
 		}
 
@@ -332,7 +364,7 @@ func (s *FileStore) List(ctx context.Context, workspaceName string) ([]*domain.T
 			return nil, err
 		}
 
-		// Try to read task
+		// Try to read task (Get holds the task's shared lock while reading)
 		task, err := s.Get(ctx, workspaceName, entry.Name())
 		if err != nil {
 			// Skip directories without valid task.json (log warning in production)
@@ -350,6 +382,30 @@ func (s *FileStore) List(ctx context.Context, workspaceName string) ([]*domain.T
 	return tasks, nil
 }
 
+// ListByStatus returns the workspace's tasks whose status is one of statuses,
+// sorted by priority (highest first), then by creation time (newest first).
+// With no statuses it returns no tasks.
+func (s *FileStore) ListByStatus(ctx context.Context, workspaceName string, statuses ...constants.TaskStatus) ([]*domain.Task, error) {
+	tasks, err := s.List(ctx, workspaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*domain.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if slices.Contains(statuses, t.Status) {
+			matched = append(matched, t)
+		}
+	}
+
+	// List already orders by creation time, so a stable sort keeps it within a priority
+	slices.SortStableFunc(matched, func(a, b *domain.Task) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+
+	return matched, nil
+}
+
 // Delete removes a task and all its artifacts.
 func (s *FileStore) Delete(ctx context.Context, workspaceName, taskID string) error {
 	if err := ctxutil.Canceled(ctx); err != nil {
@@ -513,6 +569,24 @@ func (s *FileStore) SaveArtifact(ctx context.Context, workspaceName, taskID, fil
 	return nil
 }
 
+// StepArtifactPath returns the artifact path of a step-scoped artifact,
+// e.g. StepArtifactPath("validate", "output.log") is "validate/output.log".
+func StepArtifactPath(stepName, filename string) string {
+	return filepath.Join(stepName, filename)
+}
+
+// SaveStepArtifact saves an artifact file under the step's namespace.
+// The step name must be a single path element.
+func (s *FileStore) SaveStepArtifact(ctx context.Context, workspaceName, taskID, stepName, filename string, data []byte) error {
+	if stepName == "" {
+		return fmt.Errorf("failed to save step artifact: step name %w", atlaserrors.ErrEmptyValue)
+	}
+	if stepName == "." || stepName == ".." || strings.ContainsAny(stepName, `/\`) {
+		return fmt.Errorf("failed to save step artifact: %w", atlaserrors.ErrPathTraversal)
+	}
+	return s.SaveArtifact(ctx, workspaceName, taskID, StepArtifactPath(stepName, filename), data)
+}
+
 // SaveVersionedArtifact saves an artifact with automatic version numbering.
 // For example, if "validation.json" exists, saves as "validation.1.json",
 // then "validation.2.json", etc.
@@ -782,8 +856,20 @@ func (s *FileStore) lockFilePath(workspaceName, taskID string) string {
 }
 
 // acquireLock acquires an exclusive file lock for the task.
-// It respects context cancellation during the lock acquisition retry loop.
+// Writers use it so no reader or other writer can access the task concurrently.
 func (s *FileStore) acquireLock(ctx context.Context, workspaceName, taskID string) (*os.File, error) {
+	return s.acquireLockWith(ctx, workspaceName, taskID, flock.Exclusive)
+}
+
+// acquireSharedLock acquires a shared file lock for the task.
+// Readers use it so they can proceed together but never alongside a writer.
+func (s *FileStore) acquireSharedLock(ctx context.Context, workspaceName, taskID string) (*os.File, error) {
+	return s.acquireLockWith(ctx, workspaceName, taskID, flock.Shared)
+}
+
+// acquireLockWith acquires a file lock for the task using the given lock function.
+// It respects context cancellation during the lock acquisition retry loop.
+func (s *FileStore) acquireLockWith(ctx context.Context, workspaceName, taskID string, lock func(fd uintptr) error) (*os.File, error) {
 	lockPath := s.lockFilePath(workspaceName, taskID)
 
 	// Ensure task directory exists for lock file
@@ -798,7 +884,7 @@ func (s *FileStore) acquireLock(ctx context.Context, workspaceName, taskID strin
 	}
 
 	// Try to acquire lock immediately first
-	if err := flock.Exclusive(f.Fd()); err == nil {
+	if err := lock(f.Fd()); err == nil {
 		return f, nil
 	}
 
@@ -813,8 +899,8 @@ func (s *FileStore) acquireLock(ctx context.Context, workspaceName, taskID strin
 			_ = f.Close() // cleanup in cancellation path, primary error is ctx.Err()
 			return nil, ctx.Err()
 		case <-ticker.C:
-			// Attempt to acquire exclusive non-blocking lock
-			if err := flock.Exclusive(f.Fd()); err == nil {
+			// Attempt to acquire non-blocking lock
+			if err := lock(f.Fd()); err == nil {
 				return f, nil
 			}
 
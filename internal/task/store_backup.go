@@ -0,0 +1,58 @@
+// Package task provides task persistence and execution for ATLAS.
+//
+// This file implements last-good-state backups for task files. Before the
+// task file is overwritten, its current contents are copied to a sidecar
+// backup when they still parse. If the task file is later found corrupted,
+// Get falls back to the backup instead of failing the task outright.
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// taskBackupSuffix is appended to the task file path to name its backup.
+const taskBackupSuffix = ".bak"
+
+// RecoveredFromBackupMetadataKey is the task metadata key set when a task was
+// loaded from its backup because the primary task file was corrupted.
+const RecoveredFromBackupMetadataKey = "recovered_from_backup"
+
+// writeTaskFile atomically replaces the task file, first preserving the
+// current contents as a backup if they are a valid task. A corrupted task
+// file never overwrites an existing backup. The caller must hold the task's
+// exclusive lock.
+func (s *FileStore) writeTaskFile(taskFile string, data []byte) error {
+	if current, err := os.ReadFile(taskFile); err == nil { //#nosec G304 -- path is constructed internally
+		var existing domain.Task
+		if json.Unmarshal(current, &existing) == nil {
+			if err := atomicWrite(taskFile+taskBackupSuffix, current); err != nil {
+				s.logger.Warn().Err(err).Str("path", taskFile).Msg("failed to back up task file")
+			}
+		}
+	}
+	return atomicWrite(taskFile, data)
+}
+
+// loadTaskBackup parses the backup of a corrupted task file and marks the
+// result as recovered.
+func loadTaskBackup(taskFile string) (*domain.Task, error) {
+	data, err := os.ReadFile(taskFile + taskBackupSuffix) //#nosec G304 -- path is constructed internally
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task backup: %w", err)
+	}
+
+	var task domain.Task
+	if err := json.Unmarshal(data, &task); err != nil {
+		return nil, fmt.Errorf("failed to parse task backup: %w", err)
+	}
+
+	if task.Metadata == nil {
+		task.Metadata = make(map[string]any)
+	}
+	task.Metadata[RecoveredFromBackupMetadataKey] = true
+	return &task, nil
+}
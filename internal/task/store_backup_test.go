@@ -0,0 +1,92 @@
+package task
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// TestFileStore_Get_RecoversFromBackup tests that a corrupted task file is
+// transparently replaced by the last good state.
+func TestFileStore_Get_RecoversFromBackup(t *testing.T) {
+	t.Parallel()
+	store, tmpDir := setupTestStore(t)
+	ctx := context.Background()
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000200")
+	require.NoError(t, store.Create(ctx, "test-ws", task))
+
+	task.Status = domain.TaskStatusRunning
+	require.NoError(t, store.Update(ctx, "test-ws", task))
+	task.CurrentStep = 1
+	require.NoError(t, store.Update(ctx, "test-ws", task))
+
+	taskFile := filepath.Join(tmpDir, constants.WorkspacesDir, "test-ws", constants.TasksDir, task.ID, constants.TaskFileName)
+	require.NoError(t, os.WriteFile(taskFile, []byte("{truncated"), 0o600))
+
+	got, err := store.Get(ctx, "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, got.ID)
+	assert.Equal(t, domain.TaskStatusRunning, got.Status)
+	assert.Equal(t, 0, got.CurrentStep, "backup holds the state before the last update")
+	assert.Equal(t, true, got.Metadata[RecoveredFromBackupMetadataKey])
+
+	// Saving the recovered task replaces the corrupted file but keeps the good backup
+	require.NoError(t, store.Update(ctx, "test-ws", got))
+	backup, err := loadTaskBackup(taskFile)
+	require.NoError(t, err)
+	assert.Equal(t, 0, backup.CurrentStep)
+
+	reloaded, err := store.Get(ctx, "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, true, reloaded.Metadata[RecoveredFromBackupMetadataKey])
+}
+
+// TestFileStore_Get_BackupAlsoCorrupted tests that the parse error is
+// returned when neither the task file nor its backup is readable.
+func TestFileStore_Get_BackupAlsoCorrupted(t *testing.T) {
+	t.Parallel()
+	store, tmpDir := setupTestStore(t)
+	ctx := context.Background()
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000201")
+	require.NoError(t, store.Create(ctx, "test-ws", task))
+	require.NoError(t, store.Update(ctx, "test-ws", task))
+
+	taskFile := filepath.Join(tmpDir, constants.WorkspacesDir, "test-ws", constants.TasksDir, task.ID, constants.TaskFileName)
+	require.NoError(t, os.WriteFile(taskFile, []byte("not valid json"), 0o600))
+	require.NoError(t, os.WriteFile(taskFile+taskBackupSuffix, []byte("also not json"), 0o600))
+
+	_, err := store.Get(ctx, "test-ws", task.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "corrupted state file")
+}
+
+// TestFileStore_Update_WritesBackup tests that each update preserves the
+// previous task state alongside the task file.
+func TestFileStore_Update_WritesBackup(t *testing.T) {
+	t.Parallel()
+	store, tmpDir := setupTestStore(t)
+	ctx := context.Background()
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000202")
+	require.NoError(t, store.Create(ctx, "test-ws", task))
+
+	taskFile := filepath.Join(tmpDir, constants.WorkspacesDir, "test-ws", constants.TasksDir, task.ID, constants.TaskFileName)
+	_, err := os.Stat(taskFile + taskBackupSuffix)
+	require.True(t, os.IsNotExist(err), "create has no previous state to back up")
+
+	task.Description = "updated"
+	require.NoError(t, store.Update(ctx, "test-ws", task))
+
+	backup, err := loadTaskBackup(taskFile)
+	require.NoError(t, err)
+	assert.Equal(t, "Test task", backup.Description)
+}
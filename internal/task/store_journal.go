@@ -0,0 +1,283 @@
+// Package task provides task persistence and execution for ATLAS.
+//
+// This file implements coordinated task and workspace updates. The task
+// store cannot write both records in one atomic step, so UpdateWithWorkspace
+// first writes a redo journal holding both records next to the task file.
+// The journal is removed once both writes succeed; if the process dies or
+// the workspace write fails in between, the next Get replays the journal so
+// the two records converge on the state that was being saved. A plain Update
+// supersedes a pending journal, so an older journaled task never overwrites
+// a newer save; the journaled workspace record is still written, or kept in
+// the journal until it can be.
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// updateJournalFileName is the name of the redo journal kept in a task
+// directory while a coordinated update is in flight.
+const updateJournalFileName = "update.journal.json"
+
+// WorkspaceUpdater reads and persists workspace records. workspace.Store
+// satisfies it.
+type WorkspaceUpdater interface {
+	Get(ctx context.Context, name string) (*domain.Workspace, error)
+	Update(ctx context.Context, ws *domain.Workspace) error
+}
+
+// updateJournal is the redo record written before a coordinated update.
+// Task is nil once a newer Update has superseded the journaled task.
+type updateJournal struct {
+	Task      *domain.Task      `json:"task,omitempty"`
+	Workspace *domain.Workspace `json:"workspace"`
+}
+
+// defaultWorkspaceStore opens the workspace store sharing atlasHome, or
+// returns nil if it cannot be opened.
+func defaultWorkspaceStore(atlasHome string) WorkspaceUpdater {
+	wsStore, err := workspace.NewFileStore(atlasHome)
+	if err != nil {
+		return nil
+	}
+	return wsStore
+}
+
+// WithWorkspaceStore sets the workspace store used by UpdateWithWorkspace and
+// journal recovery, and returns the FileStore for chaining.
+func (s *FileStore) WithWorkspaceStore(ws WorkspaceUpdater) *FileStore {
+	s.wsUpdater = ws
+	return s
+}
+
+// UpdateTaskAndWorkspace saves task, stamped with updatedAt, together with its
+// workspace record, whose task list is updated to the task's current status,
+// via UpdateWithWorkspace. Without a workspace store or workspace record only
+// the task is saved.
+func (s *FileStore) UpdateTaskAndWorkspace(ctx context.Context, workspaceName string, task *domain.Task, updatedAt time.Time) error {
+	if s.wsUpdater == nil {
+		return s.UpdateAt(ctx, workspaceName, task, updatedAt)
+	}
+	if err := validateTaskWithID("update task with workspace", task); err != nil {
+		return err
+	}
+	ws, err := s.wsUpdater.Get(ctx, workspaceName)
+	if errors.Is(err, atlaserrors.ErrWorkspaceNotFound) {
+		return s.UpdateAt(ctx, workspaceName, task, updatedAt)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update task '%s': %w", task.ID, err)
+	}
+	setTaskRef(ws, task)
+	return s.updateWithWorkspace(ctx, workspaceName, task, ws, updatedAt)
+}
+
+// setTaskRef adds task to the workspace's task list, or refreshes its entry.
+func setTaskRef(ws *domain.Workspace, task *domain.Task) {
+	startedAt := task.CreatedAt
+	ref := domain.TaskRef{ID: task.ID, Status: task.Status, StartedAt: &startedAt, CompletedAt: task.CompletedAt}
+	for i := range ws.Tasks {
+		if ws.Tasks[i].ID == task.ID {
+			ws.Tasks[i] = ref
+			return
+		}
+	}
+	ws.Tasks = append(ws.Tasks, ref)
+}
+
+// UpdateWithWorkspace saves task and its workspace record together. Both are
+// written to a journal first, so a failure between the two writes is rolled
+// forward by the next Get instead of leaving the records inconsistent. The
+// task's UpdatedAt is stamped with the current time.
+func (s *FileStore) UpdateWithWorkspace(ctx context.Context, workspaceName string, task *domain.Task, ws *domain.Workspace) error {
+	return s.updateWithWorkspace(ctx, workspaceName, task, ws, time.Now())
+}
+
+// updateWithWorkspace is UpdateWithWorkspace stamping the task with updatedAt.
+func (s *FileStore) updateWithWorkspace(ctx context.Context, workspaceName string, task *domain.Task, ws *domain.Workspace, updatedAt time.Time) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	// Validate inputs
+	if err := validateWorkspaceName("update task with workspace", workspaceName); err != nil {
+		return err
+	}
+	if err := validateTaskWithID("update task with workspace", task); err != nil {
+		return err
+	}
+	if ws == nil {
+		return fmt.Errorf("update task with workspace: workspace %w", atlaserrors.ErrEmptyValue)
+	}
+	if s.wsUpdater == nil {
+		return fmt.Errorf("failed to update task '%s': %w", task.ID, atlaserrors.ErrWorkspaceStoreNotConfigured)
+	}
+
+	taskDir := s.taskDir(workspaceName, task.ID)
+
+	// Check if task exists
+	if _, err := os.Stat(taskDir); os.IsNotExist(err) {
+		return fmt.Errorf("failed to update task '%s': %w", task.ID, atlaserrors.ErrTaskNotFound)
+	}
+
+	// Acquire lock for write operation
+	lockFile, err := s.acquireLock(ctx, workspaceName, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update task '%s': %w", task.ID, err)
+	}
+	defer func() { _ = s.releaseLock(lockFile) }()
+
+	// Update timestamp
+	task.UpdatedAt = updatedAt.UTC()
+
+	// Record intent before touching either record
+	data, err := json.MarshalIndent(updateJournal{Task: task, Workspace: ws}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to update task '%s': %w", task.ID, err)
+	}
+	journalPath := s.journalFilePath(workspaceName, task.ID)
+	if err := atomicWrite(journalPath, data); err != nil {
+		return fmt.Errorf("failed to write update journal for task '%s': %w", task.ID, err)
+	}
+
+	if err := s.applyJournal(ctx, workspaceName, &updateJournal{Task: task, Workspace: ws}); err != nil {
+		// The journal stays behind so the next load completes the update
+		return fmt.Errorf("failed to update task '%s': %w", task.ID, err)
+	}
+
+	if err := os.Remove(journalPath); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("failed to remove update journal")
+	}
+	return nil
+}
+
+// applyJournal writes the journaled task, if any, and workspace records. The
+// caller must hold the task's exclusive lock.
+func (s *FileStore) applyJournal(ctx context.Context, workspaceName string, journal *updateJournal) error {
+	if journal.Task != nil {
+		data, err := json.MarshalIndent(journal.Task, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := s.writeTaskFile(s.taskFilePath(workspaceName, journal.Task.ID), data); err != nil {
+			return err
+		}
+	}
+	if err := s.wsUpdater.Update(ctx, journal.Workspace); err != nil {
+		return fmt.Errorf("failed to update workspace '%s': %w", journal.Workspace.Name, err)
+	}
+	return nil
+}
+
+// recoverPendingUpdate replays an update journal left behind by an
+// interrupted UpdateWithWorkspace. Recovery is best effort: without a
+// workspace store, or if replaying fails, the journal is kept for a later
+// load and the task file is read as it is.
+func (s *FileStore) recoverPendingUpdate(ctx context.Context, workspaceName, taskID string) {
+	journalPath := s.journalFilePath(workspaceName, taskID)
+	if _, err := os.Stat(journalPath); err != nil {
+		return
+	}
+	if s.wsUpdater == nil {
+		s.logger.Debug().Str("task_id", taskID).Msg("update journal pending but no workspace store configured")
+		return
+	}
+
+	lockFile, err := s.acquireLock(ctx, workspaceName, taskID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("task_id", taskID).Msg("failed to lock task for update journal recovery")
+		return
+	}
+	defer func() { _ = s.releaseLock(lockFile) }()
+
+	// Another process may have completed the recovery while we waited
+	data, err := os.ReadFile(journalPath) //#nosec G304 -- path is validated and constructed from trusted base
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("failed to read update journal")
+		return
+	}
+
+	var journal updateJournal
+	if err := json.Unmarshal(data, &journal); err != nil || journal.Workspace == nil {
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("discarding corrupted update journal")
+		_ = os.Remove(journalPath)
+		return
+	}
+
+	if err := s.applyJournal(ctx, workspaceName, &journal); err != nil {
+		s.logger.Warn().Err(err).Str("task_id", taskID).Msg("failed to replay update journal")
+		return
+	}
+	if err := os.Remove(journalPath); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("failed to remove update journal")
+		return
+	}
+	s.logger.Info().Str("task_id", taskID).Str("workspace_name", workspaceName).Msg("recovered interrupted task and workspace update")
+}
+
+// supersedePendingUpdate clears the task from a pending update journal before
+// Update writes a newer task record. The journaled workspace record is still
+// written so the workspace does not miss the update; if that cannot be done
+// now, the journal is kept with only the workspace record so a later load
+// completes it without reverting the newer task. The caller must hold the
+// task's exclusive lock.
+func (s *FileStore) supersedePendingUpdate(ctx context.Context, workspaceName, taskID string) {
+	journalPath := s.journalFilePath(workspaceName, taskID)
+	data, err := os.ReadFile(journalPath) //#nosec G304 -- path is validated and constructed from trusted base
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+
+	var journal updateJournal
+	switch {
+	case err != nil:
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("failed to read superseded update journal")
+		return
+	case json.Unmarshal(data, &journal) != nil || journal.Workspace == nil:
+		s.logger.Warn().Str("path", journalPath).Msg("discarding corrupted update journal")
+	case s.wsUpdater == nil:
+		s.logger.Warn().Str("task_id", taskID).Msg("keeping pending workspace update: no workspace store configured")
+		s.keepWorkspaceJournal(journalPath, journal.Workspace)
+		return
+	default:
+		if err := s.wsUpdater.Update(ctx, journal.Workspace); err != nil {
+			s.logger.Warn().Err(err).Str("task_id", taskID).Msg("failed to apply pending workspace update, keeping it for a later load")
+			s.keepWorkspaceJournal(journalPath, journal.Workspace)
+			return
+		}
+	}
+
+	if err := os.Remove(journalPath); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("failed to remove update journal")
+	}
+}
+
+// keepWorkspaceJournal rewrites the journal at journalPath to hold only ws.
+func (s *FileStore) keepWorkspaceJournal(journalPath string, ws *domain.Workspace) {
+	data, err := json.MarshalIndent(updateJournal{Workspace: ws}, "", "  ")
+	if err == nil {
+		err = atomicWrite(journalPath, data)
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Str("path", journalPath).Msg("failed to rewrite update journal")
+	}
+}
+
+// journalFilePath returns the path to a task's update journal.
+func (s *FileStore) journalFilePath(workspaceName, taskID string) string {
+	return filepath.Join(s.taskDir(workspaceName, taskID), updateJournalFileName)
+}
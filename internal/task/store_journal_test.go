@@ -0,0 +1,331 @@
+package task
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/template/steps"
+	"github.com/mrz1836/atlas/internal/workspace"
+)
+
+// recordingWorkspaceUpdater records workspace updates and fails with err when
+// set. Get returns the workspace record, if any.
+type recordingWorkspaceUpdater struct {
+	mu        sync.Mutex
+	err       error
+	workspace *domain.Workspace
+	updates   []domain.Workspace
+}
+
+func (r *recordingWorkspaceUpdater) Get(_ context.Context, name string) (*domain.Workspace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.workspace == nil || r.workspace.Name != name {
+		return nil, atlaserrors.ErrWorkspaceNotFound
+	}
+	ws := *r.workspace
+	ws.Tasks = slices.Clone(r.workspace.Tasks)
+	return &ws, nil
+}
+
+func (r *recordingWorkspaceUpdater) Update(_ context.Context, ws *domain.Workspace) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.updates = append(r.updates, *ws)
+	return nil
+}
+
+// TestFileStore_UpdateWithWorkspace tests that a coordinated update writes
+// both records and leaves no journal behind.
+func TestFileStore_UpdateWithWorkspace(t *testing.T) {
+	t.Parallel()
+
+	store, _ := setupTestStore(t)
+	wsStore := &recordingWorkspaceUpdater{}
+	store.WithWorkspaceStore(wsStore)
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e01")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+
+	task.Status = constants.TaskStatusInterrupted
+	ws := &domain.Workspace{Name: "test-ws", Status: constants.WorkspaceStatusPaused}
+	require.NoError(t, store.UpdateWithWorkspace(context.Background(), "test-ws", task, ws))
+
+	got, err := store.Get(context.Background(), "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusInterrupted, got.Status)
+	require.Len(t, wsStore.updates, 1)
+	assert.Equal(t, constants.WorkspaceStatusPaused, wsStore.updates[0].Status)
+	assert.NoFileExists(t, store.journalFilePath("test-ws", task.ID))
+}
+
+// TestFileStore_UpdateWithWorkspace_RecoversOnLoad tests that a failure
+// between the task and workspace writes is rolled forward by the next load.
+func TestFileStore_UpdateWithWorkspace_RecoversOnLoad(t *testing.T) {
+	t.Parallel()
+
+	store, tmpDir := setupTestStore(t)
+	store.WithWorkspaceStore(&recordingWorkspaceUpdater{err: atlaserrors.ErrLockTimeout})
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e02")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+
+	task.Status = constants.TaskStatusInterrupted
+	ws := &domain.Workspace{Name: "test-ws", Status: constants.WorkspaceStatusPaused}
+	err := store.UpdateWithWorkspace(context.Background(), "test-ws", task, ws)
+	require.ErrorIs(t, err, atlaserrors.ErrLockTimeout)
+	assert.FileExists(t, store.journalFilePath("test-ws", task.ID))
+
+	// A later process loads the task with a working workspace store
+	wsStore := &recordingWorkspaceUpdater{}
+	reloaded, err := NewFileStore(tmpDir)
+	require.NoError(t, err)
+	reloaded.WithWorkspaceStore(wsStore)
+
+	got, err := reloaded.Get(context.Background(), "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusInterrupted, got.Status)
+	require.Len(t, wsStore.updates, 1)
+	assert.Equal(t, constants.WorkspaceStatusPaused, wsStore.updates[0].Status)
+	assert.NoFileExists(t, reloaded.journalFilePath("test-ws", task.ID))
+}
+
+// TestFileStore_UpdateWithWorkspace_PendingWithoutWorkspaceStore tests that a
+// pending journal is kept when the loading store cannot replay it.
+func TestFileStore_UpdateWithWorkspace_PendingWithoutWorkspaceStore(t *testing.T) {
+	t.Parallel()
+
+	store, tmpDir := setupTestStore(t)
+	store.WithWorkspaceStore(&recordingWorkspaceUpdater{err: atlaserrors.ErrLockTimeout})
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e03")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+	require.Error(t, store.UpdateWithWorkspace(context.Background(), "test-ws", task,
+		&domain.Workspace{Name: "test-ws", Status: constants.WorkspaceStatusPaused}))
+
+	reloaded, err := NewFileStore(tmpDir)
+	require.NoError(t, err)
+	reloaded.WithWorkspaceStore(nil)
+	_, err = reloaded.Get(context.Background(), "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.FileExists(t, reloaded.journalFilePath("test-ws", task.ID))
+}
+
+// TestFileStore_Update_KeepsWorkspaceJournalWithoutWorkspaceStore tests that
+// a plain Update through a store without a workspace store keeps the pending
+// workspace record for a later load, without reverting the newer task.
+func TestFileStore_Update_KeepsWorkspaceJournalWithoutWorkspaceStore(t *testing.T) {
+	t.Parallel()
+
+	store, tmpDir := setupTestStore(t)
+	store.WithWorkspaceStore(&recordingWorkspaceUpdater{err: atlaserrors.ErrLockTimeout})
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e06")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+	task.Status = constants.TaskStatusInterrupted
+	require.Error(t, store.UpdateWithWorkspace(context.Background(), "test-ws", task,
+		&domain.Workspace{Name: "test-ws", Status: constants.WorkspaceStatusPaused}))
+
+	bare, err := NewFileStore(tmpDir)
+	require.NoError(t, err)
+	bare.WithWorkspaceStore(nil)
+	task.Status = constants.TaskStatusRunning
+	require.NoError(t, bare.Update(context.Background(), "test-ws", task))
+	require.FileExists(t, bare.journalFilePath("test-ws", task.ID))
+
+	wsStore := &recordingWorkspaceUpdater{}
+	later, err := NewFileStore(tmpDir)
+	require.NoError(t, err)
+	later.WithWorkspaceStore(wsStore)
+	got, err := later.Get(context.Background(), "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusRunning, got.Status, "the newer task is kept")
+	require.Len(t, wsStore.updates, 1)
+	assert.Equal(t, constants.WorkspaceStatusPaused, wsStore.updates[0].Status)
+	assert.NoFileExists(t, later.journalFilePath("test-ws", task.ID))
+}
+
+// TestFileStore_UpdateTaskAndWorkspace tests that the workspace record gets
+// the task's status in its task list, and that a missing workspace record
+// only saves the task.
+func TestFileStore_UpdateTaskAndWorkspace(t *testing.T) {
+	t.Parallel()
+
+	store, _ := setupTestStore(t)
+	wsStore := &recordingWorkspaceUpdater{workspace: &domain.Workspace{Name: "test-ws", Status: constants.WorkspaceStatusActive}}
+	store.WithWorkspaceStore(wsStore)
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e07")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+	task.Status = constants.TaskStatusAwaitingApproval
+	require.NoError(t, store.UpdateTaskAndWorkspace(context.Background(), "test-ws", task, time.Now()))
+
+	require.Len(t, wsStore.updates, 1)
+	require.Len(t, wsStore.updates[0].Tasks, 1)
+	assert.Equal(t, task.ID, wsStore.updates[0].Tasks[0].ID)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, wsStore.updates[0].Tasks[0].Status)
+
+	wsStore.workspace = nil
+	task.Status = constants.TaskStatusCompleted
+	require.NoError(t, store.UpdateTaskAndWorkspace(context.Background(), "test-ws", task, time.Now()))
+	got, err := store.Get(context.Background(), "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusCompleted, got.Status)
+	assert.Len(t, wsStore.updates, 1)
+}
+
+// TestFileStore_Update_SupersedesPendingJournal tests that a plain Update
+// after a failed coordinated update is not overwritten by replaying the
+// older journal on the next load.
+func TestFileStore_Update_SupersedesPendingJournal(t *testing.T) {
+	t.Parallel()
+
+	store, tmpDir := setupTestStore(t)
+	store.WithWorkspaceStore(&recordingWorkspaceUpdater{err: atlaserrors.ErrLockTimeout})
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e05")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+
+	task.Status = constants.TaskStatusInterrupted
+	require.Error(t, store.UpdateWithWorkspace(context.Background(), "test-ws", task,
+		&domain.Workspace{Name: "test-ws", Status: constants.WorkspaceStatusPaused}))
+	require.FileExists(t, store.journalFilePath("test-ws", task.ID))
+
+	// A newer save through a store whose workspace writes work again
+	wsStore := &recordingWorkspaceUpdater{}
+	later, err := NewFileStore(tmpDir)
+	require.NoError(t, err)
+	later.WithWorkspaceStore(wsStore)
+	task.Status = constants.TaskStatusRunning
+	task.UpdatedAt = task.UpdatedAt.Add(time.Minute)
+	require.NoError(t, later.Update(context.Background(), "test-ws", task))
+	assert.NoFileExists(t, later.journalFilePath("test-ws", task.ID))
+	require.Len(t, wsStore.updates, 1, "the journaled workspace update is still applied")
+	assert.Equal(t, constants.WorkspaceStatusPaused, wsStore.updates[0].Status)
+
+	got, err := later.Get(context.Background(), "test-ws", task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusRunning, got.Status)
+	assert.Len(t, wsStore.updates, 1, "nothing is replayed on load")
+}
+
+// TestFileStore_UpdateWithWorkspace_Validation tests input validation.
+func TestFileStore_UpdateWithWorkspace_Validation(t *testing.T) {
+	t.Parallel()
+
+	store, _ := setupTestStore(t)
+	store.WithWorkspaceStore(nil)
+	task := createTestTask("task-00000000-0000-4000-8000-000000000e04")
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+	ws := &domain.Workspace{Name: "test-ws"}
+
+	err := store.UpdateWithWorkspace(context.Background(), "test-ws", task, ws)
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceStoreNotConfigured)
+
+	store.WithWorkspaceStore(&recordingWorkspaceUpdater{})
+	err = store.UpdateWithWorkspace(context.Background(), "test-ws", task, nil)
+	require.ErrorIs(t, err, atlaserrors.ErrEmptyValue)
+
+	_, statErr := os.Stat(store.journalFilePath("test-ws", task.ID))
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+// crashStateDirEnv names the state directory of the helper process started by
+// TestEngine_Complete_RecoversAfterCrashBetweenWrites.
+const crashStateDirEnv = "ATLAS_TEST_CRASH_STATE_DIR"
+
+// crashExitCode is the exit code of the helper process when it dies between
+// the task and workspace writes.
+const crashExitCode = 3
+
+// crashingWorkspaceStore exits the process instead of writing a workspace
+// record that references an awaiting-approval task, as if the process were
+// killed after the task write.
+type crashingWorkspaceStore struct {
+	WorkspaceUpdater
+}
+
+func (c crashingWorkspaceStore) Update(ctx context.Context, ws *domain.Workspace) error {
+	for _, ref := range ws.Tasks {
+		if ref.Status == constants.TaskStatusAwaitingApproval {
+			os.Exit(crashExitCode)
+		}
+	}
+	return c.WorkspaceUpdater.Update(ctx, ws)
+}
+
+// TestEngine_Complete_RecoversAfterCrashBetweenWrites runs a task to
+// completion in a helper process that dies between the task and workspace
+// writes, then checks that the next load brings the workspace record up to
+// date.
+func TestEngine_Complete_RecoversAfterCrashBetweenWrites(t *testing.T) {
+	if stateDir := os.Getenv(crashStateDirEnv); stateDir != "" {
+		runCrashingEngine(t, stateDir)
+		return
+	}
+
+	ctx := context.Background()
+	stateDir := t.TempDir()
+	wsStore, err := workspace.NewFileStore(stateDir)
+	require.NoError(t, err)
+	require.NoError(t, wsStore.Create(ctx, &domain.Workspace{Name: "crash-ws", Status: constants.WorkspaceStatusActive}))
+
+	cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestEngine_Complete_RecoversAfterCrashBetweenWrites$") //nolint:gosec // G204: re-runs this test binary
+	cmd.Env = append(os.Environ(), crashStateDirEnv+"="+stateDir)
+	out, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr, string(out))
+	require.Equal(t, crashExitCode, exitErr.ExitCode(), string(out))
+
+	ws, err := wsStore.Get(ctx, "crash-ws")
+	require.NoError(t, err)
+	require.Empty(t, ws.Tasks, "the crash happened before the workspace write")
+
+	store, err := NewFileStore(stateDir)
+	require.NoError(t, err)
+	tasks, err := store.List(ctx, "crash-ws")
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	got, err := store.Get(ctx, "crash-ws", tasks[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, got.Status)
+	assert.NoFileExists(t, store.journalFilePath("crash-ws", got.ID))
+
+	ws, err = wsStore.Get(ctx, "crash-ws")
+	require.NoError(t, err)
+	require.Len(t, ws.Tasks, 1)
+	assert.Equal(t, got.ID, ws.Tasks[0].ID)
+	assert.Equal(t, constants.TaskStatusAwaitingApproval, ws.Tasks[0].Status)
+}
+
+// runCrashingEngine runs a one-step task through an engine whose store
+// crashes between the task and workspace writes on completion.
+func runCrashingEngine(t *testing.T, stateDir string) {
+	store, err := NewFileStore(stateDir)
+	require.NoError(t, err)
+	wsStore, err := workspace.NewFileStore(stateDir)
+	require.NoError(t, err)
+	store.WithWorkspaceStore(crashingWorkspaceStore{WorkspaceUpdater: wsStore})
+
+	registry := steps.NewExecutorRegistry()
+	registry.Register(&mockExecutor{stepType: domain.StepTypeAI, result: &domain.StepResult{Status: "success"}})
+	engine := NewEngine(store, registry, DefaultEngineConfig(), testLogger())
+
+	template := &domain.Template{Name: "crash", Steps: []domain.StepDefinition{{Name: "step1", Type: domain.StepTypeAI}}}
+	_, err = engine.Start(context.Background(), "crash-ws", "fix/crash", t.TempDir(), template, "crash", "")
+	t.Fatalf("engine completed without crashing: %v", err)
+}
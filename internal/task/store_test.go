@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -77,6 +79,24 @@ func TestNewFileStore(t *testing.T) {
 	})
 }
 
+// TestNewFileStore_StateDirEnv tests ATLAS_STATE_DIR roots an unconfigured
+// store, taking precedence over ATLAS_HOME.
+func TestNewFileStore_StateDirEnv(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv(constants.StateDirEnvVar, stateDir)
+	t.Setenv(constants.AtlasHomeEnvVar, t.TempDir())
+
+	store, err := NewFileStore("")
+	require.NoError(t, err)
+	assert.Equal(t, stateDir, store.atlasHome)
+
+	task := createTestTask(GenerateTaskID())
+	require.NoError(t, store.Create(context.Background(), "test-ws", task))
+
+	_, err = os.Stat(filepath.Join(stateDir, constants.WorkspacesDir, "test-ws", constants.TasksDir, task.ID))
+	assert.NoError(t, err)
+}
+
 func TestFileStore_Create(t *testing.T) {
 	t.Parallel()
 	t.Run("creates task successfully", func(t *testing.T) {
@@ -235,6 +255,35 @@ func TestFileStore_Update(t *testing.T) {
 		assert.True(t, retrieved.UpdatedAt.After(task.CreatedAt))
 	})
 
+	t.Run("restamps a stale timestamp", func(t *testing.T) {
+		store, _ := setupTestStore(t)
+
+		task := createTestTask("task-00000000-0000-4000-8000-000000000021")
+		require.NoError(t, store.Create(context.Background(), "test-ws", task))
+
+		before := time.Now().UTC()
+		task.UpdatedAt = before.Add(-time.Hour)
+		require.NoError(t, store.Update(context.Background(), "test-ws", task))
+
+		retrieved, err := store.Get(context.Background(), "test-ws", task.ID)
+		require.NoError(t, err)
+		assert.False(t, retrieved.UpdatedAt.Before(before))
+	})
+
+	t.Run("UpdateAt uses the given timestamp", func(t *testing.T) {
+		store, _ := setupTestStore(t)
+
+		task := createTestTask("task-00000000-0000-4000-8000-000000000022")
+		require.NoError(t, store.Create(context.Background(), "test-ws", task))
+
+		at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		require.NoError(t, store.UpdateAt(context.Background(), "test-ws", task, at))
+
+		retrieved, err := store.Get(context.Background(), "test-ws", task.ID)
+		require.NoError(t, err)
+		assert.True(t, retrieved.UpdatedAt.Equal(at))
+	})
+
 	t.Run("errors on non-existent task", func(t *testing.T) {
 		store, _ := setupTestStore(t)
 
@@ -308,6 +357,41 @@ func TestFileStore_List(t *testing.T) {
 	})
 }
 
+func TestFileStore_ListByStatus(t *testing.T) {
+	t.Parallel()
+	store, _ := setupTestStore(t)
+
+	failed := createTestTask("task-00000000-0000-4000-8000-000000000040")
+	failed.Status = constants.TaskStatusValidationFailed
+	failed.CreatedAt = time.Now().UTC().Add(-2 * time.Hour)
+
+	done := createTestTask("task-00000000-0000-4000-8000-000000000041")
+	done.Status = constants.TaskStatusCompleted
+	done.CreatedAt = time.Now().UTC().Add(-1 * time.Hour)
+
+	interrupted := createTestTask("task-00000000-0000-4000-8000-000000000042")
+	interrupted.Status = constants.TaskStatusInterrupted
+	interrupted.CreatedAt = time.Now().UTC()
+
+	for _, tk := range []*domain.Task{failed, done, interrupted} {
+		require.NoError(t, store.Create(context.Background(), "test-ws", tk))
+	}
+
+	tasks, err := store.ListByStatus(context.Background(), "test-ws",
+		constants.TaskStatusValidationFailed, constants.TaskStatusInterrupted)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, interrupted.ID, tasks[0].ID)
+	assert.Equal(t, failed.ID, tasks[1].ID)
+
+	tasks, err = store.ListByStatus(context.Background(), "test-ws")
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	_, err = store.ListByStatus(context.Background(), "")
+	require.ErrorIs(t, err, atlaserrors.ErrEmptyValue)
+}
+
 func TestFileStore_Delete(t *testing.T) {
 	t.Parallel()
 	t.Run("deletes existing task", func(t *testing.T) {
@@ -988,6 +1072,47 @@ func TestFileStore_SaveArtifact_AndList(t *testing.T) {
 	assert.Equal(t, []byte("test content"), content)
 }
 
+// TestFileStore_SaveStepArtifact_NoCollision tests that same-named artifacts
+// from different steps are stored separately.
+func TestFileStore_SaveStepArtifact_NoCollision(t *testing.T) {
+	t.Parallel()
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000110010")
+	require.NoError(t, store.Create(ctx, "test-ws", task))
+
+	require.NoError(t, store.SaveStepArtifact(ctx, "test-ws", task.ID, "implement", "output.json", []byte(`{"step":"implement"}`)))
+	require.NoError(t, store.SaveStepArtifact(ctx, "test-ws", task.ID, "validate", "output.json", []byte(`{"step":"validate"}`)))
+
+	implement, err := store.GetArtifact(ctx, "test-ws", task.ID, StepArtifactPath("implement", "output.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"step":"implement"}`, string(implement))
+
+	validate, err := store.GetArtifact(ctx, "test-ws", task.ID, StepArtifactPath("validate", "output.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"step":"validate"}`, string(validate))
+}
+
+// TestFileStore_SaveStepArtifact_InvalidStepName tests step names that would
+// escape the step namespace are rejected.
+func TestFileStore_SaveStepArtifact_InvalidStepName(t *testing.T) {
+	t.Parallel()
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	task := createTestTask("task-00000000-0000-4000-8000-000000110011")
+	require.NoError(t, store.Create(ctx, "test-ws", task))
+
+	err := store.SaveStepArtifact(ctx, "test-ws", task.ID, "", "output.json", []byte("x"))
+	require.ErrorIs(t, err, atlaserrors.ErrEmptyValue)
+
+	for _, name := range []string{"..", ".", "a/b", `a\b`} {
+		err = store.SaveStepArtifact(ctx, "test-ws", task.ID, name, "output.json", []byte("x"))
+		require.ErrorIs(t, err, atlaserrors.ErrPathTraversal, "step name %q", name)
+	}
+}
+
 // TestFileStore_SaveVersionedArtifact_MultipleVersions tests saving multiple versioned artifacts.
 func TestFileStore_SaveVersionedArtifact_MultipleVersions(t *testing.T) {
 	t.Parallel()
@@ -1075,7 +1200,11 @@ func TestFileStore_List_SkipsNonDirectories(t *testing.T) {
 	assert.Equal(t, task.ID, tasks[0].ID)
 }
 
-// TestFileStore_List_SkipsInvalidTaskIDs tests that List skips directories with invalid task ID format.
+// validTaskIDRegex matches IDs produced by GenerateTaskID (task-{uuid}).
+// Format: task-[8 hex]-[4 hex]-[4 hex]-[4 hex]-[12 hex]
+var validTaskIDRegex = regexp.MustCompile(`^task-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// TestFileStore_List_SkipsInvalidTaskIDs tests that List skips directories that hold no task.
 func TestFileStore_List_SkipsInvalidTaskIDs(t *testing.T) {
 	t.Parallel()
 	store, tmpDir := setupTestStore(t)
@@ -1085,7 +1214,7 @@ func TestFileStore_List_SkipsInvalidTaskIDs(t *testing.T) {
 	err := store.Create(context.Background(), "test-ws", task)
 	require.NoError(t, err)
 
-	// Create an invalid task directory (doesn't match task ID regex)
+	// Create a directory without a task file
 	tasksDir := filepath.Join(tmpDir, constants.WorkspacesDir, "test-ws", constants.TasksDir)
 	err = os.MkdirAll(filepath.Join(tasksDir, "invalid-task-name"), 0o750)
 	require.NoError(t, err)
@@ -1095,6 +1224,30 @@ func TestFileStore_List_SkipsInvalidTaskIDs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, tasks, 1)
 	assert.Equal(t, task.ID, tasks[0].ID)
+	assert.NoFileExists(t, filepath.Join(tasksDir, "invalid-task-name", constants.TaskFileName+".lock"), "no lock is taken on non-task directories")
+}
+
+// TestFileStore_List_CustomTaskIDs tests that tasks saved under IDs from a
+// custom generator are listed alongside generated ones.
+func TestFileStore_List_CustomTaskIDs(t *testing.T) {
+	t.Parallel()
+	store, _ := setupTestStore(t)
+
+	generated := createTestTask("task-00000000-0000-4000-8000-000000120003")
+	generated.CreatedAt = time.Now().Add(-time.Hour)
+	require.NoError(t, store.Create(context.Background(), "test-ws", generated))
+	custom := createTestTask("JIRA-1234")
+	require.NoError(t, store.Create(context.Background(), "test-ws", custom))
+
+	tasks, err := store.List(context.Background(), "test-ws")
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "JIRA-1234", tasks[0].ID)
+	assert.Equal(t, generated.ID, tasks[1].ID)
+
+	got, err := store.Get(context.Background(), "test-ws", "JIRA-1234")
+	require.NoError(t, err)
+	assert.Equal(t, "JIRA-1234", got.ID)
 }
 
 // TestFileStore_List_SkipsCorruptedTasks tests that List skips tasks with invalid JSON.
@@ -1356,6 +1509,65 @@ func TestFileStore_Update_ConcurrentAccess(t *testing.T) {
 	assert.Equal(t, task.ID, loaded.ID)
 }
 
+// TestFileStore_ListDuringUpdates tests that List never observes a torn write
+// while another goroutine repeatedly updates the same tasks.
+func TestFileStore_ListDuringUpdates(t *testing.T) {
+	t.Parallel()
+	store, _ := setupTestStore(t)
+	ctx := context.Background()
+
+	const taskCount = 3
+	const updates = 100
+
+	tasks := make([]*domain.Task, taskCount)
+	for i := range tasks {
+		tasks[i] = createTestTask(GenerateTaskID())
+		require.NoError(t, store.Create(ctx, "test-ws", tasks[i]))
+	}
+
+	firstID := tasks[0].ID
+	done := make(chan struct{})
+	updateErr := make(chan error, 1)
+	go func() {
+		defer close(done)
+		for i := 0; i < updates; i++ {
+			task := tasks[i%taskCount]
+			task.Description = fmt.Sprintf("Update %d %s", i, strings.Repeat("x", i*50))
+			if err := store.Update(ctx, "test-ws", task); err != nil {
+				updateErr <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			select {
+			case err := <-updateErr:
+				require.NoError(t, err)
+			default:
+			}
+			return
+		default:
+		}
+
+		listed, err := store.List(ctx, "test-ws")
+		require.NoError(t, err)
+		require.Len(t, listed, taskCount, "List skipped a task that failed to parse")
+		for _, task := range listed {
+			assert.NotEmpty(t, task.ID)
+			assert.Len(t, task.Steps, 1)
+			assert.True(t, task.Description == "Test task" || strings.HasPrefix(task.Description, "Update "),
+				"unexpected partial description %q", task.Description)
+		}
+
+		got, err := store.Get(ctx, "test-ws", firstID)
+		require.NoError(t, err)
+		assert.Equal(t, firstID, got.ID)
+	}
+}
+
 // TestFileStore_AcquireLock_ContextCanceled tests that acquireLock respects context cancellation.
 func TestFileStore_AcquireLock_ContextCanceled(t *testing.T) {
 	t.Parallel()
@@ -1565,3 +1777,34 @@ func TestRepoScopedFileStore_Isolation(t *testing.T) {
 	require.Len(t, tasksB, 1)
 	assert.Equal(t, taskB.ID, tasksB[0].ID)
 }
+
+func TestFileStore_ListByStatus_Priority(t *testing.T) {
+	t.Parallel()
+	store, _ := setupTestStore(t)
+
+	older := createTestTask("task-00000000-0000-4000-8000-000000000050")
+	older.Status = constants.TaskStatusInterrupted
+	older.CreatedAt = time.Now().UTC().Add(-2 * time.Hour)
+	older.Priority = 5
+
+	newer := createTestTask("task-00000000-0000-4000-8000-000000000051")
+	newer.Status = constants.TaskStatusInterrupted
+	newer.CreatedAt = time.Now().UTC()
+
+	urgentOlder := createTestTask("task-00000000-0000-4000-8000-000000000052")
+	urgentOlder.Status = constants.TaskStatusValidationFailed
+	urgentOlder.CreatedAt = time.Now().UTC().Add(-3 * time.Hour)
+	urgentOlder.Priority = 5
+
+	for _, tk := range []*domain.Task{older, newer, urgentOlder} {
+		require.NoError(t, store.Create(context.Background(), "test-ws", tk))
+	}
+
+	tasks, err := store.ListByStatus(context.Background(), "test-ws",
+		constants.TaskStatusInterrupted, constants.TaskStatusValidationFailed)
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	assert.Equal(t, older.ID, tasks[0].ID)
+	assert.Equal(t, urgentOlder.ID, tasks[1].ID)
+	assert.Equal(t, newer.ID, tasks[2].ID)
+}
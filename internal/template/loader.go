@@ -23,21 +23,43 @@ type FileTemplate struct {
 	DefaultAgent       string                          `yaml:"default_agent,omitempty" json:"default_agent,omitempty"`
 	DefaultModel       string                          `yaml:"default_model,omitempty" json:"default_model,omitempty"`
 	Steps              []FileStepDefinition            `yaml:"steps" json:"steps"`
+	BeforeAll          *FileStepDefinition             `yaml:"before_all,omitempty" json:"before_all,omitempty"`
+	AfterAll           *FileStepDefinition             `yaml:"after_all,omitempty" json:"after_all,omitempty"`
 	ValidationCommands []string                        `yaml:"validation_commands,omitempty" json:"validation_commands,omitempty"`
 	Variables          map[string]FileTemplateVariable `yaml:"variables,omitempty" json:"variables,omitempty"`
 	Verify             bool                            `yaml:"verify,omitempty" json:"verify,omitempty"`
 	VerifyModel        string                          `yaml:"verify_model,omitempty" json:"verify_model,omitempty"`
+	Requires           []string                        `yaml:"requires,omitempty" json:"requires,omitempty"`
+	Webhooks           []FileWebhook                   `yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
+}
+
+// FileWebhook represents a webhook in the YAML/JSON file.
+type FileWebhook struct {
+	URL    string   `yaml:"url" json:"url"`
+	Events []string `yaml:"events,omitempty" json:"events,omitempty"`
 }
 
 // FileStepDefinition represents a step in the YAML/JSON file.
 type FileStepDefinition struct {
-	Name        string         `yaml:"name" json:"name"`
-	Type        string         `yaml:"type" json:"type"`
-	Description string         `yaml:"description,omitempty" json:"description,omitempty"`
-	Required    bool           `yaml:"required" json:"required"`
-	Timeout     string         `yaml:"timeout,omitempty" json:"timeout,omitempty"`
-	RetryCount  int            `yaml:"retry_count,omitempty" json:"retry_count,omitempty"`
-	Config      map[string]any `yaml:"config,omitempty" json:"config,omitempty"`
+	Name            string           `yaml:"name" json:"name"`
+	Type            string           `yaml:"type" json:"type"`
+	Description     string           `yaml:"description,omitempty" json:"description,omitempty"`
+	Required        bool             `yaml:"required" json:"required"`
+	Timeout         string           `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	RetryCount      int              `yaml:"retry_count,omitempty" json:"retry_count,omitempty"`
+	Retry           *FileRetryPolicy `yaml:"retry,omitempty" json:"retry,omitempty"`
+	OnFailureGoto   string           `yaml:"on_failure_goto,omitempty" json:"on_failure_goto,omitempty"`
+	OnSuccessGoto   string           `yaml:"on_success_goto,omitempty" json:"on_success_goto,omitempty"`
+	ContinueOnError bool             `yaml:"continue_on_error,omitempty" json:"continue_on_error,omitempty"`
+	ParallelGroup   string           `yaml:"parallel_group,omitempty" json:"parallel_group,omitempty"`
+	Checkpoint      *bool            `yaml:"checkpoint,omitempty" json:"checkpoint,omitempty"`
+	Config          map[string]any   `yaml:"config,omitempty" json:"config,omitempty"`
+}
+
+// FileRetryPolicy represents a step retry policy in the YAML/JSON file.
+type FileRetryPolicy struct {
+	Max   int    `yaml:"max" json:"max"`
+	Delay string `yaml:"delay,omitempty" json:"delay,omitempty"`
 }
 
 // FileTemplateVariable represents a variable in the YAML/JSON file.
@@ -161,6 +183,11 @@ func toTemplate(f *FileTemplate) (*domain.Template, error) {
 		ValidationCommands: f.ValidationCommands,
 		Verify:             f.Verify,
 		VerifyModel:        f.VerifyModel,
+		Requires:           f.Requires,
+	}
+
+	for _, fw := range f.Webhooks {
+		t.Webhooks = append(t.Webhooks, domain.Webhook{URL: fw.URL, Events: fw.Events})
 	}
 
 	// Convert steps
@@ -173,6 +200,21 @@ func toTemplate(f *FileTemplate) (*domain.Template, error) {
 		t.Steps[i] = step
 	}
 
+	if f.BeforeAll != nil {
+		step, err := toStepDefinition(f.BeforeAll)
+		if err != nil {
+			return nil, fmt.Errorf("before_all (%s): %w", f.BeforeAll.Name, err)
+		}
+		t.BeforeAll = &step
+	}
+	if f.AfterAll != nil {
+		step, err := toStepDefinition(f.AfterAll)
+		if err != nil {
+			return nil, fmt.Errorf("after_all (%s): %w", f.AfterAll.Name, err)
+		}
+		t.AfterAll = &step
+	}
+
 	// Convert variables
 	if f.Variables != nil {
 		t.Variables = make(map[string]domain.TemplateVariable, len(f.Variables))
@@ -191,11 +233,16 @@ func toTemplate(f *FileTemplate) (*domain.Template, error) {
 // toStepDefinition converts a FileStepDefinition to a domain.StepDefinition.
 func toStepDefinition(f *FileStepDefinition) (domain.StepDefinition, error) {
 	step := domain.StepDefinition{
-		Name:        f.Name,
-		Description: f.Description,
-		Required:    f.Required,
-		RetryCount:  f.RetryCount,
-		Config:      f.Config,
+		Name:            f.Name,
+		Description:     f.Description,
+		Required:        f.Required,
+		RetryCount:      f.RetryCount,
+		OnFailureGoto:   f.OnFailureGoto,
+		OnSuccessGoto:   f.OnSuccessGoto,
+		ContinueOnError: f.ContinueOnError,
+		ParallelGroup:   f.ParallelGroup,
+		Checkpoint:      f.Checkpoint,
+		Config:          f.Config,
 	}
 
 	// Parse step type (case-insensitive)
@@ -214,5 +261,17 @@ func toStepDefinition(f *FileStepDefinition) (domain.StepDefinition, error) {
 		step.Timeout = timeout
 	}
 
+	// Parse retry policy if provided
+	if f.Retry != nil {
+		step.Retry = &domain.RetryPolicy{Max: f.Retry.Max}
+		if f.Retry.Delay != "" {
+			delay, err := time.ParseDuration(f.Retry.Delay)
+			if err != nil {
+				return step, fmt.Errorf("invalid retry delay %q: %w", f.Retry.Delay, err)
+			}
+			step.Retry.Delay = delay
+		}
+	}
+
 	return step, nil
 }
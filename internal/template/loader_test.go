@@ -20,6 +20,8 @@ branch_prefix: test
 default_model: sonnet
 verify: true
 verify_model: opus
+requires:
+  - gh
 
 steps:
   - name: implement
@@ -35,6 +37,8 @@ steps:
     type: validation
     required: true
     timeout: 10m
+    on_failure_goto: implement
+    continue_on_error: true
 
 validation_commands:
   - make lint
@@ -93,6 +97,10 @@ func TestLoader_LoadFromFile_YAML_Success(t *testing.T) {
 	assert.Equal(t, "sonnet", tmpl.DefaultModel)
 	assert.True(t, tmpl.Verify)
 	assert.Equal(t, "opus", tmpl.VerifyModel)
+	assert.Equal(t, []string{"gh"}, tmpl.Requires)
+	assert.Equal(t, "implement", tmpl.Steps[1].OnFailureGoto)
+	assert.True(t, tmpl.Steps[1].ContinueOnError)
+	assert.False(t, tmpl.Steps[0].ContinueOnError)
 
 	// Verify steps
 	require.Len(t, tmpl.Steps, 2)
@@ -261,6 +269,211 @@ steps:
 	assert.Contains(t, err.Error(), "retry_count cannot be negative")
 }
 
+func TestLoader_LoadFromFile_RetryPolicy(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "retry.yaml")
+	content := `
+name: retry-template
+steps:
+  - name: step1
+    type: ai
+    required: true
+    retry:
+      max: 3
+      delay: 2s
+  - name: step2
+    type: validation
+    required: true
+`
+	require.NoError(t, os.WriteFile(tmpFile, []byte(content), 0o600))
+
+	loader := NewLoader(tmpDir)
+	tmpl, err := loader.LoadFromFile("retry.yaml")
+
+	require.NoError(t, err)
+	require.Len(t, tmpl.Steps, 2)
+	assert.Equal(t, &domain.RetryPolicy{Max: 3, Delay: 2 * time.Second}, tmpl.Steps[0].Retry)
+	assert.Nil(t, tmpl.Steps[1].Retry)
+}
+
+func TestLoader_LoadFromFile_Webhooks(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "webhooks.yaml")
+	content := `
+name: webhook-template
+steps:
+  - name: step1
+    type: ai
+    required: true
+webhooks:
+  - url: https://hooks.example.com/atlas
+    events: [task.failed]
+  - url: http://ci.internal/notify
+`
+	require.NoError(t, os.WriteFile(tmpFile, []byte(content), 0o600))
+
+	loader := NewLoader(tmpDir)
+	tmpl, err := loader.LoadFromFile("webhooks.yaml")
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Webhook{
+		{URL: "https://hooks.example.com/atlas", Events: []string{domain.WebhookEventTaskFailed}},
+		{URL: "http://ci.internal/notify"},
+	}, tmpl.Webhooks)
+}
+
+func TestLoader_LoadFromFile_BeforeAfterAll(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "around.yaml")
+	content := `
+name: around-template
+before_all:
+  name: start-services
+  type: validation
+  timeout: 2m
+  config:
+    commands: ["docker compose up -d"]
+steps:
+  - name: step1
+    type: ai
+    required: true
+after_all:
+  name: stop-services
+  type: validation
+  config:
+    commands: ["docker compose down"]
+`
+	require.NoError(t, os.WriteFile(tmpFile, []byte(content), 0o600))
+
+	loader := NewLoader(tmpDir)
+	tmpl, err := loader.LoadFromFile("around.yaml")
+
+	require.NoError(t, err)
+	require.NotNil(t, tmpl.BeforeAll)
+	assert.Equal(t, "start-services", tmpl.BeforeAll.Name)
+	assert.Equal(t, domain.StepTypeValidation, tmpl.BeforeAll.Type)
+	assert.Equal(t, 2*time.Minute, tmpl.BeforeAll.Timeout)
+	require.NotNil(t, tmpl.AfterAll)
+	assert.Equal(t, "stop-services", tmpl.AfterAll.Name)
+	assert.Len(t, tmpl.Steps, 1)
+}
+
+func TestLoader_LoadFromFile_InvalidRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		retry    string
+		errMatch string
+	}{
+		{"unparseable delay", "max: 3\n      delay: soon", "invalid retry delay"},
+		{"negative delay", "max: 3\n      delay: -1s", "retry.delay cannot be negative"},
+		{"negative max", "max: -1", "retry.max must be between 0 and 10"},
+		{"max too large", "max: 11", "retry.max must be between 0 and 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			tmpFile := filepath.Join(tmpDir, "badretry.yaml")
+			content := `
+name: bad-retry-template
+steps:
+  - name: step1
+    type: ai
+    required: true
+    retry:
+      ` + tt.retry + `
+`
+			require.NoError(t, os.WriteFile(tmpFile, []byte(content), 0o600))
+
+			loader := NewLoader(tmpDir)
+			_, err := loader.LoadFromFile("badretry.yaml")
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMatch)
+		})
+	}
+}
+
+func TestLoader_LoadFromFile_ParallelGroup(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `
+name: parallel-template
+steps:
+  - name: lint
+    type: validation
+    required: true
+    parallel_group: checks
+  - name: test
+    type: validation
+    required: true
+    parallel_group: checks
+  - name: commit
+    type: git
+    required: true
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "parallel.yaml"), []byte(content), 0o600))
+
+	tmpl, err := NewLoader(tmpDir).LoadFromFile("parallel.yaml")
+
+	require.NoError(t, err)
+	assert.Equal(t, "checks", tmpl.Steps[0].ParallelGroup)
+	assert.Equal(t, "checks", tmpl.Steps[1].ParallelGroup)
+	assert.Empty(t, tmpl.Steps[2].ParallelGroup)
+}
+
+func TestLoader_LoadFromFile_Checkpoint(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `
+name: checkpoint-template
+steps:
+  - name: implement
+    type: ai
+    required: true
+  - name: commit
+    type: git
+    required: true
+    checkpoint: false
+  - name: push
+    type: git
+    required: true
+    checkpoint: true
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "checkpoint.yaml"), []byte(content), 0o600))
+
+	tmpl, err := NewLoader(tmpDir).LoadFromFile("checkpoint.yaml")
+
+	require.NoError(t, err)
+	assert.Nil(t, tmpl.Steps[0].Checkpoint)
+	assert.True(t, tmpl.Steps[0].IsCheckpoint())
+	assert.False(t, tmpl.Steps[1].IsCheckpoint())
+	assert.True(t, tmpl.Steps[2].IsCheckpoint())
+}
+
+func TestLoader_LoadFromFile_ParallelGroupNotContiguous(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `
+name: parallel-template
+steps:
+  - name: lint
+    type: validation
+    required: true
+    parallel_group: checks
+  - name: implement
+    type: ai
+    required: true
+  - name: test
+    type: validation
+    required: true
+    parallel_group: checks
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "parallel.yaml"), []byte(content), 0o600))
+
+	_, err := NewLoader(tmpDir).LoadFromFile("parallel.yaml")
+
+	require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+	assert.Contains(t, err.Error(), `parallel_group "checks" steps must be contiguous`)
+}
+
 func TestLoader_LoadFromFile_RelativePath(t *testing.T) {
 	tmpDir := t.TempDir()
 	subDir := filepath.Join(tmpDir, "templates")
@@ -187,6 +187,11 @@ func (e *AIExecutor) buildRequest(task *domain.Task, step *domain.StepDefinition
 		e.injectPreviousValidationErrors(req, task)
 	}
 
+	// Inside a loop without fresh_context, continue from the previous iteration
+	if prev, ok := task.Metadata[loopPreviousOutputKey].(string); ok && prev != "" {
+		req.Prompt = fmt.Sprintf("%s\n\n--- Previous Iteration Output ---\n%s", req.Prompt, prev)
+	}
+
 	return req
 }
 
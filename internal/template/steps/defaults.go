@@ -123,6 +123,11 @@ type ExecutorDeps struct {
 	// ValidationLiveOutput is an optional writer for streaming validation command output.
 	// If nil, live output streaming is not enabled.
 	ValidationLiveOutput io.Writer
+
+	// LoopStore is the task store used by loop steps for checkpoints, the
+	// "store" scratchpad backend, and validation artifacts.
+	// If nil, loop state is not checkpointed.
+	LoopStore LoopArtifactStore
 }
 
 // NewDefaultRegistry creates a registry with all built-in executors.
@@ -137,6 +142,7 @@ func NewDefaultRegistry(deps ExecutorDeps) *ExecutorRegistry {
 	registerSDDExecutor(r, deps)
 	registerCIExecutor(r, deps)
 	registerVerifyExecutor(r, deps)
+	registerLoopExecutor(r, deps)
 
 	return r
 }
@@ -241,6 +247,22 @@ func registerVerifyExecutor(r *ExecutorRegistry, deps ExecutorDeps) {
 	r.Register(NewVerifyExecutor(deps.AIRunner, garbageDetector, deps.ArtifactSaver, deps.Logger, verifyOpts...))
 }
 
+func registerLoopExecutor(r *ExecutorRegistry, deps ExecutorDeps) {
+	loopOpts := []LoopExecutorOption{WithLoopLogger(deps.Logger), WithLoopWorkDir(deps.WorkDir)}
+	var stateStore LoopStateStore
+	if deps.LoopStore != nil {
+		stateStore = NewStoreLoopStateStore(deps.LoopStore)
+		loopOpts = append(loopOpts,
+			WithLoopScratchpadStore(deps.LoopStore),
+			WithLoopValidationArtifacts(NewStoreValidationArtifactReader(deps.LoopStore)),
+		)
+	}
+	if deps.GitRunner != nil {
+		loopOpts = append(loopOpts, WithLoopCommitter(NewGitIterationCommitter(deps.GitRunner)))
+	}
+	r.Register(NewLoopExecutor(NewRegistryStepRunner(r), stateStore, loopOpts...))
+}
+
 // NewMinimalRegistry creates a registry with only non-AI executors.
 // This is useful for testing or when AI is not available.
 func NewMinimalRegistry(workDir string) *ExecutorRegistry {
@@ -33,7 +33,7 @@ func TestNewDefaultRegistry(t *testing.T) {
 
 	require.NotNil(t, registry)
 
-	// All 7 step types should be registered
+	// All 8 step types should be registered
 	assert.True(t, registry.Has(domain.StepTypeAI))
 	assert.True(t, registry.Has(domain.StepTypeValidation))
 	assert.True(t, registry.Has(domain.StepTypeGit))
@@ -41,9 +41,10 @@ func TestNewDefaultRegistry(t *testing.T) {
 	assert.True(t, registry.Has(domain.StepTypeSDD))
 	assert.True(t, registry.Has(domain.StepTypeCI))
 	assert.True(t, registry.Has(domain.StepTypeVerify))
+	assert.True(t, registry.Has(domain.StepTypeLoop))
 
 	types := registry.Types()
-	assert.Len(t, types, 7)
+	assert.Len(t, types, 8)
 }
 
 func TestNewDefaultRegistry_NilAIRunner(t *testing.T) {
@@ -66,9 +67,10 @@ func TestNewDefaultRegistry_NilAIRunner(t *testing.T) {
 	assert.True(t, registry.Has(domain.StepTypeGit))
 	assert.True(t, registry.Has(domain.StepTypeHuman))
 	assert.True(t, registry.Has(domain.StepTypeCI))
+	assert.True(t, registry.Has(domain.StepTypeLoop))
 
 	types := registry.Types()
-	assert.Len(t, types, 4)
+	assert.Len(t, types, 5)
 }
 
 func TestNewDefaultRegistry_ExecutorTypes(t *testing.T) {
@@ -92,6 +94,7 @@ func TestNewDefaultRegistry_ExecutorTypes(t *testing.T) {
 		{domain.StepTypeSDD, domain.StepTypeSDD},
 		{domain.StepTypeCI, domain.StepTypeCI},
 		{domain.StepTypeVerify, domain.StepTypeVerify},
+		{domain.StepTypeLoop, domain.StepTypeLoop},
 	}
 
 	for _, tt := range tests {
@@ -277,6 +277,10 @@ func (p *DryRunPresenter) planLoop(plan *DryRunPlan, _ *domain.Task, step *domai
 	}
 
 	plan.Config["max_iterations"] = maxIterations
+	autoMaxIterations := step.Config["max_iterations"] == AutoMaxIterations
+	if autoMaxIterations {
+		plan.Config["max_iterations"] = AutoMaxIterations
+	}
 	plan.Config["until_signal"] = untilSignal
 	plan.Config["until"] = until
 
@@ -287,6 +291,13 @@ func (p *DryRunPresenter) planLoop(plan *DryRunPlan, _ *domain.Task, step *domai
 	if maxIterations > 0 {
 		plan.WouldDo = append(plan.WouldDo, fmt.Sprintf("Run up to %d iterations", maxIterations))
 	}
+	if autoMaxIterations {
+		plan.WouldDo = append(plan.WouldDo, fmt.Sprintf("Run up to the iteration cap suggested by before_steps (default %d, at most %d)",
+			DefaultAutoMaxIterations, MaxAutoIterations))
+	}
+	if before, ok := step.Config["before_steps"].([]any); ok && len(before) > 0 {
+		plan.WouldDo = append(plan.WouldDo, fmt.Sprintf("Before steps run once: %d", len(before)))
+	}
 	if untilSignal {
 		plan.WouldDo = append(plan.WouldDo, "Exit when AI signals completion")
 	}
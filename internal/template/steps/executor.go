@@ -27,6 +27,11 @@ import (
 //   - Log execution start/end with step context
 //   - Return StepResult with appropriate status, output, and timing
 //   - Handle context cancellation gracefully
+//
+// Steps in a parallel group run concurrently, each with its own copy of the
+// task. Only top-level task.Metadata changes are merged back into the task;
+// report anything else through the returned StepResult rather than by
+// mutating the task.
 type StepExecutor interface {
 	// Execute runs the step and returns its result.
 	// The context controls timeout and cancellation.
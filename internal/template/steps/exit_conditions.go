@@ -2,7 +2,9 @@
 package steps
 
 import (
+	"encoding/json"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -17,7 +19,7 @@ type ExitEvaluator interface {
 	// Returns an ExitDecision indicating whether to exit and why.
 	Evaluate(result *domain.IterationResult, output string) ExitDecision
 
-	// ParseExitSignal extracts {"exit": true} from AI output.
+	// ParseExitSignal reports whether AI output contains an exit signal.
 	ParseExitSignal(output string) (bool, error)
 
 	// CheckConditions verifies all configured exit conditions are met.
@@ -79,12 +81,66 @@ func (e *DefaultExitEvaluator) Evaluate(_ *domain.IterationResult, output string
 	return ExitDecision{ShouldExit: true, Reason: "all conditions met with exit signal"}
 }
 
+// ExitSentinel is a bare token an AI can print on its own line to signal
+// loop exit, as an alternative to the {"exit": true} JSON object.
+const ExitSentinel = "EXIT_LOOP"
+
 // exitSignalPattern matches {"exit": true} with flexible whitespace.
 var exitSignalPattern = regexp.MustCompile(`\{\s*"exit"\s*:\s*true\s*\}`)
 
-// ParseExitSignal extracts {"exit": true} from AI output.
+// ParseExitSignal reports whether AI output asks the loop to exit. Accepted forms:
+//
+//   - a {"exit": true} object anywhere in the output, including inside prose
+//   - a JSON object with "exit": true among other fields, such as
+//     {"exit": true, "reason": "all tests pass"}, bare or in a fenced json block
+//   - the ExitSentinel token on a line of its own, optionally wrapped in
+//     backticks or bold markers
+//
+// Malformed JSON is not an error; it simply does not count as a signal.
 func (e *DefaultExitEvaluator) ParseExitSignal(output string) (bool, error) {
-	return exitSignalPattern.MatchString(output), nil
+	if exitSignalPattern.MatchString(output) {
+		return true, nil
+	}
+	if hasExitSentinelLine(output) {
+		return true, nil
+	}
+	return hasExitObject(output), nil
+}
+
+// hasExitSentinelLine reports whether a line consists of only ExitSentinel.
+func hasExitSentinelLine(output string) bool {
+	if !strings.Contains(output, ExitSentinel) {
+		return false
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Trim(strings.TrimSpace(line), "`*") == ExitSentinel {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExitObject reports whether any JSON object embedded in output has an
+// "exit" field set to true. Each '{' is tried as the start of an object, so
+// objects in fenced blocks and prose are both found.
+func hasExitObject(output string) bool {
+	if !strings.Contains(output, `"exit"`) {
+		return false
+	}
+	for i := strings.IndexByte(output, '{'); i >= 0; {
+		var obj map[string]any
+		if err := json.NewDecoder(strings.NewReader(output[i:])).Decode(&obj); err == nil {
+			if exit, ok := obj["exit"].(bool); ok && exit {
+				return true
+			}
+		}
+		next := strings.IndexByte(output[i+1:], '{')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return false
 }
 
 // CheckConditions verifies all configured exit conditions are present in the output.
@@ -137,6 +193,22 @@ func checkNoRecentChanges(task *domain.Task) bool {
 	return len(lastResult.FilesChanged) == 0
 }
 
+// IsBuiltinCondition reports whether name is a known built-in condition.
+func IsBuiltinCondition(name string) bool {
+	_, exists := builtinConditions()[name]
+	return exists
+}
+
+// BuiltinConditionNames returns the sorted names of the built-in conditions.
+func BuiltinConditionNames() []string {
+	names := make([]string, 0, len(builtinConditions()))
+	for name := range builtinConditions() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // EvaluateBuiltinCondition evaluates a named condition from the built-in set.
 func EvaluateBuiltinCondition(conditionName string, task *domain.Task) bool {
 	fn, exists := builtinConditions()[conditionName]
@@ -57,6 +57,46 @@ func TestExitEvaluator_ParseExitSignal(t *testing.T) {
 			output:   "line1\nline2\n{\"exit\": true}\nline3",
 			expected: true,
 		},
+		{
+			name:     "prose-wrapped object with extra fields",
+			output:   `All tests pass now, so I'm done: {"exit": true, "reason": "tests green"}. Thanks!`,
+			expected: true,
+		},
+		{
+			name:     "fenced json block",
+			output:   "Work is complete.\n\n```json\n{\n  \"reason\": \"lint clean\",\n  \"exit\": true\n}\n```\n",
+			expected: true,
+		},
+		{
+			name:     "fenced json block with exit false",
+			output:   "```json\n{\n  \"exit\": false,\n  \"reason\": \"more to do\"\n}\n```",
+			expected: false,
+		},
+		{
+			name:     "bare sentinel token",
+			output:   "Fixed the last failing test.\nEXIT_LOOP\n",
+			expected: true,
+		},
+		{
+			name:     "sentinel token in backticks",
+			output:   "Done.\n  `EXIT_LOOP`",
+			expected: true,
+		},
+		{
+			name:     "sentinel mentioned in prose",
+			output:   "I will print EXIT_LOOP once the tests pass.",
+			expected: false,
+		},
+		{
+			name:     "exit as string is not a signal",
+			output:   `{"exit": "true"}`,
+			expected: false,
+		},
+		{
+			name:     "malformed json",
+			output:   "```json\n{\"exit\": tru\n```\n{\"exit\":",
+			expected: false,
+		},
 	}
 
 	e := NewExitEvaluator(nil, logger)
@@ -541,13 +541,18 @@ func (e *GitExecutor) validatePRDependencies() error {
 }
 
 // getBranchesForPR extracts the head and base branch names from configuration.
+// The base branch is taken from the step's base_branch config, then the task's
+// recorded BaseBranch, then the executor default, then "main".
 func (e *GitExecutor) getBranchesForPR(step *domain.StepDefinition, task *domain.Task) (string, string, error) {
 	headBranch := getBranchFromConfig(step.Config, task)
 	if headBranch == "" {
 		return "", "", fmt.Errorf("head branch not configured: %w", atlaserrors.ErrEmptyValue)
 	}
 
-	baseBranch := e.baseBranch
+	baseBranch := task.BaseBranch
+	if baseBranch == "" {
+		baseBranch = e.baseBranch
+	}
 	if baseBranch == "" {
 		baseBranch = "main"
 	}
@@ -919,6 +919,54 @@ func TestGitExecutor_ExecuteCreatePR_Success(t *testing.T) {
 	assert.Contains(t, result.Output, "https://github.com/test/repo/pull/42")
 }
 
+func TestGitExecutor_ExecuteCreatePR_UsesTaskBaseBranch(t *testing.T) {
+	ctx := context.Background()
+	prDescGen := &mockPRDescriptionGenerator{
+		generateFunc: func(_ context.Context, opts git.PRDescOptions) (*git.PRDescription, error) {
+			assert.Equal(t, "develop", opts.BaseBranch)
+			return &git.PRDescription{
+				Title: "fix(test): fix bug",
+				Body:  "## Summary\nFix\n\n## Changes\nFix\n\n## Test Plan\nTest",
+			}, nil
+		},
+	}
+
+	var prBase string
+	hubRunner := &mockHubRunner{
+		createPRFunc: func(_ context.Context, opts git.PRCreateOptions) (*git.PRResult, error) {
+			prBase = opts.BaseBranch
+			return &git.PRResult{Number: 42, URL: "https://github.com/test/repo/pull/42", State: "open"}, nil
+		},
+	}
+
+	executor := NewGitExecutor("/tmp/work",
+		WithHubRunner(hubRunner),
+		WithPRDescriptionGenerator(prDescGen),
+		WithBaseBranch("main"),
+	)
+
+	task := &domain.Task{
+		ID:          "task-123",
+		TemplateID:  "bugfix",
+		Description: "Fix a bug",
+		BaseBranch:  "develop",
+	}
+	step := &domain.StepDefinition{
+		Name: "git",
+		Type: domain.StepTypeGit,
+		Config: map[string]any{
+			"operation": "create_pr",
+			"branch":    "fix/test-branch",
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, "success", result.Status)
+	assert.Equal(t, "develop", prBase, "recorded task base branch takes precedence over the executor default")
+}
+
 func TestGitExecutor_ExecuteCreatePR_SkipsWhenPRExists(t *testing.T) {
 	ctx := context.Background()
 
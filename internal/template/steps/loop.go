@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -14,6 +16,7 @@ import (
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/ignore"
 )
 
 // InnerStepRunner executes inner steps within a loop iteration.
@@ -33,11 +36,18 @@ type LoopStateStore interface {
 // It supports count-based, condition-based, and signal-based termination
 // with circuit breakers for safety.
 type LoopExecutor struct {
-	innerRunner InnerStepRunner  // Mockable: executes inner steps
-	stateStore  LoopStateStore   // Mockable: state persistence
-	scratchpad  ScratchpadWriter // Mockable: cross-iteration memory
-	exitEval    ExitEvaluator    // Mockable: exit condition checking
-	artifactDir string           // Directory for scratchpad files
+	innerRunner InnerStepRunner          // Mockable: executes inner steps
+	stateStore  LoopStateStore           // Mockable: state persistence
+	scratchpad  ScratchpadWriter         // Mockable: cross-iteration memory
+	exitEval    ExitEvaluator            // Mockable: exit condition checking
+	artifactDir string                   // Directory for scratchpad files
+	store       ScratchpadStore          // Task store for the "store" scratchpad backend
+	workDir     string                   // Worktree directory for the summary file
+	committer   IterationCommitter       // Mockable: per-iteration git commits
+	valReader   ValidationArtifactReader // Mockable: validation artifacts for until-conditions
+	rand        *rand.Rand               // Injectable: iteration jitter source
+	randMu      sync.Mutex               // Guards rand, which is not safe for concurrent use
+	forceFresh  bool                     // Ignore checkpointed state and start over
 	logger      zerolog.Logger
 }
 
@@ -50,6 +60,7 @@ func NewLoopExecutor(
 	e := &LoopExecutor{
 		innerRunner: innerRunner,
 		stateStore:  stateStore,
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec // Non-cryptographic use for jitter
 		logger:      zerolog.Nop(),
 	}
 	for _, opt := range opts {
@@ -81,6 +92,28 @@ func WithLoopArtifactDir(dir string) LoopExecutorOption {
 	return func(e *LoopExecutor) { e.artifactDir = dir }
 }
 
+// WithLoopScratchpadStore sets the task store used by the "store" scratchpad backend.
+func WithLoopScratchpadStore(store ScratchpadStore) LoopExecutorOption {
+	return func(e *LoopExecutor) { e.store = store }
+}
+
+// WithLoopWorkDir sets the worktree directory used to resolve the summary file.
+func WithLoopWorkDir(dir string) LoopExecutorOption {
+	return func(e *LoopExecutor) { e.workDir = dir }
+}
+
+// WithLoopForceFresh makes the loop ignore any checkpointed state and start
+// from iteration 1, e.g. after its inner steps changed. The saved state is
+// replaced by the first checkpoint of the new run.
+func WithLoopForceFresh(force bool) LoopExecutorOption {
+	return func(e *LoopExecutor) { e.forceFresh = force }
+}
+
+// WithLoopCommitter sets the committer used by commit_each_iteration.
+func WithLoopCommitter(c IterationCommitter) LoopExecutorOption {
+	return func(e *LoopExecutor) { e.committer = c }
+}
+
 // Execute runs the loop step, iterating until an exit condition is met.
 //
 //nolint:gocognit // Loop orchestration inherently requires handling multiple exit conditions and states.
@@ -98,6 +131,10 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 		logger = &e.logger // Fallback to injected logger
 	}
 
+	// Backends created for this run must not leak into the next loop step
+	scratchpad, exitEval := e.scratchpad, e.exitEval
+	defer func() { e.scratchpad, e.exitEval = scratchpad, exitEval }()
+
 	startTime := time.Now()
 	cfg, err := e.parseLoopConfig(step.Config)
 	if err != nil {
@@ -108,6 +145,7 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 		Str("task_id", task.ID).
 		Str("step_name", step.Name).
 		Int("max_iterations", cfg.MaxIterations).
+		Bool("auto_max_iterations", cfg.AutoMaxIterations).
 		Bool("until_signal", cfg.UntilSignal).
 		Str("until", cfg.Until).
 		Msg("starting loop step")
@@ -121,7 +159,7 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 	}
 
 	// Set up scratchpad if configured
-	if err := e.setupScratchpad(task, step, cfg, state); err != nil {
+	if err := e.setupScratchpad(ctx, task, step, cfg, state); err != nil {
 		logger.Warn().Err(err).Msg("failed to set up scratchpad, continuing without it")
 		// Store error in metadata so caller can detect scratchpad failure
 		if task.Metadata == nil {
@@ -130,8 +168,50 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 		task.Metadata["scratchpad_setup_error"] = err.Error()
 	}
 
-	// Main loop
-	for !e.shouldExit(ctx, state, cfg, task) {
+	// Warm up and size the loop before the first iteration
+	if err := e.prepareIterations(ctx, task, cfg, state, logger); err != nil {
+		return nil, err
+	}
+
+	// Changes to files matching .atlasignore don't count as progress
+	ignored := e.loadIgnoreMatcher(logger)
+
+	// Carried iteration output must not leak into steps after the loop
+	defer clearIterationContext(task)
+
+	// Main loop. Each iteration's exit decision is logged once, either by
+	// the exit check at the top of the next pass or where the body stops.
+	ranIteration := false
+	decided := state.CurrentIteration
+	filesChanged := 0
+	decide := func(exit bool) {
+		if ranIteration && decided < state.CurrentIteration {
+			logIterationDecision(logger, state, filesChanged, exit)
+			decided = state.CurrentIteration
+		}
+	}
+	for {
+		exit := e.shouldExit(ctx, state, cfg, task)
+		decide(exit)
+		if exit {
+			break
+		}
+
+		if ranIteration {
+			delay := e.nextIterationDelay(cfg, state)
+			if delay > 0 {
+				logger.Debug().
+					Dur("delay", delay).
+					Int("consecutive_errors", state.ConsecutiveErrors).
+					Msg("waiting before next iteration")
+			}
+			if err := waitIterationDelay(ctx, delay); err != nil {
+				state.ExitReason = "context_canceled"
+				break
+			}
+		}
+		ranIteration = true
+
 		state.CurrentIteration++
 		state.CurrentInnerStep = 0
 
@@ -141,10 +221,16 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 			Msg("starting iteration")
 
 		// Execute inner steps
+		resetIterationContext(task, cfg)
 		iterResult, err := e.executeIteration(ctx, task, cfg.Steps, state)
+		carryIterationContext(task, cfg, iterResult)
+		filesChanged = 0
 		if err != nil {
 			state.ConsecutiveErrors++
+			state.NoOpCount = 0
 			iterResult.Error = err.Error()
+			recordIterationError(state, cfg, iterResult.Error)
+			resetLoopCounters(state, iterResult, logger)
 
 			logger.Warn().
 				Err(err).
@@ -152,6 +238,14 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 				Int("consecutive_errors", state.ConsecutiveErrors).
 				Msg("iteration failed")
 
+			if repeatedErrorTripped(state, cfg) {
+				logger.Warn().
+					Int("iteration", state.CurrentIteration).
+					Int("repeated", cfg.BreakOnRepeatedError).
+					Msg("same error repeated, stopping loop")
+				state.ExitReason = "repeated_error"
+				break
+			}
 			if e.circuitBreakerTripped(state, cfg) {
 				state.ExitReason = "circuit_breaker_errors"
 				break
@@ -159,18 +253,23 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 			// Save state and continue to next iteration
 			if checkpointErr := e.saveCheckpoint(ctx, task, state); checkpointErr != nil {
 				state.ExitReason = "checkpoint_failure"
+				decide(true)
 				return nil, checkpointErr
 			}
 			continue
 		}
 
 		state.ConsecutiveErrors = 0
+		state.RecentErrorFingerprints = nil
+		iterResult.FilesChanged = ignored.Filter(iterResult.FilesChanged)
+		filesChanged = len(iterResult.FilesChanged)
 		iterResult.Duration = time.Since(iterStart)
 		iterResult.CompletedAt = time.Now()
+		iterResult.NoOp = iterationSignaledNoOp(iterResult, cfg.NoOpSignal)
 		state.CompletedIterations = append(state.CompletedIterations, *iterResult)
 
 		// Update scratchpad
-		e.updateScratchpad(iterResult)
+		e.updateScratchpad(ctx, iterResult)
 
 		// Check stagnation
 		if len(iterResult.FilesChanged) == 0 {
@@ -179,11 +278,35 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 			state.StagnationCount = 0
 		}
 
+		resetLoopCounters(state, iterResult, logger)
+
+		if iterResult.NoOp {
+			state.NoOpCount++
+		} else {
+			state.NoOpCount = 0
+		}
+
+		if iterResult.Aborted {
+			state.ExitReason = "aborted"
+			break
+		}
+
+		e.commitIteration(ctx, task, cfg, iterResult, logger)
+
 		if e.stagnationTripped(state, cfg) {
 			state.ExitReason = "circuit_breaker_stagnation"
 			break
 		}
 
+		if cfg.MaxNoOps > 0 && state.NoOpCount >= cfg.MaxNoOps {
+			state.ExitReason = "no_ops_reached"
+			logger.Info().
+				Int("iteration", state.CurrentIteration).
+				Int("no_op_count", state.NoOpCount).
+				Msg("no-op limit reached")
+			break
+		}
+
 		// Check exit signal
 		if cfg.UntilSignal && iterResult.ExitSignal {
 			state.ExitReason = "exit_signal"
@@ -196,15 +319,29 @@ func (e *LoopExecutor) Execute(ctx context.Context, task *domain.Task, step *dom
 		// Checkpoint after each iteration
 		if checkpointErr := e.saveCheckpoint(ctx, task, state); checkpointErr != nil {
 			state.ExitReason = "checkpoint_failure"
+			decide(true)
 			return nil, checkpointErr
 		}
 	}
 
+	// Iterations the body stopped early have not logged their decision yet
+	decide(true)
+
 	// Set exit reason if not already set
 	if state.ExitReason == "" && state.CurrentIteration >= cfg.MaxIterations && cfg.MaxIterations > 0 {
 		state.ExitReason = "max_iterations_reached"
 	}
 
+	// Write human-readable summary if configured
+	if err := e.writeSummaryFile(step, cfg, state, startTime); err != nil {
+		logger.Warn().Err(err).Msg("failed to write loop summary file, continuing without it")
+		// Store error in metadata so caller can detect summary failure
+		if task.Metadata == nil {
+			task.Metadata = make(map[string]any)
+		}
+		task.Metadata["summary_file_error"] = err.Error()
+	}
+
 	return e.buildResult(task, step, startTime, state), nil
 }
 
@@ -215,20 +352,35 @@ func (e *LoopExecutor) Type() domain.StepType {
 
 // parseLoopConfig extracts LoopConfig from step config map.
 // Returns an error if the configuration contains invalid values.
+// A nil config is validated like an empty one.
 func (e *LoopExecutor) parseLoopConfig(config map[string]any) (*domain.LoopConfig, error) {
-	if config == nil {
-		return &domain.LoopConfig{}, nil
+	maxIterations, autoMaxIterations, err := parseMaxIterations(config)
+	if err != nil {
+		return nil, err
 	}
 
 	cfg := &domain.LoopConfig{
-		MaxIterations:  getIntFromConfig(config, "max_iterations"),
-		Until:          getStringFromConfig(config, "until"),
-		UntilSignal:    getBoolFromConfig(config, "until_signal"),
-		FreshContext:   getBoolFromConfig(config, "fresh_context"),
-		ScratchpadFile: getStringFromConfig(config, "scratchpad_file"),
-		ExitConditions: getStringSliceFromConfig(config, "exit_conditions"),
-		CircuitBreaker: e.parseCircuitBreaker(config),
-		Steps:          e.parseInnerSteps(config),
+		MaxIterations:         maxIterations,
+		AutoMaxIterations:     autoMaxIterations,
+		Until:                 getStringFromConfig(config, "until"),
+		UntilSignal:           getBoolFromConfig(config, "until_signal"),
+		FreshContext:          getBoolFromConfig(config, "fresh_context"),
+		ScratchpadFile:        getStringFromConfig(config, "scratchpad_file"),
+		ScratchpadBackend:     getStringFromConfig(config, "scratchpad_backend"),
+		SummaryFile:           getStringFromConfig(config, "summary_file"),
+		CommitEachIteration:   getBoolFromConfig(config, "commit_each_iteration"),
+		CommitMessageTemplate: getStringFromConfig(config, "commit_message_template"),
+		IterationDelay:        extractDuration(config, "iteration_delay", 0),
+		IterationJitter:       getFloatFromConfig(config, "iteration_jitter"),
+		NoOpSignal:            getStringFromConfig(config, "no_op_signal"),
+		MaxNoOps:              getIntFromConfig(config, "max_noops"),
+		BreakOnRepeatedError:  getIntFromConfig(config, "break_on_repeated_error"),
+		ExitConditions:        getStringSliceFromConfig(config, "exit_conditions"),
+		CircuitBreaker:        e.parseCircuitBreaker(config),
+		BeforeSteps:           e.parseBeforeSteps(config),
+		Steps:                 e.parseInnerSteps(config),
+		AllowEmpty:            getBoolFromConfig(config, "allow_empty"),
+		Fresh:                 getBoolFromConfig(config, "fresh"),
 	}
 
 	// Validate configuration
@@ -256,6 +408,66 @@ func (e *LoopExecutor) validateLoopConfig(cfg *domain.LoopConfig) error {
 			atlaserrors.ErrLoopConfigInvalid, cfg.CircuitBreaker.StagnationIterations)
 	}
 
+	if cfg.IterationDelay < 0 {
+		return fmt.Errorf("%w: iteration_delay cannot be negative: %s",
+			atlaserrors.ErrLoopConfigInvalid, cfg.IterationDelay)
+	}
+
+	if cfg.IterationJitter < 0 || cfg.IterationJitter > 1 {
+		return fmt.Errorf("%w: iteration_jitter must be between 0 and 1: %g",
+			atlaserrors.ErrLoopConfigInvalid, cfg.IterationJitter)
+	}
+
+	if cfg.MaxNoOps < 0 {
+		return fmt.Errorf("%w: max_noops cannot be negative: %d",
+			atlaserrors.ErrLoopConfigInvalid, cfg.MaxNoOps)
+	}
+
+	// A window of one would stop on the first failure, which is not a repetition
+	if cfg.BreakOnRepeatedError < 0 || cfg.BreakOnRepeatedError == 1 {
+		return fmt.Errorf("%w: break_on_repeated_error must be 0 or at least 2: %d",
+			atlaserrors.ErrLoopConfigInvalid, cfg.BreakOnRepeatedError)
+	}
+
+	// Either setting alone can never end the loop
+	if (cfg.MaxNoOps > 0) != (strings.TrimSpace(cfg.NoOpSignal) != "") {
+		return fmt.Errorf("%w: no_op_signal and max_noops must be set together",
+			atlaserrors.ErrLoopConfigInvalid)
+	}
+
+	// An unknown condition never evaluates true, so the loop would silently run to max_iterations
+	if cfg.Until != "" && !IsBuiltinCondition(cfg.Until) {
+		return fmt.Errorf("%w: unknown until condition %q (valid: %s)",
+			atlaserrors.ErrLoopConfigInvalid, cfg.Until, strings.Join(BuiltinConditionNames(), ", "))
+	}
+
+	switch cfg.ScratchpadBackend {
+	case "", ScratchpadBackendFile, ScratchpadBackendStore:
+	default:
+		return fmt.Errorf("%w: unknown scratchpad_backend %q (valid: %s, %s)",
+			atlaserrors.ErrLoopConfigInvalid, cfg.ScratchpadBackend, ScratchpadBackendFile, ScratchpadBackendStore)
+	}
+
+	// Exit conditions are output patterns; a blank one would always match
+	for i, cond := range cfg.ExitConditions {
+		if strings.TrimSpace(cond) == "" {
+			return fmt.Errorf("%w: exit_conditions[%d] cannot be empty",
+				atlaserrors.ErrLoopConfigInvalid, i)
+		}
+	}
+
+	// The summary is written into the worktree and must stay inside it
+	if cfg.SummaryFile != "" && !filepath.IsLocal(cfg.SummaryFile) {
+		return fmt.Errorf("%w: summary_file must be a relative path within the worktree: %q",
+			atlaserrors.ErrLoopConfigInvalid, cfg.SummaryFile)
+	}
+
+	// Iterating over nothing is almost always a config mistake
+	if len(cfg.Steps) == 0 && !cfg.AllowEmpty {
+		return fmt.Errorf("%w: loop has no inner steps to run each iteration; add steps or set allow_empty: true",
+			atlaserrors.ErrLoopConfigInvalid)
+	}
+
 	return nil
 }
 
@@ -270,6 +482,17 @@ func getIntFromConfig(config map[string]any, key string) int {
 	return 0
 }
 
+// getFloatFromConfig extracts a float64 value from config, handling both float64 and int.
+func getFloatFromConfig(config map[string]any, key string) float64 {
+	if v, ok := config[key].(float64); ok {
+		return v
+	}
+	if v, ok := config[key].(int); ok {
+		return float64(v)
+	}
+	return 0
+}
+
 // getStringFromConfig extracts a string value from config.
 func getStringFromConfig(config map[string]any, key string) string {
 	if v, ok := config[key].(string); ok {
@@ -357,7 +580,11 @@ func (e *LoopExecutor) parseStepDefinition(m map[string]any) domain.StepDefiniti
 // initOrRestoreState initializes loop state or restores from checkpoint.
 func (e *LoopExecutor) initOrRestoreState(ctx context.Context, task *domain.Task, step *domain.StepDefinition, cfg *domain.LoopConfig) *domain.LoopState {
 	// Try to restore from checkpoint
-	if e.stateStore != nil {
+	if e.forceFresh || cfg.Fresh {
+		e.logger.Info().
+			Str("step_name", step.Name).
+			Msg("force fresh set, ignoring saved loop state")
+	} else if e.stateStore != nil {
 		if existing, err := e.stateStore.LoadLoopState(ctx, task, step.Name); err == nil && existing != nil {
 			e.logger.Info().
 				Int("iteration", existing.CurrentIteration).
@@ -378,26 +605,34 @@ func (e *LoopExecutor) initOrRestoreState(ctx context.Context, task *domain.Task
 }
 
 // setupScratchpad initializes the scratchpad if configured.
-func (e *LoopExecutor) setupScratchpad(task *domain.Task, step *domain.StepDefinition, cfg *domain.LoopConfig, state *domain.LoopState) error {
+func (e *LoopExecutor) setupScratchpad(ctx context.Context, task *domain.Task, step *domain.StepDefinition, cfg *domain.LoopConfig, state *domain.LoopState) error {
 	if cfg.ScratchpadFile == "" {
 		return nil
 	}
 
-	// Determine scratchpad path
+	// Determine scratchpad location and create the backend if not injected
 	var scratchpadPath string
-	if e.artifactDir != "" {
-		scratchpadPath = filepath.Join(e.artifactDir, cfg.ScratchpadFile)
-	} else {
+	if cfg.ScratchpadBackend == ScratchpadBackendStore {
+		if e.scratchpad == nil && e.store == nil {
+			return fmt.Errorf("scratchpad_backend %q requires a task store: %w", ScratchpadBackendStore, atlaserrors.ErrLoopConfigInvalid)
+		}
 		scratchpadPath = cfg.ScratchpadFile
+		if e.scratchpad == nil {
+			e.scratchpad = NewStoreBackedScratchpad(e.store, task.WorkspaceID, task.ID, scratchpadPath, e.logger)
+		}
+	} else {
+		if e.artifactDir != "" {
+			scratchpadPath = filepath.Join(e.artifactDir, cfg.ScratchpadFile)
+		} else {
+			scratchpadPath = cfg.ScratchpadFile
+		}
+		if e.scratchpad == nil {
+			e.scratchpad = NewFileScratchpad(scratchpadPath, e.logger)
+		}
 	}
 
 	state.ScratchpadPath = scratchpadPath
 
-	// Create file-based scratchpad if not injected
-	if e.scratchpad == nil {
-		e.scratchpad = NewFileScratchpad(scratchpadPath, e.logger)
-	}
-
 	// Initialize if this is a fresh start (no completed iterations)
 	if len(state.CompletedIterations) == 0 {
 		data := &ScratchpadData{
@@ -407,7 +642,7 @@ func (e *LoopExecutor) setupScratchpad(task *domain.Task, step *domain.StepDefin
 			Iterations: []IterationSummary{},
 			Metadata:   make(map[string]any),
 		}
-		if err := e.scratchpad.Write(data); err != nil {
+		if err := e.scratchpad.Write(ctx, data); err != nil {
 			return err
 		}
 		e.logger.Debug().Str("path", scratchpadPath).Msg("initialized scratchpad")
@@ -416,6 +651,20 @@ func (e *LoopExecutor) setupScratchpad(task *domain.Task, step *domain.StepDefin
 	return nil
 }
 
+// loadIgnoreMatcher reads .atlasignore from the worktree. A missing or
+// unreadable file means no paths are ignored.
+func (e *LoopExecutor) loadIgnoreMatcher(logger *zerolog.Logger) *ignore.Matcher {
+	if e.workDir == "" {
+		return nil
+	}
+	m, err := ignore.Load(e.workDir)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to load ignore file, counting all changed files")
+		return nil
+	}
+	return m
+}
+
 // executeIteration runs all inner steps for one iteration.
 func (e *LoopExecutor) executeIteration(ctx context.Context, task *domain.Task, steps []domain.StepDefinition, state *domain.LoopState) (*domain.IterationResult, error) {
 	iterResult := &domain.IterationResult{
@@ -444,6 +693,9 @@ func (e *LoopExecutor) executeIteration(ctx context.Context, task *domain.Task,
 			Msg("executing inner step")
 
 		result, err := e.innerRunner.ExecuteStep(ctx, task, step)
+		if result != nil && resetRequested(result) {
+			iterResult.ResetCounters = true
+		}
 		if err != nil {
 			if result != nil {
 				iterResult.StepResults = append(iterResult.StepResults, *result)
@@ -456,6 +708,16 @@ func (e *LoopExecutor) executeIteration(ctx context.Context, task *domain.Task,
 		// Collect files changed
 		iterResult.FilesChanged = append(iterResult.FilesChanged, result.FilesChanged...)
 
+		// An inner step can stop the loop with a failure; skip the remaining steps
+		if abortRequested(result) {
+			iterResult.Aborted = true
+			e.logger.Warn().
+				Int("iteration", state.CurrentIteration).
+				Str("step_name", step.Name).
+				Msg("inner step requested loop abort")
+			return iterResult, nil
+		}
+
 		// Accumulate output for exit signal detection
 		if result.Output != "" {
 			combinedOutput.WriteString(result.Output)
@@ -477,15 +739,45 @@ func (e *LoopExecutor) executeIteration(ctx context.Context, task *domain.Task,
 	return iterResult, nil
 }
 
+// iterationSignaledNoOp reports whether any inner step's output contains
+// the no-op signal. An empty signal disables detection.
+func iterationSignaledNoOp(iterResult *domain.IterationResult, signal string) bool {
+	if strings.TrimSpace(signal) == "" {
+		return false
+	}
+	for _, sr := range iterResult.StepResults {
+		if strings.Contains(sr.Output, signal) {
+			return true
+		}
+	}
+	return false
+}
+
+// abortRequested reports whether an inner step result asks the loop to abort.
+func abortRequested(result *domain.StepResult) bool {
+	abort, ok := result.Metadata["abort_loop"].(bool)
+	return ok && abort
+}
+
 // updateScratchpad appends iteration summary to scratchpad.
-func (e *LoopExecutor) updateScratchpad(iterResult *domain.IterationResult) {
+func (e *LoopExecutor) updateScratchpad(ctx context.Context, iterResult *domain.IterationResult) {
 	if e.scratchpad == nil {
 		return
 	}
 
-	summary := &IterationSummary{
+	summary := summarizeIteration(iterResult)
+	if err := e.scratchpad.AppendIteration(ctx, &summary); err != nil {
+		e.logger.Warn().Err(err).Msg("failed to update scratchpad")
+	}
+}
+
+// summarizeIteration projects an iteration result into an IterationSummary,
+// building the summary text from the inner steps' output.
+func summarizeIteration(iterResult *domain.IterationResult) IterationSummary {
+	summary := IterationSummary{
 		Number:       iterResult.Iteration,
 		CompletedAt:  iterResult.CompletedAt,
+		Duration:     iterResult.Duration,
 		FilesChanged: iterResult.FilesChanged,
 		ExitSignal:   iterResult.ExitSignal,
 		Success:      iterResult.Error == "",
@@ -506,9 +798,7 @@ func (e *LoopExecutor) updateScratchpad(iterResult *domain.IterationResult) {
 	}
 	summary.Summary = strings.Join(summaryParts, "; ")
 
-	if err := e.scratchpad.AppendIteration(summary); err != nil {
-		e.logger.Warn().Err(err).Msg("failed to update scratchpad")
-	}
+	return summary
 }
 
 // shouldExit determines if the loop should terminate.
@@ -527,7 +817,7 @@ func (e *LoopExecutor) shouldExit(ctx context.Context, state *domain.LoopState,
 
 	// Check named condition (e.g., "all_tests_pass")
 	if cfg.Until != "" {
-		if EvaluateBuiltinCondition(cfg.Until, task) {
+		if e.evaluateUntil(ctx, cfg.Until, task) {
 			state.ExitReason = "condition_met"
 			return true
 		}
@@ -536,6 +826,23 @@ func (e *LoopExecutor) shouldExit(ctx context.Context, state *domain.LoopState,
 	return false
 }
 
+// logIterationDecision logs, at debug level, the state the loop based its
+// decision on after an iteration and whether it decided to exit.
+func logIterationDecision(logger *zerolog.Logger, state *domain.LoopState, filesChanged int, exit bool) {
+	decision := "continue"
+	if exit {
+		decision = "exit"
+	}
+	logger.Debug().
+		Int("iteration", state.CurrentIteration).
+		Int("files_changed", filesChanged).
+		Int("consecutive_errors", state.ConsecutiveErrors).
+		Int("stagnation_count", state.StagnationCount).
+		Str("decision", decision).
+		Str("exit_reason", state.ExitReason).
+		Msg("loop iteration decision")
+}
+
 // circuitBreakerTripped checks if error threshold is exceeded.
 func (e *LoopExecutor) circuitBreakerTripped(state *domain.LoopState, cfg *domain.LoopConfig) bool {
 	threshold := cfg.CircuitBreaker.ConsecutiveErrors
@@ -585,6 +892,48 @@ func (e *LoopExecutor) saveCheckpoint(ctx context.Context, task *domain.Task, st
 	return nil
 }
 
+// maxLoopOutputFiles bounds the files listed in a loop step's output summary.
+const maxLoopOutputFiles = 50
+
+// LoopOutput is the JSON summary stored as a loop step's Output so that
+// later steps (e.g., commit message generation) can consume it.
+type LoopOutput struct {
+	Iterations   int      `json:"iterations"`
+	ExitReason   string   `json:"exit_reason"`
+	FilesChanged []string `json:"files_changed"`
+	FilesOmitted int      `json:"files_omitted,omitempty"`
+}
+
+// buildLoopOutput renders the loop summary as JSON, listing each changed file
+// once and at most maxLoopOutputFiles of them.
+func buildLoopOutput(state *domain.LoopState, filesChanged []string) string {
+	summary := LoopOutput{
+		Iterations:   state.CurrentIteration,
+		ExitReason:   state.ExitReason,
+		FilesChanged: []string{},
+	}
+
+	seen := make(map[string]bool, len(filesChanged))
+	for _, file := range filesChanged {
+		if seen[file] {
+			continue
+		}
+		seen[file] = true
+		if len(summary.FilesChanged) < maxLoopOutputFiles {
+			summary.FilesChanged = append(summary.FilesChanged, file)
+		} else {
+			summary.FilesOmitted++
+		}
+	}
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Sprintf("Loop completed after %d iteration(s). Exit reason: %s",
+			state.CurrentIteration, state.ExitReason)
+	}
+	return string(data)
+}
+
 // buildResult creates the final StepResult for the loop.
 func (e *LoopExecutor) buildResult(task *domain.Task, step *domain.StepDefinition, startTime time.Time, state *domain.LoopState) *domain.StepResult {
 	completedAt := time.Now()
@@ -600,9 +949,7 @@ func (e *LoopExecutor) buildResult(task *domain.Task, step *domain.StepDefinitio
 		allFilesChanged = append(allFilesChanged, iter.FilesChanged...)
 	}
 
-	// Build output summary
-	output := fmt.Sprintf("Loop completed after %d iteration(s). Exit reason: %s",
-		state.CurrentIteration, state.ExitReason)
+	output := buildLoopOutput(state, allFilesChanged)
 
 	// Serialize state for metadata
 	var stateJSONStr string
@@ -610,10 +957,18 @@ func (e *LoopExecutor) buildResult(task *domain.Task, step *domain.StepDefinitio
 		stateJSONStr = string(stateJSON)
 	}
 
+	status := constants.StepStatusSuccess
+	var errMsg string
+	if state.ExitReason == "aborted" {
+		status = constants.StepStatusFailed
+		errMsg = fmt.Sprintf("loop aborted by inner step in iteration %d", state.CurrentIteration)
+	}
+
 	result := &domain.StepResult{
 		StepIndex:    task.CurrentStep,
 		StepName:     step.Name,
-		Status:       constants.StepStatusSuccess,
+		Status:       status,
+		Error:        errMsg,
 		StartedAt:    startTime,
 		CompletedAt:  completedAt,
 		DurationMs:   completedAt.Sub(startTime).Milliseconds(),
@@ -0,0 +1,133 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file implements before_steps and automatic iteration caps. A loop's
+// before_steps run once before the first iteration; with max_iterations set
+// to "auto", a before step can size the loop by reporting
+// suggested_max_iterations in its result metadata, e.g. from the number of
+// problems an initial assessment found.
+package steps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+const (
+	// AutoMaxIterations is the max_iterations value that lets before_steps
+	// choose the iteration cap.
+	AutoMaxIterations = "auto"
+
+	// SuggestedMaxIterationsKey is the result metadata key a before step sets
+	// to suggest an iteration cap.
+	SuggestedMaxIterationsKey = "suggested_max_iterations"
+
+	// DefaultAutoMaxIterations is the cap used when no before step suggests one.
+	DefaultAutoMaxIterations = 10
+
+	// MaxAutoIterations is the safety ceiling for suggested caps.
+	MaxAutoIterations = 50
+)
+
+// parseMaxIterations reads max_iterations, which is either a count or "auto".
+func parseMaxIterations(config map[string]any) (int, bool, error) {
+	s, ok := config["max_iterations"].(string)
+	if !ok {
+		return getIntFromConfig(config, "max_iterations"), false, nil
+	}
+	if s != AutoMaxIterations {
+		return 0, false, fmt.Errorf("%w: max_iterations must be a number or %q: %q",
+			atlaserrors.ErrLoopConfigInvalid, AutoMaxIterations, s)
+	}
+	return 0, true, nil
+}
+
+// parseBeforeSteps extracts the before_steps definitions from config.
+func (e *LoopExecutor) parseBeforeSteps(config map[string]any) []domain.StepDefinition {
+	return e.parseInnerSteps(map[string]any{"steps": config["before_steps"]})
+}
+
+// prepareIterations runs before_steps on a fresh loop and resolves an "auto"
+// iteration cap. A restored loop keeps the cap chosen when it started.
+func (e *LoopExecutor) prepareIterations(ctx context.Context, task *domain.Task, cfg *domain.LoopConfig, state *domain.LoopState, logger *zerolog.Logger) error {
+	fresh := state.CurrentIteration == 0 && len(state.CompletedIterations) == 0
+
+	suggested := 0
+	if fresh {
+		var err error
+		if suggested, err = e.runBeforeSteps(ctx, task, cfg.BeforeSteps); err != nil {
+			return err
+		}
+	}
+
+	if !cfg.AutoMaxIterations {
+		return nil
+	}
+	if !fresh && state.MaxIterations > 0 {
+		cfg.MaxIterations = state.MaxIterations
+		return nil
+	}
+
+	cfg.MaxIterations = resolveAutoMaxIterations(suggested)
+	state.MaxIterations = cfg.MaxIterations
+	logger.Info().
+		Int("suggested_max_iterations", suggested).
+		Int("max_iterations", cfg.MaxIterations).
+		Msg("resolved automatic iteration cap")
+	return nil
+}
+
+// runBeforeSteps executes the before steps in order and returns the last
+// iteration cap one of them suggested, or zero if none did.
+func (e *LoopExecutor) runBeforeSteps(ctx context.Context, task *domain.Task, steps []domain.StepDefinition) (int, error) {
+	suggested := 0
+	for i := range steps {
+		step := &steps[i]
+
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		e.logger.Debug().
+			Str("step_name", step.Name).
+			Msg("executing before step")
+
+		result, err := e.innerRunner.ExecuteStep(ctx, task, step)
+		if err != nil {
+			return 0, fmt.Errorf("before step %s failed: %w", step.Name, err)
+		}
+		if n := suggestedMaxIterations(result); n > 0 {
+			suggested = n
+		}
+	}
+	return suggested, nil
+}
+
+// suggestedMaxIterations reads a positive iteration cap from result metadata.
+func suggestedMaxIterations(result *domain.StepResult) int {
+	if result == nil {
+		return 0
+	}
+	switch v := result.Metadata[SuggestedMaxIterationsKey].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
+
+// resolveAutoMaxIterations clamps a suggested cap to MaxAutoIterations,
+// falling back to DefaultAutoMaxIterations when nothing was suggested.
+func resolveAutoMaxIterations(suggested int) int {
+	if suggested <= 0 {
+		return DefaultAutoMaxIterations
+	}
+	return min(suggested, MaxAutoIterations)
+}
@@ -0,0 +1,108 @@
+package steps
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+var errAssessFailed = errors.New("assessment failed")
+
+// autoLoopStep returns a loop step with an "auto" cap, one before step and one inner step.
+func autoLoopStep() *domain.StepDefinition {
+	return &domain.StepDefinition{Name: "fix", Type: domain.StepTypeLoop, Config: map[string]any{
+		"max_iterations": "auto",
+		"before_steps":   []any{map[string]any{"name": "assess", "type": "ai"}},
+		"steps":          []any{map[string]any{"name": "ai", "type": "ai"}},
+	}}
+}
+
+func TestLoopExecutor_AutoMaxIterations_UsesSuggestion(t *testing.T) {
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, Metadata: map[string]any{SuggestedMaxIterationsKey: 3}},
+		},
+	}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{})
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, autoLoopStep())
+	require.NoError(t, err)
+
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+	assert.Equal(t, 3, result.Metadata["iterations_completed"])
+	assert.Equal(t, 4, mockRunner.ExecuteCalls) // one before step, three iterations
+}
+
+func TestLoopExecutor_AutoMaxIterations_ClampsToCeiling(t *testing.T) {
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, Metadata: map[string]any{SuggestedMaxIterationsKey: float64(1000)}},
+		},
+	}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{})
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, autoLoopStep())
+	require.NoError(t, err)
+
+	assert.Equal(t, MaxAutoIterations, result.Metadata["iterations_completed"])
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_AutoMaxIterations_DefaultsWithoutSuggestion(t *testing.T) {
+	mockRunner := &MockInnerStepRunner{}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{})
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, autoLoopStep())
+	require.NoError(t, err)
+
+	assert.Equal(t, DefaultAutoMaxIterations, result.Metadata["iterations_completed"])
+}
+
+func TestLoopExecutor_AutoMaxIterations_RestoredLoopKeepsCap(t *testing.T) {
+	mockRunner := &MockInnerStepRunner{}
+	stateStore := &MockLoopStateStore{LoadState: &domain.LoopState{
+		StepName:         "fix",
+		CurrentIteration: 2,
+		MaxIterations:    4,
+	}}
+	executor := NewLoopExecutor(mockRunner, stateStore)
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, autoLoopStep())
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, result.Metadata["iterations_completed"])
+	assert.Equal(t, 2, mockRunner.ExecuteCalls) // before steps are not rerun
+}
+
+func TestLoopExecutor_BeforeStepFailure(t *testing.T) {
+	mockRunner := &MockInnerStepRunner{Errors: []error{errAssessFailed}}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{})
+
+	_, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, autoLoopStep())
+	require.ErrorIs(t, err, errAssessFailed)
+	assert.Contains(t, err.Error(), "before step assess failed")
+	assert.Equal(t, 1, mockRunner.ExecuteCalls)
+}
+
+func TestLoopExecutor_InvalidMaxIterationsString(t *testing.T) {
+	step := autoLoopStep()
+	step.Config["max_iterations"] = "many"
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{})
+
+	_, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, step)
+	require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+}
+
+func TestResolveAutoMaxIterations(t *testing.T) {
+	assert.Equal(t, DefaultAutoMaxIterations, resolveAutoMaxIterations(0))
+	assert.Equal(t, DefaultAutoMaxIterations, resolveAutoMaxIterations(-3))
+	assert.Equal(t, 7, resolveAutoMaxIterations(7))
+	assert.Equal(t, MaxAutoIterations, resolveAutoMaxIterations(MaxAutoIterations+1))
+}
@@ -0,0 +1,101 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file implements per-iteration commits for loop steps. With
+// commit_each_iteration set, every successful iteration that changed files is
+// committed on its own instead of leaving one large commit for the end.
+package steps
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/git"
+)
+
+// DefaultIterationCommitMessage is the commit message used when
+// commit_message_template is not set.
+const DefaultIterationCommitMessage = "chore(loop): iteration {iteration}"
+
+// iterationCommitErrorKey is the task metadata key holding the last
+// per-iteration commit failure.
+const iterationCommitErrorKey = "iteration_commit_error"
+
+// IterationCommitter commits the files changed by one loop iteration.
+// This interface enables mocking git commits in tests.
+type IterationCommitter interface {
+	CommitIteration(ctx context.Context, message string, files []string) error
+}
+
+// GitIterationCommitter commits iterations through a git.Runner.
+type GitIterationCommitter struct {
+	runner git.Runner
+}
+
+// NewGitIterationCommitter creates a committer that stages and commits with runner.
+func NewGitIterationCommitter(runner git.Runner) *GitIterationCommitter {
+	return &GitIterationCommitter{runner: runner}
+}
+
+// CommitIteration stages files and commits them with message.
+func (c *GitIterationCommitter) CommitIteration(ctx context.Context, message string, files []string) error {
+	if err := c.runner.Add(ctx, files); err != nil {
+		return fmt.Errorf("failed to stage iteration changes: %w", err)
+	}
+	if err := c.runner.Commit(ctx, message); err != nil {
+		return fmt.Errorf("failed to commit iteration changes: %w", err)
+	}
+	return nil
+}
+
+// commitIteration commits a finished iteration's changes when
+// commit_each_iteration is enabled. Iterations that changed no files are not
+// committed. A failed commit is logged and recorded in task metadata but does
+// not stop the loop.
+func (e *LoopExecutor) commitIteration(ctx context.Context, task *domain.Task, cfg *domain.LoopConfig, iterResult *domain.IterationResult, logger *zerolog.Logger) {
+	if !cfg.CommitEachIteration || len(iterResult.FilesChanged) == 0 {
+		return
+	}
+	if e.committer == nil {
+		logger.Warn().
+			Int("iteration", iterResult.Iteration).
+			Msg("commit_each_iteration is set but no committer is configured, skipping commit")
+		return
+	}
+
+	message := renderIterationCommitMessage(cfg.CommitMessageTemplate, iterResult)
+	if err := e.committer.CommitIteration(ctx, message, iterResult.FilesChanged); err != nil {
+		logger.Warn().
+			Err(err).
+			Int("iteration", iterResult.Iteration).
+			Msg("failed to commit iteration, continuing")
+		if task.Metadata == nil {
+			task.Metadata = make(map[string]any)
+		}
+		task.Metadata[iterationCommitErrorKey] = err.Error()
+		return
+	}
+
+	logger.Info().
+		Int("iteration", iterResult.Iteration).
+		Int("files_changed", len(iterResult.FilesChanged)).
+		Msg("committed iteration changes")
+}
+
+// renderIterationCommitMessage fills {iteration} and {summary} in tmpl,
+// falling back to DefaultIterationCommitMessage when tmpl is blank.
+func renderIterationCommitMessage(tmpl string, iterResult *domain.IterationResult) string {
+	if strings.TrimSpace(tmpl) == "" {
+		tmpl = DefaultIterationCommitMessage
+	}
+	summary := summarizeIteration(iterResult)
+	message := strings.NewReplacer(
+		"{iteration}", strconv.Itoa(iterResult.Iteration),
+		"{summary}", summary.Summary,
+	).Replace(tmpl)
+	return strings.TrimSpace(message)
+}
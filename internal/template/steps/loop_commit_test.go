@@ -0,0 +1,175 @@
+package steps
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+var errCommitFailed = errors.New("commit failed")
+
+// MockIterationCommitter records per-iteration commits.
+type MockIterationCommitter struct {
+	Messages []string
+	Files    [][]string
+	Err      error
+}
+
+func (m *MockIterationCommitter) CommitIteration(_ context.Context, message string, files []string) error {
+	m.Messages = append(m.Messages, message)
+	m.Files = append(m.Files, files)
+	return m.Err
+}
+
+// recordingGitRunner records the staging and commit calls made through git.Runner.
+type recordingGitRunner struct {
+	mockRunner
+
+	added    []string
+	messages []string
+}
+
+func (r *recordingGitRunner) Add(_ context.Context, paths []string) error {
+	r.added = append(r.added, paths...)
+	return nil
+}
+
+func (r *recordingGitRunner) Commit(_ context.Context, message string) error {
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func commitLoopStep(extra map[string]any) *domain.StepDefinition {
+	config := map[string]any{
+		"max_iterations":        3,
+		"commit_each_iteration": true,
+		"steps":                 []any{map[string]any{"name": "fix", "type": "ai"}},
+	}
+	for k, v := range extra {
+		config[k] = v
+	}
+	return &domain.StepDefinition{Name: "fix_loop", Type: domain.StepTypeLoop, Config: config}
+}
+
+func TestLoopExecutor_CommitEachIteration(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{StepName: "fix", Status: constants.StepStatusSuccess, Output: "fixed lint", FilesChanged: []string{"a.go"}},
+			{StepName: "fix", Status: constants.StepStatusSuccess, Output: "nothing to do"},
+			{StepName: "fix", Status: constants.StepStatusSuccess, Output: "fixed tests", FilesChanged: []string{"b.go", "b_test.go"}},
+		},
+	}
+	committer := &MockIterationCommitter{}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopCommitter(committer))
+
+	task := &domain.Task{ID: "task-123"}
+	step := commitLoopStep(map[string]any{
+		"commit_message_template": "fix: iteration {iteration} ({summary})",
+	})
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+	assert.Equal(t, []string{
+		"fix: iteration 1 (fix: fixed lint)",
+		"fix: iteration 3 (fix: fixed tests)",
+	}, committer.Messages)
+	assert.Equal(t, [][]string{{"a.go"}, {"b.go", "b_test.go"}}, committer.Files)
+}
+
+func TestLoopExecutor_CommitEachIteration_StagnantNotCommitted(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess},
+			{Status: constants.StepStatusSuccess},
+		},
+	}
+	committer := &MockIterationCommitter{}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopCommitter(committer))
+
+	step := commitLoopStep(map[string]any{
+		"circuit_breaker": map[string]any{"stagnation_iterations": 2},
+	})
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, "circuit_breaker_stagnation", result.Metadata["exit_reason"])
+	assert.Empty(t, committer.Messages)
+}
+
+func TestLoopExecutor_CommitEachIteration_Disabled(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"a.go"}},
+		},
+	}
+	committer := &MockIterationCommitter{}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopCommitter(committer))
+
+	step := commitLoopStep(map[string]any{"commit_each_iteration": false, "max_iterations": 1})
+
+	_, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, step)
+
+	require.NoError(t, err)
+	assert.Empty(t, committer.Messages)
+}
+
+func TestLoopExecutor_CommitEachIteration_FailureDoesNotStopLoop(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"a.go"}},
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"b.go"}},
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"c.go"}},
+		},
+	}
+	committer := &MockIterationCommitter{Err: errCommitFailed}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopCommitter(committer))
+
+	task := &domain.Task{ID: "task-123"}
+	result, err := executor.Execute(ctx, task, commitLoopStep(nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Metadata["iterations_completed"])
+	assert.Len(t, committer.Messages, 3)
+	assert.Equal(t, errCommitFailed.Error(), task.Metadata[iterationCommitErrorKey])
+}
+
+func TestRenderIterationCommitMessage(t *testing.T) {
+	iter := &domain.IterationResult{
+		Iteration:   4,
+		StepResults: []domain.StepResult{{StepName: "fix", Output: "done"}},
+	}
+
+	assert.Equal(t, "chore(loop): iteration 4", renderIterationCommitMessage("", iter))
+	assert.Equal(t, "wip 4: fix: done", renderIterationCommitMessage("wip {iteration}: {summary}", iter))
+}
+
+func TestGitIterationCommitter(t *testing.T) {
+	runner := &recordingGitRunner{}
+	committer := NewGitIterationCommitter(runner)
+
+	require.NoError(t, committer.CommitIteration(context.Background(), "chore: iteration 1", []string{"a.go"}))
+	assert.Equal(t, []string{"a.go"}, runner.added)
+	assert.Equal(t, []string{"chore: iteration 1"}, runner.messages)
+}
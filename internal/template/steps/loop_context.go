@@ -0,0 +1,70 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file implements how loop iterations share context. By default each
+// iteration's combined inner step output is carried into the next iteration
+// through task.Metadata[loopPreviousOutputKey], which the AI executor appends
+// to its prompt. With fresh_context set, the key is cleared before every
+// iteration so inner steps start without any prior iteration output; use the
+// scratchpad for anything that must survive between fresh iterations. The key
+// is removed when the loop ends so steps after the loop never see it.
+package steps
+
+import (
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// loopPreviousOutputKey is the task metadata key holding the previous
+// iteration's output when context is carried forward.
+const loopPreviousOutputKey = "loop_previous_output"
+
+// maxCarriedOutputBytes caps the carried output, keeping the most recent text.
+const maxCarriedOutputBytes = 8 * 1024
+
+// resetIterationContext clears carried output before an iteration when the
+// loop runs each iteration with a fresh context.
+func resetIterationContext(task *domain.Task, cfg *domain.LoopConfig) {
+	if cfg.FreshContext {
+		delete(task.Metadata, loopPreviousOutputKey)
+	}
+}
+
+// carryIterationContext stores the iteration's combined output for the next
+// iteration, unless the loop uses a fresh context per iteration.
+func carryIterationContext(task *domain.Task, cfg *domain.LoopConfig, iterResult *domain.IterationResult) {
+	if cfg.FreshContext || iterResult == nil {
+		return
+	}
+
+	output := iterationOutput(iterResult)
+	if output == "" {
+		delete(task.Metadata, loopPreviousOutputKey)
+		return
+	}
+	if task.Metadata == nil {
+		task.Metadata = make(map[string]any)
+	}
+	task.Metadata[loopPreviousOutputKey] = output
+}
+
+// clearIterationContext removes carried output once the loop is done.
+func clearIterationContext(task *domain.Task) {
+	delete(task.Metadata, loopPreviousOutputKey)
+}
+
+// iterationOutput joins the inner step outputs of an iteration, truncated
+// from the front to maxCarriedOutputBytes.
+func iterationOutput(iterResult *domain.IterationResult) string {
+	parts := make([]string, 0, len(iterResult.StepResults))
+	for _, r := range iterResult.StepResults {
+		if out := strings.TrimSpace(r.Output); out != "" {
+			parts = append(parts, out)
+		}
+	}
+	output := strings.Join(parts, "\n")
+	if len(output) > maxCarriedOutputBytes {
+		output = strings.ToValidUTF8(output[len(output)-maxCarriedOutputBytes:], "")
+	}
+	return output
+}
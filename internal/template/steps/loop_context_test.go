@@ -0,0 +1,91 @@
+package steps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// contextCapturingRunner records the carried output each inner step sees
+// and reports its call number as output.
+type contextCapturingRunner struct {
+	seen []any
+}
+
+func (r *contextCapturingRunner) ExecuteStep(_ context.Context, task *domain.Task, _ *domain.StepDefinition) (*domain.StepResult, error) {
+	r.seen = append(r.seen, task.Metadata[loopPreviousOutputKey])
+	return &domain.StepResult{
+		Status: constants.StepStatusSuccess,
+		Output: fmt.Sprintf("iteration %d output", len(r.seen)),
+	}, nil
+}
+
+func contextLoopStep(fresh bool) *domain.StepDefinition {
+	return &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 3,
+			"fresh_context":  fresh,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+}
+
+func TestLoopExecutor_FreshContext_InnerStepsSeeNoPriorOutput(t *testing.T) {
+	runner := &contextCapturingRunner{}
+	executor := NewLoopExecutor(runner, &MockLoopStateStore{}, WithLoopLogger(zerolog.Nop()))
+	task := &domain.Task{ID: "task-123", Metadata: map[string]any{loopPreviousOutputKey: "stale"}}
+
+	_, err := executor.Execute(context.Background(), task, contextLoopStep(true))
+
+	require.NoError(t, err)
+	assert.Equal(t, []any{nil, nil, nil}, runner.seen)
+	assert.NotContains(t, task.Metadata, loopPreviousOutputKey)
+}
+
+func TestLoopExecutor_CarriedContext_InnerStepsSeePriorOutput(t *testing.T) {
+	runner := &contextCapturingRunner{}
+	executor := NewLoopExecutor(runner, &MockLoopStateStore{}, WithLoopLogger(zerolog.Nop()))
+	task := &domain.Task{ID: "task-123"}
+
+	_, err := executor.Execute(context.Background(), task, contextLoopStep(false))
+
+	require.NoError(t, err)
+	assert.Equal(t, []any{nil, "iteration 1 output", "iteration 2 output"}, runner.seen)
+	assert.NotContains(t, task.Metadata, loopPreviousOutputKey, "carried output is removed when the loop ends")
+}
+
+func TestIterationOutput_TruncatesFromFront(t *testing.T) {
+	long := strings.Repeat("a", maxCarriedOutputBytes) + "tail"
+	iter := &domain.IterationResult{StepResults: []domain.StepResult{{Output: long}}}
+
+	got := iterationOutput(iter)
+
+	assert.Len(t, got, maxCarriedOutputBytes)
+	assert.True(t, strings.HasSuffix(got, "tail"))
+}
+
+func TestAIExecutor_BuildRequest_AppendsPreviousIterationOutput(t *testing.T) {
+	executor := NewAIExecutor(nil, nil, zerolog.Nop())
+	task := &domain.Task{
+		Description: "fix the lint errors",
+		Metadata:    map[string]any{loopPreviousOutputKey: "fixed 3 of 5"},
+	}
+
+	req := executor.buildRequest(task, &domain.StepDefinition{Name: "inner", Type: domain.StepTypeAI})
+
+	assert.Contains(t, req.Prompt, "fix the lint errors")
+	assert.Contains(t, req.Prompt, "Previous Iteration Output")
+	assert.Contains(t, req.Prompt, "fixed 3 of 5")
+}
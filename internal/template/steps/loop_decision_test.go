@@ -0,0 +1,117 @@
+package steps
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+var errIterationFailed = errors.New("iteration failed")
+
+// decisionEvents returns the "loop iteration decision" entries in a JSON log buffer.
+func decisionEvents(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var events []map[string]any
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		if entry["message"] == "loop iteration decision" {
+			events = append(events, entry)
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestLoopExecutor_LogsDecisionPerIteration(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf).Level(zerolog.DebugLevel)
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"a.go", "b.go"}},
+			nil,
+			{Status: constants.StepStatusSuccess},
+		},
+		Errors: []error{nil, errIterationFailed, nil},
+	}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{}, WithLoopLogger(logger))
+	step := &domain.StepDefinition{Name: "fix", Type: domain.StepTypeLoop, Config: map[string]any{
+		"max_iterations": 3,
+		"steps":          []any{map[string]any{"name": "ai", "type": "ai"}},
+	}}
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, step)
+	require.NoError(t, err)
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+
+	events := decisionEvents(t, &buf)
+	require.Len(t, events, 3)
+
+	expected := []struct {
+		filesChanged, consecutiveErrors, stagnation float64
+		decision, exitReason                        string
+	}{
+		{2, 0, 0, "continue", ""},
+		{0, 1, 0, "continue", ""},
+		{0, 0, 1, "exit", "max_iterations_reached"},
+	}
+	for i, want := range expected {
+		event := events[i]
+		assert.Equal(t, "debug", event["level"])
+		assert.InDelta(t, float64(i+1), event["iteration"], 0)
+		assert.InDelta(t, want.filesChanged, event["files_changed"], 0, "iteration %d", i+1)
+		assert.InDelta(t, want.consecutiveErrors, event["consecutive_errors"], 0, "iteration %d", i+1)
+		assert.InDelta(t, want.stagnation, event["stagnation_count"], 0, "iteration %d", i+1)
+		assert.Equal(t, want.decision, event["decision"], "iteration %d", i+1)
+		assert.Equal(t, want.exitReason, event["exit_reason"], "iteration %d", i+1)
+	}
+}
+
+func TestLoopExecutor_LogsDecisionWhenBodyStopsLoop(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf).Level(zerolog.DebugLevel)
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{}, WithLoopLogger(logger))
+	step := &domain.StepDefinition{Name: "fix", Type: domain.StepTypeLoop, Config: map[string]any{
+		"max_iterations":  10,
+		"circuit_breaker": map[string]any{"stagnation_iterations": 2},
+		"steps":           []any{map[string]any{"name": "ai", "type": "ai"}},
+	}}
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, step)
+	require.NoError(t, err)
+	assert.Equal(t, "circuit_breaker_stagnation", result.Metadata["exit_reason"])
+
+	events := decisionEvents(t, &buf)
+	require.Len(t, events, 2)
+	assert.Equal(t, "continue", events[0]["decision"])
+	assert.Equal(t, "exit", events[1]["decision"])
+	assert.Equal(t, "circuit_breaker_stagnation", events[1]["exit_reason"])
+	assert.InDelta(t, 2, events[1]["stagnation_count"], 0)
+}
+
+func TestLoopExecutor_DecisionLogsOnlyAtDebug(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf).Level(zerolog.InfoLevel)
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{}, WithLoopLogger(logger))
+	step := &domain.StepDefinition{Name: "fix", Type: domain.StepTypeLoop, Config: map[string]any{
+		"max_iterations": 2,
+		"steps":          []any{map[string]any{"name": "ai", "type": "ai"}},
+	}}
+
+	_, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, step)
+	require.NoError(t, err)
+
+	assert.Empty(t, decisionEvents(t, &buf))
+}
@@ -0,0 +1,68 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file implements the pause between loop iterations. With iteration_delay
+// set, each iteration waits before starting, backing off exponentially after
+// failed iterations. iteration_jitter randomizes each wait so concurrent loops
+// against the same rate-limited provider do not retry in lockstep.
+package steps
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// maxIterationBackoffShift caps exponential backoff at 32x the base delay.
+const maxIterationBackoffShift = 5
+
+// WithLoopRand sets the randomness source used for iteration jitter.
+// Pass a seeded source to make jittered delays deterministic in tests.
+func WithLoopRand(r *rand.Rand) LoopExecutorOption {
+	return func(e *LoopExecutor) { e.rand = r }
+}
+
+// iterationBackoff returns the delay before the next iteration: the base
+// delay, doubled for each consecutive failed iteration up to a fixed cap.
+func iterationBackoff(base time.Duration, consecutiveErrors int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	shift := min(max(consecutiveErrors, 0), maxIterationBackoffShift)
+	return base << shift
+}
+
+// jitterDelay randomizes d by up to ±jitter (a fraction of d).
+// A jitter of zero or a nil source returns d unchanged.
+func jitterDelay(d time.Duration, jitter float64, r *rand.Rand) time.Duration {
+	if d <= 0 || jitter <= 0 || r == nil {
+		return d
+	}
+	ratio := (r.Float64()*2 - 1) * jitter
+	return d + time.Duration(float64(d)*ratio)
+}
+
+// nextIterationDelay computes the jittered backoff before the next iteration.
+func (e *LoopExecutor) nextIterationDelay(cfg *domain.LoopConfig, state *domain.LoopState) time.Duration {
+	d := iterationBackoff(cfg.IterationDelay, state.ConsecutiveErrors)
+	e.randMu.Lock()
+	defer e.randMu.Unlock()
+	return jitterDelay(d, cfg.IterationJitter, e.rand)
+}
+
+// waitIterationDelay pauses before the next iteration.
+// Returns the context error if canceled while waiting.
+func waitIterationDelay(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
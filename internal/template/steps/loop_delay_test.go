@@ -0,0 +1,108 @@
+package steps
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func TestIterationBackoff(t *testing.T) {
+	base := 100 * time.Millisecond
+
+	assert.Equal(t, time.Duration(0), iterationBackoff(0, 3))
+	assert.Equal(t, base, iterationBackoff(base, 0))
+	assert.Equal(t, 200*time.Millisecond, iterationBackoff(base, 1))
+	assert.Equal(t, 800*time.Millisecond, iterationBackoff(base, 3))
+	assert.Equal(t, 32*base, iterationBackoff(base, 50), "backoff should be capped")
+}
+
+func TestJitterDelay_FixedSeedWithinBounds(t *testing.T) {
+	const jitter = 0.25
+	d := 2 * time.Second
+	low := time.Duration(float64(d) * (1 - jitter))
+	high := time.Duration(float64(d) * (1 + jitter))
+
+	r := rand.New(rand.NewSource(42)) //nolint:gosec // Deterministic source for tests
+	seen := make(map[time.Duration]bool)
+	for range 100 {
+		got := jitterDelay(d, jitter, r)
+		assert.GreaterOrEqual(t, got, low)
+		assert.LessOrEqual(t, got, high)
+		seen[got] = true
+	}
+	assert.Greater(t, len(seen), 1, "jitter should vary the delay")
+
+	// The same seed produces the same sequence
+	first := jitterDelay(d, jitter, rand.New(rand.NewSource(7)))  //nolint:gosec // Deterministic source for tests
+	second := jitterDelay(d, jitter, rand.New(rand.NewSource(7))) //nolint:gosec // Deterministic source for tests
+	assert.Equal(t, first, second)
+}
+
+func TestJitterDelay_Disabled(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) //nolint:gosec // Deterministic source for tests
+
+	assert.Equal(t, time.Second, jitterDelay(time.Second, 0, r))
+	assert.Equal(t, time.Second, jitterDelay(time.Second, 0.5, nil))
+	assert.Equal(t, time.Duration(0), jitterDelay(0, 0.5, r))
+}
+
+func TestLoopExecutor_NextIterationDelay_BacksOffWithJitter(t *testing.T) {
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{},
+		WithLoopRand(rand.New(rand.NewSource(99)))) //nolint:gosec // Deterministic source for tests
+	cfg := &domain.LoopConfig{IterationDelay: time.Second, IterationJitter: 0.1}
+	state := &domain.LoopState{ConsecutiveErrors: 2}
+
+	got := executor.nextIterationDelay(cfg, state)
+
+	assert.GreaterOrEqual(t, got, 3600*time.Millisecond)
+	assert.LessOrEqual(t, got, 4400*time.Millisecond)
+}
+
+func TestLoopExecutor_IterationDelay_CanceledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"a.go"}},
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"b.go"}},
+		},
+	}
+	store := &MockLoopStateStore{}
+	executor := NewLoopExecutor(mockRunner, store, WithLoopLogger(zerolog.Nop()))
+
+	step := &domain.StepDefinition{Name: "delayed", Type: domain.StepTypeLoop, Config: map[string]any{
+		"max_iterations":  2,
+		"iteration_delay": "1h",
+		"steps":           []any{map[string]any{"name": "fix", "type": "ai"}},
+	}}
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, "context_canceled", result.Metadata["exit_reason"])
+	assert.Equal(t, 1, mockRunner.ExecuteCalls)
+}
+
+func TestLoopExecutor_IterationDelay_InvalidConfig(t *testing.T) {
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{})
+
+	_, err := executor.parseLoopConfig(map[string]any{"iteration_jitter": 1.5})
+	require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+
+	_, err = executor.parseLoopConfig(map[string]any{"iteration_delay": "-1s"})
+	require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+
+	cfg, err := executor.parseLoopConfig(map[string]any{"iteration_delay": "2s", "iteration_jitter": 0.2, "allow_empty": true})
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, cfg.IterationDelay)
+	assert.InDelta(t, 0.2, cfg.IterationJitter, 1e-9)
+}
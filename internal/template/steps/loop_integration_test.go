@@ -185,7 +185,7 @@ func TestLoopIntegration_FullWorkflow(t *testing.T) {
 	assert.Contains(t, result.FilesChanged, "file3.go")
 
 	// Verify scratchpad was written
-	scratchData, err := scratchpad.Read()
+	scratchData, err := scratchpad.Read(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, scratchData.Iterations, 3)
 }
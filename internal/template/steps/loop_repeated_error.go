@@ -0,0 +1,51 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file implements the repeated-error breaker. With break_on_repeated_error
+// set to N, the loop stops as soon as the last N iterations failed with the
+// same error, even if the consecutive-errors threshold has not been reached.
+// An agent stuck on one error is unlikely to recover by retrying.
+package steps
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// errorFingerprintLen is the number of hex characters kept from the error hash.
+const errorFingerprintLen = 16
+
+// errorFingerprint returns a short stable hash of an iteration error message.
+func errorFingerprint(msg string) string {
+	sum := sha256.Sum256([]byte(msg))
+	return hex.EncodeToString(sum[:])[:errorFingerprintLen]
+}
+
+// recordIterationError appends the fingerprint of a failed iteration's error,
+// keeping only as many entries as the repeated-error window needs.
+func recordIterationError(state *domain.LoopState, cfg *domain.LoopConfig, msg string) {
+	if cfg.BreakOnRepeatedError <= 0 {
+		return
+	}
+	state.RecentErrorFingerprints = append(state.RecentErrorFingerprints, errorFingerprint(msg))
+	if excess := len(state.RecentErrorFingerprints) - cfg.BreakOnRepeatedError; excess > 0 {
+		state.RecentErrorFingerprints = state.RecentErrorFingerprints[excess:]
+	}
+}
+
+// repeatedErrorTripped reports whether the last BreakOnRepeatedError
+// iterations all failed with an identical error.
+func repeatedErrorTripped(state *domain.LoopState, cfg *domain.LoopConfig) bool {
+	window := cfg.BreakOnRepeatedError
+	if window <= 0 || len(state.RecentErrorFingerprints) < window {
+		return false
+	}
+	recent := state.RecentErrorFingerprints[len(state.RecentErrorFingerprints)-window:]
+	for _, fp := range recent[1:] {
+		if fp != recent[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package steps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func repeatedErrorLoopStep(repeated int) *domain.StepDefinition {
+	return &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations":          10,
+			"break_on_repeated_error": repeated,
+			"circuit_breaker": map[string]any{
+				"consecutive_errors": 5,
+			},
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+}
+
+func TestLoopExecutor_RepeatedError_TripsBeforeConsecutiveErrors(t *testing.T) {
+	ctx := context.Background()
+
+	errSame := atlaserrors.ErrCommandFailed
+	mockRunner := &MockInnerStepRunner{
+		Errors: []error{errSame, errSame, errSame, errSame, errSame},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, repeatedErrorLoopStep(2))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, mockRunner.ExecuteCalls)
+	assert.Equal(t, "repeated_error", result.Metadata["exit_reason"])
+	require.NotNil(t, mockStore.SavedState)
+	assert.Len(t, mockStore.SavedState.RecentErrorFingerprints, 2)
+}
+
+func TestLoopExecutor_RepeatedError_DistinctErrorsFallBackToConsecutive(t *testing.T) {
+	ctx := context.Background()
+
+	mockRunner := &MockInnerStepRunner{
+		Errors: []error{
+			atlaserrors.ErrCommandFailed,
+			atlaserrors.ErrCommandTimeout,
+			atlaserrors.ErrCommandFailed,
+			atlaserrors.ErrCommandTimeout,
+			atlaserrors.ErrCommandFailed,
+		},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, repeatedErrorLoopStep(2))
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, mockRunner.ExecuteCalls)
+	assert.Equal(t, "circuit_breaker_errors", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_RepeatedError_ResetBySuccess(t *testing.T) {
+	ctx := context.Background()
+
+	errSame := atlaserrors.ErrCommandFailed
+	mockRunner := &MockInnerStepRunner{
+		Errors: []error{errSame, errSame, nil, errSame, errSame, errSame},
+		Results: []*domain.StepResult{
+			nil, nil,
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"main.go"}},
+		},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, repeatedErrorLoopStep(3))
+
+	require.NoError(t, err)
+	assert.Equal(t, 6, mockRunner.ExecuteCalls)
+	assert.Equal(t, "repeated_error", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_RepeatedError_ResumesFromPersistedFingerprints(t *testing.T) {
+	ctx := context.Background()
+
+	errSame := atlaserrors.ErrCommandFailed
+	fp := errorFingerprint("inner step inner failed: " + errSame.Error())
+	mockRunner := &MockInnerStepRunner{
+		Errors: []error{errSame},
+	}
+	mockStore := &MockLoopStateStore{
+		LoadState: &domain.LoopState{
+			StepName:                "test_loop",
+			CurrentIteration:        2,
+			ConsecutiveErrors:       2,
+			RecentErrorFingerprints: []string{fp, fp},
+		},
+	}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, repeatedErrorLoopStep(3))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockRunner.ExecuteCalls)
+	assert.Equal(t, "repeated_error", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_RepeatedError_InvalidConfig(t *testing.T) {
+	executor := NewLoopExecutor(nil, nil)
+
+	for _, n := range []int{-1, 1} {
+		_, err := executor.parseLoopConfig(map[string]any{"break_on_repeated_error": n})
+
+		require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+		assert.Contains(t, err.Error(), "break_on_repeated_error")
+	}
+}
+
+func TestRecordIterationError_KeepsWindow(t *testing.T) {
+	state := &domain.LoopState{}
+	cfg := &domain.LoopConfig{BreakOnRepeatedError: 2}
+
+	recordIterationError(state, cfg, "a")
+	recordIterationError(state, cfg, "b")
+	recordIterationError(state, cfg, "b")
+
+	assert.Equal(t, []string{errorFingerprint("b"), errorFingerprint("b")}, state.RecentErrorFingerprints)
+	assert.True(t, repeatedErrorTripped(state, cfg))
+
+	disabled := &domain.LoopState{}
+	recordIterationError(disabled, &domain.LoopConfig{}, "a")
+	assert.Empty(t, disabled.RecentErrorFingerprints)
+}
@@ -0,0 +1,39 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file implements counter resets. An inner step that changes the
+// situation fundamentally, such as a large refactor, can set
+// Metadata["reset_loop_counters"] = true to zero the stagnation and
+// consecutive-error counters. The iteration count is left alone, so
+// max_iterations still bounds the loop.
+package steps
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// resetRequested reports whether an inner step result asks the loop to reset
+// its stagnation and error counters.
+func resetRequested(result *domain.StepResult) bool {
+	reset, ok := result.Metadata["reset_loop_counters"].(bool)
+	return ok && reset
+}
+
+// resetLoopCounters zeroes the counters that feed the stagnation and error
+// breakers once an iteration requested it, and records the iteration so the
+// reset survives a checkpoint.
+func resetLoopCounters(state *domain.LoopState, iterResult *domain.IterationResult, logger *zerolog.Logger) {
+	if !iterResult.ResetCounters {
+		return
+	}
+	logger.Info().
+		Int("iteration", state.CurrentIteration).
+		Int("stagnation_count", state.StagnationCount).
+		Int("consecutive_errors", state.ConsecutiveErrors).
+		Msg("inner step reset loop counters")
+	state.StagnationCount = 0
+	state.ConsecutiveErrors = 0
+	state.RecentErrorFingerprints = nil
+	state.CountersResetIteration = state.CurrentIteration
+}
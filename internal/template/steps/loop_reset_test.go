@@ -0,0 +1,121 @@
+package steps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func resetCountersLoopStep(breaker map[string]any) *domain.StepDefinition {
+	return &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations":  6,
+			"circuit_breaker": breaker,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+}
+
+func resettingResult() *domain.StepResult {
+	return &domain.StepResult{
+		Status:   constants.StepStatusSuccess,
+		Metadata: map[string]any{"reset_loop_counters": true},
+	}
+}
+
+func TestLoopExecutor_ResetCounters_PreventsStagnationExit(t *testing.T) {
+	ctx := context.Background()
+
+	// No iteration changes files; the second one resets the counters right
+	// before stagnation would have tripped.
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess},
+			resettingResult(),
+			{Status: constants.StepStatusSuccess},
+			{Status: constants.StepStatusSuccess},
+		},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"},
+		resetCountersLoopStep(map[string]any{"stagnation_iterations": 2}))
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, mockRunner.ExecuteCalls)
+	assert.Equal(t, "circuit_breaker_stagnation", result.Metadata["exit_reason"])
+	require.NotNil(t, mockStore.SavedState)
+	assert.Equal(t, 2, mockStore.SavedState.CountersResetIteration)
+	assert.Equal(t, 4, mockStore.SavedState.CurrentIteration, "iteration count is not reset")
+	assert.True(t, mockStore.SavedState.CompletedIterations[1].ResetCounters)
+}
+
+func TestLoopExecutor_ResetCounters_WithoutResetStagnationTrips(t *testing.T) {
+	ctx := context.Background()
+
+	mockRunner := &MockInnerStepRunner{}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"},
+		resetCountersLoopStep(map[string]any{"stagnation_iterations": 2}))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, mockRunner.ExecuteCalls)
+	assert.Equal(t, "circuit_breaker_stagnation", result.Metadata["exit_reason"])
+	assert.Zero(t, mockStore.SavedState.CountersResetIteration)
+}
+
+// failingResetRunner fails every inner step, asking for a counter reset on
+// the calls listed in resetOn (1-indexed).
+type failingResetRunner struct {
+	resetOn map[int]bool
+	calls   int
+}
+
+func (r *failingResetRunner) ExecuteStep(_ context.Context, _ *domain.Task, _ *domain.StepDefinition) (*domain.StepResult, error) {
+	r.calls++
+	result := &domain.StepResult{Status: constants.StepStatusFailed}
+	if r.resetOn[r.calls] {
+		result.Metadata = map[string]any{"reset_loop_counters": true}
+	}
+	return result, atlaserrors.ErrCommandFailed
+}
+
+func TestLoopExecutor_ResetCounters_PreventsErrorBreakerExit(t *testing.T) {
+	ctx := context.Background()
+
+	runner := &failingResetRunner{resetOn: map[int]bool{2: true}}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(runner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"},
+		resetCountersLoopStep(map[string]any{"consecutive_errors": 2}))
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, runner.calls)
+	assert.Equal(t, "circuit_breaker_errors", result.Metadata["exit_reason"])
+	require.NotNil(t, mockStore.SavedState)
+	assert.Equal(t, 2, mockStore.SavedState.CountersResetIteration)
+}
+
+func TestResetRequested(t *testing.T) {
+	assert.True(t, resetRequested(resettingResult()))
+	assert.False(t, resetRequested(&domain.StepResult{}))
+	assert.False(t, resetRequested(&domain.StepResult{Metadata: map[string]any{"reset_loop_counters": "true"}}))
+}
@@ -0,0 +1,92 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file connects the loop executor to the rest of the engine: a
+// LoopStateStore that checkpoints loop state as a task artifact, and an
+// InnerStepRunner that runs inner steps with the registry's executors.
+package steps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// loopStateArtifact is the artifact name loop state is checkpointed under,
+// inside the loop step's artifact directory.
+const loopStateArtifact = "loop-state.json"
+
+// LoopArtifactStore abstracts the task store's artifact methods used by loop
+// steps. task.Store satisfies this interface.
+type LoopArtifactStore interface {
+	ScratchpadStore
+	ValidationArtifactStore
+}
+
+// StoreLoopStateStore implements LoopStateStore using task artifacts.
+type StoreLoopStateStore struct {
+	store ScratchpadStore
+}
+
+// NewStoreLoopStateStore creates a loop state store over the task store.
+func NewStoreLoopStateStore(store ScratchpadStore) *StoreLoopStateStore {
+	return &StoreLoopStateStore{store: store}
+}
+
+// SaveLoopState checkpoints state as an artifact of task.
+func (s *StoreLoopStateStore) SaveLoopState(ctx context.Context, task *domain.Task, state *domain.LoopState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal loop state: %w", err)
+	}
+	if err := s.store.SaveArtifact(ctx, task.WorkspaceID, task.ID, loopStateArtifactName(state.StepName), data); err != nil {
+		return fmt.Errorf("failed to save loop state: %w", err)
+	}
+	return nil
+}
+
+// LoadLoopState returns the checkpointed state of the loop step stepName,
+// or nil when the loop has not been checkpointed.
+func (s *StoreLoopStateStore) LoadLoopState(ctx context.Context, task *domain.Task, stepName string) (*domain.LoopState, error) {
+	data, err := s.store.GetArtifact(ctx, task.WorkspaceID, task.ID, loopStateArtifactName(stepName))
+	if errors.Is(err, atlaserrors.ErrArtifactNotFound) {
+		return nil, nil //nolint:nilnil // no checkpoint means a fresh loop
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load loop state: %w", err)
+	}
+
+	var state domain.LoopState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse loop state: %w", err)
+	}
+	return &state, nil
+}
+
+// loopStateArtifactName returns the artifact name of a loop step's state.
+func loopStateArtifactName(stepName string) string {
+	return filepath.Join(stepName, loopStateArtifact)
+}
+
+// RegistryStepRunner implements InnerStepRunner with the executors of a registry.
+type RegistryStepRunner struct {
+	registry *ExecutorRegistry
+}
+
+// NewRegistryStepRunner creates an inner step runner over registry.
+func NewRegistryStepRunner(registry *ExecutorRegistry) *RegistryStepRunner {
+	return &RegistryStepRunner{registry: registry}
+}
+
+// ExecuteStep runs step with the executor registered for its type.
+func (r *RegistryStepRunner) ExecuteStep(ctx context.Context, task *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	executor, err := r.registry.Get(step.Type)
+	if err != nil {
+		return nil, err
+	}
+	return executor.Execute(ctx, task, step)
+}
@@ -0,0 +1,108 @@
+package steps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// memLoopArtifactStore is an in-memory LoopArtifactStore.
+type memLoopArtifactStore struct {
+	*memScratchpadStore
+}
+
+func (m memLoopArtifactStore) ListArtifacts(_ context.Context, _, _ string) ([]string, error) {
+	return nil, nil
+}
+
+func TestStoreLoopStateStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	mem := newMemScratchpadStore()
+	store := NewStoreLoopStateStore(mem)
+	task := &domain.Task{ID: "task-123", WorkspaceID: "ws"}
+
+	state, err := store.LoadLoopState(ctx, task, "fix_loop")
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	require.NoError(t, store.SaveLoopState(ctx, task, &domain.LoopState{StepName: "fix_loop", CurrentIteration: 2}))
+	assert.Contains(t, mem.artifacts, "ws/task-123/fix_loop/loop-state.json")
+
+	state, err = store.LoadLoopState(ctx, task, "fix_loop")
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, 2, state.CurrentIteration)
+}
+
+func TestStoreLoopStateStore_LoadError(t *testing.T) {
+	mem := newMemScratchpadStore()
+	mem.getErr = atlaserrors.ErrEmptyValue
+
+	_, err := NewStoreLoopStateStore(mem).LoadLoopState(context.Background(), &domain.Task{ID: "task-123"}, "fix_loop")
+	require.ErrorIs(t, err, atlaserrors.ErrEmptyValue)
+}
+
+func TestRegistryStepRunner_ExecuteStep(t *testing.T) {
+	registry := NewExecutorRegistry()
+	inner := &trackingStepExecutor{stepType: domain.StepTypeHuman}
+	registry.Register(inner)
+	runner := NewRegistryStepRunner(registry)
+
+	result, err := runner.ExecuteStep(context.Background(), &domain.Task{ID: "task-123"}, &domain.StepDefinition{Name: "review", Type: domain.StepTypeHuman})
+	require.NoError(t, err)
+	assert.Equal(t, constants.StepStatusSuccess, result.Status)
+	assert.Equal(t, 1, inner.calls)
+
+	_, err = runner.ExecuteStep(context.Background(), &domain.Task{ID: "task-123"}, &domain.StepDefinition{Name: "ci", Type: domain.StepTypeCI})
+	require.ErrorIs(t, err, atlaserrors.ErrExecutorNotFound)
+}
+
+func TestNewDefaultRegistry_LoopCheckpointsToStore(t *testing.T) {
+	store := memLoopArtifactStore{newMemScratchpadStore()}
+	registry := NewDefaultRegistry(ExecutorDeps{WorkDir: t.TempDir(), Logger: zerolog.Nop(), LoopStore: store})
+	inner := &trackingStepExecutor{stepType: domain.StepTypeHuman}
+	registry.Register(inner)
+
+	executor, err := registry.Get(domain.StepTypeLoop)
+	require.NoError(t, err)
+
+	task := &domain.Task{ID: "task-123", WorkspaceID: "ws"}
+	step := &domain.StepDefinition{
+		Name: "fix_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 2,
+			"steps": []any{
+				map[string]any{"name": "review", "type": "human"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(context.Background(), task, step)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Metadata["iterations_completed"])
+	assert.Equal(t, 2, inner.calls)
+	assert.Contains(t, store.artifacts, "ws/task-123/fix_loop/loop-state.json")
+}
+
+// trackingStepExecutor is a StepExecutor that counts its calls and succeeds.
+type trackingStepExecutor struct {
+	stepType domain.StepType
+	calls    int
+}
+
+func (e *trackingStepExecutor) Execute(_ context.Context, _ *domain.Task, step *domain.StepDefinition) (*domain.StepResult, error) {
+	e.calls++
+	return &domain.StepResult{StepName: step.Name, Status: constants.StepStatusSuccess}, nil
+}
+
+func (e *trackingStepExecutor) Type() domain.StepType {
+	return e.stepType
+}
@@ -374,7 +374,7 @@ func TestLoopStress_ScratchpadPerformance(t *testing.T) {
 	t.Logf("100 iterations with scratchpad in %v", duration)
 
 	// Verify scratchpad has all iterations
-	data, err := scratchpad.Read()
+	data, err := scratchpad.Read(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, data.Iterations, 100)
 
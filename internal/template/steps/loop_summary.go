@@ -0,0 +1,100 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+package steps
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// summaryFilePerm is the permission used for loop summary files.
+// Summaries live in the worktree for human review, so they are world-readable.
+const summaryFilePerm = 0o644
+
+// LoopIterationHistory projects a loop's completed iterations into summaries
+// for reports, in the order they ran. Returns nil for a nil state.
+func LoopIterationHistory(state *domain.LoopState) []IterationSummary {
+	if state == nil || len(state.CompletedIterations) == 0 {
+		return nil
+	}
+
+	history := make([]IterationSummary, 0, len(state.CompletedIterations))
+	for i := range state.CompletedIterations {
+		history = append(history, summarizeIteration(&state.CompletedIterations[i]))
+	}
+	return history
+}
+
+// writeSummaryFile writes a markdown summary of the loop to the configured summary file.
+// Existing content is replaced atomically so a partially written summary is never left behind.
+func (e *LoopExecutor) writeSummaryFile(step *domain.StepDefinition, cfg *domain.LoopConfig, state *domain.LoopState, startTime time.Time) error {
+	if cfg.SummaryFile == "" {
+		return nil
+	}
+
+	path := e.summaryFilePath(cfg.SummaryFile)
+	content := renderLoopSummary(step.Name, state, time.Since(startTime))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create summary directory: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(content), summaryFilePerm); err != nil { //#nosec G306 -- summary is meant to be read by humans and tooling
+		return fmt.Errorf("failed to write summary file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath) // best-effort cleanup of temp file
+		return fmt.Errorf("failed to write summary file: %w", err)
+	}
+
+	e.logger.Debug().Str("path", path).Msg("wrote loop summary file")
+	return nil
+}
+
+// summaryFilePath resolves the summary file path within the worktree.
+// validateLoopConfig has already rejected absolute and escaping paths.
+func (e *LoopExecutor) summaryFilePath(file string) string {
+	return filepath.Join(e.workDir, file)
+}
+
+// renderLoopSummary renders the markdown summary for a finished loop.
+func renderLoopSummary(stepName string, state *domain.LoopState, duration time.Duration) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Loop Summary: %s\n\n", stepName)
+	fmt.Fprintf(&b, "- **Iterations:** %d\n", state.CurrentIteration)
+	fmt.Fprintf(&b, "- **Exit reason:** %s\n", state.ExitReason)
+	fmt.Fprintf(&b, "- **Total duration:** %s\n", duration.Round(time.Second))
+
+	history := LoopIterationHistory(state)
+	if len(history) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("\n## Iterations\n")
+	for _, iter := range history {
+		fmt.Fprintf(&b, "\n### Iteration %d\n\n", iter.Number)
+		fmt.Fprintf(&b, "- Duration: %s\n", iter.Duration.Round(time.Millisecond))
+		if iter.ExitSignal {
+			b.WriteString("- Exit signal: yes\n")
+		}
+		if iter.Error != "" {
+			fmt.Fprintf(&b, "- Error: %s\n", iter.Error)
+		}
+		if len(iter.FilesChanged) == 0 {
+			b.WriteString("- Files changed: none\n")
+			continue
+		}
+		fmt.Fprintf(&b, "- Files changed (%d):\n", len(iter.FilesChanged))
+		for _, file := range iter.FilesChanged {
+			fmt.Fprintf(&b, "  - `%s`\n", file)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,184 @@
+package steps
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func newSummaryLoopStep(summaryFile string) *domain.StepDefinition {
+	return &domain.StepDefinition{
+		Name: "fix_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 2,
+			"summary_file":   summaryFile,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+}
+
+func TestLoopExecutor_SummaryFile(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"main.go", "main_test.go"}},
+			{Status: constants.StepStatusSuccess},
+		},
+	}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()),
+		WithLoopWorkDir(workDir),
+	)
+
+	task := &domain.Task{ID: "task-123"}
+	_, err := executor.Execute(ctx, task, newSummaryLoopStep("docs/LOOP_SUMMARY.md"))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(workDir, "docs", "LOOP_SUMMARY.md")) //#nosec G304 -- test temp file
+	require.NoError(t, err)
+
+	summary := string(content)
+	assert.Contains(t, summary, "# Loop Summary: fix_loop")
+	assert.Contains(t, summary, "**Iterations:** 2")
+	assert.Contains(t, summary, "**Exit reason:** max_iterations_reached")
+	assert.Contains(t, summary, "**Total duration:**")
+	assert.Contains(t, summary, "### Iteration 1")
+	assert.Contains(t, summary, "- Files changed (2):")
+	assert.Contains(t, summary, "`main_test.go`")
+	assert.Contains(t, summary, "### Iteration 2")
+	assert.Contains(t, summary, "- Files changed: none")
+	assert.NotContains(t, task.Metadata, "summary_file_error")
+}
+
+func TestLoopExecutor_SummaryFile_Overwrites(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+	summaryPath := filepath.Join(workDir, "SUMMARY.md")
+	require.NoError(t, os.WriteFile(summaryPath, []byte("stale content from a previous run\n"), 0o600))
+
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()),
+		WithLoopWorkDir(workDir),
+	)
+
+	_, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, newSummaryLoopStep("SUMMARY.md"))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(summaryPath) //#nosec G304 -- test temp file
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "stale content")
+	assert.Contains(t, string(content), "**Iterations:** 2")
+
+	_, err = os.Stat(summaryPath + ".tmp")
+	assert.True(t, os.IsNotExist(err), "temp file should not be left behind")
+}
+
+func TestLoopExecutor_SummaryFile_WriteErrorStoredInMetadata(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+
+	// A regular file where the summary's parent directory should be makes the write fail
+	blocker := filepath.Join(workDir, "blocked")
+	require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o600))
+
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()),
+		WithLoopWorkDir(workDir),
+	)
+
+	task := &domain.Task{ID: "task-123"}
+	result, err := executor.Execute(ctx, task, newSummaryLoopStep("blocked/SUMMARY.md"))
+
+	require.NoError(t, err) // Summary error doesn't fail execution
+	assert.Equal(t, constants.StepStatusSuccess, result.Status)
+	assert.Contains(t, task.Metadata, "summary_file_error")
+}
+
+func TestLoopExecutor_SummaryFile_NotConfigured(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()),
+		WithLoopWorkDir(workDir),
+	)
+
+	task := &domain.Task{ID: "task-123"}
+	_, err := executor.Execute(ctx, task, newSummaryLoopStep(""))
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(workDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.Nil(t, task.Metadata)
+}
+
+func TestLoopExecutor_ParseLoopConfig_SummaryFile(t *testing.T) {
+	executor := NewLoopExecutor(nil, nil)
+
+	cfg, err := executor.parseLoopConfig(map[string]any{"summary_file": "LOOP.md", "allow_empty": true})
+	require.NoError(t, err)
+	assert.Equal(t, "LOOP.md", cfg.SummaryFile)
+}
+
+func TestLoopExecutor_SummaryFile_RejectsPathsOutsideWorktree(t *testing.T) {
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()),
+		WithLoopWorkDir(t.TempDir()),
+	)
+
+	for _, file := range []string{"/tmp/LOOP.md", "../LOOP.md", "docs/../../LOOP.md"} {
+		t.Run(file, func(t *testing.T) {
+			_, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, newSummaryLoopStep(file))
+			require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+		})
+	}
+}
+
+func TestLoopIterationHistory(t *testing.T) {
+	t.Run("nil state", func(t *testing.T) {
+		assert.Nil(t, LoopIterationHistory(nil))
+	})
+
+	t.Run("no completed iterations", func(t *testing.T) {
+		assert.Nil(t, LoopIterationHistory(&domain.LoopState{CurrentIteration: 1}))
+	})
+
+	t.Run("preserves order and counts", func(t *testing.T) {
+		state := &domain.LoopState{
+			CompletedIterations: []domain.IterationResult{
+				{Iteration: 1, FilesChanged: []string{"a.go", "b.go"}, Duration: 2 * time.Second},
+				{Iteration: 2, Duration: time.Second, Error: "tests failed", StepResults: []domain.StepResult{{StepName: "verify", Output: "2 failures"}}},
+				{Iteration: 3, FilesChanged: []string{"c.go"}, ExitSignal: true},
+			},
+		}
+
+		history := LoopIterationHistory(state)
+
+		require.Len(t, history, 3)
+		assert.Equal(t, []int{1, 2, 3}, []int{history[0].Number, history[1].Number, history[2].Number})
+		assert.Len(t, history[0].FilesChanged, 2)
+		assert.Equal(t, 2*time.Second, history[0].Duration)
+		assert.Empty(t, history[1].FilesChanged)
+		assert.Equal(t, "tests failed", history[1].Error)
+		assert.False(t, history[1].Success)
+		assert.Equal(t, "verify: 2 failures", history[1].Summary)
+		assert.Equal(t, []string{"c.go"}, history[2].FilesChanged)
+		assert.True(t, history[2].ExitSignal)
+	})
+}
@@ -2,7 +2,10 @@ package steps
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/ignore"
 )
 
 // MockInnerStepRunner implements InnerStepRunner for testing.
@@ -98,7 +102,7 @@ type MockScratchpad struct {
 	WriteCalls int
 }
 
-func (m *MockScratchpad) Read() (*ScratchpadData, error) {
+func (m *MockScratchpad) Read(_ context.Context) (*ScratchpadData, error) {
 	m.ReadCalls++
 	if m.ReadError != nil {
 		return nil, m.ReadError
@@ -109,7 +113,7 @@ func (m *MockScratchpad) Read() (*ScratchpadData, error) {
 	return m.Data, nil
 }
 
-func (m *MockScratchpad) Write(data *ScratchpadData) error {
+func (m *MockScratchpad) Write(_ context.Context, data *ScratchpadData) error {
 	m.WriteCalls++
 	if m.WriteError != nil {
 		return m.WriteError
@@ -118,12 +122,12 @@ func (m *MockScratchpad) Write(data *ScratchpadData) error {
 	return nil
 }
 
-func (m *MockScratchpad) AppendIteration(result *IterationSummary) error {
+func (m *MockScratchpad) AppendIteration(ctx context.Context, result *IterationSummary) error {
 	if m.Data == nil {
 		m.Data = &ScratchpadData{}
 	}
 	m.Data.Iterations = append(m.Data.Iterations, *result)
-	return m.Write(m.Data)
+	return m.Write(ctx, m.Data)
 }
 
 func TestLoopExecutor_CountBasedExit(t *testing.T) {
@@ -339,6 +343,77 @@ func TestLoopExecutor_ResumeFromCheckpoint(t *testing.T) {
 	assert.Equal(t, 5, result.Metadata["iterations_completed"])
 }
 
+func TestLoopExecutor_ForceFreshIgnoresCheckpoint(t *testing.T) {
+	ctx := context.Background()
+
+	// Pre-populate state as if three iterations had already run
+	mockStore := &MockLoopStateStore{
+		LoadState: &domain.LoopState{
+			StepName:         "test_loop",
+			CurrentIteration: 3,
+			MaxIterations:    5,
+			CompletedIterations: []domain.IterationResult{
+				{Iteration: 1},
+				{Iteration: 2},
+				{Iteration: 3},
+			},
+		},
+	}
+	mockRunner := &MockInnerStepRunner{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopForceFresh(true))
+
+	task := &domain.Task{ID: "task-123"}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 5,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Zero(t, mockStore.LoadCalls)
+	assert.Equal(t, 5, mockRunner.ExecuteCalls) // All five iterations ran again
+	assert.Equal(t, 5, result.Metadata["iterations_completed"])
+	require.NotNil(t, mockStore.SavedState)
+	assert.Len(t, mockStore.SavedState.CompletedIterations, 5)
+	assert.Equal(t, 1, mockStore.SavedState.CompletedIterations[0].Iteration)
+}
+
+func TestLoopExecutor_FreshConfigIgnoresCheckpoint(t *testing.T) {
+	mockStore := &MockLoopStateStore{
+		LoadState: &domain.LoopState{StepName: "test_loop", CurrentIteration: 3, MaxIterations: 3},
+	}
+	mockRunner := &MockInnerStepRunner{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore)
+
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 3,
+			"fresh":          true,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, step)
+
+	require.NoError(t, err)
+	assert.Zero(t, mockStore.LoadCalls)
+	assert.Equal(t, 3, mockRunner.ExecuteCalls)
+	assert.Equal(t, 3, result.Metadata["iterations_completed"])
+}
+
 func TestLoopExecutor_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
@@ -409,6 +484,61 @@ func TestLoopExecutor_ScratchpadIntegration(t *testing.T) {
 	assert.Len(t, mockScratchpad.Data.Iterations, 2)
 }
 
+func TestLoopExecutor_Execute_StoreBackedScratchpad(t *testing.T) {
+	ctx := context.Background()
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, Output: "iteration 1 output"},
+			{Status: constants.StepStatusSuccess, Output: "iteration 2 output"},
+		},
+	}
+	store := newMemScratchpadStore()
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopScratchpadStore(store),
+	)
+
+	task := &domain.Task{ID: "task-123", WorkspaceID: "ws"}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations":     2,
+			"scratchpad_file":    "progress.json",
+			"scratchpad_backend": "store",
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.StepStatusSuccess, result.Status)
+	assert.NotContains(t, task.Metadata, "scratchpad_setup_error")
+
+	data, err := NewStoreBackedScratchpad(store, "ws", "task-123", "progress.json", zerolog.Nop()).Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "task-123", data.TaskID)
+	assert.Equal(t, "test_loop", data.LoopName)
+	assert.Len(t, data.Iterations, 2)
+}
+
+func TestLoopExecutor_ParseLoopConfig_ScratchpadBackend(t *testing.T) {
+	executor := &LoopExecutor{}
+
+	for _, backend := range []string{"", ScratchpadBackendFile, ScratchpadBackendStore} {
+		cfg, err := executor.parseLoopConfig(map[string]any{"scratchpad_backend": backend, "allow_empty": true})
+		require.NoError(t, err, "backend %q", backend)
+		assert.Equal(t, backend, cfg.ScratchpadBackend)
+	}
+
+	_, err := executor.parseLoopConfig(map[string]any{"scratchpad_backend": "redis"})
+	require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+	assert.Contains(t, err.Error(), `"redis"`)
+}
+
 func TestLoopExecutor_ParseLoopConfig(t *testing.T) {
 	executor := &LoopExecutor{}
 
@@ -472,6 +602,67 @@ func TestLoopExecutor_ParseLoopConfig_FloatConversion(t *testing.T) {
 	assert.Equal(t, 5, cfg.CircuitBreaker.ConsecutiveErrors)
 }
 
+func TestLoopExecutor_ParseLoopConfig_InvalidConditions(t *testing.T) {
+	executor := &LoopExecutor{}
+
+	tests := []struct {
+		name    string
+		config  map[string]any
+		wantMsg string
+	}{
+		{
+			name:    "misspelled until condition",
+			config:  map[string]any{"until": "vaidation_passed"},
+			wantMsg: `unknown until condition "vaidation_passed"`,
+		},
+		{
+			name:    "blank exit condition",
+			config:  map[string]any{"until_signal": true, "exit_conditions": []any{"all tests passing", "  "}},
+			wantMsg: "exit_conditions[1] cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := executor.parseLoopConfig(tc.config)
+			require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+			assert.Contains(t, err.Error(), tc.wantMsg)
+		})
+	}
+
+	t.Run("error lists valid conditions", func(t *testing.T) {
+		_, err := executor.parseLoopConfig(map[string]any{"until": "vaidation_passed"})
+		require.Error(t, err)
+		for _, name := range BuiltinConditionNames() {
+			assert.Contains(t, err.Error(), name)
+		}
+	})
+}
+
+func TestLoopExecutor_Execute_UnknownUntilCondition(t *testing.T) {
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{{Status: constants.StepStatusSuccess}},
+	}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{}, WithLoopLogger(zerolog.Nop()))
+
+	task := &domain.Task{ID: "task-123", CurrentStep: 0}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 5,
+			"until":          "vaidation_passed",
+			"steps":          []any{map[string]any{"name": "inner", "type": "ai"}},
+		},
+	}
+
+	result, err := executor.Execute(context.Background(), task, step)
+
+	require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+	assert.Nil(t, result)
+	assert.Equal(t, 0, mockRunner.ExecuteCalls, "loop must not run with an unknown condition")
+}
+
 func TestLoopExecutor_Type(t *testing.T) {
 	executor := &LoopExecutor{}
 	assert.Equal(t, domain.StepTypeLoop, executor.Type())
@@ -517,6 +708,86 @@ func TestLoopExecutor_MultipleInnerSteps(t *testing.T) {
 	assert.Equal(t, 4, mockRunner.ExecuteCalls) // 2 iterations * 2 steps
 }
 
+func TestLoopExecutor_AbortLoop(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	// Iteration 1 succeeds; iteration 2's first step aborts, so its second
+	// step and iteration 3 never run.
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"a.go"}},
+			{Status: constants.StepStatusSuccess},
+			{Status: constants.StepStatusSuccess, Metadata: map[string]any{"abort_loop": true}},
+		},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(logger))
+
+	task := &domain.Task{
+		ID:          "task-123",
+		CurrentStep: 0,
+	}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 3,
+			"steps": []any{
+				map[string]any{"name": "fix", "type": "ai"},
+				map[string]any{"name": "validate", "type": "validation"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.StepStatusFailed, result.Status)
+	assert.Equal(t, "aborted", result.Metadata["exit_reason"])
+	assert.Equal(t, 2, result.Metadata["iterations_completed"])
+	assert.Contains(t, result.Error, "aborted")
+	assert.Equal(t, 3, mockRunner.ExecuteCalls)
+
+	var state domain.LoopState
+	require.NoError(t, json.Unmarshal([]byte(result.Metadata["loop_state"].(string)), &state))
+	require.Len(t, state.CompletedIterations, 2)
+	assert.True(t, state.CompletedIterations[1].Aborted)
+	assert.Len(t, state.CompletedIterations[1].StepResults, 1)
+}
+
+func TestLoopExecutor_AbortLoopFalseIgnored(t *testing.T) {
+	ctx := context.Background()
+
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, Metadata: map[string]any{"abort_loop": false}},
+			{Status: constants.StepStatusSuccess, Metadata: map[string]any{"abort_loop": "true"}},
+		},
+	}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{}, WithLoopLogger(zerolog.Nop()))
+
+	task := &domain.Task{ID: "task-123"}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 2,
+			"steps": []any{
+				map[string]any{"name": "fix", "type": "ai"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.StepStatusSuccess, result.Status)
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+}
+
 func TestLoopExecutor_UntilCondition(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.Nop()
@@ -595,6 +866,58 @@ func TestLoopExecutor_FilesChangedAccumulation(t *testing.T) {
 	assert.Contains(t, result.FilesChanged, "file3.go")
 }
 
+func TestLoopExecutor_OutputSummaryJSON(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"file1.go", "file2.go"}},
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"file2.go", "file3.go"}},
+		},
+	}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{}, WithLoopLogger(logger))
+
+	task := &domain.Task{ID: "task-123"}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 2,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+	require.NoError(t, err)
+
+	var summary LoopOutput
+	require.NoError(t, json.Unmarshal([]byte(result.Output), &summary))
+	assert.Equal(t, 2, summary.Iterations)
+	assert.Equal(t, "max_iterations_reached", summary.ExitReason)
+	assert.Equal(t, []string{"file1.go", "file2.go", "file3.go"}, summary.FilesChanged)
+	assert.Zero(t, summary.FilesOmitted)
+}
+
+func TestBuildLoopOutput_BoundsFileList(t *testing.T) {
+	files := make([]string, 0, maxLoopOutputFiles+10)
+	for i := range maxLoopOutputFiles + 10 {
+		files = append(files, fmt.Sprintf("pkg/file%03d.go", i))
+	}
+
+	output := buildLoopOutput(&domain.LoopState{CurrentIteration: 3, ExitReason: "exit_signal"}, append(files, files...))
+
+	var summary LoopOutput
+	require.NoError(t, json.Unmarshal([]byte(output), &summary))
+	assert.Equal(t, 3, summary.Iterations)
+	assert.Equal(t, "exit_signal", summary.ExitReason)
+	assert.Len(t, summary.FilesChanged, maxLoopOutputFiles)
+	assert.Equal(t, 10, summary.FilesOmitted)
+}
+
 func TestLoopExecutor_CheckpointSaving(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.Nop()
@@ -637,7 +960,10 @@ func TestLoopExecutor_CheckpointSaving(t *testing.T) {
 
 func TestLoopExecutor_EmptyConfig(t *testing.T) {
 	executor := &LoopExecutor{}
-	cfg, err := executor.parseLoopConfig(nil)
+	_, err := executor.parseLoopConfig(nil)
+	require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+
+	cfg, err := executor.parseLoopConfig(map[string]any{"allow_empty": true})
 	require.NoError(t, err)
 
 	assert.NotNil(t, cfg)
@@ -646,6 +972,47 @@ func TestLoopExecutor_EmptyConfig(t *testing.T) {
 	assert.False(t, cfg.UntilSignal)
 }
 
+func TestLoopExecutor_EmptyStepsRequireAllowEmpty(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]any
+	}{
+		{name: "bounded", config: map[string]any{"max_iterations": 2, "steps": []any{}}},
+		{name: "unlimited", config: map[string]any{"until_signal": true}},
+		{name: "auto", config: map[string]any{"max_iterations": "auto"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{}, WithLoopLogger(zerolog.Nop()))
+			task := &domain.Task{ID: "task-123"}
+			step := &domain.StepDefinition{Name: "test_loop", Type: domain.StepTypeLoop, Config: tc.config}
+
+			_, err := executor.Execute(context.Background(), task, step)
+
+			require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+			assert.Contains(t, err.Error(), "no inner steps")
+			assert.Contains(t, err.Error(), "allow_empty: true")
+		})
+	}
+
+	t.Run("allowed explicitly", func(t *testing.T) {
+		runner := &MockInnerStepRunner{}
+		executor := NewLoopExecutor(runner, &MockLoopStateStore{}, WithLoopLogger(zerolog.Nop()))
+		task := &domain.Task{ID: "task-123"}
+		step := &domain.StepDefinition{Name: "test_loop", Type: domain.StepTypeLoop, Config: map[string]any{
+			"max_iterations": 2,
+			"steps":          []any{},
+			"allow_empty":    true,
+		}}
+
+		_, err := executor.Execute(context.Background(), task, step)
+
+		require.NoError(t, err)
+		assert.Empty(t, runner.ExecuteCalls)
+	})
+}
+
 func TestLoopExecutor_Duration(t *testing.T) {
 	ctx := context.Background()
 	logger := zerolog.Nop()
@@ -718,6 +1085,44 @@ func TestLoopExecutor_StagnationResetOnChange(t *testing.T) {
 	assert.Equal(t, "circuit_breaker_stagnation", result.Metadata["exit_reason"])
 }
 
+func TestLoopExecutor_IgnoredFilesDoNotResetStagnation(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, ignore.FileName), []byte("*.pb.go\nvendor/\n"), 0o600))
+
+	// Only generated or vendored files change, so every iteration is stagnant
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"api/service.pb.go"}},
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"vendor/lib/lib.go"}},
+			{Status: constants.StepStatusSuccess, FilesChanged: []string{"api/service.pb.go", "vendor/x.go"}},
+		},
+	}
+
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopWorkDir(workDir))
+
+	task := &domain.Task{ID: "task-123", CurrentStep: 0}
+	step := &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 10,
+			"circuit_breaker": map[string]any{
+				"stagnation_iterations": 3,
+			},
+			"steps": []any{map[string]any{"name": "inner", "type": "ai"}},
+		},
+	}
+
+	result, err := executor.Execute(ctx, task, step)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockRunner.ExecuteCalls)
+	assert.Equal(t, "circuit_breaker_stagnation", result.Metadata["exit_reason"])
+	assert.Empty(t, result.FilesChanged)
+}
+
 func TestLoopState_Fields(t *testing.T) {
 	now := time.Now()
 	state := domain.LoopState{
@@ -2254,11 +2659,12 @@ func TestLoopExecutor_ParseLoopConfigValid(t *testing.T) {
 				"consecutive_errors":    3,
 				"stagnation_iterations": 2,
 			},
-			"steps": []any{}, // Empty steps - should complete quickly
+			"steps":       []any{}, // Empty steps - should complete quickly
+			"allow_empty": true,
 		},
 	}
 
-	// With empty steps and max_iterations: 2, should complete immediately
+	// With explicitly allowed empty steps and max_iterations: 2, should complete immediately
 	_, err := executor.Execute(ctx, task, step)
 	require.NoError(t, err)
 }
@@ -2281,6 +2687,7 @@ func TestLoopExecutor_ParseLoopConfigNil(t *testing.T) {
 		// This tests the default behavior path while avoiding the 5s context timeout
 		Config: map[string]any{
 			"max_iterations": 1,
+			"allow_empty":    true,
 		},
 	}
 
@@ -2345,3 +2752,123 @@ func (s *failThenSucceedStore) LoadLoopState(_ context.Context, _ *domain.Task,
 	// Return nil state to indicate no saved state exists (not an error condition for resumption)
 	return nil, nil //nolint:nilnil // nil state means no state to resume from, which is valid
 }
+
+func noOpLoopStep(maxIterations, maxNoOps int) *domain.StepDefinition {
+	return &domain.StepDefinition{
+		Name: "test_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": maxIterations,
+			"no_op_signal":   "NOTHING_TO_DO",
+			"max_noops":      maxNoOps,
+			"steps": []any{
+				map[string]any{"name": "inner", "type": "ai"},
+			},
+		},
+	}
+}
+
+func TestLoopExecutor_NoOpSignal_ExitsAtThreshold(t *testing.T) {
+	ctx := context.Background()
+
+	// Iterations touch files but report there was nothing to do
+	noOp := &domain.StepResult{Status: constants.StepStatusSuccess, Output: "checked\nNOTHING_TO_DO", FilesChanged: []string{"CHANGELOG.md"}}
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{noOp, noOp, noOp},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, noOpLoopStep(10, 3))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockRunner.ExecuteCalls)
+	assert.Equal(t, "no_ops_reached", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_NoOpSignal_StreakResetsOnWork(t *testing.T) {
+	ctx := context.Background()
+
+	noOp := &domain.StepResult{Status: constants.StepStatusSuccess, Output: "NOTHING_TO_DO"}
+	work := &domain.StepResult{Status: constants.StepStatusSuccess, Output: "fixed lint", FilesChanged: []string{"main.go"}}
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{noOp, noOp, work, noOp, noOp, noOp},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, noOpLoopStep(10, 3))
+
+	require.NoError(t, err)
+	assert.Equal(t, 6, mockRunner.ExecuteCalls)
+	assert.Equal(t, "no_ops_reached", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_NoOpSignal_StreakPersisted(t *testing.T) {
+	ctx := context.Background()
+
+	noOp := &domain.StepResult{Status: constants.StepStatusSuccess, Output: "NOTHING_TO_DO"}
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{noOp, noOp},
+	}
+	mockStore := &MockLoopStateStore{}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, noOpLoopStep(2, 5))
+
+	require.NoError(t, err)
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+	require.NotNil(t, mockStore.SavedState)
+	assert.Equal(t, 2, mockStore.SavedState.NoOpCount)
+	assert.True(t, mockStore.SavedState.CompletedIterations[1].NoOp)
+}
+
+func TestLoopExecutor_NoOpSignal_ResumesStreak(t *testing.T) {
+	ctx := context.Background()
+
+	mockRunner := &MockInnerStepRunner{
+		Results: []*domain.StepResult{
+			{Status: constants.StepStatusSuccess, Output: "NOTHING_TO_DO"},
+		},
+	}
+	mockStore := &MockLoopStateStore{
+		LoadState: &domain.LoopState{
+			StepName:         "test_loop",
+			CurrentIteration: 2,
+			NoOpCount:        2,
+		},
+	}
+
+	executor := NewLoopExecutor(mockRunner, mockStore, WithLoopLogger(zerolog.Nop()))
+
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, noOpLoopStep(10, 3))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockRunner.ExecuteCalls)
+	assert.Equal(t, "no_ops_reached", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_NoOpSignal_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]any
+		errMsg string
+	}{
+		{"negative max_noops", map[string]any{"no_op_signal": "NOOP", "max_noops": -1}, "max_noops cannot be negative"},
+		{"signal without threshold", map[string]any{"no_op_signal": "NOOP"}, "must be set together"},
+		{"threshold without signal", map[string]any{"max_noops": 2}, "must be set together"},
+	}
+
+	executor := NewLoopExecutor(nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := executor.parseLoopConfig(tt.config)
+
+			require.ErrorIs(t, err, atlaserrors.ErrLoopConfigInvalid)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
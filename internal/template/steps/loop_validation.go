@@ -0,0 +1,165 @@
+// Package steps provides step execution implementations for the ATLAS task engine.
+//
+// This file lets loop until-conditions read validation artifacts. With a
+// ValidationArtifactReader configured, the validation_passed and
+// all_tests_pass conditions are decided from the error counts in the latest
+// validation.N.json artifact rather than from step statuses alone. When no
+// artifact is available the status-based check is used.
+package steps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	"github.com/mrz1836/atlas/internal/validation"
+)
+
+// validationArtifactBase is the base name validation results are saved under;
+// versioned copies are named validation.1.json, validation.2.json, and so on.
+const validationArtifactBase = "validation"
+
+// ValidationArtifactReader loads the most recent validation result of a task.
+// It returns nil and no error when the task has no validation artifact.
+type ValidationArtifactReader interface {
+	LatestValidationResult(ctx context.Context, task *domain.Task) (*validation.PipelineResult, error)
+}
+
+// ValidationArtifactStore abstracts the task store's artifact methods used by
+// StoreValidationArtifactReader. task.Store satisfies this interface.
+type ValidationArtifactStore interface {
+	// ListArtifacts lists all artifact files for the task.
+	ListArtifacts(ctx context.Context, workspaceName, taskID string) ([]string, error)
+
+	// GetArtifact retrieves an artifact file.
+	GetArtifact(ctx context.Context, workspaceName, taskID, filename string) ([]byte, error)
+}
+
+// StoreValidationArtifactReader reads validation artifacts from the task store.
+type StoreValidationArtifactReader struct {
+	store ValidationArtifactStore
+}
+
+// NewStoreValidationArtifactReader creates a reader over the task store.
+func NewStoreValidationArtifactReader(store ValidationArtifactStore) *StoreValidationArtifactReader {
+	return &StoreValidationArtifactReader{store: store}
+}
+
+// LatestValidationResult parses the highest-numbered validation artifact.
+func (r *StoreValidationArtifactReader) LatestValidationResult(ctx context.Context, task *domain.Task) (*validation.PipelineResult, error) {
+	names, err := r.store.ListArtifacts(ctx, task.WorkspaceID, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	latest, latestVersion := "", 0
+	for _, name := range names {
+		if version := validationArtifactVersion(name); version > latestVersion {
+			latest, latestVersion = name, version
+		}
+	}
+	if latest == "" {
+		return nil, nil //nolint:nilnil // No artifact is not an error; callers fall back to step statuses
+	}
+
+	data, err := r.store.GetArtifact(ctx, task.WorkspaceID, task.ID, latest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation artifact '%s': %w", latest, err)
+	}
+
+	var result validation.PipelineResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse validation artifact '%s': %w", latest, err)
+	}
+	return &result, nil
+}
+
+// validationArtifactVersion returns N for a validation.N.json artifact name
+// and 0 for any other name.
+func validationArtifactVersion(name string) int {
+	middle, ok := strings.CutPrefix(name, validationArtifactBase+".")
+	if !ok {
+		return 0
+	}
+	middle, ok = strings.CutSuffix(middle, ".json")
+	if !ok {
+		return 0
+	}
+	version, err := strconv.Atoi(middle)
+	if err != nil || version < 1 {
+		return 0
+	}
+	return version
+}
+
+// WithLoopValidationArtifacts sets the reader used to decide validation
+// until-conditions from the latest validation artifact.
+func WithLoopValidationArtifacts(r ValidationArtifactReader) LoopExecutorOption {
+	return func(e *LoopExecutor) { e.valReader = r }
+}
+
+// ValidationCounts summarizes the failures recorded in a validation artifact.
+type ValidationCounts struct {
+	// Errors is the number of failed validation commands of any kind.
+	Errors int
+
+	// TestErrors is the number of failed test commands.
+	TestErrors int
+}
+
+// CountValidationErrors counts the failed commands in a validation result.
+func CountValidationErrors(result *validation.PipelineResult) ValidationCounts {
+	var counts ValidationCounts
+	for _, r := range result.AllResults() {
+		if !r.Success {
+			counts.Errors++
+		}
+	}
+	for _, r := range result.TestResults {
+		if !r.Success {
+			counts.TestErrors++
+		}
+	}
+	return counts
+}
+
+// artifactConditions are the built-in conditions that can be decided from
+// validation error counts.
+//
+//nolint:gochecknoglobals // Read-only lookup table for artifact-aware conditions
+var artifactConditions = map[string]func(ValidationCounts) bool{
+	"validation_passed": func(c ValidationCounts) bool { return c.Errors == 0 },
+	"all_tests_pass":    func(c ValidationCounts) bool { return c.TestErrors == 0 },
+}
+
+// evaluateUntil evaluates the loop's until-condition, consulting the latest
+// validation artifact when the condition depends on validation results and a
+// reader is configured. Without a usable artifact it falls back to
+// EvaluateBuiltinCondition.
+func (e *LoopExecutor) evaluateUntil(ctx context.Context, condition string, task *domain.Task) bool {
+	check, artifactAware := artifactConditions[condition]
+	if !artifactAware || e.valReader == nil {
+		return EvaluateBuiltinCondition(condition, task)
+	}
+
+	result, err := e.valReader.LatestValidationResult(ctx, task)
+	if err != nil {
+		e.logger.Warn().Err(err).Str("condition", condition).
+			Msg("failed to read validation artifact, using step status")
+		return EvaluateBuiltinCondition(condition, task)
+	}
+	if result == nil {
+		return EvaluateBuiltinCondition(condition, task)
+	}
+
+	counts := CountValidationErrors(result)
+	e.logger.Debug().
+		Str("condition", condition).
+		Int("validation_errors", counts.Errors).
+		Int("test_errors", counts.TestErrors).
+		Msg("evaluated until condition from validation artifact")
+	return check(counts)
+}
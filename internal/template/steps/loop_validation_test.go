@@ -0,0 +1,231 @@
+package steps
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/validation"
+)
+
+// MockValidationArtifactReader implements ValidationArtifactReader for testing.
+// Each call returns the next of Results, repeating the last one once exhausted.
+type MockValidationArtifactReader struct {
+	Results []*validation.PipelineResult
+	Err     error
+	Calls   int
+}
+
+func (m *MockValidationArtifactReader) LatestValidationResult(_ context.Context, _ *domain.Task) (*validation.PipelineResult, error) {
+	m.Calls++
+	if m.Err != nil || len(m.Results) == 0 {
+		return nil, m.Err
+	}
+	return m.Results[min(m.Calls, len(m.Results))-1], nil
+}
+
+var (
+	lintFailing = &validation.PipelineResult{
+		LintResults: []validation.Result{{Command: "golangci-lint run", Success: false}},
+		TestResults: []validation.Result{{Command: "go test ./...", Success: true}},
+	}
+	allPassing = &validation.PipelineResult{
+		LintResults: []validation.Result{{Command: "golangci-lint run", Success: true}},
+		TestResults: []validation.Result{{Command: "go test ./...", Success: true}},
+	}
+)
+
+// mockArtifactStore implements ValidationArtifactStore for testing.
+type mockArtifactStore struct {
+	artifacts map[string][]byte
+}
+
+func (m *mockArtifactStore) ListArtifacts(_ context.Context, _, _ string) ([]string, error) {
+	names := make([]string, 0, len(m.artifacts))
+	for name := range m.artifacts {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (m *mockArtifactStore) GetArtifact(_ context.Context, _, _, filename string) ([]byte, error) {
+	data, ok := m.artifacts[filename]
+	if !ok {
+		return nil, atlaserrors.ErrArtifactNotFound
+	}
+	return data, nil
+}
+
+func untilLoopStep(condition string) *domain.StepDefinition {
+	return &domain.StepDefinition{
+		Name: "fix_loop",
+		Type: domain.StepTypeLoop,
+		Config: map[string]any{
+			"max_iterations": 3,
+			"until":          condition,
+			"steps": []any{
+				map[string]any{"name": "fix", "type": "ai"},
+			},
+		},
+	}
+}
+
+func TestLoopExecutor_Until_ValidationArtifactZeroErrors(t *testing.T) {
+	ctx := context.Background()
+
+	// The first iteration fixes the lint error, leaving zero errors in the artifact
+	reader := &MockValidationArtifactReader{Results: []*validation.PipelineResult{lintFailing, allPassing}}
+	mockRunner := &MockInnerStepRunner{}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopValidationArtifacts(reader))
+
+	// No validation step result exists, so a status-based check would keep looping
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, untilLoopStep("validation_passed"))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockRunner.ExecuteCalls)
+	assert.Equal(t, "condition_met", result.Metadata["exit_reason"])
+	assert.Equal(t, 2, reader.Calls)
+}
+
+func TestLoopExecutor_Until_ValidationArtifactWithErrors(t *testing.T) {
+	ctx := context.Background()
+
+	reader := &MockValidationArtifactReader{Results: []*validation.PipelineResult{lintFailing}}
+	mockRunner := &MockInnerStepRunner{}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopValidationArtifacts(reader))
+
+	// The task's step status claims success, but the artifact still reports a lint error
+	task := &domain.Task{
+		ID:          "task-123",
+		StepResults: []domain.StepResult{{StepName: "validate", Status: constants.StepStatusSuccess}},
+	}
+	result, err := executor.Execute(ctx, task, untilLoopStep("validation_passed"))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockRunner.ExecuteCalls)
+	assert.Equal(t, "max_iterations_reached", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_Until_AllTestsPassIgnoresLintErrors(t *testing.T) {
+	ctx := context.Background()
+
+	reader := &MockValidationArtifactReader{Results: []*validation.PipelineResult{lintFailing}}
+	mockRunner := &MockInnerStepRunner{}
+	executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopValidationArtifacts(reader))
+
+	// Tests already pass in the artifact, so the loop exits before iterating
+	result, err := executor.Execute(ctx, &domain.Task{ID: "task-123"}, untilLoopStep("all_tests_pass"))
+
+	require.NoError(t, err)
+	assert.Zero(t, mockRunner.ExecuteCalls)
+	assert.Equal(t, "condition_met", result.Metadata["exit_reason"])
+}
+
+func TestLoopExecutor_Until_FallsBackToStepStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		reader *MockValidationArtifactReader
+	}{
+		{name: "no artifact", reader: &MockValidationArtifactReader{}},
+		{name: "reader error", reader: &MockValidationArtifactReader{Err: atlaserrors.ErrArtifactNotFound}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRunner := &MockInnerStepRunner{}
+			executor := NewLoopExecutor(mockRunner, &MockLoopStateStore{},
+				WithLoopLogger(zerolog.Nop()), WithLoopValidationArtifacts(tt.reader))
+
+			task := &domain.Task{
+				ID:          "task-123",
+				StepResults: []domain.StepResult{{StepName: "validate", Status: constants.StepStatusSuccess}},
+			}
+			result, err := executor.Execute(context.Background(), task, untilLoopStep("validation_passed"))
+
+			require.NoError(t, err)
+			assert.Zero(t, mockRunner.ExecuteCalls)
+			assert.Equal(t, "condition_met", result.Metadata["exit_reason"])
+			assert.Equal(t, 1, tt.reader.Calls)
+		})
+	}
+}
+
+func TestLoopExecutor_Until_NoChangesSkipsArtifact(t *testing.T) {
+	reader := &MockValidationArtifactReader{}
+	executor := NewLoopExecutor(&MockInnerStepRunner{}, &MockLoopStateStore{},
+		WithLoopLogger(zerolog.Nop()), WithLoopValidationArtifacts(reader))
+
+	result, err := executor.Execute(context.Background(), &domain.Task{ID: "task-123"}, untilLoopStep("no_changes"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "condition_met", result.Metadata["exit_reason"])
+	assert.Zero(t, reader.Calls)
+}
+
+func TestCountValidationErrors(t *testing.T) {
+	counts := CountValidationErrors(&validation.PipelineResult{
+		FormatResults:    []validation.Result{{Success: true}},
+		LintResults:      []validation.Result{{Success: false}, {Success: false}},
+		TestResults:      []validation.Result{{Success: false}, {Success: true}},
+		PreCommitResults: []validation.Result{{Success: true}},
+	})
+
+	assert.Equal(t, ValidationCounts{Errors: 3, TestErrors: 1}, counts)
+}
+
+func TestStoreValidationArtifactReader_LatestValidationResult(t *testing.T) {
+	ctx := context.Background()
+	task := &domain.Task{ID: "task-123", WorkspaceID: "ws"}
+
+	encode := func(success bool) []byte {
+		data, err := json.Marshal(&validation.PipelineResult{
+			Success:     success,
+			TestResults: []validation.Result{{Command: "go test", Success: success}},
+		})
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("picks the highest version", func(t *testing.T) {
+		store := &mockArtifactStore{artifacts: map[string][]byte{
+			"validation.2.json":  encode(false),
+			"validation.10.json": encode(true),
+			"validation.9.json":  encode(false),
+			"ci-result.json":     []byte("{}"),
+			"validation.x.json":  []byte("not json"),
+		}}
+
+		result, err := NewStoreValidationArtifactReader(store).LatestValidationResult(ctx, task)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.Success)
+	})
+
+	t.Run("no validation artifact", func(t *testing.T) {
+		store := &mockArtifactStore{artifacts: map[string][]byte{"ci-result.json": []byte("{}")}}
+
+		result, err := NewStoreValidationArtifactReader(store).LatestValidationResult(ctx, task)
+
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("corrupt artifact", func(t *testing.T) {
+		store := &mockArtifactStore{artifacts: map[string][]byte{"validation.1.json": []byte("{")}}
+
+		_, err := NewStoreValidationArtifactReader(store).LatestValidationResult(ctx, task)
+
+		require.Error(t, err)
+	})
+}
@@ -2,25 +2,50 @@
 package steps
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/rs/zerolog"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// Scratchpad backends selectable via the loop's scratchpad_backend config.
+const (
+	// ScratchpadBackendFile stores the scratchpad as a file in the artifact directory (default).
+	ScratchpadBackendFile = "file"
+
+	// ScratchpadBackendStore stores the scratchpad as a task artifact via the task store,
+	// so it survives loss of the worktree or container filesystem.
+	ScratchpadBackendStore = "store"
 )
 
 // ScratchpadWriter is the interface for cross-iteration memory.
-// This interface enables mocking in tests.
+// A loop reads the scratchpad to give each iteration context from earlier ones
+// and appends a summary after each iteration completes.
+//
+// Implementations:
+//   - FileScratchpad persists to a JSON file on disk.
+//   - StoreBackedScratchpad persists to a task artifact via the task store.
+//
+// Implementations are used by a single loop and need not be safe for
+// concurrent use. This interface enables mocking in tests.
 type ScratchpadWriter interface {
-	// Read returns the current scratchpad data, or empty data if none exists.
-	Read() (*ScratchpadData, error)
+	// Read returns the current scratchpad data.
+	// Returns empty data (not an error) if nothing has been written yet.
+	Read(ctx context.Context) (*ScratchpadData, error)
 
 	// Write saves the scratchpad data, overwriting any existing content.
-	Write(data *ScratchpadData) error
+	Write(ctx context.Context, data *ScratchpadData) error
 
-	// AppendIteration adds an iteration summary to the scratchpad.
-	AppendIteration(result *IterationSummary) error
+	// AppendIteration adds an iteration summary to the scratchpad,
+	// preserving all existing data.
+	AppendIteration(ctx context.Context, result *IterationSummary) error
 }
 
 // ScratchpadData is the JSON structure for scratchpad files.
@@ -50,6 +75,9 @@ type IterationSummary struct {
 	// CompletedAt is when the iteration finished.
 	CompletedAt time.Time `json:"completed_at"`
 
+	// Duration is how long the iteration took.
+	Duration time.Duration `json:"duration,omitempty"`
+
 	// FilesChanged lists files modified during this iteration.
 	FilesChanged []string `json:"files_changed"`
 
@@ -87,7 +115,7 @@ func (s *FileScratchpad) Path() string {
 
 // Read returns the current scratchpad data from the file.
 // Returns empty data if the file doesn't exist.
-func (s *FileScratchpad) Read() (*ScratchpadData, error) {
+func (s *FileScratchpad) Read(_ context.Context) (*ScratchpadData, error) {
 	content, err := os.ReadFile(s.path)
 	if os.IsNotExist(err) {
 		s.logger.Debug().Str("path", s.path).Msg("scratchpad file does not exist, returning empty data")
@@ -112,7 +140,7 @@ func (s *FileScratchpad) Read() (*ScratchpadData, error) {
 }
 
 // Write saves the scratchpad data to the file.
-func (s *FileScratchpad) Write(data *ScratchpadData) error {
+func (s *FileScratchpad) Write(_ context.Context, data *ScratchpadData) error {
 	// Ensure parent directory exists
 	dir := filepath.Dir(s.path)
 	if err := os.MkdirAll(dir, 0o750); err != nil {
@@ -139,18 +167,18 @@ func (s *FileScratchpad) Write(data *ScratchpadData) error {
 }
 
 // AppendIteration adds an iteration summary to the scratchpad.
-func (s *FileScratchpad) AppendIteration(result *IterationSummary) error {
-	data, err := s.Read()
+func (s *FileScratchpad) AppendIteration(ctx context.Context, result *IterationSummary) error {
+	data, err := s.Read(ctx)
 	if err != nil {
 		return err
 	}
 
 	data.Iterations = append(data.Iterations, *result)
-	return s.Write(data)
+	return s.Write(ctx, data)
 }
 
 // Initialize sets up the scratchpad with initial metadata.
-func (s *FileScratchpad) Initialize(taskID, loopName string) error {
+func (s *FileScratchpad) Initialize(ctx context.Context, taskID, loopName string) error {
 	data := &ScratchpadData{
 		TaskID:     taskID,
 		LoopName:   loopName,
@@ -158,5 +186,92 @@ func (s *FileScratchpad) Initialize(taskID, loopName string) error {
 		Iterations: []IterationSummary{},
 		Metadata:   make(map[string]any),
 	}
-	return s.Write(data)
+	return s.Write(ctx, data)
+}
+
+// ScratchpadStore abstracts the task store's artifact methods used by
+// StoreBackedScratchpad. task.Store satisfies this interface.
+type ScratchpadStore interface {
+	// SaveArtifact saves an artifact file for the task.
+	SaveArtifact(ctx context.Context, workspaceName, taskID, filename string, data []byte) error
+
+	// GetArtifact retrieves an artifact file.
+	// Returns ErrArtifactNotFound if the artifact doesn't exist.
+	GetArtifact(ctx context.Context, workspaceName, taskID, filename string) ([]byte, error)
+}
+
+// StoreBackedScratchpad implements ScratchpadWriter using task artifacts.
+// The scratchpad lives in the task store rather than the worktree, so it
+// survives worktree loss and works where the filesystem is ephemeral.
+type StoreBackedScratchpad struct {
+	store         ScratchpadStore
+	workspaceName string
+	taskID        string
+	filename      string
+	logger        zerolog.Logger
+}
+
+// NewStoreBackedScratchpad creates a scratchpad stored as the named artifact of a task.
+func NewStoreBackedScratchpad(store ScratchpadStore, workspaceName, taskID, filename string, logger zerolog.Logger) *StoreBackedScratchpad {
+	return &StoreBackedScratchpad{
+		store:         store,
+		workspaceName: workspaceName,
+		taskID:        taskID,
+		filename:      filename,
+		logger:        logger,
+	}
+}
+
+// Filename returns the artifact filename for this scratchpad.
+func (s *StoreBackedScratchpad) Filename() string {
+	return s.filename
+}
+
+// Read returns the current scratchpad data from the task store.
+// Returns empty data if the artifact doesn't exist.
+func (s *StoreBackedScratchpad) Read(ctx context.Context) (*ScratchpadData, error) {
+	content, err := s.store.GetArtifact(ctx, s.workspaceName, s.taskID, s.filename)
+	if errors.Is(err, atlaserrors.ErrArtifactNotFound) {
+		s.logger.Debug().Str("artifact", s.filename).Msg("scratchpad artifact does not exist, returning empty data")
+		return &ScratchpadData{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scratchpad artifact: %w", err)
+	}
+
+	var data ScratchpadData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse scratchpad artifact: %w", err)
+	}
+
+	return &data, nil
+}
+
+// Write saves the scratchpad data to the task store.
+func (s *StoreBackedScratchpad) Write(ctx context.Context, data *ScratchpadData) error {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal scratchpad data: %w", err)
+	}
+
+	if err := s.store.SaveArtifact(ctx, s.workspaceName, s.taskID, s.filename, content); err != nil {
+		return fmt.Errorf("failed to save scratchpad artifact: %w", err)
+	}
+
+	s.logger.Debug().
+		Str("artifact", s.filename).
+		Int("iterations", len(data.Iterations)).
+		Msg("wrote scratchpad data")
+	return nil
+}
+
+// AppendIteration adds an iteration summary to the scratchpad.
+func (s *StoreBackedScratchpad) AppendIteration(ctx context.Context, result *IterationSummary) error {
+	data, err := s.Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	data.Iterations = append(data.Iterations, *result)
+	return s.Write(ctx, data)
 }
@@ -1,6 +1,8 @@
 package steps
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +11,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 )
 
 func TestFileScratchpad_ReadWrite(t *testing.T) {
@@ -26,11 +30,11 @@ func TestFileScratchpad_ReadWrite(t *testing.T) {
 			{Number: 1, Summary: "Fixed lint errors"},
 		},
 	}
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Read it back
-	read, err := s.Read()
+	read, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, "task-123", read.TaskID)
 	assert.Equal(t, "fix_loop", read.LoopName)
@@ -46,7 +50,7 @@ func TestFileScratchpad_ReadNonExistent(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Read should return empty data for non-existent file
-	data, err := s.Read()
+	data, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.NotNil(t, data)
 	assert.Empty(t, data.TaskID)
@@ -61,17 +65,17 @@ func TestFileScratchpad_AppendIteration(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Initialize with first iteration
-	err := s.Write(&ScratchpadData{TaskID: "task-123"})
+	err := s.Write(context.Background(), &ScratchpadData{TaskID: "task-123"})
 	require.NoError(t, err)
 
 	// Append iterations
-	err = s.AppendIteration(&IterationSummary{Number: 1, Summary: "First"})
+	err = s.AppendIteration(context.Background(), &IterationSummary{Number: 1, Summary: "First"})
 	require.NoError(t, err)
 
-	err = s.AppendIteration(&IterationSummary{Number: 2, Summary: "Second"})
+	err = s.AppendIteration(context.Background(), &IterationSummary{Number: 2, Summary: "Second"})
 	require.NoError(t, err)
 
-	data, err := s.Read()
+	data, err := s.Read(context.Background())
 	require.NoError(t, err)
 	require.Len(t, data.Iterations, 2)
 	assert.Equal(t, "First", data.Iterations[0].Summary)
@@ -85,11 +89,11 @@ func TestFileScratchpad_Initialize(t *testing.T) {
 
 	s := NewFileScratchpad(path, logger)
 
-	err := s.Initialize("task-456", "loop_step")
+	err := s.Initialize(context.Background(), "task-456", "loop_step")
 	require.NoError(t, err)
 
 	// Verify file exists and has correct content
-	data, err := s.Read()
+	data, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, "task-456", data.TaskID)
 	assert.Equal(t, "loop_step", data.LoopName)
@@ -115,7 +119,7 @@ func TestFileScratchpad_CreateParentDir(t *testing.T) {
 
 	// Write should create parent directories
 	data := &ScratchpadData{TaskID: "task-789"}
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Verify file was created
@@ -159,7 +163,7 @@ func TestFileScratchpad_CorruptedJSON(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Read should return error on malformed JSON
-	_, err = s.Read()
+	_, err = s.Read(context.Background())
 	require.Error(t, err)
 }
 
@@ -176,7 +180,7 @@ func TestFileScratchpad_PartialWrite(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Read should fail on truncated JSON
-	_, err = s.Read()
+	_, err = s.Read(context.Background())
 	require.Error(t, err)
 }
 
@@ -201,7 +205,7 @@ func TestFileScratchpad_PermissionDenied(t *testing.T) {
 
 	// Write should fail due to permission
 	data := &ScratchpadData{TaskID: "test-123"}
-	err = s.Write(data)
+	err = s.Write(context.Background(), data)
 	require.Error(t, err)
 }
 
@@ -221,7 +225,7 @@ func TestFileScratchpad_ConcurrentRead(t *testing.T) {
 			{Number: 2, Summary: "second"},
 		},
 	}
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Concurrent reads should not race
@@ -232,7 +236,7 @@ func TestFileScratchpad_ConcurrentRead(t *testing.T) {
 	for i := 0; i < numReaders; i++ {
 		go func() {
 			defer func() { done <- struct{}{} }()
-			readData, err := s.Read()
+			readData, err := s.Read(context.Background())
 			if err != nil {
 				errors <- err
 				return
@@ -266,7 +270,7 @@ func TestFileScratchpad_ConcurrentWrite(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Initialize file
-	err := s.Write(&ScratchpadData{TaskID: "initial"})
+	err := s.Write(context.Background(), &ScratchpadData{TaskID: "initial"})
 	require.NoError(t, err)
 
 	// Concurrent writes - this tests for race conditions
@@ -284,7 +288,7 @@ func TestFileScratchpad_ConcurrentWrite(t *testing.T) {
 					{Number: idx, Summary: "iteration from goroutine"},
 				},
 			}
-			_ = s.Write(data) // We don't check error - file may be locked
+			_ = s.Write(context.Background(), data) // We don't check error - file may be locked
 		}(i)
 	}
 
@@ -294,7 +298,7 @@ func TestFileScratchpad_ConcurrentWrite(t *testing.T) {
 	}
 
 	// File should be readable after concurrent writes
-	_, err = s.Read()
+	_, err = s.Read(context.Background())
 	assert.NoError(t, err)
 }
 
@@ -331,11 +335,11 @@ func TestFileScratchpad_LargeData(t *testing.T) {
 	}
 
 	// Write large data
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Read it back
-	readData, err := s.Read()
+	readData, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, readData.Iterations, numIterations)
 	assert.Equal(t, "task-large", readData.TaskID)
@@ -382,11 +386,11 @@ func TestFileScratchpad_SpecialCharacters(t *testing.T) {
 	}
 
 	// Write data with special characters
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Read it back
-	readData, err := s.Read()
+	readData, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, readData.Iterations, 5)
 
@@ -410,7 +414,7 @@ func TestFileScratchpad_EmptyFile(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Read empty file should return error (invalid JSON)
-	_, err = s.Read()
+	_, err = s.Read(context.Background())
 	require.Error(t, err)
 }
 
@@ -427,7 +431,7 @@ func TestFileScratchpad_AppendToCorrupted(t *testing.T) {
 
 	// Append should fail because read fails
 	summary := &IterationSummary{Number: 1, Summary: "test"}
-	err = s.AppendIteration(summary)
+	err = s.AppendIteration(context.Background(), summary)
 	require.Error(t, err)
 }
 
@@ -440,7 +444,7 @@ func TestFileScratchpad_DeepNestedPath(t *testing.T) {
 
 	// Write should create all parent directories
 	data := &ScratchpadData{TaskID: "task-deep"}
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Verify file exists
@@ -456,7 +460,7 @@ func TestFileScratchpad_AppendManyIterations(t *testing.T) {
 	s := NewFileScratchpad(path, logger)
 
 	// Initialize
-	err := s.Initialize("task-append", "append_loop")
+	err := s.Initialize(context.Background(), "task-append", "append_loop")
 	require.NoError(t, err)
 
 	// Append many iterations
@@ -469,12 +473,12 @@ func TestFileScratchpad_AppendManyIterations(t *testing.T) {
 			FilesChanged: []string{"file.go"},
 			Success:      true,
 		}
-		appendErr := s.AppendIteration(summary)
+		appendErr := s.AppendIteration(context.Background(), summary)
 		require.NoError(t, appendErr)
 	}
 
 	// Verify all iterations present
-	data, err := s.Read()
+	data, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, data.Iterations, numIterations)
 }
@@ -493,7 +497,7 @@ func TestFileScratchpad_OverwriteExisting(t *testing.T) {
 			{Number: 1}, {Number: 2}, {Number: 3},
 		},
 	}
-	err := s.Write(initialData)
+	err := s.Write(context.Background(), initialData)
 	require.NoError(t, err)
 
 	// Overwrite with smaller data
@@ -501,11 +505,11 @@ func TestFileScratchpad_OverwriteExisting(t *testing.T) {
 		TaskID:     "new",
 		Iterations: []IterationSummary{},
 	}
-	err = s.Write(newData)
+	err = s.Write(context.Background(), newData)
 	require.NoError(t, err)
 
 	// Read should return new data
-	readData, err := s.Read()
+	readData, err := s.Read(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, "new", readData.TaskID)
 	assert.Empty(t, readData.Iterations)
@@ -533,10 +537,10 @@ func TestFileScratchpad_MetadataPreserved(t *testing.T) {
 		},
 	}
 
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
-	readData, err := s.Read()
+	readData, err := s.Read(context.Background())
 	require.NoError(t, err)
 
 	assert.Equal(t, "hello", readData.Metadata["string_val"])
@@ -555,7 +559,7 @@ func TestFileScratchpad_FilePermissions(t *testing.T) {
 
 	// Write data
 	data := &ScratchpadData{TaskID: "task-perms"}
-	err := s.Write(data)
+	err := s.Write(context.Background(), data)
 	require.NoError(t, err)
 
 	// Check file permissions (should be 0600)
@@ -564,3 +568,92 @@ func TestFileScratchpad_FilePermissions(t *testing.T) {
 	mode := info.Mode().Perm()
 	assert.Equal(t, os.FileMode(0o600), mode)
 }
+
+// memScratchpadStore is an in-memory ScratchpadStore keyed by workspace/task/filename.
+type memScratchpadStore struct {
+	artifacts map[string][]byte
+	getErr    error
+}
+
+func newMemScratchpadStore() *memScratchpadStore {
+	return &memScratchpadStore{artifacts: make(map[string][]byte)}
+}
+
+func (m *memScratchpadStore) SaveArtifact(_ context.Context, workspaceName, taskID, filename string, data []byte) error {
+	m.artifacts[workspaceName+"/"+taskID+"/"+filename] = data
+	return nil
+}
+
+func (m *memScratchpadStore) GetArtifact(_ context.Context, workspaceName, taskID, filename string) ([]byte, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	data, ok := m.artifacts[workspaceName+"/"+taskID+"/"+filename]
+	if !ok {
+		return nil, atlaserrors.ErrArtifactNotFound
+	}
+	return data, nil
+}
+
+func TestStoreBackedScratchpad_RoundTrip(t *testing.T) {
+	store := newMemScratchpadStore()
+	s := NewStoreBackedScratchpad(store, "ws", "task-123", "progress.json", zerolog.Nop())
+	assert.Equal(t, "progress.json", s.Filename())
+
+	// Read before anything is written returns empty data
+	data, err := s.Read(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, data.TaskID)
+	assert.Empty(t, data.Iterations)
+
+	require.NoError(t, s.Write(context.Background(), &ScratchpadData{
+		TaskID:   "task-123",
+		LoopName: "fix_loop",
+		Metadata: map[string]any{"note": "keep"},
+	}))
+	require.NoError(t, s.AppendIteration(context.Background(), &IterationSummary{Number: 1, Summary: "First", Success: true}))
+	require.NoError(t, s.AppendIteration(context.Background(), &IterationSummary{Number: 2, Summary: "Second", ExitSignal: true}))
+
+	data, err = s.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "task-123", data.TaskID)
+	assert.Equal(t, "fix_loop", data.LoopName)
+	assert.Equal(t, "keep", data.Metadata["note"])
+	require.Len(t, data.Iterations, 2)
+	assert.Equal(t, "First", data.Iterations[0].Summary)
+	assert.True(t, data.Iterations[0].Success)
+	assert.Equal(t, "Second", data.Iterations[1].Summary)
+	assert.True(t, data.Iterations[1].ExitSignal)
+
+	// Persisted under the task's artifact
+	assert.Contains(t, store.artifacts, "ws/task-123/progress.json")
+
+	// A new scratchpad over the same store sees the same data
+	reopened := NewStoreBackedScratchpad(store, "ws", "task-123", "progress.json", zerolog.Nop())
+	data, err = reopened.Read(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, data.Iterations, 2)
+}
+
+func TestStoreBackedScratchpad_ReadErrors(t *testing.T) {
+	t.Run("store error is returned", func(t *testing.T) {
+		store := newMemScratchpadStore()
+		store.getErr = errors.New("disk gone") //nolint:err113 // test-only error
+		s := NewStoreBackedScratchpad(store, "ws", "task-123", "progress.json", zerolog.Nop())
+
+		_, err := s.Read(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "disk gone")
+
+		require.Error(t, s.AppendIteration(context.Background(), &IterationSummary{Number: 1}))
+	})
+
+	t.Run("corrupted JSON", func(t *testing.T) {
+		store := newMemScratchpadStore()
+		store.artifacts["ws/task-123/progress.json"] = []byte("{not json")
+		s := NewStoreBackedScratchpad(store, "ws", "task-123", "progress.json", zerolog.Nop())
+
+		_, err := s.Read(context.Background())
+		require.Error(t, err)
+	})
+}
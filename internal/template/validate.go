@@ -3,6 +3,7 @@ package template
 
 import (
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 
@@ -10,6 +11,9 @@ import (
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 )
 
+// MaxStepRetries is the maximum retry.max a template step may declare.
+const MaxStepRetries = 10
+
 // ValidStepTypes returns all valid step type values.
 func ValidStepTypes() []domain.StepType {
 	return []domain.StepType{
@@ -46,6 +50,18 @@ func ValidateTemplate(t *domain.Template) error {
 		}
 	}
 
+	if err := validateStepGotos(t.Steps); err != nil {
+		return err
+	}
+
+	if err := validateParallelGroups(t.Steps); err != nil {
+		return err
+	}
+
+	if err := validateAroundSteps(t); err != nil {
+		return err
+	}
+
 	// Validate variables (if any)
 	for name := range t.Variables {
 		if strings.TrimSpace(name) == "" {
@@ -53,6 +69,44 @@ func ValidateTemplate(t *domain.Template) error {
 		}
 	}
 
+	return validateWebhooks(t.Webhooks)
+}
+
+// validateWebhooks checks that each webhook has an http(s) URL and only
+// subscribes to known events.
+func validateWebhooks(hooks []domain.Webhook) error {
+	for i, hook := range hooks {
+		u, err := url.Parse(hook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: webhook %d url must be an http or https URL, got %q", atlaserrors.ErrTemplateInvalid, i, hook.URL)
+		}
+		for _, event := range hook.Events {
+			if event != domain.WebhookEventTaskCompleted && event != domain.WebhookEventTaskFailed {
+				return fmt.Errorf("%w: webhook %d has unknown event %q", atlaserrors.ErrTemplateInvalid, i, event)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAroundSteps validates the before_all and after_all steps. They run
+// outside the step sequence, so gotos and parallel groups are not allowed.
+func validateAroundSteps(t *domain.Template) error {
+	for _, around := range []struct {
+		key  string
+		step *domain.StepDefinition
+	}{{"before_all", t.BeforeAll}, {"after_all", t.AfterAll}} {
+		if around.step == nil {
+			continue
+		}
+		if err := validateStep(around.step, 0); err != nil {
+			return fmt.Errorf("%s: %w", around.key, err)
+		}
+		if around.step.OnFailureGoto != "" || around.step.OnSuccessGoto != "" || around.step.ParallelGroup != "" {
+			return fmt.Errorf("%w: %s (%s): gotos and parallel_group are not supported",
+				atlaserrors.ErrTemplateInvalid, around.key, around.step.Name)
+		}
+	}
 	return nil
 }
 
@@ -75,6 +129,16 @@ func validateStep(step *domain.StepDefinition, index int) error {
 		return fmt.Errorf("%w: step %d (%s): retry_count cannot be negative", atlaserrors.ErrTemplateInvalid, index, step.Name)
 	}
 
+	if step.Retry != nil {
+		if step.Retry.Max < 0 || step.Retry.Max > MaxStepRetries {
+			return fmt.Errorf("%w: step %d (%s): retry.max must be between 0 and %d",
+				atlaserrors.ErrTemplateInvalid, index, step.Name, MaxStepRetries)
+		}
+		if step.Retry.Delay < 0 {
+			return fmt.Errorf("%w: step %d (%s): retry.delay cannot be negative", atlaserrors.ErrTemplateInvalid, index, step.Name)
+		}
+	}
+
 	// Validate loop-specific configuration
 	if step.Type == domain.StepTypeLoop {
 		if err := validateLoopStep(step, index); err != nil {
@@ -85,6 +149,52 @@ func validateStep(step *domain.StepDefinition, index int) error {
 	return nil
 }
 
+// validateStepGotos checks that on_failure_goto and on_success_goto name
+// existing top-level steps.
+func validateStepGotos(steps []domain.StepDefinition) error {
+	names := make(map[string]bool, len(steps))
+	for _, step := range steps {
+		names[step.Name] = true
+	}
+
+	for i, step := range steps {
+		for _, ref := range []struct{ field, target string }{
+			{"on_failure_goto", step.OnFailureGoto},
+			{"on_success_goto", step.OnSuccessGoto},
+		} {
+			if ref.target != "" && !names[ref.target] {
+				return fmt.Errorf("%w: step %d (%s): %s references unknown step %q",
+					atlaserrors.ErrTemplateInvalid, i, step.Name, ref.field, ref.target)
+			}
+		}
+	}
+	return nil
+}
+
+// validateParallelGroups checks that steps sharing a parallel_group are
+// contiguous and do not use flow-control fields, which only apply to steps
+// that run one at a time.
+func validateParallelGroups(steps []domain.StepDefinition) error {
+	closed := make(map[string]bool)
+	for i, step := range steps {
+		if i > 0 && steps[i-1].ParallelGroup != "" && steps[i-1].ParallelGroup != step.ParallelGroup {
+			closed[steps[i-1].ParallelGroup] = true
+		}
+		if step.ParallelGroup == "" {
+			continue
+		}
+		if closed[step.ParallelGroup] {
+			return fmt.Errorf("%w: step %d (%s): parallel_group %q steps must be contiguous",
+				atlaserrors.ErrTemplateInvalid, i, step.Name, step.ParallelGroup)
+		}
+		if step.OnFailureGoto != "" || step.OnSuccessGoto != "" || step.ContinueOnError {
+			return fmt.Errorf("%w: step %d (%s): parallel_group steps cannot use on_failure_goto, on_success_goto, or continue_on_error",
+				atlaserrors.ErrTemplateInvalid, i, step.Name)
+		}
+	}
+	return nil
+}
+
 // validateLoopStep validates loop-specific configuration.
 func validateLoopStep(step *domain.StepDefinition, index int) error {
 	if step.Config == nil {
@@ -97,23 +207,47 @@ func validateLoopStep(step *domain.StepDefinition, index int) error {
 		return err
 	}
 
+	if s, ok := step.Config["max_iterations"].(string); ok && s != "auto" {
+		return fmt.Errorf("%w: step %d (%s): max_iterations must be a number or \"auto\", got %q",
+			atlaserrors.ErrTemplateInvalid, index, step.Name, s)
+	}
+
 	if !hasLoopTerminationCondition(step.Config) {
 		return fmt.Errorf("%w: step %d (%s): loop must have max_iterations, until, or until_signal",
 			atlaserrors.ErrTemplateInvalid, index, step.Name)
 	}
 
+	if before, ok := step.Config["before_steps"]; ok {
+		beforeSlice, isSlice := before.([]any)
+		if !isSlice {
+			return fmt.Errorf("%w: step %d (%s): before_steps must be a list of steps",
+				atlaserrors.ErrTemplateInvalid, index, step.Name)
+		}
+		if err := validateInnerStepsRecursively(beforeSlice, step, index); err != nil {
+			return err
+		}
+	}
+
 	return validateInnerStepsRecursively(stepsSlice, step, index)
 }
 
 // validateLoopInnerSteps checks that inner steps exist and are valid.
+// A loop may omit them or leave them empty only with allow_empty: true.
 func validateLoopInnerSteps(step *domain.StepDefinition, index int) ([]any, error) {
 	steps, hasSteps := step.Config["steps"]
+	allowEmpty := hasTrueBool(step.Config, "allow_empty")
+	if !hasSteps && allowEmpty {
+		return nil, nil
+	}
 	if !hasSteps {
 		return nil, fmt.Errorf("%w: step %d (%s): loop step must have inner steps",
 			atlaserrors.ErrTemplateInvalid, index, step.Name)
 	}
 
 	stepsSlice, isSlice := steps.([]any)
+	if isSlice && len(stepsSlice) == 0 && allowEmpty {
+		return stepsSlice, nil
+	}
 	if !isSlice || len(stepsSlice) == 0 {
 		return nil, fmt.Errorf("%w: step %d (%s): loop step must have at least one inner step",
 			atlaserrors.ErrTemplateInvalid, index, step.Name)
@@ -124,7 +258,7 @@ func validateLoopInnerSteps(step *domain.StepDefinition, index int) ([]any, erro
 
 // hasLoopTerminationCondition checks if any termination condition is set.
 func hasLoopTerminationCondition(config map[string]any) bool {
-	if hasPositiveInt(config, "max_iterations") {
+	if hasPositiveInt(config, "max_iterations") || config["max_iterations"] == "auto" {
 		return true
 	}
 	if hasNonEmptyString(config, "until") {
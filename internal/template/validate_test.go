@@ -195,6 +195,98 @@ func TestValidateTemplate_MultipleStepsOneInvalid(t *testing.T) {
 	assert.Contains(t, err.Error(), "step2")
 }
 
+func TestValidateTemplate_GotoReferencesExistingStep(t *testing.T) {
+	tmpl := validTemplate()
+	tmpl.Steps = append(tmpl.Steps,
+		domain.StepDefinition{Name: "validate", Type: domain.StepTypeValidation, OnFailureGoto: "implement"},
+		domain.StepDefinition{Name: "review", Type: domain.StepTypeHuman, OnSuccessGoto: "validate"},
+	)
+	assert.NoError(t, ValidateTemplate(tmpl))
+}
+
+func TestValidateTemplate_GotoUnknownStep(t *testing.T) {
+	tests := []struct {
+		name  string
+		step  domain.StepDefinition
+		field string
+	}{
+		{
+			name:  "on_failure_goto",
+			step:  domain.StepDefinition{Name: "validate", Type: domain.StepTypeValidation, OnFailureGoto: "missing"},
+			field: "on_failure_goto",
+		},
+		{
+			name:  "on_success_goto",
+			step:  domain.StepDefinition{Name: "validate", Type: domain.StepTypeValidation, OnSuccessGoto: "missing"},
+			field: "on_success_goto",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := validTemplate()
+			tmpl.Steps = append(tmpl.Steps, tt.step)
+
+			err := ValidateTemplate(tmpl)
+
+			require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+			assert.Contains(t, err.Error(), tt.field)
+			assert.Contains(t, err.Error(), `"missing"`)
+		})
+	}
+}
+
+func TestValidateTemplate_ParallelGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []domain.StepDefinition
+		errMatch string
+	}{
+		{
+			name: "contiguous groups",
+			steps: []domain.StepDefinition{
+				{Name: "a", Type: domain.StepTypeAI, ParallelGroup: "one"},
+				{Name: "b", Type: domain.StepTypeAI, ParallelGroup: "one"},
+				{Name: "c", Type: domain.StepTypeAI, ParallelGroup: "two"},
+				{Name: "d", Type: domain.StepTypeAI, ParallelGroup: "two"},
+			},
+		},
+		{
+			name: "group split by another group",
+			steps: []domain.StepDefinition{
+				{Name: "a", Type: domain.StepTypeAI, ParallelGroup: "one"},
+				{Name: "b", Type: domain.StepTypeAI, ParallelGroup: "two"},
+				{Name: "c", Type: domain.StepTypeAI, ParallelGroup: "one"},
+			},
+			errMatch: "step 2 (c): parallel_group \"one\" steps must be contiguous",
+		},
+		{
+			name: "grouped step with goto",
+			steps: []domain.StepDefinition{
+				{Name: "a", Type: domain.StepTypeAI, ParallelGroup: "one", OnFailureGoto: "b"},
+				{Name: "b", Type: domain.StepTypeAI, ParallelGroup: "one"},
+			},
+			errMatch: "parallel_group steps cannot use",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := validTemplate()
+			tmpl.Steps = tt.steps
+
+			err := ValidateTemplate(tmpl)
+
+			if tt.errMatch == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+			assert.Contains(t, err.Error(), tt.errMatch)
+		})
+	}
+}
+
 func TestValidateStep_AllValidTypes(t *testing.T) {
 	validTypes := []domain.StepType{
 		domain.StepTypeAI,
@@ -506,6 +598,25 @@ func TestValidateLoopStep_EmptyInnerSteps(t *testing.T) {
 	assert.Contains(t, err.Error(), "at least one inner step")
 }
 
+func TestValidateLoopStep_EmptyInnerStepsAllowed(t *testing.T) {
+	tmpl := &domain.Template{
+		Name:        "loop-template",
+		Description: "Template with explicitly empty inner steps",
+		Steps: []domain.StepDefinition{
+			{
+				Name: "fix_loop",
+				Type: domain.StepTypeLoop,
+				Config: map[string]any{
+					"max_iterations": 5,
+					"steps":          []any{},
+					"allow_empty":    true,
+				},
+			},
+		},
+	}
+	require.NoError(t, ValidateTemplate(tmpl))
+}
+
 func TestValidateLoopStep_NoTerminationCondition(t *testing.T) {
 	tmpl := &domain.Template{
 		Name:        "loop-template",
@@ -553,6 +664,57 @@ func TestValidateLoopStep_ZeroMaxIterations(t *testing.T) {
 	assert.Contains(t, err.Error(), "max_iterations, until, or until_signal")
 }
 
+func TestValidateLoopStep_AutoMaxIterations(t *testing.T) {
+	tmpl := &domain.Template{
+		Name:        "loop-template",
+		Description: "Template with an automatic iteration cap",
+		Steps: []domain.StepDefinition{
+			{
+				Name: "fix_loop",
+				Type: domain.StepTypeLoop,
+				Config: map[string]any{
+					"max_iterations": "auto",
+					"before_steps": []any{
+						map[string]any{"name": "assess", "type": "ai"},
+					},
+					"steps": []any{
+						map[string]any{"name": "fix", "type": "ai"},
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, ValidateTemplate(tmpl))
+
+	tmpl.Steps[0].Config["max_iterations"] = "lots"
+	err := ValidateTemplate(tmpl)
+	require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+	assert.Contains(t, err.Error(), `max_iterations must be a number or "auto"`)
+}
+
+func TestValidateLoopStep_InvalidBeforeSteps(t *testing.T) {
+	tmpl := &domain.Template{
+		Name:        "loop-template",
+		Description: "Template with a malformed before step",
+		Steps: []domain.StepDefinition{
+			{
+				Name: "fix_loop",
+				Type: domain.StepTypeLoop,
+				Config: map[string]any{
+					"max_iterations": 3,
+					"before_steps":   []any{"assess"},
+					"steps": []any{
+						map[string]any{"name": "fix", "type": "ai"},
+					},
+				},
+			},
+		},
+	}
+	err := ValidateTemplate(tmpl)
+	require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+	assert.Contains(t, err.Error(), "invalid format")
+}
+
 func TestValidateLoopStep_EmptyUntil(t *testing.T) {
 	// until: "" should not count as a valid termination condition
 	tmpl := &domain.Template{
@@ -774,3 +936,63 @@ func TestValidateLoopStep_StepsNotSlice(t *testing.T) {
 	require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
 	assert.Contains(t, err.Error(), "at least one inner step")
 }
+
+func TestValidateTemplate_Webhooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		hook    domain.Webhook
+		wantErr string
+	}{
+		{name: "valid https", hook: domain.Webhook{URL: "https://hooks.example.com/x"}},
+		{name: "valid with events", hook: domain.Webhook{URL: "http://localhost:8080", Events: []string{domain.WebhookEventTaskCompleted, domain.WebhookEventTaskFailed}}},
+		{name: "empty url", hook: domain.Webhook{}, wantErr: "http or https URL"},
+		{name: "unsupported scheme", hook: domain.Webhook{URL: "ftp://example.com"}, wantErr: "http or https URL"},
+		{name: "missing host", hook: domain.Webhook{URL: "https://"}, wantErr: "http or https URL"},
+		{name: "unknown event", hook: domain.Webhook{URL: "https://example.com", Events: []string{"task.started"}}, wantErr: "unknown event"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := validTemplate()
+			tmpl.Webhooks = []domain.Webhook{tt.hook}
+			err := ValidateTemplate(tmpl)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateTemplate_BeforeAfterAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		beforeAll *domain.StepDefinition
+		afterAll  *domain.StepDefinition
+		wantErr   string
+	}{
+		{name: "valid", beforeAll: &domain.StepDefinition{Name: "setup", Type: domain.StepTypeValidation},
+			afterAll: &domain.StepDefinition{Name: "cleanup", Type: domain.StepTypeValidation}},
+		{name: "missing name", beforeAll: &domain.StepDefinition{Type: domain.StepTypeValidation}, wantErr: "before_all"},
+		{name: "invalid type", afterAll: &domain.StepDefinition{Name: "cleanup", Type: "bogus"}, wantErr: "after_all"},
+		{name: "goto", beforeAll: &domain.StepDefinition{Name: "setup", Type: domain.StepTypeAI, OnFailureGoto: "x"}, wantErr: "gotos"},
+		{name: "parallel group", afterAll: &domain.StepDefinition{Name: "cleanup", Type: domain.StepTypeAI, ParallelGroup: "g"}, wantErr: "parallel_group"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := validTemplate()
+			tmpl.BeforeAll = tt.beforeAll
+			tmpl.AfterAll = tt.afterAll
+			err := ValidateTemplate(tmpl)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, atlaserrors.ErrTemplateInvalid)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// stepChecklistIcons maps step statuses to their checklist icons.
+//
+//nolint:gochecknoglobals // Intentional package-level constant for TUI styling
+var stepChecklistIcons = map[string]string{
+	constants.StepStatusPending:          "○",
+	constants.StepStatusRunning:          "●",
+	constants.StepStatusAwaitingApproval: "⚠",
+	constants.StepStatusSuccess:          "✓",
+	constants.StepStatusNoChanges:        "✓",
+	constants.StepStatusFailed:           "✗",
+	constants.StepStatusSkipped:          "⊘",
+}
+
+// checklistState tracks what RenderChecklist last drew.
+// Redraws in place need the previous line count; the append-only
+// fallback needs the previous statuses to print only what changed.
+type checklistState struct {
+	mu       sync.Mutex
+	lines    int
+	statuses []string
+}
+
+// checklistTrackingWriter forwards writes and forgets the drawn checklist,
+// so the next RenderChecklist draws below that output instead of over it.
+type checklistTrackingWriter struct {
+	w         io.Writer
+	checklist *checklistState
+}
+
+func (cw *checklistTrackingWriter) Write(p []byte) (int, error) {
+	cw.checklist.mu.Lock()
+	cw.checklist.lines = 0
+	cw.checklist.mu.Unlock()
+	return cw.w.Write(p)
+}
+
+// supportsCursorControl reports whether w is a terminal that understands
+// cursor movement escape sequences.
+func supportsCursorControl(w io.Writer) bool {
+	return isTTY(w) && os.Getenv("TERM") != "dumb"
+}
+
+// RenderChecklist draws an itemized view of each step's status.
+// Call it again whenever a step's status changes.
+// With cursor control, the previous checklist is redrawn in place unless
+// other output was written since; spinners don't count, as they clear
+// their own line.
+// Otherwise only steps whose status changed since the last call are
+// appended, one line each.
+func (o *TTYOutput) RenderChecklist(steps []domain.Step) {
+	o.checklist.mu.Lock()
+	defer o.checklist.mu.Unlock()
+
+	if o.cursorControl {
+		o.redrawChecklist(steps)
+	} else {
+		o.appendChecklistChanges(steps)
+	}
+
+	statuses := make([]string, len(steps))
+	for i, step := range steps {
+		statuses[i] = step.Status
+	}
+	o.checklist.statuses = statuses
+}
+
+// redrawChecklist moves the cursor back over the previous checklist and redraws it.
+func (o *TTYOutput) redrawChecklist(steps []domain.Step) {
+	for i := 0; i < o.checklist.lines; i++ {
+		_, _ = fmt.Fprint(o.raw, "\033[A\033[K") // Move up, clear line
+	}
+	for _, step := range steps {
+		_, _ = fmt.Fprintln(o.raw, o.checklistLine(step))
+	}
+	o.checklist.lines = len(steps)
+}
+
+// appendChecklistChanges writes one line for each step whose status changed.
+func (o *TTYOutput) appendChecklistChanges(steps []domain.Step) {
+	for i, step := range steps {
+		if i < len(o.checklist.statuses) && o.checklist.statuses[i] == step.Status {
+			continue
+		}
+		_, _ = fmt.Fprintln(o.raw, o.checklistLine(step))
+	}
+}
+
+// checklistLine formats a single step as "<icon> <name> (<status>)".
+func (o *TTYOutput) checklistLine(step domain.Step) string {
+	status := step.Status
+	if status == "" {
+		status = constants.StepStatusPending
+	}
+
+	icon, ok := stepChecklistIcons[status]
+	if !ok {
+		icon = "○"
+	}
+
+	line := fmt.Sprintf("%s %s (%s)", icon, step.Name, status)
+	switch status {
+	case constants.StepStatusSuccess, constants.StepStatusNoChanges:
+		return o.styles.Success.Render(line)
+	case constants.StepStatusFailed:
+		return o.styles.Error.Render(line)
+	case constants.StepStatusRunning:
+		return o.styles.Info.Render(line)
+	case constants.StepStatusAwaitingApproval:
+		return o.styles.Warning.Render(line)
+	default:
+		return o.styles.Dim.Render(line)
+	}
+}
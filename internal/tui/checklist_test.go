@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// checklistSteps returns steps with the given statuses named step1..stepN.
+func checklistSteps(statuses ...string) []domain.Step {
+	steps := make([]domain.Step, len(statuses))
+	for i, status := range statuses {
+		steps[i] = domain.Step{Name: "step" + string(rune('1'+i)), Status: status}
+	}
+	return steps
+}
+
+// TestJSONOutput_RenderChecklist tests JSON mode produces no output.
+func TestJSONOutput_RenderChecklist(t *testing.T) {
+	var buf bytes.Buffer
+	out := NewJSONOutput(&buf)
+
+	out.RenderChecklist(checklistSteps(constants.StepStatusPending, constants.StepStatusPending))
+	out.RenderChecklist(checklistSteps(constants.StepStatusRunning, constants.StepStatusPending))
+
+	assert.Empty(t, buf.String())
+}
+
+// TestTTYOutput_RenderChecklist_AppendOnly tests the fallback without cursor
+// control appends one line per status change.
+func TestTTYOutput_RenderChecklist_AppendOnly(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	var buf bytes.Buffer
+	out := NewTTYOutput(&buf)
+	require.False(t, out.cursorControl, "a buffer has no cursor control")
+
+	// Initial render prints every step
+	out.RenderChecklist(checklistSteps(constants.StepStatusPending, constants.StepStatusPending))
+	// Each subsequent render prints only the step that changed
+	out.RenderChecklist(checklistSteps(constants.StepStatusRunning, constants.StepStatusPending))
+	out.RenderChecklist(checklistSteps(constants.StepStatusSuccess, constants.StepStatusPending))
+	out.RenderChecklist(checklistSteps(constants.StepStatusSuccess, constants.StepStatusRunning))
+	out.RenderChecklist(checklistSteps(constants.StepStatusSuccess, constants.StepStatusFailed))
+	// No change prints nothing
+	out.RenderChecklist(checklistSteps(constants.StepStatusSuccess, constants.StepStatusFailed))
+
+	lines := strings.Split(strings.TrimRight(stripANSI(buf.String()), "\n"), "\n")
+	assert.Equal(t, []string{
+		"○ step1 (pending)",
+		"○ step2 (pending)",
+		"● step1 (running)",
+		"✓ step1 (success)",
+		"● step2 (running)",
+		"✗ step2 (failed)",
+	}, lines)
+	assert.NotContains(t, buf.String(), "\033[A", "fallback must not move the cursor")
+}
+
+// TestTTYOutput_RenderChecklist_InPlace tests redraws move the cursor back
+// over the previous checklist.
+func TestTTYOutput_RenderChecklist_InPlace(t *testing.T) {
+	var buf bytes.Buffer
+	out := NewTTYOutput(&buf)
+	out.cursorControl = true
+
+	out.RenderChecklist(checklistSteps(constants.StepStatusPending, constants.StepStatusPending))
+	assert.NotContains(t, buf.String(), "\033[A", "first render has nothing to clear")
+
+	buf.Reset()
+	out.RenderChecklist(checklistSteps(constants.StepStatusRunning, constants.StepStatusPending))
+
+	output := buf.String()
+	assert.Equal(t, 2, strings.Count(output, "\033[A\033[K"))
+	output = stripANSI(output)
+	assert.Contains(t, output, "● step1 (running)")
+	assert.Contains(t, output, "○ step2 (pending)")
+}
+
+// TestTTYOutput_RenderChecklist_RedrawsBelowOtherOutput tests a checklist
+// is drawn afresh, not over other output written since the last render.
+func TestTTYOutput_RenderChecklist_RedrawsBelowOtherOutput(t *testing.T) {
+	var buf bytes.Buffer
+	out := NewTTYOutput(&buf)
+	out.cursorControl = true
+
+	out.RenderChecklist(checklistSteps(constants.StepStatusRunning, constants.StepStatusPending))
+	out.Info("step1 finished")
+
+	buf.Reset()
+	out.RenderChecklist(checklistSteps(constants.StepStatusSuccess, constants.StepStatusPending))
+
+	assert.NotContains(t, buf.String(), "\033[A", "output after the checklist must not be overwritten")
+	assert.Contains(t, stripANSI(buf.String()), "✓ step1 (success)")
+}
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// destructiveInteractive reports whether a typed confirmation can be prompted for.
+// It is a variable so tests can simulate a terminal.
+//
+//nolint:gochecknoglobals // Test injection point - standard Go testing pattern
+var destructiveInteractive = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) //nolint:gosec // G115: uintptr->int for term.IsTerminal, file descriptors fit in int on all supported platforms
+}
+
+// destructiveInput prompts for the typed confirmation.
+// It is a variable so tests can inject the user's input.
+//
+//nolint:gochecknoglobals // Test injection point - standard Go testing pattern
+var destructiveInput = Input
+
+// ConfirmDestructive guards an irreversible action by requiring the user to
+// type expected (typically the workspace name) to proceed.
+// Returns true only if the typed value matches exactly.
+//
+// In non-interactive mode (JSON output or no terminal) it cannot prompt and
+// returns ErrConfirmationRequired; callers should skip it when --yes is given.
+func ConfirmDestructive(out Output, prompt, expected string) (bool, error) {
+	if _, isJSON := out.(*JSONOutput); isJSON || !destructiveInteractive() {
+		return false, fmt.Errorf("cannot confirm '%s': %w", expected, atlaserrors.ErrConfirmationRequired)
+	}
+
+	typed, err := destructiveInput(fmt.Sprintf("%s\nType '%s' to confirm:", prompt, expected), "")
+	if err != nil {
+		return false, err
+	}
+
+	if strings.TrimSpace(typed) != expected {
+		out.Warning(fmt.Sprintf("Confirmation did not match '%s'", expected))
+		return false, nil
+	}
+
+	return true, nil
+}
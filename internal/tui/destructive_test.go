@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// stubDestructivePrompt simulates an interactive terminal where the user types typed.
+func stubDestructivePrompt(t *testing.T, interactive bool, typed string, inputErr error) {
+	t.Helper()
+
+	origInteractive, origInput := destructiveInteractive, destructiveInput
+	t.Cleanup(func() {
+		destructiveInteractive, destructiveInput = origInteractive, origInput
+	})
+
+	destructiveInteractive = func() bool { return interactive }
+	destructiveInput = func(_, _ string) (string, error) { return typed, inputErr }
+}
+
+// TestConfirmDestructive_NonInteractive tests that confirmation requires --yes
+// when the user cannot be prompted.
+func TestConfirmDestructive_NonInteractive(t *testing.T) {
+	t.Run("no terminal", func(t *testing.T) {
+		stubDestructivePrompt(t, false, "my-ws", nil)
+
+		confirmed, err := ConfirmDestructive(NewTTYOutput(&bytes.Buffer{}), "Destroy?", "my-ws")
+
+		require.ErrorIs(t, err, atlaserrors.ErrConfirmationRequired)
+		assert.Contains(t, err.Error(), "--yes")
+		assert.False(t, confirmed)
+	})
+
+	t.Run("JSON output", func(t *testing.T) {
+		stubDestructivePrompt(t, true, "my-ws", nil)
+
+		confirmed, err := ConfirmDestructive(NewJSONOutput(&bytes.Buffer{}), "Destroy?", "my-ws")
+
+		require.ErrorIs(t, err, atlaserrors.ErrConfirmationRequired)
+		assert.False(t, confirmed)
+	})
+}
+
+// TestConfirmDestructive_TypedConfirmation tests matching and mismatching typed input.
+func TestConfirmDestructive_TypedConfirmation(t *testing.T) {
+	tests := []struct {
+		name      string
+		typed     string
+		confirmed bool
+	}{
+		{name: "exact match", typed: "my-ws", confirmed: true},
+		{name: "surrounding whitespace", typed: "  my-ws\n", confirmed: true},
+		{name: "mismatch", typed: "my-w", confirmed: false},
+		{name: "case mismatch", typed: "MY-WS", confirmed: false},
+		{name: "empty", typed: "", confirmed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", "1")
+			stubDestructivePrompt(t, true, tt.typed, nil)
+
+			var buf bytes.Buffer
+			confirmed, err := ConfirmDestructive(NewTTYOutput(&buf), "Destroy?", "my-ws")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.confirmed, confirmed)
+			if tt.confirmed {
+				assert.Empty(t, buf.String())
+			} else {
+				assert.Contains(t, buf.String(), "did not match 'my-ws'")
+			}
+		})
+	}
+}
+
+// TestConfirmDestructive_InputCanceled tests that a canceled prompt is returned as an error.
+func TestConfirmDestructive_InputCanceled(t *testing.T) {
+	stubDestructivePrompt(t, true, "", ErrMenuCanceled)
+
+	confirmed, err := ConfirmDestructive(NewTTYOutput(&bytes.Buffer{}), "Destroy?", "my-ws")
+
+	require.ErrorIs(t, err, ErrMenuCanceled)
+	assert.False(t, confirmed)
+}
@@ -0,0 +1,91 @@
+// Package tui provides terminal user interface components for ATLAS.
+package tui
+
+import (
+	"io"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// DiffColorizer colors unified diff output the way git does: additions in
+// green, deletions in red, and hunk headers in cyan. Coloring only happens
+// when the writer is a terminal and NO_COLOR is unset, so piped output and
+// files stay plain.
+type DiffColorizer struct {
+	w     io.Writer
+	isTTY func(io.Writer) bool
+}
+
+// DiffColorOption is a functional option for DiffColorizer configuration.
+type DiffColorOption func(*DiffColorizer)
+
+// WithDiffTTY overrides terminal detection for the colorizer's writer (for testing).
+func WithDiffTTY(isTerminal bool) DiffColorOption {
+	return func(c *DiffColorizer) {
+		c.isTTY = func(io.Writer) bool { return isTerminal }
+	}
+}
+
+// NewDiffColorizer creates a colorizer for diffs written to w.
+func NewDiffColorizer(w io.Writer, opts ...DiffColorOption) *DiffColorizer {
+	c := &DiffColorizer{
+		w:     w,
+		isTTY: isTTY,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Enabled returns true if Colorize will add colors.
+func (c *DiffColorizer) Enabled() bool {
+	return HasColorSupport() && c.isTTY(c.w)
+}
+
+// Colorize returns diff with ANSI colors added to each line, or diff
+// unchanged when coloring is disabled.
+func (c *DiffColorizer) Colorize(diff string) string {
+	if !c.Enabled() || diff == "" {
+		return diff
+	}
+
+	// Keep tabs as-is so indentation in the diff is unchanged
+	base := lipgloss.NewStyle().TabWidth(lipgloss.NoTabConversion)
+	var (
+		added   = base.Foreground(lipgloss.Green)
+		removed = base.Foreground(lipgloss.Red)
+		hunk    = base.Foreground(lipgloss.Cyan)
+		header  = base.Bold(true)
+	)
+
+	lines := strings.SplitAfter(diff, "\n")
+	var b strings.Builder
+	b.Grow(len(diff))
+	for _, line := range lines {
+		text := strings.TrimSuffix(line, "\n")
+		newline := line[len(text):]
+
+		var style *lipgloss.Style
+		switch {
+		case text == "":
+		case strings.HasPrefix(text, "diff --git "), strings.HasPrefix(text, "index "),
+			strings.HasPrefix(text, "+++ "), strings.HasPrefix(text, "--- "):
+			style = &header
+		case strings.HasPrefix(text, "@@"):
+			style = &hunk
+		case text[0] == '+':
+			style = &added
+		case text[0] == '-':
+			style = &removed
+		}
+
+		if style != nil {
+			text = style.Render(text)
+		}
+		b.WriteString(text)
+		b.WriteString(newline)
+	}
+	return b.String()
+}
@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleDiff = "diff --git a/main.go b/main.go\n" +
+	"index 1111111..2222222 100644\n" +
+	"--- a/main.go\n" +
+	"+++ b/main.go\n" +
+	"@@ -1,3 +1,3 @@ package main\n" +
+	" func main() {\n" +
+	"-\tprintln(\"old\")\n" +
+	"+\tprintln(\"new\")\n" +
+	" }\n"
+
+// lineFor returns the line of colored output containing text.
+func lineFor(t *testing.T, output, text string) string {
+	t.Helper()
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, text) {
+			return line
+		}
+	}
+	t.Fatalf("no line containing %q in %q", text, output)
+	return ""
+}
+
+func TestDiffColorizer_ColorsOnTTY(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("NO_COLOR", "") // restored after the test
+	_ = os.Unsetenv("NO_COLOR")
+
+	var buf bytes.Buffer
+	c := NewDiffColorizer(&buf, WithDiffTTY(true))
+	out := c.Colorize(sampleDiff)
+
+	assert.True(t, c.Enabled())
+	assert.Contains(t, lineFor(t, out, `println("new")`), "\x1b[32m", "additions should be green")
+	assert.Contains(t, lineFor(t, out, `println("old")`), "\x1b[31m", "deletions should be red")
+	assert.Contains(t, lineFor(t, out, "@@ -1,3"), "\x1b[36m", "hunk headers should be cyan")
+	assert.NotContains(t, lineFor(t, out, "func main"), "\x1b[", "context lines should stay plain")
+	assert.Contains(t, out, "+\tprintln", "tabs should be preserved")
+	assert.Equal(t, sampleDiff, stripANSI(out), "only color codes should be added")
+}
+
+func TestDiffColorizer_PlainWhenNotTTY(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("NO_COLOR", "") // restored after the test
+	_ = os.Unsetenv("NO_COLOR")
+
+	var buf bytes.Buffer
+	c := NewDiffColorizer(&buf)
+
+	assert.False(t, c.Enabled())
+	assert.Equal(t, sampleDiff, c.Colorize(sampleDiff))
+}
+
+func TestDiffColorizer_PlainUnderNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	var buf bytes.Buffer
+	c := NewDiffColorizer(&buf, WithDiffTTY(true))
+
+	assert.False(t, c.Enabled())
+	assert.Equal(t, sampleDiff, c.Colorize(sampleDiff))
+}
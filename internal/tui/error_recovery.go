@@ -18,6 +18,8 @@
 package tui
 
 import (
+	"slices"
+
 	"github.com/mrz1836/atlas/internal/constants"
 )
 
@@ -162,6 +164,22 @@ func PRFailedOptions() []ErrorRecoveryOption {
 	}
 }
 
+// FilterRecoveryOptions returns options without the actions named in disabled.
+// The order of the remaining options is preserved.
+func FilterRecoveryOptions(options []ErrorRecoveryOption, disabled []string) []ErrorRecoveryOption {
+	if len(disabled) == 0 {
+		return options
+	}
+
+	filtered := make([]ErrorRecoveryOption, 0, len(options))
+	for _, opt := range options {
+		if !slices.Contains(disabled, string(opt.Action)) {
+			filtered = append(filtered, opt)
+		}
+	}
+	return filtered
+}
+
 // MenuTitleForGHFailedStep returns the appropriate menu title for a gh_failed state
 // based on the specific step that failed.
 func MenuTitleForGHFailedStep(stepName string) string {
@@ -263,9 +281,10 @@ func MenuTitleForStatus(status constants.TaskStatus) string {
 
 // SelectErrorRecovery presents an error recovery menu and returns the selected action.
 // Uses the established menu system from menus.go with ATLAS styling.
+// Actions named in disabled are left out of the menu.
 // Returns ErrMenuCanceled if user presses q or Esc.
-func SelectErrorRecovery(status constants.TaskStatus) (RecoveryAction, error) {
-	options := OptionsForStatus(status)
+func SelectErrorRecovery(status constants.TaskStatus, disabled ...string) (RecoveryAction, error) {
+	options := FilterRecoveryOptions(OptionsForStatus(status), disabled)
 	if len(options) == 0 {
 		return "", ErrMenuCanceled
 	}
@@ -438,6 +438,30 @@ func TestOptionsForGHFailedStep(t *testing.T) {
 	})
 }
 
+// TestFilterRecoveryOptions verifies disabled actions are removed from menus.
+func TestFilterRecoveryOptions(t *testing.T) {
+	t.Run("removes disabled actions in order", func(t *testing.T) {
+		options := tui.FilterRecoveryOptions(tui.GHFailedOptionsForPushError("non_fast_forward"), []string{"abandon", "rebase_retry"})
+
+		actions := make([]tui.RecoveryAction, len(options))
+		for i, opt := range options {
+			actions[i] = opt.Action
+		}
+		assert.Equal(t, []tui.RecoveryAction{tui.RecoveryActionRetryGH, tui.RecoveryActionFixManually}, actions)
+	})
+
+	t.Run("no disabled actions keeps all options", func(t *testing.T) {
+		assert.Equal(t, tui.ValidationFailedOptions(), tui.FilterRecoveryOptions(tui.ValidationFailedOptions(), nil))
+	})
+
+	t.Run("menu with every action disabled is canceled", func(t *testing.T) {
+		disabled := []string{"continue_waiting", "view_logs", "fix_manually", "abandon"}
+		action, err := tui.SelectErrorRecovery(constants.TaskStatusCITimeout, disabled...)
+		require.ErrorIs(t, err, tui.ErrMenuCanceled)
+		assert.Empty(t, action)
+	})
+}
+
 // TestAllStepOptionsHaveEscapeRoute verifies all step-specific menus have abandon option.
 func TestAllStepOptionsHaveEscapeRoute(t *testing.T) {
 	stepNames := []string{"git_commit", "git_push", "git_pr", "unknown_step", ""}
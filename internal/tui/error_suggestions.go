@@ -65,6 +65,7 @@ var errorSuggestions = []ErrorSuggestion{
 
 	// User input errors
 	{atlaserrors.ErrNonInteractiveMode, "Add: --force to confirm"},
+	{atlaserrors.ErrConfirmationRequired, "Add: --yes to confirm"},
 	{atlaserrors.ErrApprovalRequired, "Add: --auto-approve to skip confirmation"},
 	{atlaserrors.ErrConflictingFlags, "Remove one of the conflicting flags"},
 
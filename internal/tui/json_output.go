@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
 )
 
 // JSONOutput provides structured JSON output for non-TTY environments (AC: #4).
@@ -51,10 +54,11 @@ func (o *JSONOutput) Success(msg string) {
 // Format: {"type": "error", "message": "...", "details": "...", "suggestion": "...", "context": "..."}
 // Details field is populated with the wrapped error's message if present.
 // If the error is an ActionableError, suggestion and context fields are included.
+// Known secrets such as tokens are redacted from the message and details.
 func (o *JSONOutput) Error(err error) {
 	jsonErr := jsonError{
 		Type:    "error",
-		Message: err.Error(),
+		Message: atlaserrors.RedactString(err.Error()),
 	}
 
 	// Check for ActionableError and extract suggestion/context
@@ -72,7 +76,7 @@ func (o *JSONOutput) Error(err error) {
 	var wrapped error
 	if errors.Unwrap(err) != nil {
 		wrapped = errors.Unwrap(err)
-		jsonErr.Details = wrapped.Error()
+		jsonErr.Details = atlaserrors.RedactString(wrapped.Error())
 	}
 
 	//nolint:errchkjson // Method has no error return per interface contract
@@ -147,6 +151,10 @@ func (o *JSONOutput) Spinner(_ context.Context, _ string) Spinner {
 	return &NoopSpinner{}
 }
 
+// RenderChecklist does nothing for JSON output.
+// Interactive checklists are a terminal-only display.
+func (o *JSONOutput) RenderChecklist(_ []domain.Step) {}
+
 // jsonURL is the structured format for URL output.
 type jsonURL struct {
 	Type    string `json:"type"`
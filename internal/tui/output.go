@@ -7,6 +7,8 @@ import (
 	"os"
 
 	"golang.org/x/term"
+
+	"github.com/mrz1836/atlas/internal/domain"
 )
 
 // Output format constants (AC: #2).
@@ -59,6 +61,12 @@ type Output interface {
 	// TTY: Raw text output.
 	// JSON: Structured JSON with type "text".
 	Text(msg string)
+
+	// RenderChecklist outputs each step with its status (pending/running/done/failed).
+	// Call it again as steps progress to update the view.
+	// TTY: Redraws in place, or appends a line per status change without cursor control.
+	// JSON: No-op; step progress is reported through structured events.
+	RenderChecklist(steps []domain.Step)
 }
 
 // Spinner is the interface for progress indication during long-running operations (AC: #6).
@@ -0,0 +1,113 @@
+// Package tui provides terminal user interface components for ATLAS.
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultPager is the pager program used when $PAGER is not set.
+const DefaultPager = "less"
+
+// defaultPagerArgs make less exit immediately when content fits on one
+// screen (-F), pass colors through (-R), and leave the content visible
+// after quitting (-X).
+const defaultPagerArgs = "-FRX"
+
+// Pager displays long content through an external pager program such as less.
+// Paging only happens when the writer is a terminal; otherwise content is
+// written directly so piped output stays unchanged.
+type Pager struct {
+	w        io.Writer
+	getenv   func(string) string
+	isTTY    func(io.Writer) bool
+	lookPath func(string) (string, error)
+}
+
+// PagerOption is a functional option for Pager configuration.
+type PagerOption func(*Pager)
+
+// WithPagerEnv sets the environment lookup used to read $PAGER (for testing).
+func WithPagerEnv(getenv func(string) string) PagerOption {
+	return func(p *Pager) {
+		p.getenv = getenv
+	}
+}
+
+// WithPagerTTY overrides terminal detection for the pager's writer (for testing).
+func WithPagerTTY(isTerminal bool) PagerOption {
+	return func(p *Pager) {
+		p.isTTY = func(io.Writer) bool { return isTerminal }
+	}
+}
+
+// NewPager creates a pager that writes to w.
+func NewPager(w io.Writer, opts ...PagerOption) *Pager {
+	p := &Pager{
+		w:        w,
+		getenv:   os.Getenv,
+		isTTY:    isTTY,
+		lookPath: exec.LookPath,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Enabled returns true if content written through the pager will be paged.
+func (p *Pager) Enabled() bool {
+	return p.isTTY(p.w)
+}
+
+// Command returns the pager command and its arguments, taken from $PAGER
+// or DefaultPager when it is unset.
+func (p *Pager) Command() []string {
+	if fields := strings.Fields(p.getenv("PAGER")); len(fields) > 0 {
+		return fields
+	}
+	return []string{DefaultPager, defaultPagerArgs}
+}
+
+// Page shows content through the pager program when the writer is a terminal.
+// It writes content directly when not on a terminal or when the pager program
+// is missing or cannot be started.
+func (p *Pager) Page(ctx context.Context, content string) error {
+	if !p.Enabled() {
+		return p.writeDirect(content)
+	}
+
+	args := p.Command()
+	path, err := p.lookPath(args[0])
+	if err != nil {
+		return p.writeDirect(content)
+	}
+
+	cmd := exec.CommandContext(ctx, path, args[1:]...) //nolint:gosec // G204: pager command comes from the user's own $PAGER
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = p.w
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return p.writeDirect(content)
+	}
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("pager %s failed: %w", args[0], err)
+	}
+	return nil
+}
+
+// writeDirect writes content to the writer without paging.
+func (p *Pager) writeDirect(content string) error {
+	if _, err := io.WriteString(p.w, content); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(content, "\n") {
+		_, err := io.WriteString(p.w, "\n")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager_NonTTYWritesDirectly(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	called := false
+	p := NewPager(&buf, WithPagerEnv(func(string) string {
+		called = true
+		return "less"
+	}))
+
+	assert.False(t, p.Enabled())
+	require.NoError(t, p.Page(context.Background(), "line one\nline two"))
+	assert.Equal(t, "line one\nline two\n", buf.String())
+	assert.False(t, called, "pager command should not be resolved when not on a terminal")
+}
+
+func TestPager_Command(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		env      string
+		expected []string
+	}{
+		{"from env", "most -s", []string{"most", "-s"}},
+		{"default when unset", "", []string{DefaultPager, "-FRX"}},
+		{"default when blank", "   ", []string{DefaultPager, "-FRX"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := NewPager(&bytes.Buffer{}, WithPagerEnv(func(key string) string {
+				if key == "PAGER" {
+					return tt.env
+				}
+				return ""
+			}))
+			assert.Equal(t, tt.expected, p.Command())
+		})
+	}
+}
+
+func TestPager_MissingPagerFallsBack(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	p := NewPager(&buf,
+		WithPagerTTY(true),
+		WithPagerEnv(func(string) string { return "atlas-no-such-pager-binary" }),
+	)
+
+	require.NoError(t, p.Page(context.Background(), "validation output\n"))
+	assert.Equal(t, "validation output\n", buf.String())
+}
+
+func TestPager_PipesThroughCommand(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	p := NewPager(&buf,
+		WithPagerTTY(true),
+		WithPagerEnv(func(string) string { return "cat" }),
+	)
+
+	require.NoError(t, p.Page(context.Background(), "paged content\n"))
+	assert.Equal(t, "paged content\n", buf.String())
+}
@@ -114,9 +114,10 @@ var (
 // References the package-level color constants for consistency.
 func StatusColors() map[constants.WorkspaceStatus]AdaptiveColor {
 	return map[constants.WorkspaceStatus]AdaptiveColor{
-		constants.WorkspaceStatusActive: ColorPrimary, // Blue - active state
-		constants.WorkspaceStatusPaused: ColorMuted,   // Gray - paused state
-		constants.WorkspaceStatusClosed: ColorMuted,   // Gray - closed state
+		constants.WorkspaceStatusActive:  ColorPrimary, // Blue - active state
+		constants.WorkspaceStatusPaused:  ColorMuted,   // Gray - paused state
+		constants.WorkspaceStatusClosed:  ColorMuted,   // Gray - closed state
+		constants.WorkspaceStatusTrashed: ColorMuted,   // Gray - trashed state
 	}
 }
 
@@ -324,9 +325,10 @@ func ActionStyle() lipgloss.Style {
 //
 //nolint:gochecknoglobals // Intentional package-level constant for TUI styling
 var workspaceStatusIcons = map[constants.WorkspaceStatus]string{
-	constants.WorkspaceStatusActive: "●", // Filled circle - active
-	constants.WorkspaceStatusPaused: "○", // Empty circle - paused
-	constants.WorkspaceStatusClosed: "◌", // Dashed circle - closed
+	constants.WorkspaceStatusActive:  "●", // Filled circle - active
+	constants.WorkspaceStatusPaused:  "○", // Empty circle - paused
+	constants.WorkspaceStatusClosed:  "◌", // Dashed circle - closed
+	constants.WorkspaceStatusTrashed: "✕", // Cross - trashed
 }
 
 // WorkspaceStatusIcon returns the icon/symbol for a given workspace status.
@@ -54,6 +54,7 @@ func TestStatusColors(t *testing.T) {
 		constants.WorkspaceStatusActive,
 		constants.WorkspaceStatusPaused,
 		constants.WorkspaceStatusClosed,
+		constants.WorkspaceStatusTrashed,
 	}
 
 	for _, status := range statuses {
@@ -212,9 +213,10 @@ func TestWorkspaceStatusIcon(t *testing.T) {
 		status       constants.WorkspaceStatus
 		expectedIcon string
 	}{
-		{constants.WorkspaceStatusActive, "●"}, // Filled circle - active
-		{constants.WorkspaceStatusPaused, "○"}, // Empty circle - paused
-		{constants.WorkspaceStatusClosed, "◌"}, // Dashed circle - closed
+		{constants.WorkspaceStatusActive, "●"},  // Filled circle - active
+		{constants.WorkspaceStatusPaused, "○"},  // Empty circle - paused
+		{constants.WorkspaceStatusClosed, "◌"},  // Dashed circle - closed
+		{constants.WorkspaceStatusTrashed, "✕"}, // Cross - trashed
 	}
 
 	for _, tc := range tests {
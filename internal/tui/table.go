@@ -6,15 +6,18 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"charm.land/lipgloss/v2"
+	"github.com/mattn/go-runewidth"
 	"golang.org/x/term"
 
 	"github.com/mrz1836/atlas/internal/constants"
 )
 
 // TableColumn defines a column in a table.
+// A zero Width sizes the column to its content when rendered with Render.
 type TableColumn struct {
 	Name  string
 	Width int
@@ -32,19 +35,189 @@ const (
 )
 
 // Table provides styled table rendering.
+//
+// Rows can be written one at a time with fixed column widths, or collected
+// with AddRow and written together by Render, which sizes columns to their
+// content. On a terminal Render styles the header and truncates cells so each
+// line fits the terminal width; otherwise it writes plain, untruncated text.
 type Table struct {
-	w       io.Writer
-	styles  *TableStyles
-	columns []TableColumn
+	w        io.Writer
+	styles   *TableStyles
+	columns  []TableColumn
+	rows     [][]string
+	tty      bool
+	color    bool
+	maxWidth int
+}
+
+// TableOption is a functional option for Table configuration.
+type TableOption func(*Table)
+
+// WithTableTTY overrides terminal detection for the table's writer.
+// Turning it off also turns off color.
+func WithTableTTY(tty bool) TableOption {
+	return func(t *Table) {
+		t.tty = tty
+		t.color = tty && HasColorSupport()
+	}
+}
+
+// WithTableMaxWidth sets the line width Render truncates to on a terminal,
+// instead of the detected terminal width. Zero disables truncation.
+func WithTableMaxWidth(width int) TableOption {
+	return func(t *Table) {
+		t.maxWidth = max(width, 0)
+	}
 }
 
 // NewTable creates a new table with the given columns.
-func NewTable(w io.Writer, columns []TableColumn) *Table {
-	return &Table{
-		w:       w,
-		styles:  NewTableStyles(),
-		columns: columns,
+// Terminal status, color support (NO_COLOR), and the maximum line width are
+// detected from w and the environment unless overridden by options.
+func NewTable(w io.Writer, columns []TableColumn, opts ...TableOption) *Table {
+	tty := isTTY(w)
+	t := &Table{
+		w:        w,
+		styles:   NewTableStyles(),
+		columns:  columns,
+		tty:      tty,
+		color:    tty && HasColorSupport(),
+		maxWidth: -1,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if t.maxWidth < 0 {
+		t.maxWidth = 0
+		if t.tty {
+			t.maxWidth = TerminalWidth()
+		}
+	}
+	return t
+}
+
+// NewTableFromHeaders creates a table with a content-sized, left-aligned
+// column for each header.
+func NewTableFromHeaders(w io.Writer, headers []string, opts ...TableOption) *Table {
+	columns := make([]TableColumn, len(headers))
+	for i, h := range headers {
+		columns[i] = TableColumn{Name: h}
+	}
+	return NewTable(w, columns, opts...)
+}
+
+// AddRow collects a data row for Render. Missing values render empty and
+// extra values are ignored.
+func (t *Table) AddRow(values ...string) {
+	t.rows = append(t.rows, values)
+}
+
+// tableColumnGap separates columns in rendered tables.
+const tableColumnGap = "  "
+
+// minTableColumnWidth is the narrowest a column is shrunk to when fitting a
+// table to the terminal width.
+const minTableColumnWidth = 4
+
+// Render writes the header and collected rows with aligned columns.
+// Nothing is written for a table without columns.
+func (t *Table) Render() error {
+	if len(t.columns) == 0 {
+		return nil
+	}
+
+	widths := t.columnWidths()
+
+	header := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		header[i] = alignCell(truncateCell(col.Name, widths[i]), widths[i], col.Align)
+	}
+	line := strings.TrimRight(strings.Join(header, tableColumnGap), " ")
+	if t.color {
+		line = t.styles.Header.Render(line)
+	}
+	if _, err := fmt.Fprintln(t.w, line); err != nil {
+		return err
+	}
+
+	for _, row := range t.rows {
+		cells := make([]string, len(t.columns))
+		for i, col := range t.columns {
+			value := ""
+			if i < len(row) {
+				value = row[i]
+			}
+			cells[i] = alignCell(truncateCell(value, widths[i]), widths[i], col.Align)
+		}
+		if _, err := fmt.Fprintln(t.w, strings.TrimRight(strings.Join(cells, tableColumnGap), " ")); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// columnWidths returns the rendered width of each column: the fixed Width
+// when set, otherwise the widest header or cell. On a terminal with a known
+// width, the widest content-sized columns are narrowed until lines fit.
+func (t *Table) columnWidths() []int {
+	widths := make([]int, len(t.columns))
+	for i, col := range t.columns {
+		if col.Width > 0 {
+			widths[i] = col.Width
+			continue
+		}
+		widths[i] = runewidth.StringWidth(col.Name)
+		for _, row := range t.rows {
+			if i < len(row) {
+				widths[i] = max(widths[i], runewidth.StringWidth(row[i]))
+			}
+		}
+	}
+
+	if !t.tty || t.maxWidth <= 0 {
+		return widths
+	}
+
+	total := len(tableColumnGap) * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	for total > t.maxWidth {
+		widest := -1
+		for i, w := range widths {
+			if t.columns[i].Width == 0 && w > minTableColumnWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+	return widths
+}
+
+// truncateCell shortens s to width display columns, ending in "…" when cut.
+func truncateCell(s string, width int) string {
+	if runewidth.StringWidth(s) <= width {
+		return s
+	}
+	if width <= 1 {
+		return runewidth.Truncate(s, width, "")
+	}
+	return runewidth.Truncate(s, width, "…")
+}
+
+// alignCell pads s to width display columns according to align.
+func alignCell(s string, width int, align Alignment) string {
+	pad := width - runewidth.StringWidth(s)
+	if pad <= 0 {
+		return s
+	}
+	if align == AlignRight {
+		return strings.Repeat(" ", pad) + s
+	}
+	return s + strings.Repeat(" ", pad)
 }
 
 // WriteHeader writes the table header row.
@@ -685,6 +858,7 @@ type TaskInfo struct {
 	Status      constants.TaskStatus
 	CurrentStep int
 	TotalSteps  int
+	UpdatedAt   time.Time // Last task update, stored in UTC
 }
 
 // HierarchicalRow represents a row in the hierarchical status table.
@@ -824,6 +998,9 @@ func (t *HierarchicalStatusTable) ToJSONData() []HierarchicalJSONWorkspace {
 				Step:     fmt.Sprintf("%d/%d", task.CurrentStep, task.TotalSteps),
 				Template: task.Template,
 			}
+			if !task.UpdatedAt.IsZero() {
+				tasks[j].UpdatedAt = task.UpdatedAt.UTC().Format(time.RFC3339)
+			}
 		}
 
 		result[i] = HierarchicalJSONWorkspace{
@@ -1123,8 +1300,9 @@ type HierarchicalJSONWorkspace struct {
 
 // HierarchicalJSONTask is the JSON representation of a task.
 type HierarchicalJSONTask struct {
-	ID       string `json:"id"`
-	Status   string `json:"status"`
-	Step     string `json:"step"`
-	Template string `json:"template"`
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Step      string `json:"step"`
+	Template  string `json:"template"`
+	UpdatedAt string `json:"updated_at,omitempty"` // RFC3339 in UTC
 }
@@ -70,6 +70,82 @@ func TestTable(t *testing.T) {
 	})
 }
 
+func TestTable_Render(t *testing.T) {
+	headers := []string{"NAME", "STATUS", "DESCRIPTION"}
+	rows := [][]string{
+		{"auth-fix", "running", "Fix the login redirect"},
+		{"ui", "awaiting_approval", "Restyle the dashboard cards for the dark theme"},
+	}
+	render := func(opts ...TableOption) []string {
+		var buf bytes.Buffer
+		table := NewTableFromHeaders(&buf, headers, opts...)
+		for _, row := range rows {
+			table.AddRow(row...)
+		}
+		require.NoError(t, table.Render())
+		return strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	}
+
+	t.Run("aligns columns to the widest cell", func(t *testing.T) {
+		lines := render()
+		require.Len(t, lines, 3)
+		assert.Equal(t, "NAME      STATUS             DESCRIPTION", lines[0])
+		assert.Equal(t, "auth-fix  running            Fix the login redirect", lines[1])
+		assert.Equal(t, "ui        awaiting_approval  Restyle the dashboard cards for the dark theme", lines[2])
+	})
+
+	t.Run("truncates overlong cells to the terminal width", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "1")
+		lines := render(WithTableTTY(true), WithTableMaxWidth(50))
+		for _, line := range lines {
+			assert.LessOrEqual(t, utf8.RuneCountInString(line), 50, line)
+		}
+		assert.Equal(t, "ui        awaiting_approval  Restyle the dashboar…", lines[2])
+		assert.Equal(t, "auth-fix  running            Fix the login redire…", lines[1])
+	})
+
+	t.Run("plain and untruncated when not a TTY", func(t *testing.T) {
+		lines := render(WithTableMaxWidth(20))
+		assert.Contains(t, lines[2], "Restyle the dashboard cards for the dark theme")
+		for _, line := range lines {
+			assert.NotContains(t, line, "\x1b[")
+		}
+	})
+
+	t.Run("styles the header on a color TTY", func(t *testing.T) {
+		if _, exists := os.LookupEnv("NO_COLOR"); exists {
+			t.Skip("NO_COLOR is set")
+		}
+		t.Setenv("TERM", "xterm-256color")
+		lines := render(WithTableTTY(true), WithTableMaxWidth(0))
+		assert.Contains(t, lines[0], "\x1b[")
+		assert.NotContains(t, lines[1], "\x1b[")
+	})
+
+	t.Run("respects NO_COLOR on a TTY", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		lines := render(WithTableTTY(true), WithTableMaxWidth(0))
+		assert.Equal(t, "NAME      STATUS             DESCRIPTION", lines[0])
+	})
+
+	t.Run("fixed width columns keep their width", func(t *testing.T) {
+		var buf bytes.Buffer
+		table := NewTable(&buf, []TableColumn{
+			{Name: "N", Width: 5, Align: AlignRight},
+			{Name: "NAME"},
+		})
+		table.AddRow("42", "verylongname")
+		require.NoError(t, table.Render())
+		assert.Equal(t, "    N  NAME\n   42  verylongname\n", buf.String())
+	})
+
+	t.Run("no columns writes nothing", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, NewTableFromHeaders(&buf, nil).Render())
+		assert.Empty(t, buf.String())
+	})
+}
+
 func TestColorOffset(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/mrz1836/atlas/internal/clock"
+	"github.com/mrz1836/atlas/internal/constants"
 )
 
 // DefaultClock is the default clock used for time operations.
@@ -55,3 +56,25 @@ func RelativeTimeWith(t time.Time, c clock.Clock) string {
 		return fmt.Sprintf("%d weeks ago", weeks)
 	}
 }
+
+// DisplayLocation returns the zone used to display timestamps:
+// UTC when utc is true, otherwise the local zone.
+func DisplayLocation(utc bool) *time.Location {
+	if utc {
+		return time.UTC
+	}
+	return time.Local
+}
+
+// FormatTimestamp formats a stored timestamp in the given zone with an explicit
+// offset, e.g. "2024-01-15 16:30:00 +02:00". A nil location means local time.
+// Returns an empty string for the zero time.
+func FormatTimestamp(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	return t.In(loc).Format(constants.TimeFormatDisplay)
+}
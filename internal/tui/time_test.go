@@ -104,3 +104,33 @@ func TestRelativeTimeWith_EnsuresClockInterfaceIsUsed(t *testing.T) {
 	result := RelativeTimeWith(time.Now().Add(-1*time.Minute), c)
 	assert.NotEmpty(t, result)
 }
+
+func TestFormatTimestamp(t *testing.T) {
+	t.Parallel()
+	stored := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		loc      *time.Location
+		expected string
+	}{
+		{"fixed zone ahead", stored, time.FixedZone("CEST", 2*60*60), "2024-01-15 16:30:00 +02:00"},
+		{"fixed zone behind", stored, time.FixedZone("EST", -5*60*60), "2024-01-15 09:30:00 -05:00"},
+		{"utc", stored, time.UTC, "2024-01-15 14:30:00 +00:00"},
+		{"zero time", time.Time{}, time.UTC, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, FormatTimestamp(tt.input, tt.loc))
+		})
+	}
+}
+
+func TestDisplayLocation(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, time.UTC, DisplayLocation(true))
+	assert.Equal(t, time.Local, DisplayLocation(false))
+}
@@ -6,8 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"strings"
-	"unicode/utf8"
 
 	"github.com/charmbracelet/colorprofile"
 )
@@ -15,9 +13,13 @@ import (
 // TTYOutput provides styled terminal output using Lip Gloss (AC: #3).
 // Uses the style system from Story 7.1 for consistent styling.
 type TTYOutput struct {
-	w      io.Writer
+	w      io.Writer // Marks the checklist as scrolled past on every write
+	raw    io.Writer // Bypasses that tracking for the checklist and spinners
 	styles *OutputStyles
-	table  *TableStyles
+	tty    bool // w is a terminal, detected before any color wrapping
+
+	cursorControl bool // w supports in-place redraws
+	checklist     checklistState
 }
 
 // NewTTYOutput creates a new TTYOutput with styled output (AC: #3, #7).
@@ -28,17 +30,25 @@ func NewTTYOutput(w io.Writer) *TTYOutput {
 	// Respect NO_COLOR environment variable (AC: #7)
 	CheckNoColor()
 
+	// Detect cursor control before wrapping hides the underlying *os.File
+	cursorControl := supportsCursorControl(w)
+	tty := isTTY(w)
+
 	// In lipgloss v2, Style.Render() is pure (always emits ANSI).
 	// Wrap the writer to strip ANSI codes when colors are disabled.
 	if !HasColorSupport() {
 		w = &colorprofile.Writer{Forward: w, Profile: colorprofile.Ascii}
 	}
 
-	return &TTYOutput{
-		w:      w,
+	o := &TTYOutput{
+		raw:    w,
 		styles: NewOutputStyles(),
-		table:  NewTableStyles(),
+		tty:    tty,
+
+		cursorControl: cursorControl,
 	}
+	o.w = &checklistTrackingWriter{w: w, checklist: &o.checklist}
+	return o
 }
 
 // Success outputs a success message with green color and ✓ icon (AC: #3).
@@ -80,47 +90,17 @@ func (o *TTYOutput) Text(msg string) {
 }
 
 // Table outputs tabular data with aligned columns (AC: #5).
-// Applies TableStyles from styles.go for headers and cells.
+// Rendering is delegated to Table so every command's tables look the same.
 func (o *TTYOutput) Table(headers []string, rows [][]string) {
 	if len(headers) == 0 {
 		return
 	}
 
-	// Calculate column widths based on content (AC: #5 subtask 4.3)
-	widths := make([]int, len(headers))
-	for i, h := range headers {
-		widths[i] = utf8.RuneCountInString(h)
-	}
+	table := NewTableFromHeaders(o.w, headers, WithTableTTY(o.tty))
 	for _, row := range rows {
-		for i, cell := range row {
-			if i < len(widths) {
-				cellWidth := utf8.RuneCountInString(cell)
-				if cellWidth > widths[i] {
-					widths[i] = cellWidth
-				}
-			}
-		}
-	}
-
-	// Render header row with bold styling
-	headerParts := make([]string, 0, len(headers))
-	for i, h := range headers {
-		headerParts = append(headerParts, o.table.Header.Render(padRight(h, widths[i])))
-	}
-	_, _ = fmt.Fprintln(o.w, strings.Join(headerParts, "  "))
-
-	// Render data rows
-	for _, row := range rows {
-		var rowParts []string
-		for i := 0; i < len(headers); i++ {
-			cell := ""
-			if i < len(row) {
-				cell = row[i]
-			}
-			rowParts = append(rowParts, o.table.Cell.Render(padRight(cell, widths[i])))
-		}
-		_, _ = fmt.Fprintln(o.w, strings.Join(rowParts, "  "))
+		table.AddRow(row...)
 	}
+	_ = table.Render()
 }
 
 // JSON outputs an arbitrary value as formatted JSON.
@@ -135,7 +115,8 @@ func (o *TTYOutput) JSON(v any) error {
 // Spinner returns a SpinnerAdapter for animated progress indication (AC: #6).
 // Context is propagated for proper cancellation handling.
 func (o *TTYOutput) Spinner(ctx context.Context, msg string) Spinner {
-	return NewSpinnerAdapter(ctx, o.w, msg)
+	// Spinners clear their own line when stopped, so they don't move a checklist
+	return NewSpinnerAdapter(ctx, o.raw, msg)
 }
 
 // URL outputs a URL with clickable hyperlink in supported terminals.
@@ -0,0 +1,180 @@
+// Package tui provides terminal user interface components for ATLAS.
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// maxUnparsedOutputLines caps the output lines shown for a failed command
+// whose errors do not name a file.
+const maxUnparsedOutputLines = 10
+
+// fileErrorPattern matches compiler and linter diagnostics of the form
+// "path/to/file.go:12:3: message" (the column is optional).
+var fileErrorPattern = regexp.MustCompile(`^(\S+?\.[A-Za-z0-9]+):(\d+)(?::(\d+))?:\s*(.+)$`)
+
+// validationArtifact mirrors the JSON written by validation.ResultHandler.
+// It is decoded here so tui does not depend on the validation package.
+type validationArtifact struct {
+	Success          *bool                     `json:"success"`
+	FormatResults    []validationCommandResult `json:"format_results"`
+	LintResults      []validationCommandResult `json:"lint_results"`
+	TestResults      []validationCommandResult `json:"test_results"`
+	PreCommitResults []validationCommandResult `json:"pre_commit_results"`
+	DurationMs       int64                     `json:"duration_ms"`
+	FailedStepName   string                    `json:"failed_step"`
+}
+
+// validationCommandResult is one command's entry in a validation artifact.
+type validationCommandResult struct {
+	Command  string `json:"command"`
+	Success  bool   `json:"success"`
+	ExitCode int    `json:"exit_code"`
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	Error    string `json:"error"`
+}
+
+// fileError is a diagnostic parsed from command output.
+type fileError struct {
+	position string
+	message  string
+}
+
+// RenderValidationSummary renders a saved validation artifact as a compact
+// summary: the overall result, the error count, and each failed command's
+// errors grouped by file. Output that names no file is shown as-is, capped
+// at a few lines. When data is not a validation artifact it returns data
+// unchanged along with the parse error, so callers can display it either way.
+func RenderValidationSummary(data []byte) (string, error) {
+	var artifact validationArtifact
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return string(data), fmt.Errorf("%w: %w", atlaserrors.ErrMalformedValidationArtifact, err)
+	}
+	if artifact.Success == nil {
+		return string(data), fmt.Errorf("%w: missing success field", atlaserrors.ErrMalformedValidationArtifact)
+	}
+
+	styles := GetOutputStyles()
+	style := func(s lipgloss.Style, text string) string {
+		if !HasColorSupport() {
+			return text
+		}
+		return s.Render(text)
+	}
+
+	groups := []struct {
+		name    string
+		results []validationCommandResult
+	}{
+		{"format", artifact.FormatResults},
+		{"lint", artifact.LintResults},
+		{"test", artifact.TestResults},
+		{"pre-commit", artifact.PreCommitResults},
+	}
+
+	var body strings.Builder
+	errorCount, fileCount, failedCount := 0, 0, 0
+	for _, group := range groups {
+		for _, result := range group.results {
+			if result.Success {
+				continue
+			}
+			failedCount++
+			byFile, files, unparsed := parseFileErrors(result.Stdout + "\n" + result.Stderr)
+
+			header := fmt.Sprintf("  %s: %s (exit %d)", group.name, result.Command, result.ExitCode)
+			body.WriteString(style(styles.Error, header) + "\n")
+			if result.Error != "" {
+				body.WriteString("    " + result.Error + "\n")
+			}
+			for _, file := range files {
+				body.WriteString("    " + style(StyleBold, file) + "\n")
+				for _, e := range byFile[file] {
+					body.WriteString("      " + style(styles.Dim, e.position) + "  " + e.message + "\n")
+					errorCount++
+				}
+			}
+			fileCount += len(files)
+			if len(files) == 0 {
+				writeUnparsedOutput(&body, unparsed, styles.Dim, style)
+			}
+		}
+	}
+
+	var b strings.Builder
+	if *artifact.Success {
+		b.WriteString(style(styles.Success, "✓ Validation passed"))
+	} else {
+		title := "✗ Validation failed"
+		if artifact.FailedStepName != "" {
+			title += " at " + artifact.FailedStepName
+		}
+		b.WriteString(style(styles.Error, title))
+	}
+	switch {
+	case errorCount > 0:
+		b.WriteString(fmt.Sprintf(" (%s in %s)", pluralize(errorCount, "error"), pluralize(fileCount, "file")))
+	case failedCount > 0:
+		b.WriteString(fmt.Sprintf(" (%s failed)", pluralize(failedCount, "command")))
+	}
+	b.WriteString("\n")
+	if body.Len() > 0 {
+		b.WriteString("\n" + body.String())
+	}
+	return b.String(), nil
+}
+
+// parseFileErrors groups file diagnostics in output by file, in order of first
+// appearance, and returns the non-empty lines that are not diagnostics.
+func parseFileErrors(output string) (map[string][]fileError, []string, []string) {
+	byFile := make(map[string][]fileError)
+	var files, unparsed []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		m := fileErrorPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			unparsed = append(unparsed, line)
+			continue
+		}
+		position := m[2]
+		if m[3] != "" {
+			position += ":" + m[3]
+		}
+		if _, seen := byFile[m[1]]; !seen {
+			files = append(files, m[1])
+		}
+		byFile[m[1]] = append(byFile[m[1]], fileError{position: position, message: m[4]})
+	}
+	return byFile, files, unparsed
+}
+
+// writeUnparsedOutput writes the last maxUnparsedOutputLines lines of output,
+// noting how many earlier lines were left out.
+func writeUnparsedOutput(b *strings.Builder, lines []string, dim lipgloss.Style, style func(lipgloss.Style, string) string) {
+	if len(lines) > maxUnparsedOutputLines {
+		b.WriteString("    " + style(dim, fmt.Sprintf("... %d earlier lines omitted", len(lines)-maxUnparsedOutputLines)) + "\n")
+		lines = lines[len(lines)-maxUnparsedOutputLines:]
+	}
+	for _, line := range lines {
+		b.WriteString("    " + line + "\n")
+	}
+}
+
+// pluralize returns "1 error" or "3 errors".
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+const sampleValidationArtifact = `{
+  "success": false,
+  "format_results": [{"command": "magex format:fix", "success": true, "exit_code": 0}],
+  "lint_results": [{
+    "command": "magex lint",
+    "success": false,
+    "exit_code": 1,
+    "stdout": "internal/cli/open.go:12:3: unused variable x (unused)\ninternal/cli/open.go:40:1: missing doc comment\ninternal/task/store.go:8: line too long\n",
+    "stderr": "level=info msg=\"done\"\n"
+  }],
+  "test_results": [{
+    "command": "magex test",
+    "success": false,
+    "exit_code": 2,
+    "stdout": "",
+    "stderr": "panic: boom\ngoroutine 1 [running]\n"
+  }],
+  "pre_commit_results": [],
+  "duration_ms": 5400,
+  "failed_step": "lint"
+}`
+
+func TestRenderValidationSummary_Structured(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	summary, err := RenderValidationSummary([]byte(sampleValidationArtifact))
+	require.NoError(t, err)
+
+	lines := strings.Split(summary, "\n")
+	assert.Equal(t, "✗ Validation failed at lint (3 errors in 2 files)", lines[0])
+	assert.Contains(t, summary, "  lint: magex lint (exit 1)\n"+
+		"    internal/cli/open.go\n"+
+		"      12:3  unused variable x (unused)\n"+
+		"      40:1  missing doc comment\n"+
+		"    internal/task/store.go\n"+
+		"      8  line too long\n")
+	assert.Contains(t, summary, "  test: magex test (exit 2)\n    panic: boom\n")
+	assert.NotContains(t, summary, "magex format:fix", "passing commands are left out")
+	assert.NotContains(t, summary, "level=info", "non-diagnostic lines are dropped when a command has file errors")
+}
+
+func TestRenderValidationSummary_Passed(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	summary, err := RenderValidationSummary([]byte(`{"success": true, "lint_results": [{"command": "magex lint", "success": true}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Validation passed\n", summary)
+}
+
+func TestRenderValidationSummary_CapsUnparsedOutput(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	var stderr strings.Builder
+	for i := 1; i <= 15; i++ {
+		stderr.WriteString(fmt.Sprintf("line %d\\n", i))
+	}
+	data := `{"success": false, "test_results": [{"command": "go test", "success": false, "exit_code": 1, "stderr": "` + stderr.String() + `"}]}`
+
+	summary, err := RenderValidationSummary([]byte(data))
+	require.NoError(t, err)
+	assert.Contains(t, summary, "✗ Validation failed (1 command failed)")
+	assert.Contains(t, summary, "... 5 earlier lines omitted")
+	assert.NotContains(t, summary, "line 5\n")
+	assert.Contains(t, summary, "line 6\n")
+	assert.Contains(t, summary, "line 15\n")
+}
+
+func TestRenderValidationSummary_MalformedFallsBackToRaw(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "not JSON", data: "validation error from a plain log"},
+		{name: "truncated JSON", data: `{"success": false, "lint_results": [`},
+		{name: "other JSON shape", data: `{"errors": 3}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, err := RenderValidationSummary([]byte(tt.data))
+
+			require.ErrorIs(t, err, atlaserrors.ErrMalformedValidationArtifact)
+			assert.Equal(t, tt.data, summary)
+		})
+	}
+}
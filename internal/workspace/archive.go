@@ -0,0 +1,264 @@
+package workspace
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// Export writes a tar.gz archive of the workspace record, its tasks, and their
+// artifacts to w. Entries are rooted at the workspace name. The worktree is not
+// included, nor are lock or temporary files.
+func (s *FileStore) Export(ctx context.Context, name string, w io.Writer) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	if err := validateName(name); err != nil {
+		return fmt.Errorf("failed to export workspace '%s': %w", name, err)
+	}
+
+	exists, err := s.Exists(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to export workspace '%s': %w", name, err)
+	}
+	if !exists {
+		return fmt.Errorf("failed to export workspace '%s': %w", name, atlaserrors.ErrWorkspaceNotFound)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	wsPath := s.workspacePath(name)
+	walkErr := filepath.WalkDir(wsPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctxutil.Canceled(ctx); err != nil {
+			return err
+		}
+		if isArchiveExcluded(d.Name()) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.workspacesDir(), p)
+		if err != nil {
+			return err
+		}
+		return addArchiveEntry(tw, p, filepath.ToSlash(rel), d)
+	})
+	if walkErr != nil {
+		return fmt.Errorf("failed to export workspace '%s': %w", name, walkErr)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to export workspace '%s': %w", name, err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to export workspace '%s': %w", name, err)
+	}
+
+	return nil
+}
+
+// Import reconstitutes a workspace from an archive produced by Export and
+// returns its name. It refuses to overwrite an existing workspace, including
+// one whose task history was preserved after closing.
+func (s *FileStore) Import(ctx context.Context, r io.Reader) (string, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return "", err
+	}
+
+	if err := s.mkdirAll(s.workspacesDir()); err != nil {
+		return "", fmt.Errorf("failed to import workspace: %w", err)
+	}
+
+	// Extract into a staging directory so a failed import leaves nothing behind
+	stagingDir, err := os.MkdirTemp(s.workspacesDir(), ".import-")
+	if err != nil {
+		return "", fmt.Errorf("failed to import workspace: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(stagingDir) }()
+
+	name, err := s.extractArchive(ctx, r, stagingDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to import workspace: %w", err)
+	}
+
+	staged := filepath.Join(stagingDir, name)
+	ws, err := s.getFromDir(ctx, name, staged)
+	if err != nil {
+		return "", fmt.Errorf("failed to import workspace '%s': %w", name, atlaserrors.ErrInvalidWorkspaceArchive)
+	}
+
+	// Point the record at its new location before moving it into place
+	ws.Path = s.workspacePath(name)
+	data, err := json.MarshalIndent(ws, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to import workspace '%s': %w", name, err)
+	}
+	if err := atomicWrite(filepath.Join(staged, constants.WorkspaceFileName), data, s.filePerm); err != nil {
+		return "", fmt.Errorf("failed to import workspace '%s': %w", name, err)
+	}
+
+	if _, err := os.Stat(ws.Path); err == nil {
+		return "", fmt.Errorf("failed to import workspace '%s': %w", name, atlaserrors.ErrWorkspaceExists)
+	}
+	if err := os.Rename(staged, ws.Path); err != nil {
+		return "", fmt.Errorf("failed to import workspace '%s': %w", name, err)
+	}
+
+	s.indexPut(ctx, ws)
+
+	return name, nil
+}
+
+// isArchiveExcluded reports whether a file in the workspace directory is
+// transient and should not be exported.
+func isArchiveExcluded(base string) bool {
+	return strings.HasSuffix(base, ".lock") || strings.HasSuffix(base, ".tmp")
+}
+
+// addArchiveEntry writes a directory or regular file to the tar archive.
+// Other file types (symlinks, devices) are skipped.
+func addArchiveEntry(tw *tar.Writer, fullPath, name string, d fs.DirEntry) error {
+	if !d.IsDir() && !d.Type().IsRegular() {
+		return nil
+	}
+
+	info, err := d.Info()
+	if err != nil {
+		return err
+	}
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = name
+	if d.IsDir() {
+		hdr.Name += "/"
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if d.IsDir() {
+		return nil
+	}
+
+	f, err := os.Open(fullPath) //#nosec G304 -- path comes from walking the workspace directory
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// extractArchive unpacks a workspace archive into destDir and returns the
+// workspace name. Every entry must live under a single valid workspace name;
+// absolute paths, parent references, and non-regular files are rejected.
+func (s *FileStore) extractArchive(ctx context.Context, r io.Reader, destDir string) (string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", atlaserrors.ErrInvalidWorkspaceArchive, err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	tr := tar.NewReader(gz)
+	var name string
+	for {
+		if err := ctxutil.Canceled(ctx); err != nil {
+			return "", err
+		}
+
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", atlaserrors.ErrInvalidWorkspaceArchive, err)
+		}
+
+		entryName, err := archiveEntryRoot(hdr.Name, &name)
+		if err != nil {
+			return "", err
+		}
+
+		target := filepath.Join(destDir, filepath.FromSlash(entryName))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := s.mkdirAll(target); err != nil {
+				return "", err
+			}
+		case tar.TypeReg:
+			if err := s.extractArchiveFile(tr, target); err != nil {
+				return "", err
+			}
+		default:
+			return "", fmt.Errorf("%w: unsupported entry type for %q", atlaserrors.ErrInvalidWorkspaceArchive, hdr.Name)
+		}
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("%w: archive is empty", atlaserrors.ErrInvalidWorkspaceArchive)
+	}
+	return name, nil
+}
+
+// archiveEntryRoot cleans an entry name and checks it lives under the archive's
+// workspace directory, recording that workspace name from the first entry.
+func archiveEntryRoot(entry string, name *string) (string, error) {
+	cleaned := path.Clean(strings.TrimSuffix(entry, "/"))
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("%w: unsafe path %q", atlaserrors.ErrInvalidWorkspaceArchive, entry)
+	}
+
+	root, _, _ := strings.Cut(cleaned, "/")
+	if *name == "" {
+		if err := validateName(root); err != nil {
+			return "", fmt.Errorf("%w: %w", atlaserrors.ErrInvalidWorkspaceArchive, err)
+		}
+		*name = root
+	}
+	if root != *name {
+		return "", fmt.Errorf("%w: entries for multiple workspaces (%q and %q)", atlaserrors.ErrInvalidWorkspaceArchive, *name, root)
+	}
+
+	return cleaned, nil
+}
+
+// extractArchiveFile writes a single regular file from the archive to target.
+func (s *FileStore) extractArchiveFile(r io.Reader, target string) error {
+	if err := s.mkdirAll(filepath.Dir(target)); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, s.filePerm) //#nosec G304 -- target is validated by archiveEntryRoot
+	if err != nil {
+		return err
+	}
+	if err := f.Chmod(s.filePerm); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil { //#nosec G110 -- archives are produced by Export for support and reproduction
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,156 @@
+package workspace
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// seedArchiveWorkspace creates a workspace with one task, a log, and an artifact.
+func seedArchiveWorkspace(t *testing.T, store *FileStore, name string) {
+	t.Helper()
+
+	require.NoError(t, store.Create(context.Background(), &domain.Workspace{
+		Name:         name,
+		WorktreePath: filepath.Join(t.TempDir(), "worktree"),
+		Branch:       "feat/" + name,
+		Status:       constants.WorkspaceStatusPaused,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}))
+
+	taskDir := filepath.Join(store.workspacePath(name), constants.TasksDir, "task-1")
+	require.NoError(t, os.MkdirAll(filepath.Join(taskDir, constants.ArtifactsDir, "validate"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(taskDir, constants.TaskFileName), []byte(`{"id":"task-1"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(taskDir, "task.log"), []byte("{\"msg\":\"started\"}\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(taskDir, constants.ArtifactsDir, "validate", "output.log"), []byte("lint ok"), 0o600))
+}
+
+// TestFileStore_ExportImport_RoundTrip tests a workspace exported from one store
+// is reconstituted with its tasks and artifacts in a fresh store.
+func TestFileStore_ExportImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	seedArchiveWorkspace(t, src, "auth-fix")
+
+	var archive bytes.Buffer
+	require.NoError(t, src.Export(ctx, "auth-fix", &archive))
+
+	dst, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	name, err := dst.Import(ctx, &archive)
+	require.NoError(t, err)
+	assert.Equal(t, "auth-fix", name)
+
+	ws, err := dst.Get(ctx, "auth-fix")
+	require.NoError(t, err)
+	assert.Equal(t, "feat/auth-fix", ws.Branch)
+	assert.Equal(t, constants.WorkspaceStatusPaused, ws.Status)
+	assert.Equal(t, dst.workspacePath("auth-fix"), ws.Path)
+
+	taskDir := filepath.Join(dst.workspacePath("auth-fix"), constants.TasksDir, "task-1")
+	data, err := os.ReadFile(filepath.Join(taskDir, constants.TaskFileName)) //#nosec G304 -- test path
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"task-1"}`, string(data))
+
+	data, err = os.ReadFile(filepath.Join(taskDir, constants.ArtifactsDir, "validate", "output.log")) //#nosec G304 -- test path
+	require.NoError(t, err)
+	assert.Equal(t, "lint ok", string(data))
+
+	// Lock files and staging directories are not carried over
+	_, err = os.Stat(dst.lockFilePath("auth-fix"))
+	assert.True(t, os.IsNotExist(err))
+	entries, err := os.ReadDir(dst.workspacesDir())
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// TestFileStore_Import_RefusesExistingWorkspace tests import does not overwrite
+// a workspace that already exists in the store.
+func TestFileStore_Import_RefusesExistingWorkspace(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	seedArchiveWorkspace(t, store, "auth-fix")
+
+	var archive bytes.Buffer
+	require.NoError(t, store.Export(ctx, "auth-fix", &archive))
+
+	_, err = store.Import(ctx, &archive)
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceExists)
+
+	entries, err := os.ReadDir(store.workspacesDir())
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "staging directory should be cleaned up")
+}
+
+// TestFileStore_Export_NotFound tests exporting a missing workspace.
+func TestFileStore_Export_NotFound(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	err = store.Export(context.Background(), "missing", &archive)
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+}
+
+// TestFileStore_Import_RejectsUnsafeArchives tests malformed and path-traversal archives.
+func TestFileStore_Import_RejectsUnsafeArchives(t *testing.T) {
+	buildArchive := func(t *testing.T, names ...string) *bytes.Buffer {
+		t.Helper()
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		for _, name := range names {
+			content := []byte("{}")
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+			_, err := tw.Write(content)
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+		return &buf
+	}
+
+	tests := []struct {
+		name    string
+		archive func(t *testing.T) *bytes.Buffer
+	}{
+		{"not gzip", func(_ *testing.T) *bytes.Buffer { return bytes.NewBufferString("plain text") }},
+		{"empty archive", func(t *testing.T) *bytes.Buffer { return buildArchive(t) }},
+		{"parent traversal", func(t *testing.T) *bytes.Buffer { return buildArchive(t, "../evil/workspace.json") }},
+		{"absolute path", func(t *testing.T) *bytes.Buffer { return buildArchive(t, "/etc/workspace.json") }},
+		{"multiple workspaces", func(t *testing.T) *bytes.Buffer {
+			return buildArchive(t, "one/workspace.json", "two/workspace.json")
+		}},
+		{"missing workspace record", func(t *testing.T) *bytes.Buffer { return buildArchive(t, "one/tasks/task-1/task.json") }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store, err := NewFileStore(t.TempDir())
+			require.NoError(t, err)
+
+			_, err = store.Import(context.Background(), tc.archive(t))
+			require.ErrorIs(t, err, atlaserrors.ErrInvalidWorkspaceArchive)
+
+			entries, err := os.ReadDir(store.workspacesDir())
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
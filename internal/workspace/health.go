@@ -0,0 +1,86 @@
+// Package workspace provides workspace persistence and management for ATLAS.
+// This file implements read-only worktree health checks.
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mrz1836/atlas/internal/ctxutil"
+)
+
+// HealthReport describes problems found in a workspace's worktree.
+// An empty Issues list means the worktree is safe to resume in.
+type HealthReport struct {
+	Workspace      string   // Workspace name
+	WorktreePath   string   // Worktree path recorded for the workspace
+	ExpectedBranch string   // Branch recorded for the workspace
+	Branch         string   // Branch actually checked out, empty if detached or unknown
+	HeadCommit     string   // HEAD commit SHA, if the worktree could be inspected
+	Issues         []string // Human-readable problems, in detection order
+}
+
+// Healthy returns true if no issues were found.
+func (r HealthReport) Healthy() bool {
+	return len(r.Issues) == 0
+}
+
+// Healthcheck inspects the workspace's worktree and reports issues such as a
+// missing directory, detached HEAD, branch mismatch, or an interrupted rebase
+// or merge. It never modifies the worktree or workspace state.
+// Returns an error only if the workspace cannot be loaded or git inspection fails.
+func (m *DefaultManager) Healthcheck(ctx context.Context, name string) (HealthReport, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return HealthReport{}, err
+	}
+
+	unlock, err := m.lockWorkspace(name, true)
+	if err != nil {
+		return HealthReport{}, err
+	}
+	defer unlock()
+
+	ws, err := m.store.Get(ctx, name)
+	if err != nil {
+		return HealthReport{}, err
+	}
+
+	report := HealthReport{
+		Workspace:      ws.Name,
+		WorktreePath:   ws.WorktreePath,
+		ExpectedBranch: ws.Branch,
+	}
+
+	if ws.WorktreePath == "" {
+		report.Issues = append(report.Issues, "no worktree path recorded")
+		return report, nil
+	}
+	if _, statErr := os.Stat(ws.WorktreePath); statErr != nil {
+		report.Issues = append(report.Issues, fmt.Sprintf("worktree directory %s is missing", ws.WorktreePath))
+		return report, nil
+	}
+
+	status, err := m.worktreeRunner.Inspect(ctx, ws.WorktreePath)
+	if err != nil {
+		return report, fmt.Errorf("failed to inspect worktree for workspace '%s': %w", name, err)
+	}
+	report.Branch = status.Branch
+	report.HeadCommit = status.HeadCommit
+
+	switch {
+	case status.Branch == "":
+		report.Issues = append(report.Issues, "HEAD is detached")
+	case ws.Branch != "" && status.Branch != ws.Branch:
+		report.Issues = append(report.Issues,
+			fmt.Sprintf("worktree is on branch %q, expected %q", status.Branch, ws.Branch))
+	}
+	if status.RebaseInProgress {
+		report.Issues = append(report.Issues, "a rebase is in progress")
+	}
+	if status.MergeInProgress {
+		report.Issues = append(report.Issues, "a merge is in progress")
+	}
+
+	return report, nil
+}
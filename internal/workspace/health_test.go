@@ -0,0 +1,96 @@
+package workspace
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func TestDefaultManager_Healthcheck_CleanWorktree(t *testing.T) {
+	wtPath := t.TempDir()
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{Name: "test", WorktreePath: wtPath, Branch: "feat/test"}
+	runner := newMockWorktreeRunner()
+	runner.inspectResult = &WorktreeStatus{Branch: "feat/test", HeadCommit: "abc123"}
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	report, err := mgr.Healthcheck(context.Background(), "test")
+
+	require.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.Empty(t, report.Issues)
+	assert.Equal(t, "feat/test", report.Branch)
+	assert.Equal(t, "abc123", report.HeadCommit)
+	assert.Equal(t, wtPath, runner.inspectLastPath)
+}
+
+func TestDefaultManager_Healthcheck_BranchMismatch(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{Name: "test", WorktreePath: t.TempDir(), Branch: "feat/test"}
+	runner := newMockWorktreeRunner()
+	runner.inspectResult = &WorktreeStatus{Branch: "feat/other", RebaseInProgress: true}
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	report, err := mgr.Healthcheck(context.Background(), "test")
+
+	require.NoError(t, err)
+	assert.False(t, report.Healthy())
+	assert.Equal(t, []string{
+		`worktree is on branch "feat/other", expected "feat/test"`,
+		"a rebase is in progress",
+	}, report.Issues)
+	assert.Equal(t, "feat/test", store.workspaces["test"].Branch, "healthcheck must not modify the workspace")
+}
+
+func TestDefaultManager_Healthcheck_DetachedHead(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{Name: "test", WorktreePath: t.TempDir(), Branch: "feat/test"}
+	runner := newMockWorktreeRunner()
+	runner.inspectResult = &WorktreeStatus{HeadCommit: "abc123", MergeInProgress: true}
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	report, err := mgr.Healthcheck(context.Background(), "test")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HEAD is detached", "a merge is in progress"}, report.Issues)
+}
+
+func TestDefaultManager_Healthcheck_MissingWorktree(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "gone")
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{Name: "test", WorktreePath: missing, Branch: "feat/test"}
+	runner := newMockWorktreeRunner()
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	report, err := mgr.Healthcheck(context.Background(), "test")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"worktree directory " + missing + " is missing"}, report.Issues)
+	assert.Empty(t, runner.inspectLastPath, "missing worktree should not be inspected")
+}
+
+func TestDefaultManager_Healthcheck_InspectError(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{Name: "test", WorktreePath: t.TempDir(), Branch: "feat/test"}
+	runner := newMockWorktreeRunner()
+	runner.inspectErr = atlaserrors.ErrGitOperation
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	_, err := mgr.Healthcheck(context.Background(), "test")
+
+	require.ErrorIs(t, err, atlaserrors.ErrGitOperation)
+}
+
+func TestDefaultManager_Healthcheck_WorkspaceNotFound(t *testing.T) {
+	mgr := NewManager(newMockStore(), newMockWorktreeRunner(), zerolog.Nop())
+	_, err := mgr.Healthcheck(context.Background(), "missing")
+
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+}
@@ -0,0 +1,211 @@
+// Package workspace provides workspace persistence and management for ATLAS.
+// This file implements the workspace index: a single index.json summarizing
+// every workspace so listings do not have to open each workspace.json.
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// IndexFileName is the name of the workspace index file in the workspaces directory.
+const IndexFileName = "index.json"
+
+// indexVersion is the current version of the index file format.
+const indexVersion = 1
+
+// IndexEntry summarizes one workspace in the index.
+type IndexEntry struct {
+	Name      string                    `json:"name"`
+	Status    constants.WorkspaceStatus `json:"status"`
+	Branch    string                    `json:"branch"`
+	CreatedAt time.Time                 `json:"created_at"`
+	UpdatedAt time.Time                 `json:"updated_at"`
+	Tasks     []domain.TaskRef          `json:"tasks,omitempty"`
+}
+
+// Workspace returns a workspace populated with the entry's fields.
+// Fields not kept in the index, such as WorktreePath, are empty.
+func (e IndexEntry) Workspace() *domain.Workspace {
+	return &domain.Workspace{
+		Name:      e.Name,
+		Status:    e.Status,
+		Branch:    e.Branch,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+		Tasks:     e.Tasks,
+	}
+}
+
+// workspaceIndex is the on-disk format of index.json.
+type workspaceIndex struct {
+	Version    int          `json:"version"`
+	Workspaces []IndexEntry `json:"workspaces"`
+}
+
+// newIndexEntry builds the index entry for a workspace.
+func newIndexEntry(ws *domain.Workspace) IndexEntry {
+	return IndexEntry{
+		Name:      ws.Name,
+		Status:    ws.Status,
+		Branch:    ws.Branch,
+		CreatedAt: ws.CreatedAt,
+		UpdatedAt: ws.UpdatedAt,
+		Tasks:     ws.Tasks,
+	}
+}
+
+// ListIndex returns the summary of every workspace from the index, sorted
+// by name. If the index is missing or unreadable it is rebuilt from the
+// individual workspace files first.
+func (s *FileStore) ListIndex(ctx context.Context) ([]IndexEntry, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return nil, err
+	}
+
+	idx, err := s.readIndex()
+	if err == nil {
+		return idx.Workspaces, nil
+	}
+	return s.RebuildIndex(ctx)
+}
+
+// RebuildIndex reconstructs the index from the individual workspace files,
+// replacing whatever index.json held. Use it when the index may be stale,
+// e.g. after workspace directories were changed by hand.
+func (s *FileStore) RebuildIndex(ctx context.Context) ([]IndexEntry, error) {
+	// Hold the lock while scanning so a concurrent write is applied after the rebuild
+	lockFile, err := s.acquireIndexLock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild workspace index: %w", err)
+	}
+	defer func() { _ = s.releaseLock(lockFile) }()
+
+	workspaces, err := s.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild workspace index: %w", err)
+	}
+
+	entries := make([]IndexEntry, 0, len(workspaces))
+	for _, ws := range workspaces {
+		entries = append(entries, newIndexEntry(ws))
+	}
+	sortIndexEntries(entries)
+
+	if err := s.writeIndex(&workspaceIndex{Version: indexVersion, Workspaces: entries}); err != nil {
+		return nil, fmt.Errorf("failed to rebuild workspace index: %w", err)
+	}
+	return entries, nil
+}
+
+// indexPut records ws in the index.
+func (s *FileStore) indexPut(ctx context.Context, ws *domain.Workspace) {
+	entry := newIndexEntry(ws)
+	s.modifyIndex(ctx, func(entries []IndexEntry) []IndexEntry {
+		entries = slices.DeleteFunc(entries, func(e IndexEntry) bool { return e.Name == ws.Name })
+		return append(entries, entry)
+	})
+}
+
+// indexRemove drops the named workspace from the index.
+func (s *FileStore) indexRemove(ctx context.Context, name string) {
+	s.modifyIndex(ctx, func(entries []IndexEntry) []IndexEntry {
+		return slices.DeleteFunc(entries, func(e IndexEntry) bool { return e.Name == name })
+	})
+}
+
+// modifyIndex applies fn to the index under the index lock. A missing index
+// is left for ListIndex to rebuild. If the index cannot be updated it is
+// removed, so the next ListIndex rebuilds it rather than serving stale data.
+func (s *FileStore) modifyIndex(ctx context.Context, fn func([]IndexEntry) []IndexEntry) {
+	// Stores that are never listed never get an index or its lock file
+	if _, err := os.Stat(s.indexFilePath()); err != nil {
+		return
+	}
+
+	lockFile, err := s.acquireIndexLock(ctx)
+	if err != nil {
+		s.invalidateIndex()
+		return
+	}
+	defer func() { _ = s.releaseLock(lockFile) }()
+
+	idx, err := s.readIndex()
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		s.invalidateIndex()
+		return
+	}
+
+	idx.Workspaces = fn(idx.Workspaces)
+	sortIndexEntries(idx.Workspaces)
+	if err := s.writeIndex(idx); err != nil {
+		s.invalidateIndex()
+	}
+}
+
+// readIndex loads and parses index.json.
+func (s *FileStore) readIndex() (*workspaceIndex, error) {
+	data, err := os.ReadFile(s.indexFilePath())
+	if err != nil {
+		return nil, err
+	}
+	var idx workspaceIndex
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, err
+	}
+	if idx.Version != indexVersion {
+		return nil, fmt.Errorf("%w: unsupported index version %d", atlaserrors.ErrWorkspaceCorrupted, idx.Version)
+	}
+	return &idx, nil
+}
+
+// writeIndex atomically writes index.json.
+func (s *FileStore) writeIndex(idx *workspaceIndex) error {
+	if err := s.mkdirAll(s.workspacesDir()); err != nil {
+		return fmt.Errorf("failed to create workspaces directory: %w", err)
+	}
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return atomicWrite(s.indexFilePath(), data, s.filePerm)
+}
+
+// invalidateIndex removes index.json so the next ListIndex rebuilds it.
+func (s *FileStore) invalidateIndex() {
+	_ = os.Remove(s.indexFilePath())
+}
+
+// acquireIndexLock takes the exclusive lock guarding index.json.
+func (s *FileStore) acquireIndexLock(ctx context.Context) (*os.File, error) {
+	if err := s.mkdirAll(s.workspacesDir()); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+	return lockFileWithTimeout(ctx, s.indexFilePath()+".lock", s.filePerm)
+}
+
+// indexFilePath returns the path to the workspace index file.
+func (s *FileStore) indexFilePath() string {
+	return filepath.Join(s.workspacesDir(), IndexFileName)
+}
+
+// sortIndexEntries orders entries by workspace name.
+func sortIndexEntries(entries []IndexEntry) {
+	slices.SortFunc(entries, func(a, b IndexEntry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,148 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// indexNames returns the workspace names in index order.
+func indexNames(entries []IndexEntry) []string {
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name
+	}
+	return names
+}
+
+// assertIndexMatchesDisk checks the stored index equals a fresh scan of the workspace files.
+func assertIndexMatchesDisk(t *testing.T, store *FileStore) {
+	t.Helper()
+
+	idx, err := store.readIndex()
+	require.NoError(t, err)
+
+	workspaces, err := store.List(context.Background())
+	require.NoError(t, err)
+	expected := make([]IndexEntry, 0, len(workspaces))
+	for _, ws := range workspaces {
+		expected = append(expected, newIndexEntry(ws))
+	}
+	sortIndexEntries(expected)
+
+	require.Len(t, idx.Workspaces, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Name, idx.Workspaces[i].Name)
+		assert.Equal(t, expected[i].Status, idx.Workspaces[i].Status)
+		assert.Equal(t, expected[i].Branch, idx.Workspaces[i].Branch)
+		assert.True(t, expected[i].UpdatedAt.Equal(idx.Workspaces[i].UpdatedAt), expected[i].Name)
+	}
+}
+
+func TestListIndex_BuildsMissingIndex(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, name := range []string{"bravo", "alpha"} {
+		require.NoError(t, store.Create(ctx, &domain.Workspace{Name: name, Branch: "feat/" + name, Status: constants.WorkspaceStatusActive}))
+	}
+	_, statErr := os.Stat(store.indexFilePath())
+	require.True(t, os.IsNotExist(statErr), "index should only be created when first listed")
+
+	entries, err := store.ListIndex(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alpha", "bravo"}, indexNames(entries))
+	assert.Equal(t, "feat/alpha", entries[0].Branch)
+	assertIndexMatchesDisk(t, store)
+}
+
+func TestIndex_ConsistentAcrossCRUD(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = store.ListIndex(ctx)
+	require.NoError(t, err)
+
+	ws := &domain.Workspace{Name: "one", Branch: "feat/one", Status: constants.WorkspaceStatusActive}
+	require.NoError(t, store.Create(ctx, ws))
+	require.NoError(t, store.Create(ctx, &domain.Workspace{Name: "two", Branch: "feat/two", Status: constants.WorkspaceStatusActive}))
+	assertIndexMatchesDisk(t, store)
+
+	ws.Status = constants.WorkspaceStatusClosed
+	ws.Tasks = []domain.TaskRef{{ID: "task-1", Status: constants.TaskStatusCompleted}}
+	require.NoError(t, store.Update(ctx, ws))
+	assertIndexMatchesDisk(t, store)
+
+	entries, err := store.ListIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, constants.WorkspaceStatusClosed, entries[0].Status)
+	assert.Len(t, entries[0].Tasks, 1)
+
+	require.NoError(t, store.Delete(ctx, "two"))
+	assertIndexMatchesDisk(t, store)
+
+	require.NoError(t, store.ResetMetadata(ctx, "one"))
+	assertIndexMatchesDisk(t, store)
+
+	entries, err = store.ListIndex(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestRebuildIndex_ReconstructsFromRecords(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = store.ListIndex(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, &domain.Workspace{Name: "kept", Branch: "feat/kept", Status: constants.WorkspaceStatusActive}))
+	require.NoError(t, store.Create(ctx, &domain.Workspace{Name: "removed", Branch: "feat/removed", Status: constants.WorkspaceStatusActive}))
+
+	// Change the records behind the store's back so the index goes stale
+	require.NoError(t, os.RemoveAll(store.workspacePath("removed")))
+	stale, err := store.ListIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kept", "removed"}, indexNames(stale))
+
+	entries, err := store.RebuildIndex(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kept"}, indexNames(entries))
+	assertIndexMatchesDisk(t, store)
+}
+
+func TestListIndex_RebuildsCorruptedIndex(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, store.Create(ctx, &domain.Workspace{Name: "ws", Status: constants.WorkspaceStatusActive}))
+	require.NoError(t, os.WriteFile(store.indexFilePath(), []byte("{not json"), constants.WorkspaceFilePerm))
+
+	entries, err := store.ListIndex(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ws"}, indexNames(entries))
+	assertIndexMatchesDisk(t, store)
+}
+
+func TestIndexEntry_Workspace(t *testing.T) {
+	entry := IndexEntry{Name: "ws", Branch: "feat/ws", Status: constants.WorkspaceStatusPaused}
+
+	ws := entry.Workspace()
+
+	assert.Equal(t, "ws", ws.Name)
+	assert.Equal(t, "feat/ws", ws.Branch)
+	assert.Equal(t, constants.WorkspaceStatusPaused, ws.Status)
+	assert.Empty(t, ws.WorktreePath)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/mrz1836/atlas/internal/ctxutil"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/flock"
 )
 
 // TaskLister defines the interface for listing tasks by workspace.
@@ -106,6 +107,8 @@ type CreateOptions struct {
 	// ExistingBranch is the name of an existing branch to checkout.
 	// Mutually exclusive with BranchType. Used for hotfix workflows
 	// where you want to work on an existing branch without creating a new one.
+	// If the branch exists only on the remote, it is fetched and the worktree
+	// tracks the remote branch.
 	ExistingBranch string
 }
 
@@ -122,6 +125,10 @@ type Reader interface {
 
 	// Exists returns true if a workspace exists.
 	Exists(ctx context.Context, name string) (bool, error)
+
+	// Healthcheck inspects the workspace's worktree without modifying it.
+	// Returns ErrWorkspaceNotFound if the workspace does not exist.
+	Healthcheck(ctx context.Context, name string) (HealthReport, error)
 }
 
 // Creator handles workspace creation.
@@ -135,13 +142,22 @@ type Creator interface {
 	// Closed workspaces are automatically cleaned up, allowing the name to be reused.
 	// Returns ErrBranchNotFound if BaseBranch is specified but doesn't exist.
 	Create(ctx context.Context, opts CreateOptions) (*domain.Workspace, error)
+
+	// Fork creates a new workspace from the current HEAD of an existing
+	// workspace's branch, on a new branch "fork/<newName>".
+	// The source workspace is left untouched.
+	// Returns ErrWorkspaceExists if an active or paused workspace named newName exists.
+	Fork(ctx context.Context, srcName, newName string) (*domain.Workspace, error)
 }
 
 // Lifecycle manages workspace lifecycle operations.
 // Use this interface when you need to modify workspace state.
+// Each operation holds the workspace's cross-process lock while it runs and
+// fails fast with ErrLocked if another process holds it.
 type Lifecycle interface {
 	// Destroy removes a workspace and its worktree.
-	// ALWAYS succeeds even if state is corrupted (NFR18).
+	// ALWAYS succeeds even if state is corrupted (NFR18), but returns
+	// ErrLocked if another process is modifying the workspace.
 	Destroy(ctx context.Context, name string) error
 
 	// Close archives a workspace, removing worktree but keeping state.
@@ -152,6 +168,28 @@ type Lifecycle interface {
 
 	// UpdateStatus updates the status of a workspace.
 	UpdateStatus(ctx context.Context, name string, status constants.WorkspaceStatus) error
+
+	// Trash soft-deletes a workspace: the worktree is removed but the record
+	// and branch are kept, with status trashed, so Restore can bring it back
+	// within the retention window. A worktree with uncommitted changes is
+	// refused with ErrWorktreeDirty. Trashed workspaces are only removed by
+	// PurgeTrash or Destroy.
+	Trash(ctx context.Context, name string) error
+
+	// Restore brings a trashed workspace back to its previous status,
+	// recreating the worktree on its branch if it had one.
+	// Returns ErrWorkspaceNotTrashed if the workspace is not trashed and
+	// ErrWorkspaceTrashExpired if its retention window has passed.
+	Restore(ctx context.Context, name string) (*domain.Workspace, error)
+
+	// PurgeTrash destroys trashed workspaces older than the retention window
+	// and returns their names.
+	PurgeTrash(ctx context.Context) ([]string, error)
+
+	// Reconcile finds git worktrees and atlas branches that no workspace
+	// record owns and, unless opts.DryRun is set, removes them. Removal
+	// failures are reported per item and joined into the returned error.
+	Reconcile(ctx context.Context, opts ReconcileOptions) (*ReconcileResult, error)
 }
 
 // Manager orchestrates workspace lifecycle operations.
@@ -164,20 +202,34 @@ type Manager interface {
 	Lifecycle
 }
 
+// workspaceLocker is implemented by stores that can lock a workspace across
+// processes, such as FileStore. Stores without it are not locked.
+type workspaceLocker interface {
+	LockWorkspace(name string) (*flock.DirLock, error)
+	RLockWorkspace(name string) (*flock.DirLock, error)
+}
+
 // DefaultManager implements Manager using Store and WorktreeRunner.
 type DefaultManager struct {
 	store          Store
 	worktreeRunner WorktreeRunner
 	logger         zerolog.Logger
+	observers      []Observer
+	trashRetention time.Duration
 }
 
 // NewManager creates a new DefaultManager.
-func NewManager(store Store, worktreeRunner WorktreeRunner, logger zerolog.Logger) *DefaultManager {
-	return &DefaultManager{
+func NewManager(store Store, worktreeRunner WorktreeRunner, logger zerolog.Logger, opts ...ManagerOption) *DefaultManager {
+	m := &DefaultManager{
 		store:          store,
 		worktreeRunner: worktreeRunner,
 		logger:         logger,
+		trashRetention: DefaultTrashRetention,
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
 // Create creates a new workspace with a git worktree.
@@ -213,22 +265,15 @@ func (m *DefaultManager) Create(ctx context.Context, opts CreateOptions) (*domai
 		return nil, fmt.Errorf("failed to create workspace: %w", atlaserrors.ErrWorktreeRunnerNotAvailable)
 	}
 
-	// Check if workspace already exists
-	existingWs, err := m.store.Get(ctx, opts.Name)
-	if err != nil && !errors.Is(err, atlaserrors.ErrWorkspaceNotFound) {
-		return nil, fmt.Errorf("failed to check workspace existence: %w", err)
+	unlock, err := m.lockWorkspace(opts.Name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
 	}
+	defer unlock()
 
-	// Handle existing workspace
-	if existingWs != nil {
-		// Active or paused workspace - cannot overwrite
-		if existingWs.Status != constants.WorkspaceStatusClosed {
-			return nil, fmt.Errorf("failed to create workspace '%s': %w", opts.Name, atlaserrors.ErrWorkspaceExists)
-		}
-		// Reset metadata (preserve tasks) to make room for the new workspace
-		if deleteErr := m.store.ResetMetadata(ctx, opts.Name); deleteErr != nil {
-			return nil, fmt.Errorf("failed to cleanup closed workspace '%s': %w", opts.Name, deleteErr)
-		}
+	// Check if workspace already exists
+	if err := m.ensureNameAvailable(ctx, opts.Name); err != nil {
+		return nil, err
 	}
 
 	// Clean up stale worktree if the target branch is already checked out
@@ -247,10 +292,16 @@ func (m *DefaultManager) Create(ctx context.Context, opts CreateOptions) (*domai
 	//nolint:nestif // Branch mode logic requires conditional nesting for validation
 	if opts.ExistingBranch != "" {
 		// Existing branch mode: checkout an existing branch
+		trackRemote, resolveErr := m.resolveExistingBranch(ctx, opts.ExistingBranch)
+		if resolveErr != nil {
+			return nil, fmt.Errorf("failed to resolve branch '%s': %w", opts.ExistingBranch, resolveErr)
+		}
 		wtOpts.ExistingBranch = opts.ExistingBranch
+		wtOpts.TrackRemote = trackRemote
 		m.logger.Info().
 			Str("workspace", opts.Name).
 			Str("existing_branch", opts.ExistingBranch).
+			Str("track_remote", trackRemote).
 			Msg("creating workspace with existing branch")
 	} else {
 		// New branch mode: create a new branch from base
@@ -292,14 +343,139 @@ func (m *DefaultManager) Create(ctx context.Context, opts CreateOptions) (*domai
 		return nil, fmt.Errorf("failed to persist workspace: %w", err)
 	}
 
+	m.notifyObservers(ctx, EventCreated, ws)
+
+	return ws, nil
+}
+
+// forkBranchType is the branch prefix for workspaces created by Fork.
+const forkBranchType = "fork"
+
+// Fork creates a new workspace from the current HEAD of the source workspace's
+// branch. The fork gets its own worktree on a new branch "fork/<newName>" and
+// inherits the source's repository path and metadata. The source workspace is
+// left untouched.
+func (m *DefaultManager) Fork(ctx context.Context, srcName, newName string) (*domain.Workspace, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return nil, err
+	}
+
+	if srcName == "" {
+		return nil, fmt.Errorf("failed to fork workspace: source name %w", atlaserrors.ErrEmptyValue)
+	}
+	if newName == "" {
+		return nil, fmt.Errorf("failed to fork workspace: name %w", atlaserrors.ErrEmptyValue)
+	}
+	if m.worktreeRunner == nil {
+		return nil, fmt.Errorf("failed to fork workspace: %w", atlaserrors.ErrWorktreeRunnerNotAvailable)
+	}
+
+	unlockSrc, err := m.lockWorkspace(srcName, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fork workspace: %w", err)
+	}
+	defer unlockSrc()
+	unlockNew, err := m.lockWorkspace(newName, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fork workspace: %w", err)
+	}
+	defer unlockNew()
+
+	src, err := m.store.Get(ctx, srcName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source workspace '%s': %w", srcName, err)
+	}
+	if src.Branch == "" {
+		return nil, fmt.Errorf("failed to fork workspace '%s': branch %w", srcName, atlaserrors.ErrEmptyValue)
+	}
+
+	if err := m.ensureNameAvailable(ctx, newName); err != nil {
+		return nil, err
+	}
+
+	wtInfo, err := m.worktreeRunner.Create(ctx, WorktreeCreateOptions{
+		RepoPath:      src.RepoPath,
+		WorkspaceName: newName,
+		BranchType:    forkBranchType,
+		BaseBranch:    src.Branch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worktree: %w", err)
+	}
+
+	metadata := make(map[string]any, len(src.Metadata)+1)
+	for k, v := range src.Metadata {
+		metadata[k] = v
+	}
+	metadata["forked_from"] = srcName
+
+	now := time.Now()
+	ws := &domain.Workspace{
+		Name:         newName,
+		WorktreePath: wtInfo.Path,
+		Branch:       wtInfo.Branch,
+		RepoPath:     src.RepoPath,
+		Status:       constants.WorkspaceStatusActive,
+		Tasks:        []domain.TaskRef{},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Metadata:     metadata,
+	}
+
+	// Persist to store
+	if err := m.store.Create(ctx, ws); err != nil {
+		// CRITICAL: Rollback worktree on store failure
+		_ = m.worktreeRunner.Remove(ctx, wtInfo.Path, true)
+		return nil, fmt.Errorf("failed to persist workspace: %w", err)
+	}
+
+	m.logger.Info().
+		Str("workspace", newName).
+		Str("source_workspace", srcName).
+		Str("branch", ws.Branch).
+		Msg("forked workspace")
+
+	m.notifyObservers(ctx, EventCreated, ws)
+
 	return ws, nil
 }
 
+// ensureNameAvailable checks that no active or paused workspace uses name.
+// A closed workspace with that name has its metadata reset (tasks preserved)
+// so the name can be reused.
+func (m *DefaultManager) ensureNameAvailable(ctx context.Context, name string) error {
+	existingWs, err := m.store.Get(ctx, name)
+	if err != nil && !errors.Is(err, atlaserrors.ErrWorkspaceNotFound) {
+		return fmt.Errorf("failed to check workspace existence: %w", err)
+	}
+
+	if existingWs == nil {
+		return nil
+	}
+
+	// Active or paused workspace - cannot overwrite
+	if existingWs.Status != constants.WorkspaceStatusClosed {
+		return fmt.Errorf("failed to create workspace '%s': %w", name, atlaserrors.ErrWorkspaceExists)
+	}
+	// Reset metadata (preserve tasks) to make room for the new workspace
+	if err := m.store.ResetMetadata(ctx, name); err != nil {
+		return fmt.Errorf("failed to cleanup closed workspace '%s': %w", name, err)
+	}
+	return nil
+}
+
 // Get retrieves a workspace by name.
 func (m *DefaultManager) Get(ctx context.Context, name string) (*domain.Workspace, error) {
 	if err := ctxutil.Canceled(ctx); err != nil {
 		return nil, err
 	}
+
+	unlock, err := m.lockWorkspace(name, true)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	return m.store.Get(ctx, name)
 }
 
@@ -321,6 +497,18 @@ func (m *DefaultManager) Destroy(ctx context.Context, name string) error {
 	// Collect warnings (for logging in production)
 	wc := newWarningCollector(m.logger, name)
 
+	// A concurrent command working on the workspace must finish first.
+	// Any other lock failure is only a warning so destroy still succeeds (NFR18).
+	unlock, err := m.lockWorkspace(name, false)
+	switch {
+	case errors.Is(err, atlaserrors.ErrLocked):
+		return err
+	case err != nil:
+		wc.Addf("failed to lock workspace: %w", err)
+	default:
+		defer unlock()
+	}
+
 	// Try to load workspace (may be corrupted)
 	ws := m.loadWorkspaceForDestroy(ctx, name, wc)
 
@@ -341,6 +529,11 @@ func (m *DefaultManager) Destroy(ctx context.Context, name string) error {
 	// Log warnings for debugging and observability
 	wc.Log()
 
+	if ws == nil {
+		ws = &domain.Workspace{Name: name}
+	}
+	m.notifyObservers(ctx, EventDestroyed, ws)
+
 	return nil
 }
 
@@ -373,6 +566,12 @@ func (m *DefaultManager) Close(ctx context.Context, name string, taskLister Task
 		return nil, err
 	}
 
+	unlock, err := m.lockWorkspace(name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close workspace '%s': %w", name, err)
+	}
+	defer unlock()
+
 	// Load workspace
 	ws, err := m.store.Get(ctx, name)
 	if err != nil {
@@ -431,6 +630,8 @@ func (m *DefaultManager) Close(ctx context.Context, name string, taskLister Task
 	}
 	logEvent.Msg("workspace closed")
 
+	m.notifyObservers(ctx, EventArchived, ws)
+
 	return result, nil
 }
 
@@ -440,6 +641,12 @@ func (m *DefaultManager) UpdateStatus(ctx context.Context, name string, status c
 		return err
 	}
 
+	unlock, err := m.lockWorkspace(name, false)
+	if err != nil {
+		return fmt.Errorf("failed to update workspace '%s' status: %w", name, err)
+	}
+	defer unlock()
+
 	// Load workspace
 	ws, err := m.store.Get(ctx, name)
 	if err != nil {
@@ -447,6 +654,7 @@ func (m *DefaultManager) UpdateStatus(ctx context.Context, name string, status c
 	}
 
 	// Update Status field and timestamp (Manager owns timestamp for consistency)
+	previous := ws.Status
 	ws.Status = status
 	ws.UpdatedAt = time.Now()
 
@@ -455,6 +663,10 @@ func (m *DefaultManager) UpdateStatus(ctx context.Context, name string, status c
 		return fmt.Errorf("failed to update workspace '%s' status: %w", name, err)
 	}
 
+	if eventType, ok := statusEventType(previous, status); ok {
+		m.notifyObservers(ctx, eventType, ws)
+	}
+
 	return nil
 }
 
@@ -791,6 +1003,42 @@ func (m *DefaultManager) deleteWorkspaceState(ctx context.Context, name string,
 	}
 }
 
+// resolveExistingBranch determines how to check out an existing branch.
+// Returns an empty remote if the branch exists locally. If it exists only on
+// the remote, fetches and returns the remote name so the worktree tracks it.
+// Returns ErrBranchNotFound if neither local nor remote has the branch.
+func (m *DefaultManager) resolveExistingBranch(ctx context.Context, branch string) (string, error) {
+	localExists, err := m.worktreeRunner.BranchExists(ctx, branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to check local branch: %w", err)
+	}
+	if localExists {
+		return "", nil
+	}
+
+	// Fetch may fail on network errors; the remote ref may already be present
+	if fetchErr := m.worktreeRunner.Fetch(ctx, constants.DefaultRemote); fetchErr != nil {
+		m.logger.Debug().Err(fetchErr).Msg("fetch failed, continuing to check remote refs")
+	}
+
+	remoteExists, err := m.worktreeRunner.RemoteBranchExists(ctx, constants.DefaultRemote, branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to check remote branch: %w", err)
+	}
+	if !remoteExists {
+		return "", fmt.Errorf("%w: branch '%s' does not exist locally or on remote '%s'. "+
+			"Use 'git branch -a' to see available branches",
+			atlaserrors.ErrBranchNotFound, branch, constants.DefaultRemote)
+	}
+
+	m.logger.Info().
+		Str("branch", branch).
+		Str("source", "remote").
+		Str("remote", constants.DefaultRemote).
+		Msg("tracking remote-only branch")
+	return constants.DefaultRemote, nil
+}
+
 // ensureBaseBranch ensures the base branch exists, fetching from remote if needed.
 // Returns the resolved branch reference to use (e.g., "origin/develop" for remote, "develop" for local).
 // By default (useLocal=false), prefers remote branches for safety.
@@ -875,3 +1123,31 @@ func (m *DefaultManager) ensureBaseBranch(ctx context.Context, branch string, us
 		"Use 'git branch -a' to see available branches",
 		atlaserrors.ErrBranchNotFound, branch, constants.DefaultRemote)
 }
+
+// lockWorkspace takes the workspace's cross-process lock, shared for reads and
+// exclusive for mutations, and returns the function that releases it.
+// Returns an error wrapping ErrLocked if another process holds a conflicting lock.
+func (m *DefaultManager) lockWorkspace(name string, shared bool) (func(), error) {
+	locker, ok := m.store.(workspaceLocker)
+	if !ok {
+		return func() {}, nil
+	}
+
+	lock := locker.LockWorkspace
+	if shared {
+		lock = locker.RLockWorkspace
+	}
+	l, err := lock(name)
+	if err != nil {
+		if errors.Is(err, atlaserrors.ErrLocked) {
+			return nil, fmt.Errorf("workspace '%s' is busy: %w", name, atlaserrors.ErrLocked)
+		}
+		return nil, err
+	}
+
+	return func() {
+		if err := l.Unlock(); err != nil {
+			m.logger.Warn().Err(err).Str("workspace", name).Msg("failed to release workspace lock")
+		}
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/mrz1836/atlas/internal/constants"
 	"github.com/mrz1836/atlas/internal/domain"
 	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/git"
 	"github.com/mrz1836/atlas/internal/testutil"
 )
 
@@ -158,11 +160,24 @@ type MockWorktreeRunner struct {
 	findByBranchResult    string
 	repoPathResult        string
 	detachBranchErr       error
+	inspectResult         *WorktreeStatus
+	inspectErr            error
+	divergenceAhead       int
+	divergenceBehind      int
+	divergenceErr         error
+	listBranchesResult    []string
+	listBranchesErr       error
+	createdBranchesResult []string
+	createdBranchesErr    error
+	deleteBranchErrs      map[string]error // per-branch DeleteBranch errors
+	pruneDryRunResult     []PrunableWorktree
+	pruneDryRunErr        error
 
 	// Track calls for verification
 	removeCallCount          int
 	removeForceCallCount     int
 	deleteBranchCallCount    int
+	deleteBranchForceCount   int
 	pruneCallCount           int
 	fetchCallCount           int
 	findByBranchCallCount    int
@@ -170,6 +185,10 @@ type MockWorktreeRunner struct {
 	detachBranchCallCount    int
 	detachBranchLastBranch   string
 	detachBranchLastFallback string
+	lastCreateOpts           WorktreeCreateOptions
+	inspectLastPath          string
+	removedPaths             []string
+	deletedBranches          []string
 
 	// Track operation order for sequencing tests
 	operationOrder []string
@@ -187,7 +206,8 @@ func newMockWorktreeRunner() *MockWorktreeRunner {
 	}
 }
 
-func (m *MockWorktreeRunner) Create(_ context.Context, _ WorktreeCreateOptions) (*WorktreeInfo, error) {
+func (m *MockWorktreeRunner) Create(_ context.Context, opts WorktreeCreateOptions) (*WorktreeInfo, error) {
+	m.lastCreateOpts = opts
 	if m.createErr != nil {
 		return nil, m.createErr
 	}
@@ -201,8 +221,9 @@ func (m *MockWorktreeRunner) List(_ context.Context) ([]*WorktreeInfo, error) {
 	return m.listResult, nil
 }
 
-func (m *MockWorktreeRunner) Remove(_ context.Context, _ string, force bool) error {
+func (m *MockWorktreeRunner) Remove(_ context.Context, path string, force bool) error {
 	m.removeCallCount++
+	m.removedPaths = append(m.removedPaths, path)
 	m.operationOrder = append(m.operationOrder, "remove")
 	if force {
 		m.removeForceCallCount++
@@ -216,6 +237,10 @@ func (m *MockWorktreeRunner) Prune(_ context.Context) error {
 	return m.pruneErr
 }
 
+func (m *MockWorktreeRunner) PruneDryRun(_ context.Context) ([]PrunableWorktree, error) {
+	return m.pruneDryRunResult, m.pruneDryRunErr
+}
+
 func (m *MockWorktreeRunner) BranchExists(_ context.Context, _ string) (bool, error) {
 	if m.branchExistsErr != nil {
 		return false, m.branchExistsErr
@@ -223,9 +248,16 @@ func (m *MockWorktreeRunner) BranchExists(_ context.Context, _ string) (bool, er
 	return m.branchExists, nil
 }
 
-func (m *MockWorktreeRunner) DeleteBranch(_ context.Context, _ string, _ bool) error {
+func (m *MockWorktreeRunner) DeleteBranch(_ context.Context, name string, force bool) error {
 	m.deleteBranchCallCount++
+	if force {
+		m.deleteBranchForceCount++
+	}
+	m.deletedBranches = append(m.deletedBranches, name)
 	m.operationOrder = append(m.operationOrder, "deleteBranch")
+	if err := m.deleteBranchErrs[name]; err != nil {
+		return err
+	}
 	return m.deleteBranchErr
 }
 
@@ -260,6 +292,26 @@ func (m *MockWorktreeRunner) DetachBranch(_ context.Context, branch, fallbackBra
 	return m.detachBranchErr
 }
 
+func (m *MockWorktreeRunner) Divergence(_ context.Context, _, _ string) (int, int, error) {
+	return m.divergenceAhead, m.divergenceBehind, m.divergenceErr
+}
+
+func (m *MockWorktreeRunner) ListBranches(_ context.Context, _ ...string) ([]string, error) {
+	return m.listBranchesResult, m.listBranchesErr
+}
+
+func (m *MockWorktreeRunner) CreatedBranches(_ context.Context) ([]string, error) {
+	return m.createdBranchesResult, m.createdBranchesErr
+}
+
+func (m *MockWorktreeRunner) Inspect(_ context.Context, path string) (*WorktreeStatus, error) {
+	m.inspectLastPath = path
+	if m.inspectErr != nil {
+		return nil, m.inspectErr
+	}
+	return m.inspectResult, nil
+}
+
 // ============================================================================
 // Task 1 Tests: Manager interface and struct
 // ============================================================================
@@ -1880,6 +1932,88 @@ func TestDefaultManager_Create_ExistingBranch_Success(t *testing.T) {
 	assert.Equal(t, constants.WorkspaceStatusActive, ws.Status)
 }
 
+func TestDefaultManager_Create_ExistingBranch_LocalDoesNotTrackRemote(t *testing.T) {
+	store := newMockStore()
+	runner := newMockWorktreeRunner()
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	_, err := mgr.Create(context.Background(), CreateOptions{
+		Name:           "hotfix-ws",
+		RepoPath:       "/tmp/repo",
+		ExistingBranch: "feat/existing-feature",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "feat/existing-feature", runner.lastCreateOpts.ExistingBranch)
+	assert.Empty(t, runner.lastCreateOpts.TrackRemote)
+	assert.Equal(t, 0, runner.fetchCallCount)
+}
+
+func TestDefaultManager_Create_ExistingBranch_RemoteOnly(t *testing.T) {
+	store := newMockStore()
+	runner := newMockWorktreeRunner()
+	runner.branchExists = false
+	runner.remoteBranchExists = true
+	runner.createResult = &WorktreeInfo{
+		Path:   "/tmp/repo-hotfix",
+		Branch: "feat/remote-only",
+	}
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	ws, err := mgr.Create(context.Background(), CreateOptions{
+		Name:           "hotfix-ws",
+		RepoPath:       "/tmp/repo",
+		ExistingBranch: "feat/remote-only",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, ws)
+	assert.Equal(t, "feat/remote-only", ws.Branch)
+	assert.Equal(t, 1, runner.fetchCallCount)
+	assert.Equal(t, "feat/remote-only", runner.lastCreateOpts.ExistingBranch)
+	assert.Equal(t, constants.DefaultRemote, runner.lastCreateOpts.TrackRemote)
+}
+
+func TestDefaultManager_Create_ExistingBranch_RemoteOnlyFetchFails(t *testing.T) {
+	store := newMockStore()
+	runner := newMockWorktreeRunner()
+	runner.branchExists = false
+	runner.fetchErr = atlaserrors.ErrGitOperation
+	runner.remoteBranchExists = true // Remote ref already present from an earlier fetch
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	_, err := mgr.Create(context.Background(), CreateOptions{
+		Name:           "hotfix-ws",
+		RepoPath:       "/tmp/repo",
+		ExistingBranch: "feat/remote-only",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.DefaultRemote, runner.lastCreateOpts.TrackRemote)
+}
+
+func TestDefaultManager_Create_ExistingBranch_NotFound(t *testing.T) {
+	store := newMockStore()
+	runner := newMockWorktreeRunner()
+	runner.branchExists = false
+	runner.remoteBranchExists = false
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	ws, err := mgr.Create(context.Background(), CreateOptions{
+		Name:           "hotfix-ws",
+		RepoPath:       "/tmp/repo",
+		ExistingBranch: "feat/missing",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, ws)
+	require.ErrorIs(t, err, atlaserrors.ErrBranchNotFound)
+	assert.Equal(t, 1, runner.fetchCallCount)
+	assert.Empty(t, runner.lastCreateOpts.ExistingBranch, "worktree should not be created")
+	_, exists := store.workspaces["hotfix-ws"]
+	assert.False(t, exists)
+}
+
 func TestDefaultManager_Create_ExistingBranch_ConflictWithBranchType(t *testing.T) {
 	store := newMockStore()
 	runner := newMockWorktreeRunner()
@@ -2279,3 +2413,173 @@ func (m *branchExistsMockRunner) BranchExists(_ context.Context, name string) (b
 	}
 	return false, nil
 }
+
+func TestDefaultManager_Fork_PointsAtSourceHead(t *testing.T) {
+	ctx := context.Background()
+	repoPath := createTestRepo(t)
+
+	runner, err := NewGitWorktreeRunner(ctx, repoPath, zerolog.Nop())
+	require.NoError(t, err)
+	store := newMockStore()
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	src, err := mgr.Create(ctx, CreateOptions{Name: "src", RepoPath: repoPath, BranchType: "feat"})
+	require.NoError(t, err)
+	src.Metadata = map[string]any{"note": "exploring"}
+	store.workspaces["src"] = src
+
+	// Advance the source branch past the repo's default branch
+	require.NoError(t, os.WriteFile(filepath.Join(src.WorktreePath, "work.txt"), []byte("work"), 0o600))
+	runGit(t, src.WorktreePath, "add", ".")
+	runGit(t, src.WorktreePath, "commit", "-m", "Source work")
+	srcHead, err := git.RunCommand(ctx, src.WorktreePath, "rev-parse", "HEAD")
+	require.NoError(t, err)
+
+	fork, err := mgr.Fork(ctx, "src", "alt")
+	require.NoError(t, err)
+
+	assert.Equal(t, "fork/alt", fork.Branch)
+	assert.Equal(t, repoPath, fork.RepoPath)
+	assert.Equal(t, constants.WorkspaceStatusActive, fork.Status)
+	assert.Equal(t, "exploring", fork.Metadata["note"])
+	assert.Equal(t, "src", fork.Metadata["forked_from"])
+	assert.NotEqual(t, src.WorktreePath, fork.WorktreePath)
+
+	forkHead, err := git.RunCommand(ctx, fork.WorktreePath, "rev-parse", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, srcHead, forkHead)
+
+	// Source is untouched
+	afterHead, err := git.RunCommand(ctx, src.WorktreePath, "rev-parse", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, srcHead, afterHead)
+	branch, err := git.RunCommand(ctx, src.WorktreePath, "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, src.Branch, branch)
+
+	stored, err := store.Get(ctx, "src")
+	require.NoError(t, err)
+	assert.Equal(t, src.Branch, stored.Branch)
+	assert.Equal(t, src.WorktreePath, stored.WorktreePath)
+	assert.NotContains(t, stored.Metadata, "forked_from")
+}
+
+func TestDefaultManager_Fork_UsesSourceBranchAsBase(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["src"] = &domain.Workspace{
+		Name:     "src",
+		Branch:   "feat/src",
+		RepoPath: "/tmp/repo",
+		Status:   constants.WorkspaceStatusActive,
+	}
+	runner := newMockWorktreeRunner()
+	runner.createResult = &WorktreeInfo{Path: "/tmp/repo-alt", Branch: "fork/alt"}
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	ws, err := mgr.Fork(context.Background(), "src", "alt")
+
+	require.NoError(t, err)
+	assert.Equal(t, "fork/alt", ws.Branch)
+	assert.Equal(t, WorktreeCreateOptions{
+		RepoPath:      "/tmp/repo",
+		WorkspaceName: "alt",
+		BranchType:    "fork",
+		BaseBranch:    "feat/src",
+	}, runner.lastCreateOpts)
+}
+
+func TestDefaultManager_Fork_RejectsExistingName(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["src"] = &domain.Workspace{Name: "src", Branch: "feat/src", Status: constants.WorkspaceStatusActive}
+	store.workspaces["alt"] = &domain.Workspace{Name: "alt", Branch: "feat/alt", Status: constants.WorkspaceStatusPaused}
+	runner := newMockWorktreeRunner()
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	ws, err := mgr.Fork(context.Background(), "src", "alt")
+
+	require.Error(t, err)
+	assert.Nil(t, ws)
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceExists)
+	assert.Empty(t, runner.lastCreateOpts.WorkspaceName, "no worktree should be created")
+}
+
+func TestDefaultManager_Fork_SourceNotFound(t *testing.T) {
+	mgr := NewManager(newMockStore(), newMockWorktreeRunner(), zerolog.Nop())
+	ws, err := mgr.Fork(context.Background(), "missing", "alt")
+
+	require.Error(t, err)
+	assert.Nil(t, ws)
+	assert.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+}
+
+func TestDefaultManager_Fork_RollsBackWorktreeOnStoreFailure(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["src"] = &domain.Workspace{Name: "src", Branch: "feat/src", Status: constants.WorkspaceStatusActive}
+	store.createErr = atlaserrors.ErrLockTimeout
+	runner := newMockWorktreeRunner()
+	runner.createResult = &WorktreeInfo{Path: "/tmp/repo-alt", Branch: "fork/alt"}
+
+	mgr := NewManager(store, runner, zerolog.Nop())
+	ws, err := mgr.Fork(context.Background(), "src", "alt")
+
+	require.Error(t, err)
+	assert.Nil(t, ws)
+	assert.Contains(t, err.Error(), "failed to persist workspace")
+	assert.Equal(t, 1, runner.removeForceCallCount)
+}
+
+func TestDefaultManager_WorkspaceLock_HeldLockFailsFast(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, &domain.Workspace{
+		Name:   "busy",
+		Status: constants.WorkspaceStatusActive,
+	}))
+
+	mgr := NewManager(store, newMockWorktreeRunner(), zerolog.Nop())
+
+	// Simulate another atlas process mid-mutation
+	held, err := store.LockWorkspace("busy")
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = mgr.UpdateStatus(ctx, "busy", constants.WorkspaceStatusPaused)
+	require.ErrorIs(t, err, atlaserrors.ErrLocked)
+	assert.Less(t, time.Since(start), time.Second, "should fail fast instead of waiting")
+
+	require.ErrorIs(t, mgr.Destroy(ctx, "busy"), atlaserrors.ErrLocked)
+
+	_, err = mgr.Get(ctx, "busy")
+	require.ErrorIs(t, err, atlaserrors.ErrLocked, "reads wait for the writer to finish")
+
+	require.NoError(t, held.Unlock())
+
+	require.NoError(t, mgr.UpdateStatus(ctx, "busy", constants.WorkspaceStatusPaused))
+	ws, err := mgr.Get(ctx, "busy")
+	require.NoError(t, err)
+	assert.Equal(t, constants.WorkspaceStatusPaused, ws.Status)
+}
+
+func TestDefaultManager_WorkspaceLock_ReadersShare(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, &domain.Workspace{
+		Name:   "shared",
+		Status: constants.WorkspaceStatusActive,
+	}))
+
+	mgr := NewManager(store, newMockWorktreeRunner(), zerolog.Nop())
+
+	reader, err := store.RLockWorkspace("shared")
+	require.NoError(t, err)
+	defer func() { _ = reader.Unlock() }()
+
+	ws, err := mgr.Get(ctx, "shared")
+	require.NoError(t, err)
+	assert.Equal(t, "shared", ws.Name)
+
+	err = mgr.UpdateStatus(ctx, "shared", constants.WorkspaceStatusPaused)
+	require.ErrorIs(t, err, atlaserrors.ErrLocked)
+}
@@ -0,0 +1,127 @@
+// Package workspace provides workspace persistence and management for ATLAS.
+// This file implements lifecycle observers for integrators reacting to workspace changes.
+package workspace
+
+import (
+	"context"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// EventType identifies a workspace lifecycle change.
+type EventType string
+
+// Workspace lifecycle event types.
+const (
+	// EventCreated fires after a workspace and its worktree are created.
+	EventCreated EventType = "created"
+
+	// EventDestroyed fires after a workspace and its worktree are removed.
+	EventDestroyed EventType = "destroyed"
+
+	// EventArchived fires after a workspace is closed, keeping its state.
+	EventArchived EventType = "archived"
+
+	// EventPaused fires after a workspace transitions to paused.
+	EventPaused EventType = "paused"
+
+	// EventResumed fires after a paused workspace transitions back to active.
+	EventResumed EventType = "resumed"
+
+	// EventTrashed fires after a workspace is moved to the trash.
+	EventTrashed EventType = "trashed"
+
+	// EventRestored fires after a trashed workspace is restored.
+	EventRestored EventType = "restored"
+)
+
+// Event describes a workspace lifecycle change.
+type Event struct {
+	// Type is the kind of lifecycle change.
+	Type EventType
+
+	// Workspace is a snapshot of the workspace after the change.
+	// For EventDestroyed it is the last known state, or only the name if
+	// the state could not be loaded.
+	Workspace domain.Workspace
+
+	// Timestamp is when the change was observed.
+	Timestamp time.Time
+}
+
+// Observer is invoked after a workspace lifecycle operation succeeds.
+// Returned errors are logged and never fail the operation.
+type Observer func(ctx context.Context, event Event) error
+
+// ManagerOption configures a DefaultManager.
+type ManagerOption func(*DefaultManager)
+
+// WithWorkspaceObserver registers an observer for workspace lifecycle events.
+// Multiple observers are invoked in registration order.
+func WithWorkspaceObserver(observer Observer) ManagerOption {
+	return func(m *DefaultManager) {
+		if observer != nil {
+			m.observers = append(m.observers, observer)
+		}
+	}
+}
+
+// notifyObservers invokes all registered observers with a snapshot of ws.
+func (m *DefaultManager) notifyObservers(ctx context.Context, eventType EventType, ws *domain.Workspace) {
+	if len(m.observers) == 0 || ws == nil {
+		return
+	}
+
+	event := Event{
+		Type:      eventType,
+		Workspace: snapshotWorkspace(ws),
+		Timestamp: time.Now(),
+	}
+
+	for _, observer := range m.observers {
+		if err := observer(ctx, event); err != nil {
+			m.logger.Warn().
+				Err(err).
+				Str("workspace", ws.Name).
+				Str("event", string(eventType)).
+				Msg("workspace observer failed")
+		}
+	}
+}
+
+// statusEventType maps a status change to its lifecycle event.
+// Returns false when the change has no corresponding event.
+func statusEventType(from, to constants.WorkspaceStatus) (EventType, bool) {
+	switch {
+	case to == constants.WorkspaceStatusPaused && from != constants.WorkspaceStatusPaused:
+		return EventPaused, true
+	case to == constants.WorkspaceStatusActive && from == constants.WorkspaceStatusPaused:
+		return EventResumed, true
+	case to == constants.WorkspaceStatusClosed && from != constants.WorkspaceStatusClosed:
+		return EventArchived, true
+	default:
+		return "", false
+	}
+}
+
+// snapshotWorkspace copies a workspace so observers cannot mutate manager state.
+func snapshotWorkspace(ws *domain.Workspace) domain.Workspace {
+	snapshot := *ws
+	if ws.Tasks != nil {
+		snapshot.Tasks = make([]domain.TaskRef, len(ws.Tasks))
+		copy(snapshot.Tasks, ws.Tasks)
+	}
+	if ws.Trash != nil {
+		trash := *ws.Trash
+		snapshot.Trash = &trash
+	}
+	if ws.Metadata != nil {
+		snapshot.Metadata = make(map[string]any, len(ws.Metadata))
+		for k, v := range ws.Metadata {
+			snapshot.Metadata[k] = v
+		}
+	}
+	return snapshot
+}
@@ -0,0 +1,178 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+var errObserverFailed = errors.New("observer failed")
+
+// recordingObserver records every event it receives.
+type recordingObserver struct {
+	events []Event
+	err    error
+}
+
+func (r *recordingObserver) observe(_ context.Context, event Event) error {
+	r.events = append(r.events, event)
+	return r.err
+}
+
+func newObservedManager(store *MockStore, observer *recordingObserver) *DefaultManager {
+	return NewManager(store, newMockWorktreeRunner(), zerolog.Nop(), WithWorkspaceObserver(observer.observe))
+}
+
+func TestWithWorkspaceObserver_Create(t *testing.T) {
+	store := newMockStore()
+	observer := &recordingObserver{}
+	mgr := newObservedManager(store, observer)
+
+	ws, err := mgr.Create(context.Background(), CreateOptions{Name: "test", RepoPath: "/tmp/repo", BranchType: "feat"})
+	require.NoError(t, err)
+
+	require.Len(t, observer.events, 1)
+	assert.Equal(t, EventCreated, observer.events[0].Type)
+	assert.Equal(t, "test", observer.events[0].Workspace.Name)
+	assert.Equal(t, ws.Branch, observer.events[0].Workspace.Branch)
+	assert.Equal(t, constants.WorkspaceStatusActive, observer.events[0].Workspace.Status)
+	assert.False(t, observer.events[0].Timestamp.IsZero())
+}
+
+func TestWithWorkspaceObserver_Destroy(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{
+		Name:         "test",
+		WorktreePath: "/tmp/repo-test",
+		Branch:       "feat/test",
+		Status:       constants.WorkspaceStatusActive,
+	}
+	observer := &recordingObserver{}
+	mgr := newObservedManager(store, observer)
+
+	require.NoError(t, mgr.Destroy(context.Background(), "test"))
+
+	require.Len(t, observer.events, 1)
+	assert.Equal(t, EventDestroyed, observer.events[0].Type)
+	assert.Equal(t, "test", observer.events[0].Workspace.Name)
+	assert.Equal(t, "feat/test", observer.events[0].Workspace.Branch)
+}
+
+func TestWithWorkspaceObserver_DestroyUnknownWorkspace(t *testing.T) {
+	observer := &recordingObserver{}
+	mgr := newObservedManager(newMockStore(), observer)
+
+	require.NoError(t, mgr.Destroy(context.Background(), "missing"))
+
+	require.Len(t, observer.events, 1)
+	assert.Equal(t, EventDestroyed, observer.events[0].Type)
+	assert.Equal(t, "missing", observer.events[0].Workspace.Name)
+}
+
+func TestWithWorkspaceObserver_Archive(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{
+		Name:         "test",
+		WorktreePath: "/tmp/repo-test",
+		Branch:       "feat/test",
+		Status:       constants.WorkspaceStatusActive,
+	}
+	observer := &recordingObserver{}
+	mgr := newObservedManager(store, observer)
+
+	_, err := mgr.Close(context.Background(), "test", nil)
+	require.NoError(t, err)
+
+	require.Len(t, observer.events, 1)
+	assert.Equal(t, EventArchived, observer.events[0].Type)
+	assert.Equal(t, "test", observer.events[0].Workspace.Name)
+	assert.Equal(t, constants.WorkspaceStatusClosed, observer.events[0].Workspace.Status)
+}
+
+func TestWithWorkspaceObserver_PauseAndResume(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{
+		Name:   "test",
+		Status: constants.WorkspaceStatusActive,
+	}
+	observer := &recordingObserver{}
+	mgr := newObservedManager(store, observer)
+
+	require.NoError(t, mgr.UpdateStatus(context.Background(), "test", constants.WorkspaceStatusPaused))
+	require.NoError(t, mgr.UpdateStatus(context.Background(), "test", constants.WorkspaceStatusActive))
+
+	require.Len(t, observer.events, 2)
+	assert.Equal(t, EventPaused, observer.events[0].Type)
+	assert.Equal(t, constants.WorkspaceStatusPaused, observer.events[0].Workspace.Status)
+	assert.Equal(t, EventResumed, observer.events[1].Type)
+	assert.Equal(t, constants.WorkspaceStatusActive, observer.events[1].Workspace.Status)
+	assert.Equal(t, "test", observer.events[1].Workspace.Name)
+}
+
+func TestWithWorkspaceObserver_UnchangedStatusDoesNotNotify(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{
+		Name:   "test",
+		Status: constants.WorkspaceStatusActive,
+	}
+	observer := &recordingObserver{}
+	mgr := newObservedManager(store, observer)
+
+	require.NoError(t, mgr.UpdateStatus(context.Background(), "test", constants.WorkspaceStatusActive))
+
+	assert.Empty(t, observer.events)
+}
+
+func TestWithWorkspaceObserver_ErrorDoesNotFailOperation(t *testing.T) {
+	store := newMockStore()
+	failing := &recordingObserver{err: errObserverFailed}
+	second := &recordingObserver{}
+	mgr := NewManager(store, newMockWorktreeRunner(), zerolog.Nop(),
+		WithWorkspaceObserver(failing.observe),
+		WithWorkspaceObserver(second.observe),
+	)
+
+	_, err := mgr.Create(context.Background(), CreateOptions{Name: "test", RepoPath: "/tmp/repo", BranchType: "feat"})
+
+	require.NoError(t, err)
+	assert.Len(t, failing.events, 1)
+	assert.Len(t, second.events, 1)
+}
+
+func TestWithWorkspaceObserver_SnapshotIsIsolated(t *testing.T) {
+	store := newMockStore()
+	store.workspaces["test"] = &domain.Workspace{
+		Name:   "test",
+		Status: constants.WorkspaceStatusActive,
+		Tasks:  []domain.TaskRef{{ID: "task-1"}},
+	}
+	mgr := NewManager(store, newMockWorktreeRunner(), zerolog.Nop(),
+		WithWorkspaceObserver(func(_ context.Context, event Event) error {
+			event.Workspace.Tasks[0].ID = "mutated"
+			return nil
+		}),
+	)
+
+	require.NoError(t, mgr.UpdateStatus(context.Background(), "test", constants.WorkspaceStatusPaused))
+
+	assert.Equal(t, "task-1", store.workspaces["test"].Tasks[0].ID)
+}
+
+func TestWithWorkspaceObserver_FailedOperationDoesNotNotify(t *testing.T) {
+	store := newMockStore()
+	store.createErr = errObserverFailed
+	observer := &recordingObserver{}
+	mgr := newObservedManager(store, observer)
+
+	_, err := mgr.Create(context.Background(), CreateOptions{Name: "test", RepoPath: "/tmp/repo", BranchType: "feat"})
+
+	require.Error(t, err)
+	assert.Empty(t, observer.events)
+}
@@ -0,0 +1,77 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// readOnlyManager exposes a Reader as a Manager whose mutating methods
+// always fail with ErrReadOnly.
+type readOnlyManager struct {
+	Reader
+}
+
+// ReadOnly wraps r in a Manager that cannot alter workspaces. Reads are
+// delegated to r; Create, Fork, Destroy, Close, UpdateStatus, Trash,
+// Restore, PurgeTrash, and Reconcile return ErrReadOnly without touching
+// state. Use it for inspection commands such as status and diff.
+func ReadOnly(r Reader) Manager {
+	if ro, ok := r.(*readOnlyManager); ok {
+		return ro
+	}
+	return &readOnlyManager{Reader: r}
+}
+
+// ReadOnly returns a view of m that cannot alter workspaces.
+func (m *DefaultManager) ReadOnly() Manager {
+	return ReadOnly(m)
+}
+
+// Create always fails with ErrReadOnly.
+func (m *readOnlyManager) Create(_ context.Context, opts CreateOptions) (*domain.Workspace, error) {
+	return nil, atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot create workspace '%s'", opts.Name)
+}
+
+// Fork always fails with ErrReadOnly.
+func (m *readOnlyManager) Fork(_ context.Context, _, newName string) (*domain.Workspace, error) {
+	return nil, atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot fork workspace '%s'", newName)
+}
+
+// Destroy always fails with ErrReadOnly.
+func (m *readOnlyManager) Destroy(_ context.Context, name string) error {
+	return atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot destroy workspace '%s'", name)
+}
+
+// Close always fails with ErrReadOnly.
+func (m *readOnlyManager) Close(_ context.Context, name string, _ TaskLister) (*CloseResult, error) {
+	return nil, atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot close workspace '%s'", name)
+}
+
+// UpdateStatus always fails with ErrReadOnly.
+func (m *readOnlyManager) UpdateStatus(_ context.Context, name string, _ constants.WorkspaceStatus) error {
+	return atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot update status of workspace '%s'", name)
+}
+
+// Trash always fails with ErrReadOnly.
+func (m *readOnlyManager) Trash(_ context.Context, name string) error {
+	return atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot trash workspace '%s'", name)
+}
+
+// Restore always fails with ErrReadOnly.
+func (m *readOnlyManager) Restore(_ context.Context, name string) (*domain.Workspace, error) {
+	return nil, atlaserrors.Wrapf(atlaserrors.ErrReadOnly, "cannot restore workspace '%s'", name)
+}
+
+// PurgeTrash always fails with ErrReadOnly.
+func (m *readOnlyManager) PurgeTrash(_ context.Context) ([]string, error) {
+	return nil, atlaserrors.Wrap(atlaserrors.ErrReadOnly, "cannot purge trashed workspaces")
+}
+
+// Reconcile always fails with ErrReadOnly, including dry runs, so a caller
+// cannot turn a read-only view into a removal by clearing opts.DryRun.
+func (m *readOnlyManager) Reconcile(_ context.Context, _ ReconcileOptions) (*ReconcileResult, error) {
+	return nil, atlaserrors.Wrap(atlaserrors.ErrReadOnly, "cannot reconcile workspaces")
+}
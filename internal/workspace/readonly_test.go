@@ -0,0 +1,121 @@
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func newReadOnlyTestManager(t *testing.T) (Manager, *MockStore, *MockWorktreeRunner) {
+	t.Helper()
+
+	store := newMockStore()
+	store.workspaces["auth"] = &domain.Workspace{
+		Name:         "auth",
+		WorktreePath: "/tmp/repo-auth",
+		Branch:       "feat/auth",
+		Status:       constants.WorkspaceStatusActive,
+		CreatedAt:    time.Now(),
+	}
+	runner := newMockWorktreeRunner()
+
+	return NewManager(store, runner, zerolog.Nop()).ReadOnly(), store, runner
+}
+
+func TestReadOnly_BlocksMutations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, store, runner := newReadOnlyTestManager(t)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"Create", func() error {
+			_, err := mgr.Create(ctx, CreateOptions{Name: "new", RepoPath: "/tmp/repo", BranchType: "feat"})
+			return err
+		}},
+		{"Fork", func() error {
+			_, err := mgr.Fork(ctx, "auth", "auth-copy")
+			return err
+		}},
+		{"Destroy", func() error {
+			return mgr.Destroy(ctx, "auth")
+		}},
+		{"Close", func() error {
+			_, err := mgr.Close(ctx, "auth", nil)
+			return err
+		}},
+		{"UpdateStatus", func() error {
+			return mgr.UpdateStatus(ctx, "auth", constants.WorkspaceStatusPaused)
+		}},
+		{"Trash", func() error {
+			return mgr.Trash(ctx, "auth")
+		}},
+		{"Restore", func() error {
+			_, err := mgr.Restore(ctx, "auth")
+			return err
+		}},
+		{"PurgeTrash", func() error {
+			_, err := mgr.PurgeTrash(ctx)
+			return err
+		}},
+		{"Reconcile", func() error {
+			_, err := mgr.Reconcile(ctx, ReconcileOptions{DryRun: true})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		err := tt.call()
+		require.ErrorIs(t, err, atlaserrors.ErrReadOnly, tt.name)
+	}
+
+	// Nothing reached the store or git
+	require.Len(t, store.workspaces, 1)
+	ws := store.workspaces["auth"]
+	assert.Equal(t, constants.WorkspaceStatusActive, ws.Status)
+	assert.Zero(t, runner.removeCallCount)
+	assert.Zero(t, runner.deleteBranchCallCount)
+	assert.Zero(t, runner.pruneCallCount)
+	assert.Empty(t, runner.operationOrder)
+}
+
+func TestReadOnly_AllowsReads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, _, _ := newReadOnlyTestManager(t)
+
+	ws, err := mgr.Get(ctx, "auth")
+	require.NoError(t, err)
+	assert.Equal(t, "feat/auth", ws.Branch)
+
+	list, err := mgr.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	exists, err := mgr.Exists(ctx, "auth")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	_, err = mgr.Get(ctx, "missing")
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+}
+
+func TestReadOnly_DoesNotDoubleWrap(t *testing.T) {
+	t.Parallel()
+
+	mgr, _, _ := newReadOnlyTestManager(t)
+
+	assert.Same(t, mgr, ReadOnly(mgr))
+}
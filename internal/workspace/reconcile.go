@@ -0,0 +1,259 @@
+// Package workspace provides workspace persistence and management for ATLAS.
+// This file implements reconciliation of git worktrees and branches against
+// workspace records, used to prune what failed runs leave behind.
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+	"github.com/mrz1836/atlas/internal/git"
+)
+
+// Orphan kinds reported by Reconcile.
+const (
+	OrphanKindWorktree = "worktree"
+	OrphanKindBranch   = "branch"
+)
+
+// forkBranchPrefix is the prefix of branches created by Fork.
+const forkBranchPrefix = "fork"
+
+// ReconcileOptions controls Reconcile.
+type ReconcileOptions struct {
+	// DryRun reports orphans without removing them.
+	DryRun bool
+
+	// BranchPrefixes limits reconciliation to branches under these prefixes.
+	// Defaults to DefaultReconcilePrefixes. Worktrees on other branches are
+	// never touched, so worktrees the user created by hand are left alone.
+	BranchPrefixes []string
+}
+
+// Orphan is a worktree or branch that no workspace record owns.
+type Orphan struct {
+	Kind    string // OrphanKindWorktree or OrphanKindBranch
+	Name    string // Worktree path or branch name
+	Branch  string // Branch checked out in an orphaned worktree
+	Removed bool   // True once the orphan was removed
+	Skipped string // Why the orphan was kept, e.g. uncommitted changes or unmerged commits
+	Error   string // Removal failure, empty on success or in dry-run
+}
+
+// ReconcileResult lists the orphans Reconcile found, in removal order:
+// worktrees first, then branches, and the stale worktree entries git
+// reported before pruning.
+type ReconcileResult struct {
+	DryRun   bool
+	Orphans  []Orphan
+	Prunable []PrunableWorktree
+}
+
+// Failed returns the number of orphans that could not be removed.
+func (r *ReconcileResult) Failed() int {
+	failed := 0
+	for _, o := range r.Orphans {
+		if o.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// DefaultReconcilePrefixes returns the branch prefixes atlas creates branches
+// under: the default template prefixes and the fork prefix.
+func DefaultReconcilePrefixes() []string {
+	prefixes := []string{forkBranchPrefix}
+	for _, prefix := range git.DefaultBranchPrefixes {
+		if !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	slices.Sort(prefixes)
+	return prefixes
+}
+
+// Reconcile finds worktrees and branches under opts.BranchPrefixes that atlas
+// created but no workspace record (of any status) owns. Branches atlas did
+// not record creating, and worktrees on them, are never reported. Unless
+// opts.DryRun is set, orphaned worktrees are removed, stale worktree entries
+// pruned, and orphaned branches deleted, all without force: a worktree with
+// uncommitted changes or a branch with unmerged commits is kept and marked
+// Skipped. Each removal failure is recorded on its orphan and the failures
+// are joined into the returned error; the result is always returned once the
+// orphans have been determined.
+func (m *DefaultManager) Reconcile(ctx context.Context, opts ReconcileOptions) (*ReconcileResult, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return nil, err
+	}
+	if m.worktreeRunner == nil {
+		return nil, fmt.Errorf("failed to reconcile workspaces: %w", atlaserrors.ErrWorktreeRunnerNotAvailable)
+	}
+
+	prefixes := opts.BranchPrefixes
+	if len(prefixes) == 0 {
+		prefixes = DefaultReconcilePrefixes()
+	}
+
+	workspaces, err := m.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	ownedPaths := make(map[string]bool, len(workspaces))
+	ownedBranches := make(map[string]bool, len(workspaces))
+	for _, ws := range workspaces {
+		if ws.WorktreePath != "" {
+			ownedPaths[ws.WorktreePath] = true
+		}
+		if ws.Branch != "" {
+			ownedBranches[ws.Branch] = true
+		}
+	}
+
+	worktrees, err := m.worktreeRunner.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worktrees: %w", err)
+	}
+	branches, err := m.worktreeRunner.ListBranches(ctx, prefixes...)
+	if err != nil {
+		return nil, err
+	}
+	createdList, err := m.worktreeRunner.CreatedBranches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created := make(map[string]bool, len(createdList))
+	for _, branch := range createdList {
+		created[branch] = true
+	}
+
+	result := &ReconcileResult{DryRun: opts.DryRun}
+
+	// A branch checked out in a worktree that stays cannot be deleted
+	inUse := make(map[string]bool)
+	repoPath := m.worktreeRunner.RepoPath()
+	for _, wt := range worktrees {
+		orphaned := wt.Path != repoPath && !ownedPaths[wt.Path] &&
+			created[wt.Branch] && !ownedBranches[wt.Branch] && hasBranchPrefix(wt.Branch, prefixes)
+		if !orphaned {
+			if wt.Branch != "" {
+				inUse[wt.Branch] = true
+			}
+			continue
+		}
+		result.Orphans = append(result.Orphans, Orphan{Kind: OrphanKindWorktree, Name: wt.Path, Branch: wt.Branch})
+	}
+	for _, branch := range branches {
+		if !created[branch] || ownedBranches[branch] || inUse[branch] {
+			continue
+		}
+		result.Orphans = append(result.Orphans, Orphan{Kind: OrphanKindBranch, Name: branch})
+	}
+
+	// Stale entries are only reported, so a failure to list them is not fatal
+	if result.Prunable, err = m.worktreeRunner.PruneDryRun(ctx); err != nil {
+		m.logger.Warn().Err(err).Msg("failed to list prunable worktree entries")
+	}
+
+	if opts.DryRun {
+		return result, nil
+	}
+	if len(result.Orphans) == 0 {
+		if len(result.Prunable) > 0 {
+			if err := m.worktreeRunner.Prune(ctx); err != nil {
+				return result, fmt.Errorf("failed to prune stale worktree entries: %w", err)
+			}
+		}
+		return result, nil
+	}
+	return result, m.removeOrphans(ctx, result)
+}
+
+// removeOrphans removes each orphan in result, recording per-item failures,
+// and returns them joined. Orphans git refuses to remove without force are
+// kept and marked Skipped rather than failed.
+func (m *DefaultManager) removeOrphans(ctx context.Context, result *ReconcileResult) error {
+	var errs []error
+	pruned := false
+	keptBranches := make(map[string]bool)
+	for i := range result.Orphans {
+		o := &result.Orphans[i]
+		if err := ctxutil.Canceled(ctx); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		var err error
+		switch o.Kind {
+		case OrphanKindWorktree:
+			err = m.worktreeRunner.Remove(ctx, o.Name, false)
+		case OrphanKindBranch:
+			// A branch still checked out in a kept worktree cannot be deleted
+			if keptBranches[o.Name] {
+				o.Skipped = "checked out in a kept worktree"
+				continue
+			}
+			// Prune once before deleting branches so removed worktrees no longer hold them
+			if !pruned {
+				if pruneErr := m.worktreeRunner.Prune(ctx); pruneErr != nil {
+					m.logger.Warn().Err(pruneErr).Msg("prune failed before deleting orphaned branches")
+				}
+				pruned = true
+			}
+			err = m.worktreeRunner.DeleteBranch(ctx, o.Name, false)
+		}
+
+		if reason := skipReason(err); reason != "" {
+			o.Skipped = reason
+			if o.Kind == OrphanKindWorktree {
+				keptBranches[o.Branch] = true
+			}
+			m.logger.Info().Str("kind", o.Kind).Str("name", o.Name).Str("reason", reason).Msg("kept orphan")
+			continue
+		}
+		if err != nil {
+			if o.Kind == OrphanKindWorktree {
+				keptBranches[o.Branch] = true
+			}
+			o.Error = err.Error()
+			errs = append(errs, fmt.Errorf("failed to remove %s '%s': %w", o.Kind, o.Name, err))
+			continue
+		}
+		o.Removed = true
+		m.logger.Info().Str("kind", o.Kind).Str("name", o.Name).Msg("removed orphan")
+	}
+
+	if !pruned {
+		if err := m.worktreeRunner.Prune(ctx); err != nil {
+			m.logger.Warn().Err(err).Msg("prune failed after removing orphaned worktrees")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// skipReason describes why git refused a non-forced removal, or returns ""
+// when err is nil or a genuine failure.
+func skipReason(err error) string {
+	switch {
+	case errors.Is(err, atlaserrors.ErrWorktreeDirty):
+		return "uncommitted changes"
+	case errors.Is(err, atlaserrors.ErrBranchNotMerged):
+		return "unmerged commits"
+	default:
+		return ""
+	}
+}
+
+// hasBranchPrefix reports whether branch lies under any of prefixes.
+func hasBranchPrefix(branch string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(branch, strings.Trim(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// newOrphanedRunner returns a mock runner seeded with one owned worktree, one
+// orphaned worktree, one user worktree, and branches with and without records.
+func newOrphanedRunner() (*MockStore, *MockWorktreeRunner) {
+	store := newMockStore()
+	store.workspaces["owned"] = &domain.Workspace{Name: "owned", WorktreePath: "/tmp/repo-owned", Branch: "feat/owned"}
+
+	runner := newMockWorktreeRunner()
+	runner.listResult = []*WorktreeInfo{
+		{Path: "/tmp/repo", Branch: "fix/main-checkout"},
+		{Path: "/tmp/repo-owned", Branch: "feat/owned"},
+		{Path: "/tmp/repo-failed", Branch: "fix/failed"},
+		{Path: "/tmp/repo-manual", Branch: "experiment"},
+	}
+	runner.listBranchesResult = []string{"feat/owned", "fix/failed", "fix/main-checkout", "fix/stale", "fix/user-made"}
+	runner.createdBranchesResult = []string{"feat/owned", "fix/failed", "fix/main-checkout", "fix/stale"}
+	return store, runner
+}
+
+func TestDefaultManager_Reconcile_DryRunReportsOrphans(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{DryRun: true})
+
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, []Orphan{
+		{Kind: OrphanKindWorktree, Name: "/tmp/repo-failed", Branch: "fix/failed"},
+		{Kind: OrphanKindBranch, Name: "fix/failed"},
+		{Kind: OrphanKindBranch, Name: "fix/stale"},
+	}, result.Orphans)
+	assert.Empty(t, runner.removedPaths)
+	assert.Empty(t, runner.deletedBranches)
+	assert.Zero(t, runner.pruneCallCount)
+}
+
+func TestDefaultManager_Reconcile_RemovesOrphans(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/tmp/repo-failed"}, runner.removedPaths)
+	assert.Equal(t, []string{"fix/failed", "fix/stale"}, runner.deletedBranches)
+	assert.Equal(t, []string{"remove", "prune", "deleteBranch", "deleteBranch"}, runner.operationOrder)
+	assert.Zero(t, runner.removeForceCallCount)
+	assert.Zero(t, runner.deleteBranchForceCount)
+	for _, o := range result.Orphans {
+		assert.True(t, o.Removed, o.Name)
+	}
+	assert.Zero(t, result.Failed())
+	assert.Contains(t, store.workspaces, "owned")
+}
+
+func TestDefaultManager_Reconcile_AggregatesFailures(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	runner.deleteBranchErrs = map[string]error{"fix/failed": errGitCommandFailed}
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.ErrorIs(t, err, errGitCommandFailed)
+	assert.Contains(t, err.Error(), "fix/failed")
+	assert.Equal(t, 1, result.Failed())
+	assert.Equal(t, []string{"fix/failed", "fix/stale"}, runner.deletedBranches)
+	assert.True(t, result.Orphans[2].Removed)
+	assert.Equal(t, errGitCommandFailed.Error(), result.Orphans[1].Error)
+}
+
+func TestDefaultManager_Reconcile_IgnoresBranchesAtlasDidNotCreate(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	runner.createdBranchesResult = nil
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Orphans)
+	assert.Empty(t, runner.removedPaths)
+	assert.Empty(t, runner.deletedBranches)
+}
+
+func TestDefaultManager_Reconcile_CreatedBranchesError(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	runner.createdBranchesErr = errGitCommandFailed
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	_, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.ErrorIs(t, err, errGitCommandFailed)
+	assert.Empty(t, runner.removedPaths)
+	assert.Empty(t, runner.deletedBranches)
+}
+
+func TestDefaultManager_Reconcile_KeepsUnmergedBranches(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	runner.deleteBranchErrs = map[string]error{"fix/stale": fmt.Errorf("delete fix/stale: %w", atlaserrors.ErrBranchNotMerged)}
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.NoError(t, err)
+	assert.Zero(t, result.Failed())
+	assert.False(t, result.Orphans[2].Removed)
+	assert.Equal(t, "unmerged commits", result.Orphans[2].Skipped)
+}
+
+func TestDefaultManager_Reconcile_KeepsDirtyWorktreeAndItsBranch(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	runner.removeErr = fmt.Errorf("remove /tmp/repo-failed: %w", atlaserrors.ErrWorktreeDirty)
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "uncommitted changes", result.Orphans[0].Skipped)
+	assert.Equal(t, "checked out in a kept worktree", result.Orphans[1].Skipped)
+	assert.Equal(t, []string{"fix/stale"}, runner.deletedBranches)
+}
+
+func TestDefaultManager_Reconcile_ReportsPrunableEntries(t *testing.T) {
+	store, runner := newOrphanedRunner()
+	stale := []PrunableWorktree{{Path: "/tmp/repo-gone", Branch: "feat/gone", Reason: "gitdir file points to non-existent location"}}
+	runner.pruneDryRunResult = stale
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{DryRun: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, stale, result.Prunable)
+	assert.Zero(t, runner.pruneCallCount)
+}
+
+func TestDefaultManager_Reconcile_PrunesStaleEntriesWithoutOrphans(t *testing.T) {
+	store := newMockStore()
+	runner := newMockWorktreeRunner()
+	runner.listResult = []*WorktreeInfo{{Path: "/tmp/repo", Branch: "main"}}
+	runner.pruneDryRunResult = []PrunableWorktree{{Path: "/tmp/repo-gone", Reason: "gitdir file points to non-existent location"}}
+	mgr := NewManager(store, runner, zerolog.Nop())
+
+	result, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Orphans)
+	assert.Len(t, result.Prunable, 1)
+	assert.Equal(t, 1, runner.pruneCallCount)
+}
+
+func TestDefaultManager_Reconcile_NoRunner(t *testing.T) {
+	mgr := NewManager(newMockStore(), nil, zerolog.Nop())
+
+	_, err := mgr.Reconcile(context.Background(), ReconcileOptions{})
+
+	require.ErrorIs(t, err, atlaserrors.ErrWorktreeRunnerNotAvailable)
+}
+
+func TestDefaultReconcilePrefixes(t *testing.T) {
+	prefixes := DefaultReconcilePrefixes()
+
+	assert.Contains(t, prefixes, "fix")
+	assert.Contains(t, prefixes, "feat")
+	assert.Contains(t, prefixes, "fork")
+	assert.True(t, hasBranchPrefix("fix/auth", prefixes))
+	assert.False(t, hasBranchPrefix("fixup", prefixes))
+}
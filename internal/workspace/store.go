@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/mrz1836/atlas/internal/constants"
@@ -54,22 +55,167 @@ type Store interface {
 	Exists(ctx context.Context, name string) (bool, error)
 }
 
+// statePermissions is a file and directory mode pair for state written by a FileStore.
+type statePermissions struct {
+	filePerm os.FileMode
+	dirPerm  os.FileMode
+}
+
+// defaultStatePermissions overrides the modes new stores start from; nil
+// keeps constants.WorkspaceFilePerm and constants.WorkspaceDirPerm.
+var defaultStatePermissions atomic.Pointer[statePermissions] //nolint:gochecknoglobals // Set once at CLI startup from --shared-state
+
+// SetDefaultStatePermissions sets the modes every FileStore created afterwards
+// starts from, so a process-wide setting such as --shared-state reaches all
+// stores without threading WithStatePermissions through each call site.
+// WithStatePermissions still takes precedence. The modes are validated as in
+// WithStatePermissions.
+func SetDefaultStatePermissions(filePerm, dirPerm os.FileMode) error {
+	if err := validateStatePermissions(filePerm, dirPerm); err != nil {
+		return err
+	}
+	defaultStatePermissions.Store(&statePermissions{filePerm: filePerm, dirPerm: dirPerm})
+	return nil
+}
+
 // FileStore implements Store using the local filesystem.
 type FileStore struct {
-	baseDir string // Usually ~/.atlas
+	baseDir  string      // Usually ~/.atlas
+	filePerm os.FileMode // Mode for state and lock files
+	dirPerm  os.FileMode // Mode for workspace directories
+}
+
+// FileStoreOption configures a FileStore.
+type FileStoreOption func(*FileStore)
+
+// WithStatePermissions sets the mode bits for the files and directories the
+// store writes, so a team or CI group can share state (e.g. 0o660 and 0o770).
+// The defaults are constants.WorkspaceFilePerm and constants.WorkspaceDirPerm.
+// The owner must keep full access, files may not be executable, and
+// neither mode may grant access to others.
+func WithStatePermissions(filePerm, dirPerm os.FileMode) FileStoreOption {
+	return func(s *FileStore) {
+		s.filePerm = filePerm
+		s.dirPerm = dirPerm
+	}
 }
 
 // NewFileStore creates a new FileStore with the given base directory.
-// If baseDir is empty, uses the default ~/.atlas directory.
-func NewFileStore(baseDir string) (*FileStore, error) {
+// If baseDir is empty, uses StateDir (--base-dir, ATLAS_STATE_DIR, ATLAS_HOME or ~/.atlas).
+func NewFileStore(baseDir string, opts ...FileStoreOption) (*FileStore, error) {
 	if baseDir == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		var err error
+		if baseDir, err = StateDir(); err != nil {
+			return nil, err
 		}
-		baseDir = filepath.Join(home, constants.AtlasHome)
 	}
-	return &FileStore{baseDir: baseDir}, nil
+	return newFileStore(baseDir, opts)
+}
+
+// newFileStore applies opts over the default permissions and validates them.
+func newFileStore(baseDir string, opts []FileStoreOption) (*FileStore, error) {
+	s := &FileStore{
+		baseDir:  baseDir,
+		filePerm: constants.WorkspaceFilePerm,
+		dirPerm:  constants.WorkspaceDirPerm,
+	}
+	if perms := defaultStatePermissions.Load(); perms != nil {
+		s.filePerm, s.dirPerm = perms.filePerm, perms.dirPerm
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := validateStatePermissions(s.filePerm, s.dirPerm); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// validateStatePermissions rejects modes that would lock the owner out of
+// its own state or expose it beyond the owner and group.
+func validateStatePermissions(filePerm, dirPerm os.FileMode) error {
+	if filePerm&^os.ModePerm != 0 || dirPerm&^os.ModePerm != 0 {
+		return fmt.Errorf("%w: state permissions must be plain mode bits, got %#o and %#o",
+			atlaserrors.ErrInvalidArgument, filePerm, dirPerm)
+	}
+	if filePerm&0o600 != 0o600 || filePerm&0o111 != 0 {
+		return fmt.Errorf("%w: state file mode %#o must be owner read/write and not executable",
+			atlaserrors.ErrInvalidArgument, filePerm)
+	}
+	if dirPerm&0o700 != 0o700 {
+		return fmt.Errorf("%w: state directory mode %#o must give the owner full access",
+			atlaserrors.ErrInvalidArgument, dirPerm)
+	}
+	if filePerm&0o007 != 0 || dirPerm&0o007 != 0 {
+		return fmt.Errorf("%w: state modes %#o and %#o must not grant access to others",
+			atlaserrors.ErrInsecurePermissions, filePerm, dirPerm)
+	}
+	return nil
+}
+
+// mkdirAll creates dir and any missing parents with the store's directory
+// mode. The mode is applied explicitly to every directory it creates, so the
+// umask cannot narrow what was configured; existing directories, possibly
+// owned by another member of the group, are left as they are.
+func (s *FileStore) mkdirAll(dir string) error {
+	var missing []string
+	for d := filepath.Clean(dir); ; d = filepath.Dir(d) {
+		if _, err := os.Stat(d); !os.IsNotExist(err) {
+			break
+		}
+		missing = append(missing, d)
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+
+	if err := os.MkdirAll(dir, s.dirPerm); err != nil {
+		return err
+	}
+	for _, d := range missing {
+		if err := os.Chmod(d, s.dirPerm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stateDirOverride is the state directory set by --base-dir; nil defers to
+// ATLAS_STATE_DIR and ATLAS_HOME.
+var stateDirOverride atomic.Pointer[string] //nolint:gochecknoglobals // Set once at CLI startup from --base-dir
+
+// SetStateDir roots every store created afterwards at dir, taking precedence
+// over ATLAS_STATE_DIR and ATLAS_HOME. An empty dir clears the override.
+func SetStateDir(dir string) {
+	if dir == "" {
+		stateDirOverride.Store(nil)
+		return
+	}
+	stateDirOverride.Store(&dir)
+}
+
+// StateDir returns the root directory for ATLAS state: the SetStateDir
+// override, then ATLAS_STATE_DIR, then ATLAS_HOME, otherwise ~/.atlas.
+func StateDir() (string, error) {
+	if dir := stateDirOverride.Load(); dir != nil {
+		return *dir, nil
+	}
+	if dir := stateDirFromEnv(); dir != "" {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, constants.AtlasHome), nil
+}
+
+// stateDirFromEnv returns ATLAS_STATE_DIR, or ATLAS_HOME when it is unset.
+func stateDirFromEnv() string {
+	if dir := os.Getenv(constants.StateDirEnvVar); dir != "" {
+		return dir
+	}
+	return os.Getenv(constants.AtlasHomeEnvVar)
 }
 
 // RepoHash computes a deterministic short hash of a repository path.
@@ -86,20 +232,20 @@ func RepoHash(repoPath string) (string, error) {
 // NewRepoScopedFileStore creates a FileStore scoped to a specific repository.
 // Storage path: ~/.atlas/repos/{repo-hash}/
 // This prevents workspace name collisions across different repositories.
-func NewRepoScopedFileStore(repoPath string) (*FileStore, error) {
+func NewRepoScopedFileStore(repoPath string, opts ...FileStoreOption) (*FileStore, error) {
 	if repoPath == "" {
 		return nil, fmt.Errorf("repo path cannot be empty: %w", atlaserrors.ErrEmptyValue)
 	}
-	home, err := os.UserHomeDir()
+	stateDir, err := StateDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		return nil, err
 	}
 	hash, err := RepoHash(repoPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compute repo hash: %w", err)
 	}
-	baseDir := filepath.Join(home, constants.AtlasHome, constants.ReposDir, hash)
-	return &FileStore{baseDir: baseDir}, nil
+	baseDir := filepath.Join(stateDir, constants.ReposDir, hash)
+	return newFileStore(baseDir, opts)
 }
 
 // Create persists a new workspace.
@@ -123,7 +269,7 @@ func (s *FileStore) Create(ctx context.Context, ws *domain.Workspace) error {
 	}
 
 	// Create workspace directory (may already exist if recreating closed workspace)
-	if err := os.MkdirAll(wsPath, constants.WorkspaceDirPerm); err != nil {
+	if err := s.mkdirAll(wsPath); err != nil {
 		return fmt.Errorf("failed to create workspace directory '%s': %w", ws.Name, err)
 	}
 
@@ -150,11 +296,13 @@ func (s *FileStore) Create(ctx context.Context, ws *domain.Workspace) error {
 	}
 
 	// Write workspace file atomically
-	if err := atomicWrite(wsFile, data, constants.WorkspaceFilePerm); err != nil {
+	if err := atomicWrite(wsFile, data, s.filePerm); err != nil {
 		_ = os.RemoveAll(wsPath)
 		return fmt.Errorf("failed to create workspace '%s': %w", ws.Name, err)
 	}
 
+	s.indexPut(ctx, ws)
+
 	return nil
 }
 
@@ -175,14 +323,11 @@ func (s *FileStore) Get(ctx context.Context, name string) (*domain.Workspace, er
 		return ws, nil
 	}
 
-	// Legacy fallback: if not found in repo-scoped path, check legacy ~/.atlas/workspaces/
+	// Legacy fallback: if not found in repo-scoped path, check the legacy {state dir}/workspaces/
 	if isRepoScoped(s.baseDir) {
-		legacyBase, legacyErr := legacyBaseDir()
-		if legacyErr == nil {
-			legacyPath := filepath.Join(legacyBase, constants.WorkspacesDir, name)
-			if legacyWs, legacyGetErr := s.getFromDir(ctx, name, legacyPath); legacyGetErr == nil {
-				return legacyWs, nil
-			}
+		legacyPath := filepath.Join(s.legacyBaseDir(), constants.WorkspacesDir, name)
+		if legacyWs, legacyGetErr := s.getFromDir(ctx, name, legacyPath); legacyGetErr == nil {
+			return legacyWs, nil
 		}
 	}
 
@@ -226,14 +371,17 @@ func (s *FileStore) Update(ctx context.Context, ws *domain.Workspace) error {
 
 	// Write workspace file atomically
 	wsFile := s.workspaceFilePath(ws.Name)
-	if err := atomicWrite(wsFile, data, constants.WorkspaceFilePerm); err != nil {
+	if err := atomicWrite(wsFile, data, s.filePerm); err != nil {
 		return fmt.Errorf("failed to update workspace '%s': %w", ws.Name, err)
 	}
 
+	s.indexPut(ctx, ws)
+
 	return nil
 }
 
-// List returns all workspaces.
+// List returns all workspaces, reading every workspace file.
+// ListIndex is faster when only the indexed fields are needed.
 func (s *FileStore) List(ctx context.Context) ([]*domain.Workspace, error) {
 	// Check for cancellation at entry
 	if err := ctxutil.Canceled(ctx); err != nil {
@@ -276,7 +424,7 @@ func (s *FileStore) List(ctx context.Context) ([]*domain.Workspace, error) {
 		seen[ws.Name] = true
 	}
 
-	// Legacy fallback: also list workspaces from legacy ~/.atlas/workspaces/
+	// Legacy fallback: also list workspaces from legacy {state dir}/workspaces/
 	if isRepoScoped(s.baseDir) {
 		legacyWs, legacyErr := s.listLegacyWorkspaces(ctx, seen)
 		if legacyErr != nil {
@@ -312,6 +460,8 @@ func (s *FileStore) Delete(ctx context.Context, name string) error {
 		return fmt.Errorf("failed to delete workspace '%s': %w", name, err)
 	}
 
+	s.indexRemove(ctx, name)
+
 	return nil
 }
 
@@ -341,6 +491,8 @@ func (s *FileStore) ResetMetadata(ctx context.Context, name string) error {
 		return fmt.Errorf("failed to reset workspace '%s': %w", name, err)
 	}
 
+	s.indexRemove(ctx, name)
+
 	return nil
 }
 
@@ -370,14 +522,9 @@ func (s *FileStore) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
-// listLegacyWorkspaces lists workspaces from the legacy ~/.atlas/workspaces/ path.
+// listLegacyWorkspaces lists workspaces from the legacy {state dir}/workspaces/ path.
 func (s *FileStore) listLegacyWorkspaces(ctx context.Context, seen map[string]bool) ([]*domain.Workspace, error) {
-	legacyBase, err := legacyBaseDir()
-	if err != nil {
-		return nil, nil //nolint:nilerr // legacy fallback is best-effort
-	}
-
-	legacyDir := filepath.Join(legacyBase, constants.WorkspacesDir)
+	legacyDir := filepath.Join(s.legacyBaseDir(), constants.WorkspacesDir)
 	legacyEntries, readErr := os.ReadDir(legacyDir)
 	if readErr != nil {
 		return nil, nil //nolint:nilerr // legacy dir may not exist
@@ -446,14 +593,30 @@ func (s *FileStore) acquireLock(ctx context.Context, name string) (*os.File, err
 
 	// Ensure workspace directory exists for lock file
 	wsPath := s.workspacePath(name)
-	if err := os.MkdirAll(wsPath, constants.WorkspaceDirPerm); err != nil {
+	if err := s.mkdirAll(wsPath); err != nil {
 		return nil, fmt.Errorf("failed to create lock directory: %w", err)
 	}
 
-	f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, constants.WorkspaceFilePerm) //#nosec G302,G304 -- lock file needs write access, path is constructed from validated name
+	return lockFileWithTimeout(ctx, lockPath, s.filePerm)
+}
+
+// lockFileWithTimeout opens lockPath, creating it with perm, and takes an
+// exclusive lock on it, retrying until constants.WorkspaceLockTimeout.
+// A lock file it creates is chmodded to perm so the umask cannot keep other
+// group members from opening it. It respects context cancellation during
+// the retry loop.
+func lockFileWithTimeout(ctx context.Context, lockPath string, perm os.FileMode) (*os.File, error) {
+	_, statErr := os.Stat(lockPath)
+	f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, perm) //#nosec G302,G304 -- lock file needs write access, path is constructed from validated name
 	if err != nil {
 		return nil, fmt.Errorf("failed to open lock file: %w", err)
 	}
+	if os.IsNotExist(statErr) {
+		if err := f.Chmod(perm); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to set lock file permissions: %w", err)
+		}
+	}
 
 	// Try to acquire lock with timeout
 	deadline := time.Now().Add(constants.WorkspaceLockTimeout)
@@ -482,6 +645,24 @@ func (s *FileStore) acquireLock(ctx context.Context, name string) (*os.File, err
 	}
 }
 
+// LockWorkspace takes the exclusive cross-process workspace lock that guards
+// manager mutations. It fails fast with ErrLocked if another process holds it.
+func (s *FileStore) LockWorkspace(name string) (*flock.DirLock, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	return flock.LockDir(s.workspacePath(name))
+}
+
+// RLockWorkspace takes the shared cross-process workspace lock for reads.
+// It fails fast with ErrLocked while a mutation holds the exclusive lock.
+func (s *FileStore) RLockWorkspace(name string) (*flock.DirLock, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	return flock.RLockDir(s.workspacePath(name))
+}
+
 // releaseLock releases a file lock.
 func (s *FileStore) releaseLock(f *os.File) error {
 	if f == nil {
@@ -529,18 +710,15 @@ func isRepoScoped(baseDir string) bool {
 	return strings.Contains(baseDir, string(filepath.Separator)+constants.ReposDir+string(filepath.Separator))
 }
 
-// legacyBaseDir returns the legacy ~/.atlas base directory.
-func legacyBaseDir() (string, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", err
-	}
-	return filepath.Join(home, constants.AtlasHome), nil
+// legacyBaseDir returns the pre-repo-scoping base directory: the state
+// directory a repo-scoped store lives under ({state dir}/repos/{hash}), so a
+// relocated state directory never falls back to ~/.atlas.
+func (s *FileStore) legacyBaseDir() string {
+	return filepath.Dir(filepath.Dir(s.baseDir))
 }
 
 // atomicWrite writes data to a file atomically using write-then-rename.
-//
-//nolint:unparam // perm is designed for flexibility, currently only uses constants.WorkspaceFilePerm
+// The file gets exactly perm, regardless of the umask.
 func atomicWrite(path string, data []byte, perm os.FileMode) error {
 	// Write to temp file
 	tmpPath := path + ".tmp"
@@ -556,6 +734,12 @@ func atomicWrite(path string, data []byte, perm os.FileMode) error {
 		return fmt.Errorf("failed to write data: %w", err)
 	}
 
+	if err := f.Chmod(perm); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to set file mode: %w", err)
+	}
+
 	// Sync to disk (ensure data is persisted before rename)
 	if err := f.Sync(); err != nil {
 		_ = f.Close()
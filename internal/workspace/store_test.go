@@ -40,6 +40,131 @@ func TestNewFileStore_CustomDir(t *testing.T) {
 	assert.Equal(t, tmpDir, store.baseDir)
 }
 
+// TestNewFileStore_StateDirEnv tests ATLAS_STATE_DIR isolates created
+// workspaces from the default location.
+func TestNewFileStore_StateDirEnv(t *testing.T) {
+	home := t.TempDir()
+	stateDir := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(constants.AtlasHomeEnvVar, "")
+	t.Setenv(constants.StateDirEnvVar, stateDir)
+
+	store, err := NewFileStore("")
+	require.NoError(t, err)
+	assert.Equal(t, stateDir, store.baseDir)
+
+	ws := &domain.Workspace{
+		Name:   "isolated",
+		Status: constants.WorkspaceStatusActive,
+		Tasks:  []domain.TaskRef{},
+	}
+	require.NoError(t, store.Create(context.Background(), ws))
+
+	_, err = os.Stat(filepath.Join(stateDir, constants.WorkspacesDir, "isolated", constants.WorkspaceFileName))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(home, constants.AtlasHome))
+	assert.True(t, os.IsNotExist(err), "default location must not be touched")
+
+	repoStore, err := NewRepoScopedFileStore(t.TempDir())
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(repoStore.baseDir, filepath.Join(stateDir, constants.ReposDir)))
+}
+
+// TestNewFileStore_StatePermissions tests that a store configured with
+// group-accessible permissions writes its files and directories with them.
+func TestNewFileStore_StatePermissions(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := NewFileStore(tmpDir, WithStatePermissions(0o660, 0o770))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ws := &domain.Workspace{
+		Name:   "shared",
+		Status: constants.WorkspaceStatusActive,
+		Tasks:  []domain.TaskRef{},
+	}
+	require.NoError(t, store.Create(ctx, ws))
+	ws.Branch = "feat/shared"
+	require.NoError(t, store.Update(ctx, ws))
+	_, err = store.ListIndex(ctx)
+	require.NoError(t, err)
+
+	wsDir := filepath.Join(tmpDir, constants.WorkspacesDir, "shared")
+	info, err := os.Stat(wsDir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o770), info.Mode().Perm())
+
+	for _, path := range []string{
+		filepath.Join(wsDir, constants.WorkspaceFileName),
+		filepath.Join(tmpDir, constants.WorkspacesDir, IndexFileName),
+	} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o660), info.Mode().Perm(), path)
+	}
+}
+
+// TestNewFileStore_DefaultPermissions tests that state stays owner-only
+// unless permissions are configured.
+func TestNewFileStore_DefaultPermissions(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := NewFileStore(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(constants.WorkspaceFilePerm), store.filePerm)
+	assert.Equal(t, os.FileMode(constants.WorkspaceDirPerm), store.dirPerm)
+
+	ws := &domain.Workspace{Name: "private", Status: constants.WorkspaceStatusActive, Tasks: []domain.TaskRef{}}
+	require.NoError(t, store.Create(context.Background(), ws))
+
+	info, err := os.Stat(filepath.Join(tmpDir, constants.WorkspacesDir, "private", constants.WorkspaceFileName))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(constants.WorkspaceFilePerm), info.Mode().Perm())
+}
+
+// TestNewFileStore_InvalidPermissions tests that unreasonable modes are rejected.
+func TestNewFileStore_InvalidPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePerm os.FileMode
+		dirPerm  os.FileMode
+		wantErr  error
+	}{
+		{"owner cannot write files", 0o440, 0o750, atlaserrors.ErrInvalidArgument},
+		{"executable files", 0o700, 0o750, atlaserrors.ErrInvalidArgument},
+		{"owner cannot enter dirs", 0o640, 0o650, atlaserrors.ErrInvalidArgument},
+		{"special bits", 0o640, os.ModeSetgid | 0o750, atlaserrors.ErrInvalidArgument},
+		{"world readable files", 0o644, 0o750, atlaserrors.ErrInsecurePermissions},
+		{"world writable dirs", 0o640, 0o777, atlaserrors.ErrInsecurePermissions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewFileStore(t.TempDir(), WithStatePermissions(tt.filePerm, tt.dirPerm))
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, store)
+		})
+	}
+}
+
+// TestSetDefaultStatePermissions tests that process-wide permissions apply
+// to stores created afterwards and that an explicit option still wins.
+func TestSetDefaultStatePermissions(t *testing.T) {
+	t.Cleanup(func() { defaultStatePermissions.Store(nil) })
+
+	require.ErrorIs(t, SetDefaultStatePermissions(0o666, 0o777), atlaserrors.ErrInsecurePermissions)
+	require.NoError(t, SetDefaultStatePermissions(constants.SharedStateFilePerm, constants.SharedStateDirPerm))
+
+	store, err := NewRepoScopedFileStore(t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(constants.SharedStateFilePerm), store.filePerm)
+	assert.Equal(t, os.FileMode(constants.SharedStateDirPerm), store.dirPerm)
+
+	store, err = NewFileStore(t.TempDir(), WithStatePermissions(0o640, 0o750))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), store.filePerm)
+	assert.Equal(t, os.FileMode(0o750), store.dirPerm)
+}
+
 // TestFileStore_Create_Success tests successful workspace creation.
 func TestFileStore_Create_Success(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -1198,6 +1323,7 @@ func TestFileStore_StressConcurrentReads(t *testing.T) {
 func TestRepoScopedFileStore_LegacyFallback_List(t *testing.T) {
 	tmpDir := t.TempDir()
 	t.Setenv("HOME", tmpDir)
+	t.Setenv(constants.AtlasHomeEnvVar, "")
 
 	// Create a workspace in the legacy path
 	legacyWsDir := filepath.Join(tmpDir, constants.AtlasHome, constants.WorkspacesDir, "legacy-ws")
@@ -1228,6 +1354,7 @@ func TestRepoScopedFileStore_LegacyFallback_List(t *testing.T) {
 func TestRepoScopedFileStore_LegacyFallback_Get(t *testing.T) {
 	tmpDir := t.TempDir()
 	t.Setenv("HOME", tmpDir)
+	t.Setenv(constants.AtlasHomeEnvVar, "")
 
 	// Create a workspace in the legacy path
 	legacyWsDir := filepath.Join(tmpDir, constants.AtlasHome, constants.WorkspacesDir, "legacy-ws")
@@ -1253,6 +1380,80 @@ func TestRepoScopedFileStore_LegacyFallback_Get(t *testing.T) {
 	assert.Equal(t, "legacy-ws", got.Name)
 }
 
+// TestRepoScopedFileStore_LegacyFallback_RelocatedStateDir tests that a
+// relocated state directory does not fall back to workspaces in ~/.atlas.
+func TestRepoScopedFileStore_LegacyFallback_RelocatedStateDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	stateDir := t.TempDir()
+	t.Setenv(constants.AtlasHomeEnvVar, stateDir)
+
+	for _, base := range []string{filepath.Join(home, constants.AtlasHome), stateDir} {
+		name := "home-ws"
+		if base == stateDir {
+			name = "state-ws"
+		}
+		wsDir := filepath.Join(base, constants.WorkspacesDir, name)
+		require.NoError(t, os.MkdirAll(wsDir, 0o750))
+		data, err := json.Marshal(domain.Workspace{Name: name, Status: constants.WorkspaceStatusActive})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(wsDir, constants.WorkspaceFileName), data, 0o600))
+	}
+
+	store, err := NewRepoScopedFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.Get(context.Background(), "home-ws")
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+
+	got, err := store.Get(context.Background(), "state-ws")
+	require.NoError(t, err)
+	assert.Equal(t, "state-ws", got.Name)
+
+	workspaces, err := store.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, workspaces, 1)
+	assert.Equal(t, "state-ws", workspaces[0].Name)
+}
+
+// TestStateDir_EnvPrecedence tests that ATLAS_STATE_DIR takes precedence
+// over ATLAS_HOME, which is used when ATLAS_STATE_DIR is unset.
+func TestStateDir_EnvPrecedence(t *testing.T) {
+	stateDir := t.TempDir()
+	homeDir := t.TempDir()
+	t.Setenv(constants.StateDirEnvVar, stateDir)
+	t.Setenv(constants.AtlasHomeEnvVar, homeDir)
+
+	dir, err := StateDir()
+	require.NoError(t, err)
+	assert.Equal(t, stateDir, dir)
+
+	t.Setenv(constants.StateDirEnvVar, "")
+	dir, err = StateDir()
+	require.NoError(t, err)
+	assert.Equal(t, homeDir, dir)
+}
+
+// TestSetStateDir tests that the --base-dir override takes precedence over
+// ATLAS_STATE_DIR and ATLAS_HOME and can be cleared.
+func TestSetStateDir(t *testing.T) {
+	t.Cleanup(func() { SetStateDir("") })
+	envDir := t.TempDir()
+	t.Setenv(constants.StateDirEnvVar, envDir)
+	t.Setenv(constants.AtlasHomeEnvVar, t.TempDir())
+
+	overrideDir := t.TempDir()
+	SetStateDir(overrideDir)
+	dir, err := StateDir()
+	require.NoError(t, err)
+	assert.Equal(t, overrideDir, dir)
+
+	SetStateDir("")
+	dir, err = StateDir()
+	require.NoError(t, err)
+	assert.Equal(t, envDir, dir)
+}
+
 // TestRepoHash tests deterministic hash generation.
 func TestRepoHash(t *testing.T) {
 	tmpDir := t.TempDir()
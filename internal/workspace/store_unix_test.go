@@ -0,0 +1,54 @@
+//go:build unix
+
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+)
+
+// TestNewFileStore_StatePermissions_RestrictiveUmask tests that a
+// restrictive umask cannot narrow the configured modes of the directories,
+// state files and lock files the store creates.
+func TestNewFileStore_StatePermissions_RestrictiveUmask(t *testing.T) {
+	oldMask := syscall.Umask(0o077)
+	t.Cleanup(func() { syscall.Umask(oldMask) })
+
+	baseDir := filepath.Join(t.TempDir(), "repos", "abc123")
+	store, err := NewFileStore(baseDir, WithStatePermissions(0o660, 0o770))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ws := &domain.Workspace{Name: "shared", Status: constants.WorkspaceStatusActive, Tasks: []domain.TaskRef{}}
+	require.NoError(t, store.Create(ctx, ws))
+	_, err = store.ListIndex(ctx)
+	require.NoError(t, err)
+
+	workspacesDir := filepath.Join(baseDir, constants.WorkspacesDir)
+	wsDir := filepath.Join(workspacesDir, "shared")
+	for _, dir := range []string{filepath.Dir(baseDir), baseDir, workspacesDir, wsDir} {
+		info, err := os.Stat(dir)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o770), info.Mode().Perm(), dir)
+	}
+
+	for _, path := range []string{
+		filepath.Join(wsDir, constants.WorkspaceFileName),
+		filepath.Join(wsDir, constants.WorkspaceFileName+".lock"),
+		filepath.Join(workspacesDir, IndexFileName),
+		filepath.Join(workspacesDir, IndexFileName+".lock"),
+	} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o660), info.Mode().Perm(), path)
+	}
+}
@@ -0,0 +1,251 @@
+// Package workspace provides workspace persistence and management for ATLAS.
+// This file implements soft deletion: trashing a workspace keeps its record
+// and branch for a retention window so an accidental delete can be undone.
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/ctxutil"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// DefaultTrashRetention is how long a trashed workspace can be restored
+// before PurgeTrash, run by every Trash, may destroy it.
+const DefaultTrashRetention = 7 * 24 * time.Hour
+
+// WithTrashRetention sets how long trashed workspaces can be restored before
+// PurgeTrash may destroy them. Non-positive values keep DefaultTrashRetention.
+func WithTrashRetention(d time.Duration) ManagerOption {
+	return func(m *DefaultManager) {
+		if d > 0 {
+			m.trashRetention = d
+		}
+	}
+}
+
+// Trash removes the workspace's worktree and marks it trashed, keeping the
+// record and branch so Restore can bring it back. Only committed work
+// survives, so a worktree with uncommitted changes is refused with
+// ErrWorktreeDirty. Trashing an already trashed workspace is a no-op that
+// keeps the original trash time. Once the workspace is trashed, workspaces
+// whose retention window has passed are purged, so the trash cannot grow
+// without bound.
+func (m *DefaultManager) Trash(ctx context.Context, name string) error {
+	if err := m.trash(ctx, name); err != nil {
+		return err
+	}
+	m.purgeExpiredTrash(ctx)
+	return nil
+}
+
+// trash does the work of Trash under the workspace's lock.
+func (m *DefaultManager) trash(ctx context.Context, name string) error {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return err
+	}
+
+	unlock, err := m.lockWorkspace(name, false)
+	if err != nil {
+		return fmt.Errorf("failed to trash workspace '%s': %w", name, err)
+	}
+	defer unlock()
+
+	ws, err := m.store.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to trash workspace '%s': %w", name, err)
+	}
+	if ws.Status == constants.WorkspaceStatusTrashed {
+		return nil
+	}
+	if err := m.ensureWorktreeClean(ctx, ws); err != nil {
+		return fmt.Errorf("failed to trash workspace '%s': %w", name, err)
+	}
+
+	// The branch is kept so the worktree can be recreated on restore
+	wc := newWarningCollector(m.logger, name)
+	m.removeWorktree(ctx, ws, wc)
+	if ws.WorktreePath != "" || ws.Branch != "" {
+		m.pruneWorktrees(ctx, wc)
+	}
+	wc.Log()
+
+	now := time.Now().UTC()
+	ws.Trash = &domain.WorkspaceTrash{
+		TrashedAt:      now,
+		PreviousStatus: ws.Status,
+	}
+	ws.Status = constants.WorkspaceStatusTrashed
+	ws.WorktreePath = ""
+	ws.UpdatedAt = now
+
+	if err := m.store.Update(ctx, ws); err != nil {
+		return fmt.Errorf("failed to trash workspace '%s': %w", name, err)
+	}
+
+	m.logger.Info().Str("workspace", name).Msg("workspace trashed")
+	m.notifyObservers(ctx, EventTrashed, ws)
+
+	return nil
+}
+
+// Restore returns a trashed workspace to the status it had before it was
+// trashed. Unless that status was closed, the worktree is recreated on the
+// workspace's branch.
+func (m *DefaultManager) Restore(ctx context.Context, name string) (*domain.Workspace, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.lockWorkspace(name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, err)
+	}
+	defer unlock()
+
+	ws, err := m.store.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, err)
+	}
+	if ws.Status != constants.WorkspaceStatusTrashed {
+		return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, atlaserrors.ErrWorkspaceNotTrashed)
+	}
+	if m.trashExpired(ws, time.Now().UTC()) {
+		return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, atlaserrors.ErrWorkspaceTrashExpired)
+	}
+
+	status := constants.WorkspaceStatusActive
+	if ws.Trash != nil && ws.Trash.PreviousStatus != "" {
+		status = ws.Trash.PreviousStatus
+	}
+
+	if status != constants.WorkspaceStatusClosed && ws.Branch != "" {
+		worktreePath, wtErr := m.recreateWorktree(ctx, ws)
+		if wtErr != nil {
+			return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, wtErr)
+		}
+		ws.WorktreePath = worktreePath
+	}
+
+	ws.Status = status
+	ws.Trash = nil
+	ws.UpdatedAt = time.Now().UTC()
+
+	if err := m.store.Update(ctx, ws); err != nil {
+		if ws.WorktreePath != "" {
+			_ = m.worktreeRunner.Remove(ctx, ws.WorktreePath, true)
+		}
+		return nil, fmt.Errorf("failed to restore workspace '%s': %w", name, err)
+	}
+
+	m.logger.Info().Str("workspace", name).Str("status", status.String()).Msg("workspace restored")
+	m.notifyObservers(ctx, EventRestored, ws)
+
+	return ws, nil
+}
+
+// PurgeTrash destroys every trashed workspace whose retention window has
+// passed and returns the names of those it purged. Trash runs it after each
+// trashed workspace; callers may also run it on request. Workspaces locked
+// by another process are skipped and reported in the joined error.
+func (m *DefaultManager) PurgeTrash(ctx context.Context) ([]string, error) {
+	if err := ctxutil.Canceled(ctx); err != nil {
+		return nil, err
+	}
+
+	workspaces, err := m.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge trashed workspaces: %w", err)
+	}
+
+	now := time.Now().UTC()
+	var purged []string
+	var errs []error
+	for _, ws := range workspaces {
+		if ws.Status != constants.WorkspaceStatusTrashed || !m.trashExpired(ws, now) {
+			continue
+		}
+		if err := m.Destroy(ctx, ws.Name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to purge workspace '%s': %w", ws.Name, err))
+			continue
+		}
+		m.logger.Info().Str("workspace", ws.Name).Msg("purged trashed workspace")
+		purged = append(purged, ws.Name)
+	}
+
+	return purged, errors.Join(errs...)
+}
+
+// purgeExpiredTrash runs PurgeTrash, logging failures instead of returning
+// them so they do not fail the operation that triggered the purge.
+func (m *DefaultManager) purgeExpiredTrash(ctx context.Context) {
+	if _, err := m.PurgeTrash(ctx); err != nil {
+		m.logger.Warn().Err(err).Msg("failed to purge expired trashed workspaces")
+	}
+}
+
+// ensureWorktreeClean returns ErrWorktreeDirty if the workspace's worktree
+// has uncommitted changes or an unfinished rebase or merge. A missing
+// worktree has nothing to lose and passes.
+func (m *DefaultManager) ensureWorktreeClean(ctx context.Context, ws *domain.Workspace) error {
+	if ws.WorktreePath == "" || m.worktreeRunner == nil {
+		return nil
+	}
+	if _, err := os.Stat(ws.WorktreePath); os.IsNotExist(err) {
+		return nil
+	}
+
+	status, err := m.worktreeRunner.Inspect(ctx, ws.WorktreePath)
+	if err != nil {
+		return fmt.Errorf("failed to check worktree '%s' for uncommitted changes: %w", ws.WorktreePath, err)
+	}
+	if status.Dirty || status.RebaseInProgress || status.MergeInProgress {
+		return fmt.Errorf("worktree '%s' has uncommitted changes; commit or stash them first: %w",
+			ws.WorktreePath, atlaserrors.ErrWorktreeDirty)
+	}
+	return nil
+}
+
+// trashExpired reports whether ws has been in the trash longer than the
+// retention window. A trashed workspace without a trash time is expired.
+func (m *DefaultManager) trashExpired(ws *domain.Workspace, now time.Time) bool {
+	if ws.Trash == nil {
+		return true
+	}
+	return now.Sub(ws.Trash.TrashedAt) > m.trashRetention
+}
+
+// recreateWorktree checks out the trashed workspace's branch in a new
+// worktree and returns its path.
+func (m *DefaultManager) recreateWorktree(ctx context.Context, ws *domain.Workspace) (string, error) {
+	if m.worktreeRunner == nil {
+		return "", fmt.Errorf("cannot recreate worktree for branch '%s': %w", ws.Branch, atlaserrors.ErrWorktreeNotFound)
+	}
+
+	repoPath := ws.RepoPath
+	if repoPath == "" {
+		repoPath = m.worktreeRunner.RepoPath()
+	}
+
+	trackRemote, err := m.resolveExistingBranch(ctx, ws.Branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve branch '%s': %w", ws.Branch, err)
+	}
+
+	wtInfo, err := m.worktreeRunner.Create(ctx, WorktreeCreateOptions{
+		RepoPath:       repoPath,
+		WorkspaceName:  ws.Name,
+		ExistingBranch: ws.Branch,
+		TrackRemote:    trackRemote,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create worktree: %w", err)
+	}
+	return wtInfo.Path, nil
+}
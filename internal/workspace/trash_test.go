@@ -0,0 +1,197 @@
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	"github.com/mrz1836/atlas/internal/domain"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// newTrashTestManager returns a manager with one active workspace "auth".
+func newTrashTestManager(opts ...ManagerOption) (*DefaultManager, *MockStore, *MockWorktreeRunner) {
+	store := newMockStore()
+	store.workspaces["auth"] = &domain.Workspace{
+		Name:         "auth",
+		WorktreePath: "/tmp/repo-auth",
+		Branch:       "feat/auth",
+		RepoPath:     "/tmp/repo",
+		Status:       constants.WorkspaceStatusActive,
+	}
+	runner := newMockWorktreeRunner()
+	runner.createResult = &WorktreeInfo{Path: "/tmp/repo-auth-2", Branch: "feat/auth"}
+	return NewManager(store, runner, zerolog.Nop(), opts...), store, runner
+}
+
+func TestDefaultManager_Trash_RestoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, runner := newTrashTestManager()
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+
+	trashed := store.workspaces["auth"]
+	require.NotNil(t, trashed, "trash must keep the workspace record")
+	assert.Equal(t, constants.WorkspaceStatusTrashed, trashed.Status)
+	assert.Empty(t, trashed.WorktreePath)
+	require.NotNil(t, trashed.Trash)
+	assert.Equal(t, constants.WorkspaceStatusActive, trashed.Trash.PreviousStatus)
+	assert.WithinDuration(t, time.Now(), trashed.Trash.TrashedAt, time.Minute)
+	assert.Equal(t, []string{"/tmp/repo-auth"}, runner.removedPaths)
+	assert.Zero(t, runner.deleteBranchCallCount, "trash must keep the branch")
+
+	ws, err := mgr.Restore(ctx, "auth")
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.WorkspaceStatusActive, ws.Status)
+	assert.Nil(t, ws.Trash)
+	assert.Equal(t, "/tmp/repo-auth-2", ws.WorktreePath)
+	assert.Equal(t, "feat/auth", runner.lastCreateOpts.ExistingBranch)
+	assert.Equal(t, "/tmp/repo", runner.lastCreateOpts.RepoPath)
+	assert.Equal(t, constants.WorkspaceStatusActive, store.workspaces["auth"].Status)
+}
+
+func TestDefaultManager_Trash_AlreadyTrashedKeepsTime(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, _ := newTrashTestManager()
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+	trashedAt := store.workspaces["auth"].Trash.TrashedAt
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+	assert.Equal(t, trashedAt, store.workspaces["auth"].Trash.TrashedAt)
+}
+
+func TestDefaultManager_Trash_NotFound(t *testing.T) {
+	mgr, _, _ := newTrashTestManager()
+
+	err := mgr.Trash(context.Background(), "missing")
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotFound)
+}
+
+func TestDefaultManager_Restore_ClosedWorkspaceSkipsWorktree(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, runner := newTrashTestManager()
+	store.workspaces["auth"].Status = constants.WorkspaceStatusClosed
+	store.workspaces["auth"].WorktreePath = ""
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+	ws, err := mgr.Restore(ctx, "auth")
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.WorkspaceStatusClosed, ws.Status)
+	assert.Empty(t, ws.WorktreePath)
+	assert.Empty(t, runner.lastCreateOpts.ExistingBranch, "closed workspaces get no worktree")
+}
+
+func TestDefaultManager_Restore_NotTrashed(t *testing.T) {
+	mgr, _, _ := newTrashTestManager()
+
+	_, err := mgr.Restore(context.Background(), "auth")
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceNotTrashed)
+}
+
+func TestDefaultManager_Restore_Expired(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, runner := newTrashTestManager(WithTrashRetention(time.Hour))
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+	store.workspaces["auth"].Trash.TrashedAt = time.Now().Add(-2 * time.Hour)
+
+	_, err := mgr.Restore(ctx, "auth")
+	require.ErrorIs(t, err, atlaserrors.ErrWorkspaceTrashExpired)
+	assert.Empty(t, runner.lastCreateOpts.ExistingBranch)
+	assert.Equal(t, constants.WorkspaceStatusTrashed, store.workspaces["auth"].Status)
+}
+
+func TestDefaultManager_PurgeTrash_RemovesExpired(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, runner := newTrashTestManager(WithTrashRetention(time.Hour))
+	store.workspaces["old"] = &domain.Workspace{
+		Name:   "old",
+		Branch: "feat/old",
+		Status: constants.WorkspaceStatusTrashed,
+		Trash: &domain.WorkspaceTrash{
+			TrashedAt:      time.Now().Add(-2 * time.Hour),
+			PreviousStatus: constants.WorkspaceStatusActive,
+		},
+	}
+	store.workspaces["recent"] = &domain.Workspace{
+		Name:   "recent",
+		Branch: "feat/recent",
+		Status: constants.WorkspaceStatusTrashed,
+		Trash: &domain.WorkspaceTrash{
+			TrashedAt:      time.Now().Add(-time.Minute),
+			PreviousStatus: constants.WorkspaceStatusActive,
+		},
+	}
+
+	purged, err := mgr.PurgeTrash(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"old"}, purged)
+	assert.NotContains(t, store.workspaces, "old")
+	assert.Contains(t, store.workspaces, "recent")
+	assert.Contains(t, store.workspaces, "auth")
+	assert.Equal(t, []string{"feat/old"}, runner.deletedBranches)
+}
+
+func TestDefaultManager_Trash_PurgesExpiredTrash(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, _ := newTrashTestManager(WithTrashRetention(time.Hour))
+	store.workspaces["old"] = &domain.Workspace{
+		Name:   "old",
+		Status: constants.WorkspaceStatusTrashed,
+		Trash:  &domain.WorkspaceTrash{TrashedAt: time.Now().UTC().Add(-2 * time.Hour)},
+	}
+	store.workspaces["recent"] = &domain.Workspace{
+		Name:   "recent",
+		Status: constants.WorkspaceStatusTrashed,
+		Trash:  &domain.WorkspaceTrash{TrashedAt: time.Now().UTC().Add(-time.Minute)},
+	}
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+
+	assert.NotContains(t, store.workspaces, "old", "expired trash is purged")
+	assert.Contains(t, store.workspaces, "recent")
+	assert.Equal(t, constants.WorkspaceStatusTrashed, store.workspaces["auth"].Status)
+	assert.Equal(t, time.UTC, store.workspaces["auth"].Trash.TrashedAt.Location())
+}
+
+func TestDefaultManager_Trash_RefusesDirtyWorktree(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, runner := newTrashTestManager()
+	store.workspaces["auth"].WorktreePath = t.TempDir()
+	runner.inspectResult = &WorktreeStatus{Branch: "feat/auth", Dirty: true}
+
+	err := mgr.Trash(ctx, "auth")
+
+	require.ErrorIs(t, err, atlaserrors.ErrWorktreeDirty)
+	assert.Equal(t, constants.WorkspaceStatusActive, store.workspaces["auth"].Status)
+	assert.Zero(t, runner.removeCallCount, "a dirty worktree must not be removed")
+}
+
+func TestDefaultManager_Trash_CleanWorktree(t *testing.T) {
+	ctx := context.Background()
+	mgr, store, runner := newTrashTestManager()
+	worktree := t.TempDir()
+	store.workspaces["auth"].WorktreePath = worktree
+	runner.inspectResult = &WorktreeStatus{Branch: "feat/auth"}
+
+	require.NoError(t, mgr.Trash(ctx, "auth"))
+
+	assert.Equal(t, worktree, runner.inspectLastPath)
+	assert.Equal(t, constants.WorkspaceStatusTrashed, store.workspaces["auth"].Status)
+	assert.Equal(t, []string{worktree}, runner.removedPaths)
+}
+
+func TestWithTrashRetention_IgnoresNonPositive(t *testing.T) {
+	mgr, _, _ := newTrashTestManager(WithTrashRetention(0))
+
+	assert.Equal(t, DefaultTrashRetention, mgr.trashRetention)
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +34,11 @@ type WorktreeRunner interface {
 	// Prune removes stale worktree entries.
 	Prune(ctx context.Context) error
 
+	// PruneDryRun reports the worktree entries Prune would remove, with the
+	// reason git gives for each, plus locked entries whose directory is
+	// missing, which Prune keeps until they are unlocked.
+	PruneDryRun(ctx context.Context) ([]PrunableWorktree, error)
+
 	// BranchExists checks if a branch exists in the repository.
 	BranchExists(ctx context.Context, name string) (bool, error)
 
@@ -58,6 +65,22 @@ type WorktreeRunner interface {
 	// and checks out `fallbackBranch` instead. No-op if already on a different branch.
 	// Returns ErrWorktreeDirty if the working tree has uncommitted changes.
 	DetachBranch(ctx context.Context, branch, fallbackBranch string) error
+
+	// Inspect reports the git state of the worktree at path without modifying it.
+	Inspect(ctx context.Context, path string) (*WorktreeStatus, error)
+
+	// Divergence reports how many commits branch is ahead of and behind base.
+	// Returns ErrBranchNotFound if either ref is missing and
+	// ErrUnrelatedHistories if they share no common ancestor.
+	Divergence(ctx context.Context, branch, base string) (ahead, behind int, err error)
+
+	// ListBranches returns the local branches under any of the given prefixes
+	// (e.g. "fix" matches "fix/auth"). With no prefixes it returns every local branch.
+	ListBranches(ctx context.Context, prefixes ...string) ([]string, error)
+
+	// CreatedBranches returns the local branches Create made, as recorded in
+	// git config. Deleting a branch drops its record.
+	CreatedBranches(ctx context.Context) ([]string, error)
 }
 
 // WorktreeCreateOptions contains options for creating a worktree.
@@ -67,32 +90,68 @@ type WorktreeCreateOptions struct {
 	BranchType     string // Branch type prefix (feat, fix, chore) - mutually exclusive with ExistingBranch
 	BaseBranch     string // Branch to create from (default: current branch)
 	ExistingBranch string // Existing branch to checkout (mutually exclusive with BranchType)
+	TrackRemote    string // Remote to track when ExistingBranch exists only on that remote (e.g., "origin")
 }
 
 // WorktreeInfo contains information about a worktree.
 type WorktreeInfo struct {
-	Path       string    // Absolute path to the worktree
-	Branch     string    // Branch name (e.g., "feat/auth")
-	HeadCommit string    // HEAD commit SHA
-	IsPrunable bool      // True if worktree directory is missing
-	IsLocked   bool      // True if worktree has a lock file
-	CreatedAt  time.Time // When the worktree was created (if known)
+	Path           string    // Absolute path to the worktree
+	Branch         string    // Branch name (e.g., "feat/auth")
+	HeadCommit     string    // HEAD commit SHA
+	IsPrunable     bool      // True if worktree directory is missing
+	PrunableReason string    // Why git considers the worktree prunable, if given
+	IsLocked       bool      // True if worktree has a lock file
+	LockReason     string    // Reason recorded with the lock, if given
+	CreatedAt      time.Time // When the worktree was created (if known)
+}
+
+// PrunableWorktree is a stale worktree entry reported by PruneDryRun.
+type PrunableWorktree struct {
+	Path   string // Worktree path recorded by git
+	Branch string // Branch checked out in the worktree, if any
+	Reason string // Why the entry is stale (e.g., "gitdir file points to non-existent location")
+	Locked bool   // True if the entry is locked, so Prune keeps it
+}
+
+// WorktreeStatus describes the git state of a single worktree.
+type WorktreeStatus struct {
+	Branch           string // Checked-out branch, empty if HEAD is detached
+	HeadCommit       string // HEAD commit SHA
+	RebaseInProgress bool   // True if a rebase was started and not finished
+	MergeInProgress  bool   // True if a merge was started and not finished
+	Dirty            bool   // True if there are uncommitted or untracked changes
 }
 
 // GitWorktreeRunner implements WorktreeRunner using git CLI.
 type GitWorktreeRunner struct {
-	repoPath string         // Path to the main repository
-	logger   zerolog.Logger // Logger for operations
+	repoPath     string         // Path to the main repository
+	pathTemplate string         // Template for new worktree paths, empty for the default
+	logger       zerolog.Logger // Logger for operations
+}
+
+// GitWorktreeRunnerOption configures a GitWorktreeRunner.
+type GitWorktreeRunnerOption func(*GitWorktreeRunner)
+
+// WithWorktreePathTemplate sets the template used to place new worktrees.
+// See ResolveWorktreePath for the supported placeholders.
+func WithWorktreePathTemplate(template string) GitWorktreeRunnerOption {
+	return func(r *GitWorktreeRunner) {
+		r.pathTemplate = template
+	}
 }
 
 // NewGitWorktreeRunner creates a new GitWorktreeRunner.
-func NewGitWorktreeRunner(ctx context.Context, repoPath string, logger zerolog.Logger) (*GitWorktreeRunner, error) {
+func NewGitWorktreeRunner(ctx context.Context, repoPath string, logger zerolog.Logger, opts ...GitWorktreeRunnerOption) (*GitWorktreeRunner, error) {
 	// Detect repo root to ensure we're in a git repo
 	root, err := detectRepoRoot(ctx, repoPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to detect git repository: %w", err)
 	}
-	return &GitWorktreeRunner{repoPath: root, logger: logger}, nil
+	r := &GitWorktreeRunner{repoPath: root, logger: logger}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
 }
 
 // Create creates a new worktree with the given options.
@@ -110,12 +169,17 @@ func (r *GitWorktreeRunner) Create(ctx context.Context, opts WorktreeCreateOptio
 		return nil, err
 	}
 
-	wtPath := siblingPath(r.repoPath, opts.WorkspaceName)
-	if err := r.cleanupOrphanedPath(ctx, wtPath); err != nil {
-		r.logger.Debug().Err(err).Str("path", wtPath).Msg("failed to cleanup orphaned path")
+	wtPath, err := ResolveWorktreePath(r.pathTemplate, r.repoPath, opts.WorkspaceName)
+	if err != nil {
+		return nil, err
+	}
+	if err = ensureWritableParent(wtPath); err != nil {
+		return nil, err
+	}
+	if err = r.ensurePathAvailable(ctx, wtPath); err != nil {
+		return nil, err
 	}
 
-	var err error
 	wtPath, err = ensureUniquePath(wtPath)
 	if err != nil {
 		return nil, err
@@ -140,6 +204,10 @@ func (r *GitWorktreeRunner) Create(ctx context.Context, opts WorktreeCreateOptio
 		return nil, fmt.Errorf("failed to create worktree: %w", err)
 	}
 
+	if opts.ExistingBranch == "" {
+		r.recordCreatedBranch(ctx, branchName)
+	}
+
 	gitDir := filepath.Join(wtPath, ".git")
 	if err := git.CleanupStaleLockFiles(ctx, gitDir, git.DefaultLockStalenessThreshold, r.logger); err != nil {
 		r.logger.Warn().Err(err).Str("path", wtPath).Msg("failed to cleanup stale locks")
@@ -251,6 +319,45 @@ func (r *GitWorktreeRunner) Prune(ctx context.Context) error {
 	return nil
 }
 
+// PruneDryRun reports the stale worktree entries without removing them.
+func (r *GitWorktreeRunner) PruneDryRun(ctx context.Context) ([]PrunableWorktree, error) {
+	worktrees, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return prunableWorktrees(worktrees), nil
+}
+
+// prunableWorktrees selects the stale entries from a worktree listing.
+// Git does not flag a locked worktree as prunable even when its directory
+// is gone, so those are reported as locked when the directory is missing.
+func prunableWorktrees(worktrees []*WorktreeInfo) []PrunableWorktree {
+	var prunable []PrunableWorktree
+	for _, wt := range worktrees {
+		switch {
+		case wt.IsPrunable:
+			reason := wt.PrunableReason
+			if reason == "" {
+				reason = "worktree directory is missing"
+			}
+			prunable = append(prunable, PrunableWorktree{Path: wt.Path, Branch: wt.Branch, Reason: reason, Locked: wt.IsLocked})
+		case wt.IsLocked && !dirExists(wt.Path):
+			reason := "locked"
+			if wt.LockReason != "" {
+				reason += ": " + wt.LockReason
+			}
+			prunable = append(prunable, PrunableWorktree{Path: wt.Path, Branch: wt.Branch, Reason: reason, Locked: true})
+		}
+	}
+	return prunable
+}
+
+// dirExists reports whether path exists and is a directory.
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
 // BranchExists checks if a branch exists in the repository.
 func (r *GitWorktreeRunner) BranchExists(ctx context.Context, name string) (bool, error) {
 	// Check for cancellation at entry
@@ -289,6 +396,9 @@ func (r *GitWorktreeRunner) DeleteBranch(ctx context.Context, name string, force
 
 	_, err := git.RunCommand(ctx, r.repoPath, "branch", flag, name)
 	if err != nil {
+		if strings.Contains(err.Error(), "not fully merged") {
+			return fmt.Errorf("failed to delete branch '%s': %w", name, atlaserrors.ErrBranchNotMerged)
+		}
 		return fmt.Errorf("failed to delete branch '%s': %w", name, err)
 	}
 
@@ -415,6 +525,180 @@ func (r *GitWorktreeRunner) DetachBranch(ctx context.Context, branch, fallbackBr
 	return nil
 }
 
+// Inspect reports the branch, HEAD commit, uncommitted changes, and any
+// in-progress rebase or merge of the worktree at path. It only reads
+// repository state.
+func (r *GitWorktreeRunner) Inspect(ctx context.Context, path string) (*WorktreeStatus, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	branch, err := git.RunCommand(ctx, path, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine worktree branch: %w", err)
+	}
+	head, err := git.RunCommand(ctx, path, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine worktree HEAD: %w", err)
+	}
+
+	status := &WorktreeStatus{HeadCommit: strings.TrimSpace(head)}
+	if branch = strings.TrimSpace(branch); branch != "HEAD" {
+		status.Branch = branch
+	}
+	status.RebaseInProgress = gitPathExists(ctx, path, "rebase-merge") || gitPathExists(ctx, path, "rebase-apply")
+	status.MergeInProgress = gitPathExists(ctx, path, "MERGE_HEAD")
+
+	changes, err := git.RunCommand(ctx, path, "status", "--porcelain")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check worktree status: %w", err)
+	}
+	status.Dirty = strings.TrimSpace(changes) != ""
+
+	return status, nil
+}
+
+// Divergence reports how many commits branch is ahead of and behind base,
+// using git rev-list --left-right --count. Either ref may be a local or
+// remote-tracking branch name or any other commit-ish.
+func (r *GitWorktreeRunner) Divergence(ctx context.Context, branch, base string) (int, int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, 0, ctx.Err()
+	default:
+	}
+
+	for _, ref := range []string{branch, base} {
+		if _, err := git.RunCommand(ctx, r.repoPath, "rev-parse", "--verify", "--quiet", ref+"^{commit}"); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return 0, 0, ctxErr
+			}
+			return 0, 0, fmt.Errorf("%w: %s", atlaserrors.ErrBranchNotFound, ref)
+		}
+	}
+
+	if _, err := git.RunCommand(ctx, r.repoPath, "merge-base", branch, base); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return 0, 0, ctxErr
+		}
+		return 0, 0, fmt.Errorf("%w: %s and %s", atlaserrors.ErrUnrelatedHistories, branch, base)
+	}
+
+	out, err := git.RunCommand(ctx, r.repoPath, "rev-list", "--left-right", "--count", branch+"..."+base)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count commits between %s and %s: %w", branch, base, err)
+	}
+	return parseLeftRightCount(out)
+}
+
+// ListBranches returns the local branches under any of the given prefixes,
+// using git for-each-ref over refs/heads.
+func (r *GitWorktreeRunner) ListBranches(ctx context.Context, prefixes ...string) ([]string, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	args := []string{"for-each-ref", "--format=%(refname:short)"}
+	if len(prefixes) == 0 {
+		args = append(args, "refs/heads")
+	}
+	for _, prefix := range prefixes {
+		args = append(args, "refs/heads/"+strings.Trim(prefix, "/"))
+	}
+
+	out, err := git.RunCommand(ctx, r.repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	var branches []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
+// createdBranchKey is the per-branch git config variable marking branches
+// Create made. Git keeps it in the branch's config section, which
+// git branch -d and -D remove together with the branch.
+const createdBranchKey = "atlasCreated"
+
+// recordCreatedBranch marks branch as made by atlas. A failure only means
+// prune will not consider the branch, so it is logged rather than returned.
+func (r *GitWorktreeRunner) recordCreatedBranch(ctx context.Context, branch string) {
+	if _, err := git.RunCommand(ctx, r.repoPath, "config", "branch."+branch+"."+createdBranchKey, "true"); err != nil {
+		r.logger.Warn().Err(err).Str("branch_name", branch).Msg("failed to record created branch")
+	}
+}
+
+// CreatedBranches returns the branches marked by recordCreatedBranch.
+func (r *GitWorktreeRunner) CreatedBranches(ctx context.Context) ([]string, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Config variable names are case-insensitive and listed in lower case
+	suffix := "." + strings.ToLower(createdBranchKey)
+	out, err := git.RunCommand(ctx, r.repoPath, "config", "--get-regexp", `^branch\..*`+regexp.QuoteMeta(suffix)+`$`)
+	if err != nil {
+		// git config exits 1 when no variable matches
+		if strings.Contains(err.Error(), "(exit 1)") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list created branches: %w", err)
+	}
+
+	var branches []string
+	for _, line := range strings.Split(out, "\n") {
+		key, value, _ := strings.Cut(strings.TrimSpace(line), " ")
+		if key == "" || value != "true" {
+			continue
+		}
+		branches = append(branches, strings.TrimSuffix(strings.TrimPrefix(key, "branch."), suffix))
+	}
+	return branches, nil
+}
+
+// parseLeftRightCount parses the "<left>\t<right>" output of
+// git rev-list --left-right --count.
+func parseLeftRightCount(out string) (int, int, error) {
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("%w: unexpected rev-list output %q", atlaserrors.ErrGitOperation, out)
+	}
+	left, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: unexpected rev-list output %q", atlaserrors.ErrGitOperation, out)
+	}
+	right, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: unexpected rev-list output %q", atlaserrors.ErrGitOperation, out)
+	}
+	return left, right, nil
+}
+
+// gitPathExists reports whether the named entry exists in the worktree's git directory.
+func gitPathExists(ctx context.Context, worktreePath, name string) bool {
+	gitPath, err := git.RunCommand(ctx, worktreePath, "rev-parse", "--git-path", name)
+	if err != nil {
+		return false
+	}
+	gitPath = strings.TrimSpace(gitPath)
+	if !filepath.IsAbs(gitPath) {
+		gitPath = filepath.Join(worktreePath, gitPath)
+	}
+	_, err = os.Stat(gitPath)
+	return err == nil
+}
+
 // CleanupLocks removes stale lock files from a worktree directory.
 // This is useful for cleaning up lock files left by crashed git processes.
 func (r *GitWorktreeRunner) CleanupLocks(ctx context.Context, path string) error {
@@ -440,8 +724,12 @@ func (r *GitWorktreeRunner) validateWorktreeOptions(opts WorktreeCreateOptions)
 	return nil
 }
 
-// buildWorktreeCommandForExisting builds command for checking out an existing branch
-func (r *GitWorktreeRunner) buildWorktreeCommandForExisting(ctx context.Context, branch, wtPath string) ([]string, error) {
+// buildWorktreeCommandForExisting builds command for checking out an existing branch.
+// If trackRemote is set, the branch is created locally tracking {trackRemote}/{branch}.
+func (r *GitWorktreeRunner) buildWorktreeCommandForExisting(ctx context.Context, branch, trackRemote, wtPath string) ([]string, error) {
+	if trackRemote != "" {
+		return []string{"worktree", "add", "--track", "-b", branch, wtPath, trackRemote + "/" + branch}, nil
+	}
 	if err := r.ensureBranchExists(ctx, branch); err != nil {
 		return nil, err
 	}
@@ -470,7 +758,7 @@ func (r *GitWorktreeRunner) buildWorktreeCommandForNew(ctx context.Context, bran
 // buildWorktreeCommand builds the git worktree add command for existing or new branch mode
 func (r *GitWorktreeRunner) buildWorktreeCommand(ctx context.Context, opts WorktreeCreateOptions, wtPath string) (string, []string, error) {
 	if opts.ExistingBranch != "" {
-		args, err := r.buildWorktreeCommandForExisting(ctx, opts.ExistingBranch, wtPath)
+		args, err := r.buildWorktreeCommandForExisting(ctx, opts.ExistingBranch, opts.TrackRemote, wtPath)
 		return opts.ExistingBranch, args, err
 	}
 	return r.buildWorktreeCommandForNew(ctx, opts.BranchType, opts.WorkspaceName, opts.BaseBranch, wtPath)
@@ -552,29 +840,82 @@ func generateBranchName(branchType, workspaceName string) string {
 // maxPathRetries is the maximum number of numeric suffixes to try before using timestamp.
 const maxPathRetries = 100
 
-// cleanupOrphanedPath removes a directory if it exists but is not a registered git worktree.
-// This handles the case where a previous destroy failed to fully clean up, leaving an orphaned directory.
-func (r *GitWorktreeRunner) cleanupOrphanedPath(ctx context.Context, path string) error {
-	// Check if path exists
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return nil // Nothing to clean up
+// ensurePathAvailable prepares the computed worktree path for git worktree add.
+// A missing path, an empty leftover directory, the directory of a prunable
+// worktree entry, or an unregistered leftover of one of this repository's
+// worktrees (such as one a failed destroy left behind) is cleared; an active
+// worktree is left alone so ensureUniquePath can pick a suffixed sibling.
+// Anything else is a directory atlas does not own, and ErrWorktreePathOccupied
+// is returned rather than letting git fail with a less helpful message.
+func (r *GitWorktreeRunner) ensurePathAvailable(ctx context.Context, path string) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to inspect worktree path '%s': %w", path, err)
 	}
 
-	// Check if it's a registered worktree
 	worktrees, err := r.List(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list worktrees: %w", err)
 	}
 
 	for _, wt := range worktrees {
-		if wt.Path == path {
+		if wt.Path != path {
+			continue
+		}
+		if !wt.IsPrunable || wt.IsLocked {
 			return nil // It's an active worktree, don't touch it
 		}
+		r.logger.Info().Str("path", path).Msg("removing prunable worktree directory")
+		if err := r.Prune(ctx); err != nil {
+			return err
+		}
+		return os.RemoveAll(path)
 	}
 
-	// Path exists but isn't a worktree - it's orphaned
-	r.logger.Info().Str("path", path).Msg("removing orphaned worktree directory")
-	return os.RemoveAll(path)
+	if info.IsDir() {
+		entries, readErr := os.ReadDir(path)
+		if readErr != nil {
+			return fmt.Errorf("failed to read worktree path '%s': %w", path, readErr)
+		}
+		if len(entries) == 0 {
+			r.logger.Info().Str("path", path).Msg("removing empty leftover worktree directory")
+			return os.Remove(path)
+		}
+		if r.isLeftoverWorktree(ctx, path) {
+			r.logger.Info().Str("path", path).Msg("removing leftover worktree directory")
+			return os.RemoveAll(path)
+		}
+	}
+
+	return fmt.Errorf("%w: '%s' is not a git worktree; remove it manually and try again",
+		atlaserrors.ErrWorktreePathOccupied, path)
+}
+
+// isLeftoverWorktree reports whether path is an unregistered worktree of this
+// repository: its .git file still points into the repository's worktrees
+// directory, but git no longer tracks it.
+func (r *GitWorktreeRunner) isLeftoverWorktree(ctx context.Context, path string) bool {
+	data, err := os.ReadFile(filepath.Join(path, ".git")) //#nosec G304 -- .git file of the computed worktree path
+	if err != nil {
+		return false
+	}
+	gitDir, found := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !found {
+		return false
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(path, gitDir)
+	}
+
+	commonDir, err := git.RunCommand(ctx, r.repoPath, "rev-parse", "--path-format=absolute", "--git-common-dir")
+	if err != nil {
+		return false
+	}
+	return filepath.Dir(filepath.Clean(gitDir)) == filepath.Join(filepath.Clean(commonDir), "worktrees")
 }
 
 // ensureUniquePath finds a unique worktree path, appending -2, -3, etc.
@@ -625,10 +966,13 @@ func parseWorktreeList(output string) []*WorktreeInfo {
 			// refs/heads/feat/auth -> feat/auth
 			branch := strings.TrimPrefix(line, "branch refs/heads/")
 			current.Branch = branch
-		} else if line == "prunable" && current != nil {
+		} else if (line == "prunable" || strings.HasPrefix(line, "prunable ")) && current != nil {
+			// Git 2.31+ appends the reason: "prunable gitdir file points to non-existent location"
 			current.IsPrunable = true
-		} else if strings.HasPrefix(line, "locked") && current != nil {
+			current.PrunableReason = strings.TrimSpace(strings.TrimPrefix(line, "prunable"))
+		} else if (line == "locked" || strings.HasPrefix(line, "locked ")) && current != nil {
 			current.IsLocked = true
+			current.LockReason = strings.TrimSpace(strings.TrimPrefix(line, "locked"))
 		}
 	}
 
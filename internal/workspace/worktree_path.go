@@ -0,0 +1,59 @@
+// Package workspace provides workspace persistence and management for ATLAS.
+// This file resolves where new worktrees are created.
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mrz1836/atlas/internal/constants"
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+// ResolveWorktreePath expands a worktree path template for a workspace.
+// The {repo}, {name}, and {home} placeholders are replaced with the repository
+// root, the workspace name, and the user's home directory. An empty template
+// uses constants.DefaultWorktreePathTemplate. The result must be absolute.
+func ResolveWorktreePath(template, repoRoot, workspaceName string) (string, error) {
+	if template == "" {
+		template = constants.DefaultWorktreePathTemplate
+	}
+
+	resolved := strings.NewReplacer("{repo}", repoRoot, "{name}", workspaceName).Replace(template)
+	if strings.Contains(resolved, "{home}") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve {home} in worktree path: %w", err)
+		}
+		resolved = strings.ReplaceAll(resolved, "{home}", home)
+	}
+
+	resolved = filepath.Clean(resolved)
+	if !filepath.IsAbs(resolved) {
+		return "", fmt.Errorf("worktree path '%s' from template '%s' is not absolute: %w",
+			resolved, template, atlaserrors.ErrInvalidWorktreePath)
+	}
+	return resolved, nil
+}
+
+// ensureWritableParent creates the worktree's parent directory if needed and
+// checks that a worktree can be created in it.
+func ensureWritableParent(wtPath string) error {
+	parent := filepath.Dir(wtPath)
+	if err := os.MkdirAll(parent, constants.WorkspaceDirPerm); err != nil {
+		return fmt.Errorf("cannot create worktree parent directory '%s': %w: %w",
+			parent, atlaserrors.ErrInvalidWorktreePath, err)
+	}
+
+	probe, err := os.CreateTemp(parent, ".atlas-write-check-*")
+	if err != nil {
+		return fmt.Errorf("worktree parent directory '%s' is not writable: %w: %w",
+			parent, atlaserrors.ErrInvalidWorktreePath, err)
+	}
+	name := probe.Name()
+	_ = probe.Close()
+	_ = os.Remove(name)
+	return nil
+}
@@ -0,0 +1,106 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	atlaserrors "github.com/mrz1836/atlas/internal/errors"
+)
+
+func TestResolveWorktreePath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "empty template uses sibling path",
+			template: "",
+			expected: "/Users/dev/projects/atlas-auth",
+		},
+		{
+			name:     "explicit default template",
+			template: "{repo}-{name}",
+			expected: "/Users/dev/projects/atlas-auth",
+		},
+		{
+			name:     "dedicated worktree tree under home",
+			template: "{home}/.atlas/worktrees/{name}",
+			expected: filepath.Join(home, ".atlas", "worktrees", "auth"),
+		},
+		{
+			name:     "resolved path is cleaned",
+			template: "/srv/worktrees/{name}/../{name}-wt",
+			expected: "/srv/worktrees/auth-wt",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ResolveWorktreePath(tc.template, "/Users/dev/projects/atlas", "auth")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+
+	t.Run("default matches sibling path", func(t *testing.T) {
+		got, err := ResolveWorktreePath("", "/repo/atlas", "auth")
+		require.NoError(t, err)
+		assert.Equal(t, SiblingPath("/repo/atlas", "auth"), got)
+	})
+
+	t.Run("relative result is rejected", func(t *testing.T) {
+		_, err := ResolveWorktreePath("worktrees/{name}", "/repo", "auth")
+		require.ErrorIs(t, err, atlaserrors.ErrInvalidWorktreePath)
+	})
+}
+
+func TestEnsureWritableParent(t *testing.T) {
+	t.Run("creates missing parent directories", func(t *testing.T) {
+		parent := filepath.Join(t.TempDir(), "a", "b")
+
+		require.NoError(t, ensureWritableParent(filepath.Join(parent, "wt")))
+
+		info, err := os.Stat(parent)
+		require.NoError(t, err)
+		assert.True(t, info.IsDir())
+		entries, err := os.ReadDir(parent)
+		require.NoError(t, err)
+		assert.Empty(t, entries, "write probe should be removed")
+	})
+
+	t.Run("parent that is a file is rejected", func(t *testing.T) {
+		blocker := filepath.Join(t.TempDir(), "blocker")
+		require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o600))
+
+		err := ensureWritableParent(filepath.Join(blocker, "wt"))
+		require.ErrorIs(t, err, atlaserrors.ErrInvalidWorktreePath)
+	})
+}
+
+func TestGitWorktreeRunner_Create_PathTemplate(t *testing.T) {
+	repoPath := createTestRepo(t)
+	base := t.TempDir()
+	runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop(),
+		WithWorktreePathTemplate(filepath.Join(base, "worktrees", "{name}")))
+	require.NoError(t, err)
+
+	info, err := runner.Create(context.Background(), WorktreeCreateOptions{
+		WorkspaceName: "auth",
+		BranchType:    "feat",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(base, "worktrees", "auth"), info.Path)
+	_, err = os.Stat(filepath.Join(info.Path, ".git"))
+	require.NoError(t, err)
+}
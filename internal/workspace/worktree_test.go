@@ -367,6 +367,27 @@ locked
 		assert.True(t, result[1].IsLocked)
 	})
 
+	t.Run("keeps prunable and lock reasons", func(t *testing.T) {
+		output := `worktree /path/to/stale
+HEAD abc123
+branch refs/heads/stale
+prunable gitdir file points to non-existent location
+
+worktree /path/to/locked
+HEAD def456
+branch refs/heads/locked
+locked on a removable drive
+`
+		result := parseWorktreeList(output)
+		require.Len(t, result, 2)
+
+		assert.True(t, result[0].IsPrunable)
+		assert.Equal(t, "gitdir file points to non-existent location", result[0].PrunableReason)
+
+		assert.True(t, result[1].IsLocked)
+		assert.Equal(t, "on a removable drive", result[1].LockReason)
+	})
+
 	t.Run("handles empty output", func(t *testing.T) {
 		result := parseWorktreeList("")
 		assert.Empty(t, result)
@@ -832,6 +853,70 @@ func TestGitWorktreeRunner_Remove(t *testing.T) {
 	})
 }
 
+func TestGitWorktreeRunner_PruneDryRun(t *testing.T) {
+	t.Run("reports removed worktree directory with reason", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		stale, err := runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName: "stale",
+			BranchType:    "feat",
+		})
+		require.NoError(t, err)
+		_, err = runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName: "healthy",
+			BranchType:    "feat",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, os.RemoveAll(stale.Path))
+
+		prunable, err := runner.PruneDryRun(context.Background())
+		require.NoError(t, err)
+		require.Len(t, prunable, 1)
+		assert.Equal(t, filepath.Base(stale.Path), filepath.Base(prunable[0].Path))
+		assert.Equal(t, stale.Branch, prunable[0].Branch)
+		assert.Equal(t, "gitdir file points to non-existent location", prunable[0].Reason)
+		assert.False(t, prunable[0].Locked)
+
+		// Nothing was removed
+		worktrees, err := runner.List(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, worktrees, 3)
+	})
+
+	t.Run("reports locked worktree with missing directory", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		info, err := runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName: "locked",
+			BranchType:    "feat",
+		})
+		require.NoError(t, err)
+		runGit(t, repoPath, "worktree", "lock", "--reason", "on usb", info.Path)
+		require.NoError(t, os.RemoveAll(info.Path))
+
+		prunable, err := runner.PruneDryRun(context.Background())
+		require.NoError(t, err)
+		require.Len(t, prunable, 1)
+		assert.Equal(t, "locked: on usb", prunable[0].Reason)
+		assert.True(t, prunable[0].Locked)
+	})
+
+	t.Run("reports nothing for healthy worktrees", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		prunable, err := runner.PruneDryRun(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, prunable)
+	})
+}
+
 func TestGitWorktreeRunner_Prune(t *testing.T) {
 	t.Run("prunes stale worktrees", func(t *testing.T) {
 		repoPath := createTestRepo(t)
@@ -1253,14 +1338,14 @@ func TestGitWorktreeRunner_Create_WithRemoteBaseBranch(t *testing.T) {
 	})
 }
 
-func TestGitWorktreeRunner_CleanupOrphanedPath(t *testing.T) {
+func TestGitWorktreeRunner_EnsurePathAvailable(t *testing.T) {
 	t.Run("does nothing if path does not exist", func(t *testing.T) {
 		repoPath := createTestRepo(t)
 		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
 		require.NoError(t, err)
 
 		nonExistentPath := filepath.Join(t.TempDir(), "does-not-exist")
-		err = runner.cleanupOrphanedPath(context.Background(), nonExistentPath)
+		err = runner.ensurePathAvailable(context.Background(), nonExistentPath)
 		require.NoError(t, err)
 	})
 
@@ -1276,8 +1361,8 @@ func TestGitWorktreeRunner_CleanupOrphanedPath(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		// Try to cleanup the active worktree path - should do nothing
-		err = runner.cleanupOrphanedPath(context.Background(), info.Path)
+		// Checking the active worktree path should do nothing
+		err = runner.ensurePathAvailable(context.Background(), info.Path)
 		require.NoError(t, err)
 
 		// Worktree should still exist
@@ -1285,41 +1370,138 @@ func TestGitWorktreeRunner_CleanupOrphanedPath(t *testing.T) {
 		require.NoError(t, err, "active worktree should not be removed")
 	})
 
-	t.Run("removes orphaned directory that is not a worktree", func(t *testing.T) {
+	t.Run("rejects non-empty directory that is not a worktree", func(t *testing.T) {
 		repoPath := createTestRepo(t)
 		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
 		require.NoError(t, err)
 
-		// Create an orphaned directory at the expected worktree path
-		orphanedPath := SiblingPath(repoPath, "orphaned")
-		err = os.MkdirAll(orphanedPath, 0o750)
+		// Create a leftover directory at the expected worktree path
+		occupiedPath := SiblingPath(repoPath, "occupied")
+		err = os.MkdirAll(occupiedPath, 0o750)
 		require.NoError(t, err)
 
-		// Create a file inside to verify full removal
-		testFile := filepath.Join(orphanedPath, "test.txt")
+		testFile := filepath.Join(occupiedPath, "test.txt")
 		err = os.WriteFile(testFile, []byte("test"), 0o600)
 		require.NoError(t, err)
 
-		// Cleanup should remove the orphaned directory
-		err = runner.cleanupOrphanedPath(context.Background(), orphanedPath)
+		err = runner.ensurePathAvailable(context.Background(), occupiedPath)
+		require.ErrorIs(t, err, atlaserrors.ErrWorktreePathOccupied)
+		assert.Contains(t, err.Error(), occupiedPath)
+		assert.Contains(t, err.Error(), "remove it manually")
+
+		// Contents must be left untouched
+		_, err = os.Stat(testFile)
+		require.NoError(t, err, "occupied directory should not be removed")
+	})
+
+	t.Run("rejects a file at the worktree path", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		filePath := SiblingPath(repoPath, "file")
+		err = os.WriteFile(filePath, []byte("test"), 0o600)
 		require.NoError(t, err)
 
-		// Directory should be gone
-		_, err = os.Stat(orphanedPath)
-		assert.True(t, os.IsNotExist(err), "orphaned directory should be removed")
+		err = runner.ensurePathAvailable(context.Background(), filePath)
+		require.ErrorIs(t, err, atlaserrors.ErrWorktreePathOccupied)
 	})
 
-	t.Run("create uses expected path when orphaned directory is cleaned up", func(t *testing.T) {
+	t.Run("clears directory of a prunable worktree", func(t *testing.T) {
 		repoPath := createTestRepo(t)
 		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
 		require.NoError(t, err)
 
-		// Create an orphaned directory at the expected worktree path
+		info, err := runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName: "stale",
+			BranchType:    "feat",
+		})
+		require.NoError(t, err)
+
+		// Dropping the .git file leaves git with a prunable entry while the
+		// directory and its files stay behind
+		require.NoError(t, os.Remove(filepath.Join(info.Path, ".git")))
+
+		err = runner.ensurePathAvailable(context.Background(), info.Path)
+		require.NoError(t, err)
+
+		_, err = os.Stat(info.Path)
+		assert.True(t, os.IsNotExist(err), "prunable worktree directory should be removed")
+	})
+
+	t.Run("clears leftover directory of an unregistered worktree", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		info, err := runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName: "leftover",
+			BranchType:    "feat",
+		})
+		require.NoError(t, err)
+
+		// A failed destroy can drop git's record of the worktree while its
+		// directory, .git file included, stays behind
+		require.NoError(t, os.RemoveAll(filepath.Join(repoPath, ".git", "worktrees", filepath.Base(info.Path))))
+		require.NoError(t, runner.Prune(context.Background()))
+
+		err = runner.ensurePathAvailable(context.Background(), info.Path)
+		require.NoError(t, err)
+
+		_, err = os.Stat(info.Path)
+		assert.True(t, os.IsNotExist(err), "leftover worktree directory should be removed")
+	})
+
+	t.Run("keeps a directory whose .git file points at another repository", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		otherPath := SiblingPath(repoPath, "other")
+		require.NoError(t, os.MkdirAll(otherPath, 0o750))
+		gitFile := "gitdir: " + filepath.Join(t.TempDir(), ".git", "worktrees", "other") + "\n"
+		require.NoError(t, os.WriteFile(filepath.Join(otherPath, ".git"), []byte(gitFile), 0o600))
+
+		err = runner.ensurePathAvailable(context.Background(), otherPath)
+		require.ErrorIs(t, err, atlaserrors.ErrWorktreePathOccupied)
+
+		_, err = os.Stat(otherPath)
+		require.NoError(t, err, "unrelated directory should not be removed")
+	})
+
+	t.Run("create fails with clear error when path holds a non-empty directory", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		expectedPath := SiblingPath(repoPath, "taken")
+		require.NoError(t, os.MkdirAll(expectedPath, 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(expectedPath, "keep.txt"), []byte("keep"), 0o600))
+
+		info, err := runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName: "taken",
+			BranchType:    "feat",
+		})
+		require.ErrorIs(t, err, atlaserrors.ErrWorktreePathOccupied)
+		assert.Nil(t, info)
+
+		// No branch should have been created for the failed attempt
+		exists, err := runner.BranchExists(context.Background(), "feat/taken")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("create uses expected path when empty leftover directory is cleaned up", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		// Create an empty leftover directory at the expected worktree path
 		expectedPath := SiblingPath(repoPath, "test-ws")
 		err = os.MkdirAll(expectedPath, 0o750)
 		require.NoError(t, err)
 
-		// Create worktree - should cleanup orphaned and use expected path (not -2)
+		// Create worktree - should clear the empty directory and use expected path (not -2)
 		info, err := runner.Create(context.Background(), WorktreeCreateOptions{
 			WorkspaceName: "test-ws",
 			BranchType:    "feat",
@@ -1487,6 +1669,59 @@ func TestGitWorktreeRunner_Create_ExistingBranch(t *testing.T) {
 		_, err = os.Stat(filepath.Join(info.Path, "remote.txt"))
 		assert.NoError(t, err, "worktree should contain remote.txt from remote branch")
 	})
+
+	t.Run("tracks remote branch when TrackRemote is set", func(t *testing.T) {
+		// Create a bare remote repo
+		remoteDir := t.TempDir()
+		runGit(t, remoteDir, "init", "--bare")
+
+		// Clone it
+		localDir := t.TempDir()
+		ctx := context.Background()
+		cmd := exec.CommandContext(ctx, "git", "clone", remoteDir, localDir) // #nosec G204 -- test code
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "clone failed: %s", out)
+
+		// Configure and create initial commit
+		runGit(t, localDir, "config", "user.email", "test@test.com")
+		runGit(t, localDir, "config", "user.name", "Test")
+		readme := filepath.Join(localDir, "README.md")
+		err = os.WriteFile(readme, []byte("# Test"), 0o600)
+		require.NoError(t, err)
+		runGit(t, localDir, "add", ".")
+		runGit(t, localDir, "commit", "-m", "Initial")
+		runGit(t, localDir, "push", "-u", "origin", "master")
+
+		// Create a remote-only branch
+		runGit(t, localDir, "checkout", "-b", "remote-only-branch")
+		remoteFile := filepath.Join(localDir, "remote.txt")
+		err = os.WriteFile(remoteFile, []byte("remote content"), 0o600)
+		require.NoError(t, err)
+		runGit(t, localDir, "add", ".")
+		runGit(t, localDir, "commit", "-m", "Remote commit")
+		runGit(t, localDir, "push", "-u", "origin", "remote-only-branch")
+
+		// Go back to master and delete local branch
+		runGit(t, localDir, "checkout", "master")
+		runGit(t, localDir, "branch", "-D", "remote-only-branch")
+
+		runner, err := NewGitWorktreeRunner(context.Background(), localDir, zerolog.Nop())
+		require.NoError(t, err)
+
+		// Create worktree for the remote-only branch
+		info, err := runner.Create(context.Background(), WorktreeCreateOptions{
+			WorkspaceName:  "hotfix-remote",
+			ExistingBranch: "remote-only-branch",
+			TrackRemote:    "origin",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "remote-only-branch", info.Branch)
+
+		// Verify the local branch was created tracking the remote
+		upstream, err := git.RunCommand(context.Background(), info.Path, "rev-parse", "--abbrev-ref", "@{upstream}")
+		require.NoError(t, err)
+		assert.Equal(t, "origin/remote-only-branch", upstream)
+	})
 }
 
 func TestGitWorktreeRunner_EnsureBranchExists(t *testing.T) {
@@ -1633,3 +1868,222 @@ func TestGitWorktreeRunner_DetachBranch(t *testing.T) {
 		assert.ErrorIs(t, err, context.Canceled)
 	})
 }
+
+func TestGitWorktreeRunner_Inspect(t *testing.T) {
+	t.Run("reports branch and head of a clean worktree", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		runGit(t, repoPath, "checkout", "-b", "feat/inspect")
+
+		status, err := runner.Inspect(context.Background(), repoPath)
+		require.NoError(t, err)
+
+		head, err := git.RunCommand(context.Background(), repoPath, "rev-parse", "HEAD")
+		require.NoError(t, err)
+		assert.Equal(t, "feat/inspect", status.Branch)
+		assert.Equal(t, head, status.HeadCommit)
+		assert.False(t, status.RebaseInProgress)
+		assert.False(t, status.MergeInProgress)
+		assert.False(t, status.Dirty)
+	})
+
+	t.Run("reports uncommitted changes as dirty", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, "scratch.txt"), []byte("wip"), 0o600))
+
+		status, err := runner.Inspect(context.Background(), repoPath)
+		require.NoError(t, err)
+		assert.True(t, status.Dirty)
+	})
+
+	t.Run("reports detached HEAD as empty branch", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		runGit(t, repoPath, "checkout", "--detach")
+
+		status, err := runner.Inspect(context.Background(), repoPath)
+		require.NoError(t, err)
+		assert.Empty(t, status.Branch)
+		assert.NotEmpty(t, status.HeadCommit)
+	})
+
+	t.Run("detects interrupted rebase in a linked worktree", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		wtPath := filepath.Join(t.TempDir(), "wt")
+		runGit(t, repoPath, "worktree", "add", "-b", "feat/rebase", wtPath)
+
+		rebaseDir, err := git.RunCommand(context.Background(), wtPath, "rev-parse", "--git-path", "rebase-merge")
+		require.NoError(t, err)
+		if !filepath.IsAbs(rebaseDir) {
+			rebaseDir = filepath.Join(wtPath, rebaseDir)
+		}
+		require.NoError(t, os.MkdirAll(rebaseDir, 0o750))
+
+		status, err := runner.Inspect(context.Background(), wtPath)
+		require.NoError(t, err)
+		assert.True(t, status.RebaseInProgress)
+		assert.False(t, status.MergeInProgress)
+		assert.False(t, gitPathExists(context.Background(), repoPath, "rebase-merge"))
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = runner.Inspect(ctx, repoPath)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestGitWorktreeRunner_Divergence(t *testing.T) {
+	// commitFile adds a commit touching name on the current branch of dir.
+	commitFile := func(t *testing.T, dir, name string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600))
+		runGit(t, dir, "add", name)
+		runGit(t, dir, "commit", "-m", "add "+name)
+	}
+
+	t.Run("counts commits ahead and behind the base", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		runGit(t, repoPath, "branch", "-M", "base")
+
+		runGit(t, repoPath, "checkout", "-b", "feat/diverged")
+		commitFile(t, repoPath, "a.txt")
+		commitFile(t, repoPath, "b.txt")
+		commitFile(t, repoPath, "c.txt")
+		runGit(t, repoPath, "checkout", "base")
+		commitFile(t, repoPath, "d.txt")
+
+		ahead, behind, err := runner.Divergence(context.Background(), "feat/diverged", "base")
+		require.NoError(t, err)
+		assert.Equal(t, 3, ahead)
+		assert.Equal(t, 1, behind)
+
+		ahead, behind, err = runner.Divergence(context.Background(), "base", "feat/diverged")
+		require.NoError(t, err)
+		assert.Equal(t, 1, ahead)
+		assert.Equal(t, 3, behind)
+	})
+
+	t.Run("reports zero for an up-to-date branch", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		runGit(t, repoPath, "branch", "feat/same")
+
+		ahead, behind, err := runner.Divergence(context.Background(), "feat/same", "HEAD")
+		require.NoError(t, err)
+		assert.Zero(t, ahead)
+		assert.Zero(t, behind)
+	})
+
+	t.Run("missing ref returns ErrBranchNotFound", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		_, _, err = runner.Divergence(context.Background(), "feat/missing", "HEAD")
+		require.ErrorIs(t, err, atlaserrors.ErrBranchNotFound)
+		assert.Contains(t, err.Error(), "feat/missing")
+	})
+
+	t.Run("unrelated histories return ErrUnrelatedHistories", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+		runGit(t, repoPath, "branch", "-M", "base")
+		runGit(t, repoPath, "checkout", "--orphan", "orphan")
+		commitFile(t, repoPath, "orphan.txt")
+
+		_, _, err = runner.Divergence(context.Background(), "orphan", "base")
+		require.ErrorIs(t, err, atlaserrors.ErrUnrelatedHistories)
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		repoPath := createTestRepo(t)
+		runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err = runner.Divergence(ctx, "HEAD", "HEAD")
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestParseLeftRightCount(t *testing.T) {
+	ahead, behind, err := parseLeftRightCount("4\t2\n")
+	require.NoError(t, err)
+	assert.Equal(t, 4, ahead)
+	assert.Equal(t, 2, behind)
+
+	_, _, err = parseLeftRightCount("garbage")
+	require.ErrorIs(t, err, atlaserrors.ErrGitOperation)
+}
+
+func TestGitWorktreeRunner_ListBranches(t *testing.T) {
+	repoPath := createTestRepo(t)
+	runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+	require.NoError(t, err)
+	runGit(t, repoPath, "branch", "fix/one")
+	runGit(t, repoPath, "branch", "feat/two")
+	runGit(t, repoPath, "branch", "fixup")
+
+	branches, err := runner.ListBranches(context.Background(), "fix", "feat/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"fix/one", "feat/two"}, branches)
+
+	all, err := runner.ListBranches(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, all, "fixup")
+}
+
+func TestGitWorktreeRunner_CreatedBranches(t *testing.T) {
+	repoPath := createTestRepo(t)
+	runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+	require.NoError(t, err)
+
+	created, err := runner.CreatedBranches(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, created)
+
+	_, err = runner.Create(context.Background(), WorktreeCreateOptions{WorkspaceName: "auth", BranchType: "feat"})
+	require.NoError(t, err)
+	runGit(t, repoPath, "branch", "fix/user-made")
+	_, err = runner.Create(context.Background(), WorktreeCreateOptions{WorkspaceName: "user", ExistingBranch: "fix/user-made"})
+	require.NoError(t, err)
+
+	created, err = runner.CreatedBranches(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feat/auth"}, created)
+}
+
+func TestGitWorktreeRunner_DeleteBranch_NotMerged(t *testing.T) {
+	repoPath := createTestRepo(t)
+	runner, err := NewGitWorktreeRunner(context.Background(), repoPath, zerolog.Nop())
+	require.NoError(t, err)
+	runGit(t, repoPath, "checkout", "-q", "-b", "fix/unmerged")
+	runGit(t, repoPath, "commit", "-q", "--allow-empty", "-m", "unmerged work")
+	runGit(t, repoPath, "checkout", "-q", "-")
+
+	err = runner.DeleteBranch(context.Background(), "fix/unmerged", false)
+	require.ErrorIs(t, err, atlaserrors.ErrBranchNotMerged)
+
+	exists, err := runner.BranchExists(context.Background(), "fix/unmerged")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}